@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"mars/internal/database"
+	"mars/internal/history"
+	"mars/internal/setup"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// importHistoryUsage is printed when the import-history subcommand is misused.
+const importHistoryUsage = "usage: mars import-history -email <email> <Streaming_History_Audio_*.json>..."
+
+// runImportHistory imports Spotify extended streaming history files for a user.
+func runImportHistory(ctx context.Context, logger *slog.Logger, args []string) error {
+	flags := flag.NewFlagSet("import-history", flag.ContinueOnError)
+	email := flags.String("email", "", "email of the user to import listens for")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *email == "" || flags.NArg() == 0 {
+		return errors.New(importHistoryUsage)
+	}
+
+	_, pool, err := setup.Database(ctx)
+	if err != nil {
+		return fmt.Errorf("setting up database: %w", err)
+	}
+	defer pool.Close()
+
+	user, err := database.New(pool).GetUserByEmail(ctx, *email)
+	if err != nil {
+		return fmt.Errorf("getting user (%s): %w", *email, err)
+	}
+
+	var total history.Result
+	for _, path := range flags.Args() {
+		result, err := importHistoryFile(ctx, pool, user.ID, path)
+		if err != nil {
+			return fmt.Errorf("importing %s: %w", path, err)
+		}
+		logger.Info("imported streaming history file",
+			slog.String("file", path),
+			slog.Int("imported", result.Imported),
+			slog.Int("skipped", result.Skipped),
+			slog.Int("duplicates", result.Duplicates))
+		total.Add(result)
+	}
+
+	logger.Info("imported streaming history",
+		slog.Int("files", flags.NArg()),
+		slog.Int("imported", total.Imported),
+		slog.Int("skipped", total.Skipped),
+		slog.Int("duplicates", total.Duplicates))
+	return nil
+}
+
+// importHistoryFile imports a single file in a transaction.
+func importHistoryFile(ctx context.Context, pool *pgxpool.Pool, userID uuid.UUID, path string) (history.Result, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return history.Result{}, err
+	}
+	defer f.Close()
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return history.Result{}, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	result, err := history.Import(ctx, database.New(tx), userID, f)
+	if err != nil {
+		return result, err
+	}
+	return result, tx.Commit(ctx)
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"mars/internal/mars"
+	"mars/internal/provider"
+	"mars/internal/scheduler"
+)
+
+// playlistGracePeriod is how long after a period ends before its playlist is
+// created, leaving time for the last listens to be synced.
+const playlistGracePeriod = time.Hour
+
+// registerJobs registers the recurring jobs run by the server.
+func registerJobs(sched *scheduler.Scheduler, svc *mars.Service) error {
+	jobs := []scheduler.Job{
+		{
+			// Refresh all user spotify tokens every 30 minutes
+			Name:     "spotify_token_refresh",
+			Schedule: "*/30 * * * *",
+			Run: func(ctx context.Context, _ time.Time) error {
+				return svc.RefreshAllProviderTokens(ctx, provider.Spotify)
+			},
+		},
+		{
+			// Sync spotify tracks for all users every 10 minutes
+			Name:     "spotify_track_sync",
+			Schedule: "*/10 * * * *",
+			Run: func(ctx context.Context, _ time.Time) error {
+				return svc.SyncAllSpotifyPlays(ctx)
+			},
+		},
+		{
+			// Create each user's playlist for their last week once it has ended
+			// in their timezone
+			Name:     "weekly_playlist",
+			Schedule: "0 * * * *",
+			CatchUp:  true,
+			Run: func(ctx context.Context, scheduledAt time.Time) error {
+				return svc.CreateAllPlaylists(ctx, "weekly", scheduledAt.Add(-playlistGracePeriod))
+			},
+		},
+		{
+			// Create each user's playlist for their last month once it has ended
+			// in their timezone
+			Name:     "monthly_playlist",
+			Schedule: "0 * * * *",
+			CatchUp:  true,
+			Run: func(ctx context.Context, scheduledAt time.Time) error {
+				return svc.CreateAllPlaylists(ctx, "monthly", scheduledAt.Add(-playlistGracePeriod))
+			},
+		},
+		{
+			// Create each user's playlist for their last year once it has ended
+			// in their timezone
+			Name:     "yearly_playlist",
+			Schedule: "0 * * * *",
+			CatchUp:  true,
+			Run: func(ctx context.Context, scheduledAt time.Time) error {
+				return svc.CreateAllPlaylists(ctx, "yearly", scheduledAt.Add(-playlistGracePeriod))
+			},
+		},
+		{
+			// Move each user's rolling playlist to their last 30 days once a
+			// day has ended in their timezone
+			Name:     "rolling_playlist",
+			Schedule: "0 * * * *",
+			Run: func(ctx context.Context, scheduledAt time.Time) error {
+				return svc.CreateAllPlaylists(ctx, "rolling_30d", scheduledAt.Add(-playlistGracePeriod))
+			},
+		},
+	}
+
+	for _, job := range jobs {
+		if err := sched.Register(job); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -9,22 +9,33 @@ import (
 	"os/signal"
 	"strconv"
 	"syscall"
-	"time"
 
 	"mars/internal/api"
 	"mars/internal/env"
 	marshttp "mars/internal/http"
 	marslog "mars/internal/log"
 	"mars/internal/mars"
+	"mars/internal/provider"
+	"mars/internal/provider/spotify"
+	"mars/internal/ratelimit"
+	"mars/internal/scheduler"
 	"mars/internal/setup"
 
 	_ "time/tzdata"
 )
 
+const defaultPort uint16 = 8080
+
+// defaultAppURL is where the frontend is served by the docker compose setup.
+const defaultAppURL = "http://localhost"
+
 const (
-	defaultPort              uint16 = 8080
-	spotifyRefreshInterval          = 30 * time.Minute
-	spotifyTrackSyncInterval        = 10 * time.Minute
+	// providerRequestsPerSecond is the steady rate of requests each user may
+	// make to a provider, shared by syncs and exports.
+	providerRequestsPerSecond = 5
+	// providerRequestBurst is the most requests each user may make to a
+	// provider at once.
+	providerRequestBurst = 20
 )
 
 func main() {
@@ -33,6 +44,14 @@ func main() {
 
 	logger := marslog.New(nil)
 
+	// Run a subcommand instead of the server if one is given
+	if len(os.Args) > 1 && os.Args[1] == "import-history" {
+		if err := runImportHistory(ctx, logger, os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	errCh := make(chan error, 1)
 	go func() {
 		errCh <- run(ctx, logger)
@@ -62,7 +81,9 @@ func run(ctx context.Context, logger *slog.Logger) error {
 		return fmt.Errorf("setting up admin: %w", err)
 	}
 
-	serviceEmail, servicePassword, err := setup.ServiceAccount(ctx, db, logger)
+	// Jobs run in-process, but the service account is still seeded so
+	// external tools can call the api as the service
+	_, _, err = setup.ServiceAccount(ctx, db, logger)
 	if err != nil {
 		return fmt.Errorf("setting up service account: %w", err)
 	}
@@ -73,6 +94,33 @@ func run(ctx context.Context, logger *slog.Logger) error {
 	e.Pool = pool
 	e.HTTP = marshttp.New()
 	e.HTTP.Logger = logger
+	spotifyHTTP := marshttp.New()
+	spotifyHTTP.Logger = logger
+	spotifyHTTP.CheckRetry = spotify.RetryPolicy
+	e.SpotifyAccountsURL = os.Getenv("SPOTIFY_ACCOUNTS_URL")
+	e.SpotifyAPIURL = os.Getenv("SPOTIFY_API_URL")
+	e.Providers = provider.NewRegistry(
+		spotify.New(spotify.Config{
+			ClientID:     os.Getenv("SPOTIFY_CLIENT_ID"),
+			ClientSecret: os.Getenv("SPOTIFY_CLIENT_SECRET"),
+			RedirectURI:  os.Getenv("SPOTIFY_REDIRECT_URI"),
+			AccountsURL:  e.SpotifyAccountsURL,
+			APIURL:       e.SpotifyAPIURL,
+		}, spotifyHTTP),
+	)
+	e.RateLimiter = ratelimit.New(providerRequestsPerSecond, providerRequestBurst)
+	e.Mailer, err = setup.Mailer(logger)
+	if err != nil {
+		return fmt.Errorf("setting up mailer: %w", err)
+	}
+	e.AppURL = os.Getenv("APP_URL")
+	if e.AppURL == "" {
+		e.AppURL = defaultAppURL
+	}
+	e.WebAuthn, err = setup.WebAuthn(e.AppURL)
+	if err != nil {
+		return fmt.Errorf("setting up webauthn: %w", err)
+	}
 
 	err = setup.AppSecret(e)
 	if err != nil {
@@ -88,153 +136,16 @@ func run(ctx context.Context, logger *slog.Logger) error {
 		port = uint16(p)
 	}
 
-	// Start Spotify token refresh goroutine using service account
-	go runSpotifyTokenRefresh(ctx, logger, *e.HTTP, serviceEmail, servicePassword)
-
-	// Start Spotify track sync goroutine using service account
-	go runSpotifyTrackSync(ctx, logger, *e.HTTP, serviceEmail, servicePassword)
-
-	// Start weekly playlist goroutine using service account
-	go runCreateWeeklyPlaylist(ctx, logger, *e.HTTP, serviceEmail, servicePassword)
-
-	// Start monthly playlist gorouting using service account
-	go runCreateMonthlyPlaylist(ctx, logger, *e.HTTP, serviceEmail, servicePassword)
-
-	return api.Start(ctx, port, e)
-}
-
-// runSpotifyTokenRefresh waits a specified interval before refreshing all user spotify tokens.
-func runSpotifyTokenRefresh(ctx context.Context, logger *slog.Logger, client marshttp.Client, email, password string) {
-	ticker := time.NewTicker(spotifyRefreshInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			logger.Info("stopping spotify token refresh goroutine")
-			return
-		case <-ticker.C:
-			logger.Info("refreshing spotify tokens")
-			if err := mars.RefreshSpotifyTokens(ctx, client, email, password); err != nil {
-				logger.Error("failed to refresh spotify tokens", "error", err)
-			} else {
-				logger.Info("refreshed spotify tokens")
-			}
-		}
-	}
-}
-
-// runSpotifyTrackSync waits a specified interval before syncing spotify tracks for all users.
-func runSpotifyTrackSync(ctx context.Context, logger *slog.Logger, client marshttp.Client, email, password string) {
-	ticker := time.NewTicker(spotifyTrackSyncInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			logger.Info("stopping spotify track sync goroutine")
-			return
-		case <-ticker.C:
-			logger.Info("syncing spotify tracks")
-			if err := mars.SyncSpotifyTracks(ctx, client, email, password); err != nil {
-				logger.Error("failed to sync spotify tracks", "error", err)
-			} else {
-				logger.Info("synced spotify tracks")
-			}
-		}
-	}
-}
-
-// runCreateWeeklyPlaylist creates weekly playlists for all users every Friday at 5 PM America/New_York time.
-func runCreateWeeklyPlaylist(ctx context.Context, logger *slog.Logger, client marshttp.Client, email, password string) {
-	loc, err := time.LoadLocation("America/New_York")
+	// Start job scheduler
+	sched := scheduler.New(db, logger)
+	err = registerJobs(sched, mars.New(e))
 	if err != nil {
-		log.Fatalf("failed to load timezone: %v", err)
-	}
-
-	daysUntilTarget := func(now time.Time) time.Time {
-		// today at 17:00
-		target := time.Date(
-			now.Year(), now.Month(), now.Day(),
-			17, 0, 0, 0, loc,
-		)
-
-		// days til friday
-		daysUntil := ((int(time.Friday) - int(now.Weekday())) + 7) % 7
-
-		// friday, but we're past target
-		if daysUntil == 0 && now.After(target) {
-			daysUntil = 7
-		}
-
-		return target.AddDate(0, 0, daysUntil)
-	}
-
-	for {
-		now := time.Now().In(loc)
-		next := daysUntilTarget(now)
-		timer := time.NewTimer(time.Until(next))
-
-		select {
-		case <-ctx.Done():
-			timer.Stop()
-			return
-		case <-timer.C:
-			lastWeek := time.Now().In(loc).AddDate(0, 0, -7)
-			logger.Info("creating weekly playlists", slog.Time("date", lastWeek))
-			err = mars.CreatePlaylist(ctx, client, email, password, "weekly", lastWeek.Year(), lastWeek.Month(), lastWeek.Day())
-			if err != nil {
-				logger.Error("failed to create weekly playlist", slog.Any("error", err))
-			} else {
-				logger.Info("created monthly playlists")
-			}
-		}
+		return fmt.Errorf("registering jobs: %w", err)
 	}
-}
-
-// runCreateMonthlyPlaylist creates monthly playlists for all users every first of the month at 5 PM.
-func runCreateMonthlyPlaylist(
-	ctx context.Context, logger *slog.Logger, client marshttp.Client, email, password string,
-) {
-	loc, err := time.LoadLocation("America/New_York")
+	err = sched.Start(ctx)
 	if err != nil {
-		log.Fatalf("failed to load timezone: %v", err)
+		return fmt.Errorf("starting job scheduler: %w", err)
 	}
 
-	daysUntilTarget := func(now time.Time) time.Time {
-		// today at 17:00
-		target := time.Date(
-			now.Year(), now.Month(), now.Day(),
-			17, 0, 0, 0, loc,
-		)
-
-		// past target, lets wait for next month
-		if now.After(target) {
-			return target.AddDate(0, 1, 0)
-		}
-
-		return target
-	}
-
-	for {
-		now := time.Now().In(loc)
-		next := daysUntilTarget(now)
-		timer := time.NewTimer(time.Until(next))
-
-		select {
-		case <-ctx.Done():
-			timer.Stop()
-			return
-		case <-timer.C:
-			lastMonth := time.Now().In(loc).AddDate(0, -1, 0)
-			logger.Info("creating monthly playlists", slog.Time("date", now))
-			err = mars.CreatePlaylist(ctx, client, email, password,
-				"monthly", lastMonth.Year(), lastMonth.Month(), lastMonth.Day())
-			if err != nil {
-				logger.Error("failed to create monthly playlist", slog.Any("error", err))
-			} else {
-				logger.Info("created monthly playlists")
-			}
-		}
-	}
+	return api.Start(ctx, port, e)
 }
@@ -37,6 +37,7 @@ func Start(ctx context.Context, port uint16, env *env.Env) error {
 	router := chi.NewMux()
 	m := middleware.NewMiddleware(env)
 	router.Use(m.AddRequestID)
+	router.Use(m.AddClientInfo)
 	router.Use(m.LogRequest())
 	router.Use(m.Recoverer)
 	router.Use(oapimw.OapiRequestValidatorWithOptions(swagger, &oapimw.Options{
@@ -87,6 +88,8 @@ func Start(ctx context.Context, port uint16, env *env.Env) error {
 		if err := s.Shutdown(shutdownCtx); err != nil && !errors.Is(err, context.Canceled) {
 			return fmt.Errorf("server shutdown: %w", err)
 		}
+		// Finish sending emails requests have started
+		server.Service.Wait()
 		return nil
 
 	case err := <-errCh:
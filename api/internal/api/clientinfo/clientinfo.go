@@ -0,0 +1,28 @@
+// Package clientinfo contains utilities for handling the client making a request.
+package clientinfo
+
+import "context"
+
+// Info describes the client making a request.
+type Info struct {
+	UserAgent string
+	IPAddress string
+}
+
+type clientInfoKeyType struct{}
+
+var clientInfoKey clientInfoKeyType
+
+// WithContext injects a given client info into a context.
+func WithContext(ctx context.Context, info Info) context.Context {
+	return context.WithValue(ctx, clientInfoKey, info)
+}
+
+// FromContext extracts the client info from a context if it exists.
+// If none is found, then an empty Info is returned.
+func FromContext(ctx context.Context) Info {
+	if v, ok := ctx.Value(clientInfoKey).(Info); ok {
+		return v
+	}
+	return Info{}
+}
@@ -12,12 +12,35 @@ const (
 	InvalidCredentials      ErrorCode = "invalid_credentials"
 	InvalidAccessToken      ErrorCode = "invalid_access_token"
 	ExpiredAccessToken      ErrorCode = "expired_access_token"
+	RevokedAccessToken      ErrorCode = "revoked_access_token"
 	InvalidRefreshToken     ErrorCode = "invalid_refresh_token"
 	ExpiredRefreshToken     ErrorCode = "expired_refresh_token"
 	InsufficientPermissions ErrorCode = "insufficient_permissions"
 	NoSpotifyIntegration    ErrorCode = "no_spotify_integration"
 	NoTracksListened        ErrorCode = "no_tracks_listened"
 	PlaylistNotFound        ErrorCode = "playlist_not_found"
+	EmailAlreadyExists      ErrorCode = "email_already_exists"
+	InvalidInviteToken      ErrorCode = "invalid_invite_token"
+	WeakPassword            ErrorCode = "weak_password"
+	InviteNotFound          ErrorCode = "invite_not_found"
+	JobNotFound             ErrorCode = "job_not_found"
+	PlaylistAlreadyExists   ErrorCode = "playlist_already_exists"
+	InvalidTimezone         ErrorCode = "invalid_timezone"
+	UserNotFound            ErrorCode = "user_not_found"
+	InvalidListenToken      ErrorCode = "invalid_listen_token"
+	ListenTokenNotFound     ErrorCode = "listen_token_not_found"
+	InvalidPlaylistRules    ErrorCode = "invalid_playlist_rules"
+	PlaylistNotExported     ErrorCode = "playlist_not_exported"
+	SessionNotFound         ErrorCode = "session_not_found"
+	InvalidResetToken       ErrorCode = "invalid_reset_token"
+	MFAAlreadyEnabled       ErrorCode = "mfa_already_enabled"
+	MFANotEnrolled          ErrorCode = "mfa_not_enrolled"
+	InvalidMFACode          ErrorCode = "invalid_mfa_code"
+	InvalidMFAToken         ErrorCode = "invalid_mfa_token"
+	InvalidPasskey          ErrorCode = "invalid_passkey"
+	PasskeyNotFound         ErrorCode = "passkey_not_found"
+	NoExportableTracks      ErrorCode = "no_exportable_tracks"
+	MFALocked               ErrorCode = "mfa_locked"
 )
 
 var errorCodeToStatusCode = map[ErrorCode]int{
@@ -27,6 +50,7 @@ var errorCodeToStatusCode = map[ErrorCode]int{
 	UnprocessibleEntity:     http.StatusUnprocessableEntity,
 	InvalidAccessToken:      http.StatusUnauthorized,
 	ExpiredAccessToken:      http.StatusUnauthorized,
+	RevokedAccessToken:      http.StatusUnauthorized,
 	InsufficientPermissions: http.StatusForbidden,
 	InvalidRefreshToken:     http.StatusUnauthorized,
 	ExpiredRefreshToken:     http.StatusUnauthorized,
@@ -34,6 +58,28 @@ var errorCodeToStatusCode = map[ErrorCode]int{
 	NoSpotifyIntegration:    http.StatusNotFound,
 	NoTracksListened:        http.StatusConflict,
 	PlaylistNotFound:        http.StatusNotFound,
+	EmailAlreadyExists:      http.StatusConflict,
+	InvalidInviteToken:      http.StatusForbidden,
+	WeakPassword:            http.StatusUnprocessableEntity,
+	InviteNotFound:          http.StatusNotFound,
+	JobNotFound:             http.StatusNotFound,
+	PlaylistAlreadyExists:   http.StatusConflict,
+	InvalidTimezone:         http.StatusBadRequest,
+	UserNotFound:            http.StatusNotFound,
+	InvalidListenToken:      http.StatusUnauthorized,
+	ListenTokenNotFound:     http.StatusNotFound,
+	InvalidPlaylistRules:    http.StatusBadRequest,
+	PlaylistNotExported:     http.StatusNotFound,
+	SessionNotFound:         http.StatusNotFound,
+	InvalidResetToken:       http.StatusForbidden,
+	MFAAlreadyEnabled:       http.StatusConflict,
+	MFANotEnrolled:          http.StatusNotFound,
+	InvalidMFACode:          http.StatusUnauthorized,
+	InvalidMFAToken:         http.StatusUnauthorized,
+	InvalidPasskey:          http.StatusUnauthorized,
+	PasskeyNotFound:         http.StatusNotFound,
+	NoExportableTracks:      http.StatusConflict,
+	MFALocked:               http.StatusTooManyRequests,
 }
 
 func (ec ErrorCode) Status() int {
@@ -8,12 +8,16 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"runtime/debug"
 	"slices"
+	"strings"
 
+	"mars/internal/api/clientinfo"
 	apierror "mars/internal/api/error"
 	"mars/internal/api/requestid"
+	"mars/internal/argon2id"
 	"mars/internal/env"
 	marsjwt "mars/internal/jwt"
 	"mars/internal/log"
@@ -24,10 +28,15 @@ import (
 	"github.com/go-chi/httplog/v3"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	oapimw "github.com/oapi-codegen/nethttp-middleware"
 	"github.com/oklog/ulid/v2"
 )
 
+// ListenTokenSecurityScheme is the security scheme of the
+// ListenBrainz-compatible endpoints.
+const ListenTokenSecurityScheme = "ListenTokenAuth"
+
 type requestidKeyType struct{}
 
 var requestidKey requestidKeyType
@@ -64,6 +73,26 @@ func (m Middleware) AddRequestID(next http.Handler) http.Handler {
 	})
 }
 
+// AddClientInfo adds the user agent and IP address of the client to the
+// request context. The api runs behind a proxy that sets X-Real-IP, so it is
+// preferred over the address of the connection.
+func (m Middleware) AddClientInfo(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := r.Header.Get("X-Real-IP")
+		if ip == "" {
+			ip = r.RemoteAddr
+			if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+				ip = host
+			}
+		}
+		r = r.WithContext(clientinfo.WithContext(r.Context(), clientinfo.Info{
+			UserAgent: r.UserAgent(),
+			IPAddress: ip,
+		}))
+		next.ServeHTTP(w, r)
+	})
+}
+
 // Recoverer recovers from panics and returns a standardized error response.
 func (m Middleware) Recoverer(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -144,12 +173,23 @@ func (m Middleware) OAPIAuthFunc(ctx context.Context, input *openapi3filter.Auth
 		"/api/integrations/spotify/tracks/sync",
 		"/api/integrations/spotify/playlist",
 		"/api/users",
+		"/api/invites",
+		"/api/jobs",
+		"/api/listens/export",
+	}
+	adminRoutePrefixes := []string{
+		"/api/users/",
+		"/api/invites/",
+		"/api/jobs/",
 	}
 	reqid := requestid.FromContext(ctx)
 
 	if input.SecuritySchemeName == "" {
 		return nil
 	}
+	if input.SecuritySchemeName == ListenTokenSecurityScheme {
+		return m.authenticateListenToken(ctx, input)
+	}
 
 	// Get access token
 	var accessToken string
@@ -251,11 +291,56 @@ func (m Middleware) OAPIAuthFunc(ctx context.Context, input *openapi3filter.Auth
 		}
 	}
 
+	// Check the token hasn't been revoked
+	versionClaim, ok := jwtAccess.Claims.(jwt.MapClaims)["ver"].(float64)
+	if !ok {
+		m.Env.Logger.ErrorContext(ctx, "access token has no token version")
+		return &apierror.Error{
+			Code:    apierror.InvalidAccessToken,
+			Status:  apierror.InvalidAccessToken.Status(),
+			Message: "invalid access token",
+			ErrorID: reqid,
+		}
+	}
+	tokenVersion, err := m.Env.Database.GetUserTokenVersion(ctx, userid)
+	if errors.Is(err, pgx.ErrNoRows) {
+		m.Env.Logger.ErrorContext(ctx, "user does not exist", slog.Any("error", err))
+		return &apierror.Error{
+			Code:    apierror.InvalidAccessToken,
+			Status:  apierror.InvalidAccessToken.Status(),
+			Message: "invalid access token",
+			ErrorID: reqid,
+		}
+	} else if err != nil {
+		m.Env.Logger.ErrorContext(ctx, "failed to get token version", slog.Any("error", err))
+		return &apierror.Error{
+			Code:    apierror.InternalServerError,
+			Status:  apierror.InternalServerError.Status(),
+			Message: "internal server error",
+			ErrorID: reqid,
+		}
+	}
+	if int32(versionClaim) != tokenVersion {
+		m.Env.Logger.ErrorContext(ctx, "access token has been revoked",
+			slog.Int("token-version", int(versionClaim)),
+			slog.Int("user-token-version", int(tokenVersion)))
+		return &apierror.Error{
+			Code:    apierror.RevokedAccessToken,
+			Status:  apierror.RevokedAccessToken.Status(),
+			Message: "access token has been revoked",
+			ErrorID: reqid,
+		}
+	}
+
 	// Authorize user
 	roleClaim := jwtAccess.Claims.(jwt.MapClaims)["role"].(string)
 	userRole := role.ToRole(roleClaim)
-	if slices.Contains(adminRoutes, input.RequestValidationInput.Request.URL.Path) &&
-		(userRole != role.RoleAdmin && userRole != role.RoleService) {
+	path := input.RequestValidationInput.Request.URL.Path
+	isAdminRoute := slices.Contains(adminRoutes, path) ||
+		slices.ContainsFunc(adminRoutePrefixes, func(prefix string) bool {
+			return strings.HasPrefix(path, prefix)
+		})
+	if isAdminRoute && (userRole != role.RoleAdmin && userRole != role.RoleService) {
 		return &apierror.Error{
 			Code:    apierror.InsufficientPermissions,
 			Status:  apierror.InsufficientPermissions.Status(),
@@ -268,12 +353,83 @@ func (m Middleware) OAPIAuthFunc(ctx context.Context, input *openapi3filter.Auth
 	r := input.RequestValidationInput.Request
 	r = r.WithContext(log.AppendCtx(r.Context(), slog.String("user-id", userid.String())))
 	r = r.WithContext(tokens.UserIDWithContext(r.Context(), userid))
+	if sid, ok := jwtAccess.Claims.(jwt.MapClaims)["sid"].(string); ok {
+		if sessionid, err := uuid.Parse(sid); err == nil {
+			r = r.WithContext(tokens.SessionIDWithContext(r.Context(), sessionid))
+		}
+	}
 	r = r.WithContext(tokens.AccessTokenWithContext(r.Context(), jwtAccess))
 	*input.RequestValidationInput.Request = *r
 
 	return nil
 }
 
+// authenticateListenToken authenticates requests from players submitting
+// listens with a "Token <token>" authorization header. CSRF tokens aren't
+// checked since players don't use cookies.
+func (m Middleware) authenticateListenToken(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
+	reqid := requestid.FromContext(ctx)
+	invalidToken := &apierror.Error{
+		Code:    apierror.InvalidListenToken,
+		Status:  apierror.InvalidListenToken.Status(),
+		Message: "listen token invalid or missing",
+		ErrorID: reqid,
+	}
+
+	// Parse token
+	authHeader := input.RequestValidationInput.Request.Header.Get(tokens.AuthorizationHeader)
+	listenToken, err := tokens.ParseListenTokenHeader(authHeader)
+	if err != nil {
+		m.Env.Logger.ErrorContext(ctx, "failed to parse authorization header", slog.Any("error", err))
+		return invalidToken
+	}
+	userid, err := tokens.ParseListenToken(listenToken)
+	if err != nil {
+		m.Env.Logger.ErrorContext(ctx, "failed to parse listen token", slog.Any("error", err))
+		return invalidToken
+	}
+
+	// Get token hash
+	stored, err := m.Env.Database.GetListenToken(ctx, userid)
+	if errors.Is(err, pgx.ErrNoRows) {
+		m.Env.Logger.ErrorContext(ctx, "user has no listen token", slog.Any("error", err))
+		return invalidToken
+	} else if err != nil {
+		m.Env.Logger.ErrorContext(ctx, "failed to get listen token", slog.Any("error", err))
+		return &apierror.Error{
+			Code:    apierror.InternalServerError,
+			Status:  apierror.InternalServerError.Status(),
+			Message: "internal server error",
+			ErrorID: reqid,
+		}
+	}
+
+	// Compare tokens
+	argonParams, salt, groundHash, err := argon2id.DecodeHash(stored.TokenHash)
+	if err != nil {
+		m.Env.Logger.ErrorContext(ctx, "failed to decode listen token hash", slog.Any("error", err))
+		return &apierror.Error{
+			Code:    apierror.InternalServerError,
+			Status:  apierror.InternalServerError.Status(),
+			Message: "internal server error",
+			ErrorID: reqid,
+		}
+	}
+	givenHash := argon2id.HashWithSalt(listenToken, *argonParams, salt)
+	if subtle.ConstantTimeCompare(givenHash, groundHash) == 0 {
+		m.Env.Logger.ErrorContext(ctx, "listen tokens do not match")
+		return invalidToken
+	}
+
+	// Store user info in context
+	r := input.RequestValidationInput.Request
+	r = r.WithContext(log.AppendCtx(r.Context(), slog.String("user-id", userid.String())))
+	r = r.WithContext(tokens.UserIDWithContext(r.Context(), userid))
+	*input.RequestValidationInput.Request = *r
+
+	return nil
+}
+
 func validateCSRFHeader(input *openapi3filter.AuthenticationInput) error {
 	csrfHeader := input.RequestValidationInput.Request.Header.Get(tokens.CsrfTokenHeader)
 	if csrfHeader == "" {
@@ -5,10 +5,12 @@ import (
 	"crypto/subtle"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"time"
 
+	"mars/internal/api/clientinfo"
 	apierror "mars/internal/api/error"
 	"mars/internal/api/requestid"
 	"mars/internal/argon2id"
@@ -16,6 +18,7 @@ import (
 	"mars/internal/role"
 	"mars/internal/tokens"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	openapi_types "github.com/oapi-codegen/runtime/types"
@@ -29,6 +32,18 @@ type loginSuccessResponse struct {
 }
 
 func (r loginSuccessResponse) VisitPostApiLoginResponse(w http.ResponseWriter) error {
+	return r.write(w)
+}
+
+func (r loginSuccessResponse) VisitPostApiLoginMfaResponse(w http.ResponseWriter) error {
+	return r.write(w)
+}
+
+func (r loginSuccessResponse) VisitPostApiLoginPasskeyFinishResponse(w http.ResponseWriter) error {
+	return r.write(w)
+}
+
+func (r loginSuccessResponse) write(w http.ResponseWriter) error {
 	http.SetCookie(w, r.accessCookie)
 	http.SetCookie(w, r.refreshCookie)
 	http.SetCookie(w, r.csrfCookie)
@@ -112,12 +127,11 @@ func (s Server) PostApiLogin(ctx context.Context, request PostApiLoginRequestObj
 		}, nil
 	}
 
-	s.Env.Logger.DebugContext(ctx, "creating tokens")
-
-	// Create refresh token
-	refresh, err := tokens.CreateRefreshToken(user.ID)
+	// Require a second factor before starting a session
+	s.Env.Logger.DebugContext(ctx, "checking mfa")
+	mfaEnabled, err := s.Service.TOTPEnabled(ctx, user.ID)
 	if err != nil {
-		s.Env.Logger.ErrorContext(ctx, "failed to create refresh token", slog.Any("error", err))
+		s.Env.Logger.ErrorContext(ctx, "failed to check mfa", slog.Any("error", err))
 		return PostApiLogin500JSONResponse{
 			Message: "Internal Server Error",
 			ErrorId: reqid,
@@ -125,9 +139,28 @@ func (s Server) PostApiLogin(ctx context.Context, request PostApiLoginRequestObj
 			Status:  apierror.InternalServerError.Status(),
 		}, nil
 	}
-	refreshHash, err := argon2id.HashAndEncode(refresh, argon2id.DefaultParams)
+	if mfaEnabled {
+		s.Env.Logger.DebugContext(ctx, "creating mfa challenge")
+		mfaToken, err := s.Service.CreateMFAChallenge(ctx, user.ID)
+		if err != nil {
+			s.Env.Logger.ErrorContext(ctx, "failed to create mfa challenge", slog.Any("error", err))
+			return PostApiLogin500JSONResponse{
+				Message: "Internal Server Error",
+				ErrorId: reqid,
+				Code:    apierror.InternalServerError.String(),
+				Status:  apierror.InternalServerError.Status(),
+			}, nil
+		}
+		return PostApiLogin202JSONResponse{
+			MfaToken:  mfaToken,
+			ExpiresIn: int64(tokens.MFAChallengeDuration().Seconds()),
+		}, nil
+	}
+
+	s.Env.Logger.DebugContext(ctx, "creating tokens")
+	res, err := s.newLoginSession(ctx, user.ID, user.Role, user.TokenVersion)
 	if err != nil {
-		s.Env.Logger.ErrorContext(ctx, "failed to hash refresh token", slog.Any("error", err))
+		s.Env.Logger.ErrorContext(ctx, "failed to create session", slog.Any("error", err))
 		return PostApiLogin500JSONResponse{
 			Message: "Internal Server Error",
 			ErrorId: reqid,
@@ -135,52 +168,58 @@ func (s Server) PostApiLogin(ctx context.Context, request PostApiLoginRequestObj
 			Status:  apierror.InternalServerError.Status(),
 		}, nil
 	}
-	err = s.Env.Database.UpdateUserRefreshToken(ctx, database.UpdateUserRefreshTokenParams{
-		RefreshTokenHash: pgtype.Text{
-			String: refreshHash,
-			Valid:  true,
-		},
-		RefreshTokenExpiresAt: pgtype.Timestamptz{
+	return res, nil
+}
+
+// newLoginSession starts a session for a user who has logged in, and creates
+// the cookies and body of the response.
+func (s Server) newLoginSession(
+	ctx context.Context, userID uuid.UUID, userRole database.Role, tokenVersion int32,
+) (loginSuccessResponse, error) {
+	// Clear out sessions that have expired since the user last logged in
+	err := s.Env.Database.DeleteExpiredUserSessions(ctx, userID)
+	if err != nil {
+		return loginSuccessResponse{}, fmt.Errorf("deleting expired sessions: %w", err)
+	}
+
+	// Create refresh token for a new session
+	sessionid := uuid.New()
+	refresh, err := tokens.CreateRefreshToken(sessionid)
+	if err != nil {
+		return loginSuccessResponse{}, fmt.Errorf("creating refresh token: %w", err)
+	}
+	refreshHash, err := argon2id.HashAndEncode(refresh, argon2id.DefaultParams)
+	if err != nil {
+		return loginSuccessResponse{}, fmt.Errorf("hashing refresh token: %w", err)
+	}
+	client := clientinfo.FromContext(ctx)
+	err = s.Env.Database.CreateSession(ctx, database.CreateSessionParams{
+		ID:               sessionid,
+		UserID:           userID,
+		RefreshTokenHash: refreshHash,
+		UserAgent:        client.UserAgent,
+		IpAddress:        client.IPAddress,
+		ExpiresAt: pgtype.Timestamptz{
 			Time:  time.Now().Add(tokens.RefreshTokenDuration()),
 			Valid: true,
 		},
-		ID: user.ID,
 	})
 	if err != nil {
-		s.Env.Logger.ErrorContext(ctx, "failed to update user refresh token", slog.Any("error", err))
-		return PostApiLogin500JSONResponse{
-			Message: "Internal Server Error",
-			ErrorId: reqid,
-			Code:    apierror.InternalServerError.String(),
-			Status:  apierror.InternalServerError.Status(),
-		}, nil
+		return loginSuccessResponse{}, fmt.Errorf("storing session: %w", err)
 	}
 
 	// Create CSRF token
 	csrf, err := tokens.CreateCSRFToken()
 	if err != nil {
-		s.Env.Logger.ErrorContext(ctx, "failed to create csrf token", slog.Any("error", err))
-		return PostApiLogin500JSONResponse{
-			Message: "Internal Server Error",
-			ErrorId: reqid,
-			Code:    apierror.InternalServerError.String(),
-			Status:  apierror.InternalServerError.Status(),
-		}, nil
+		return loginSuccessResponse{}, fmt.Errorf("creating csrf token: %w", err)
 	}
 
 	// Create access token
-	access, err := tokens.CreateAccessToken(s.Env, user.ID, role.DBToRole(user.Role))
+	access, err := tokens.CreateAccessToken(s.Env, userID, sessionid, role.DBToRole(userRole), tokenVersion)
 	if err != nil {
-		s.Env.Logger.ErrorContext(ctx, "failed to create user access token", slog.Any("error", err))
-		return PostApiLogin500JSONResponse{
-			Message: "Internal Server Error",
-			ErrorId: reqid,
-			Code:    apierror.InternalServerError.String(),
-			Status:  apierror.InternalServerError.Status(),
-		}, nil
+		return loginSuccessResponse{}, fmt.Errorf("creating access token: %w", err)
 	}
 
-	// Return response
 	return loginSuccessResponse{
 		accessCookie:  tokens.NewAccessTokenCookie(access, s.Env.IsProd()),
 		refreshCookie: tokens.NewRefreshTokenCookie(refresh, s.Env.IsProd()),
@@ -218,7 +257,7 @@ func (s Server) PostApiAuthRefresh(ctx context.Context, request PostApiAuthRefre
 
 	// Parse refresh token
 	s.Env.Logger.DebugContext(ctx, "parsing refresh token")
-	userid, err := tokens.ParseRefreshToken(refreshToken)
+	sessionid, err := tokens.ParseRefreshToken(refreshToken)
 	if err != nil {
 		s.Env.Logger.ErrorContext(ctx, "failed to parse refresh token", slog.Any("error", err))
 		return PostApiAuthRefresh401JSONResponse{
@@ -229,11 +268,11 @@ func (s Server) PostApiAuthRefresh(ctx context.Context, request PostApiAuthRefre
 		}, nil
 	}
 
-	// Get current refresh token
-	s.Env.Logger.DebugContext(ctx, "getting user refresh token from db")
-	refresh, err := s.Env.Database.GetUserRefreshToken(ctx, userid)
+	// Get session
+	s.Env.Logger.DebugContext(ctx, "getting session from db")
+	session, err := s.Env.Database.GetSession(ctx, sessionid)
 	if errors.Is(err, pgx.ErrNoRows) {
-		s.Env.Logger.ErrorContext(ctx, "failed to find user", slog.Any("error", err))
+		s.Env.Logger.ErrorContext(ctx, "session does not exist", slog.Any("error", err))
 		return PostApiAuthRefresh401JSONResponse{
 			Status:  apierror.InvalidRefreshToken.Status(),
 			Code:    apierror.InvalidRefreshToken.String(),
@@ -241,6 +280,7 @@ func (s Server) PostApiAuthRefresh(ctx context.Context, request PostApiAuthRefre
 			ErrorId: reqid,
 		}, nil
 	} else if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get session", slog.Any("error", err))
 		return PostApiAuthRefresh500JSONResponse{
 			Status:  apierror.InternalServerError.Status(),
 			Code:    apierror.InternalServerError.String(),
@@ -248,8 +288,11 @@ func (s Server) PostApiAuthRefresh(ctx context.Context, request PostApiAuthRefre
 			ErrorId: reqid,
 		}, nil
 	}
-	if !refresh.RefreshTokenHash.Valid {
-		s.Env.Logger.ErrorContext(ctx, "user does not have a refresh token", slog.Any("error", err))
+	if session.ExpiresAt.Time.Before(time.Now()) {
+		s.Env.Logger.ErrorContext(ctx, "session has expired")
+		if err := s.Env.Database.DeleteSession(ctx, sessionid); err != nil {
+			s.Env.Logger.ErrorContext(ctx, "failed to delete expired session", slog.Any("error", err))
+		}
 		return PostApiAuthRefresh401JSONResponse{
 			Status:  apierror.InvalidRefreshToken.Status(),
 			Code:    apierror.InvalidRefreshToken.String(),
@@ -257,19 +300,34 @@ func (s Server) PostApiAuthRefresh(ctx context.Context, request PostApiAuthRefre
 			ErrorId: reqid,
 		}, nil
 	}
-	if refresh.RefreshTokenExpiresAt.Time.Before(time.Now()) {
-		s.Env.Logger.ErrorContext(ctx, "refresh token has expired")
+	userid := session.UserID
+	revokeSession := func() PostApiAuthRefreshResponseObject {
+		// Refresh tokens can only be used once. A session presented with
+		// an old token has leaked, so it is revoked and every device using
+		// it will need to log in again.
+		s.Env.Logger.WarnContext(ctx, "refresh token reused, revoking session",
+			slog.String("session-id", sessionid.String()),
+			slog.String("user-id", userid.String()))
+		if err := s.Env.Database.DeleteSession(ctx, sessionid); err != nil {
+			s.Env.Logger.ErrorContext(ctx, "failed to revoke session", slog.Any("error", err))
+			return PostApiAuthRefresh500JSONResponse{
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				Message: "internal server error",
+				ErrorId: reqid,
+			}
+		}
 		return PostApiAuthRefresh401JSONResponse{
 			Status:  apierror.InvalidRefreshToken.Status(),
 			Code:    apierror.InvalidRefreshToken.String(),
 			Message: "invalid refresh token",
 			ErrorId: reqid,
-		}, nil
+		}
 	}
 
 	// Compare tokens
 	s.Env.Logger.DebugContext(ctx, "comparing refresh tokens")
-	argonParams, salt, groundRefreshHash, err := argon2id.DecodeHash(refresh.RefreshTokenHash.String)
+	argonParams, salt, groundRefreshHash, err := argon2id.DecodeHash(session.RefreshTokenHash)
 	if err != nil {
 		s.Env.Logger.ErrorContext(ctx, "failed to decode refresh token hash", slog.Any("error", err))
 		return PostApiAuthRefresh500JSONResponse{
@@ -281,18 +339,12 @@ func (s Server) PostApiAuthRefresh(ctx context.Context, request PostApiAuthRefre
 	}
 	givenRefreshHash := argon2id.HashWithSalt(refreshToken, *argonParams, salt)
 	if subtle.ConstantTimeCompare(givenRefreshHash, groundRefreshHash) == 0 {
-		s.Env.Logger.ErrorContext(ctx, "refresh tokens do not match", slog.Any("error", err))
-		return PostApiAuthRefresh401JSONResponse{
-			Status:  apierror.InvalidRefreshToken.Status(),
-			Code:    apierror.InvalidRefreshToken.String(),
-			Message: "invalid refresh token",
-			ErrorId: reqid,
-		}, nil
+		return revokeSession(), nil
 	}
 
-	// Get user role
+	// Get user role and token version
 	s.Env.Logger.DebugContext(ctx, "getting user role")
-	userrole, err := s.Env.Database.GetUserRole(ctx, userid)
+	user, err := s.Env.Database.GetUserAuth(ctx, userid)
 	if err != nil {
 		s.Env.Logger.ErrorContext(ctx, "failed to get user role", slog.Any("error", err))
 		return PostApiAuthRefresh500JSONResponse{
@@ -303,9 +355,9 @@ func (s Server) PostApiAuthRefresh(ctx context.Context, request PostApiAuthRefre
 		}, nil
 	}
 
-	// Create new token
-	s.Env.Logger.DebugContext(ctx, "creating new tokens")
-	newToken, err := tokens.CreateRefreshToken(userid)
+	// Rotate refresh token
+	s.Env.Logger.DebugContext(ctx, "rotating refresh token")
+	newToken, err := tokens.CreateRefreshToken(sessionid)
 	if err != nil {
 		s.Env.Logger.ErrorContext(ctx, "failed to create new refresh token", slog.Any("error", err))
 		return PostApiAuthRefresh500JSONResponse{
@@ -325,19 +377,20 @@ func (s Server) PostApiAuthRefresh(ctx context.Context, request PostApiAuthRefre
 			ErrorId: reqid,
 		}, nil
 	}
-	err = s.Env.Database.UpdateUserRefreshToken(ctx, database.UpdateUserRefreshTokenParams{
-		RefreshTokenHash: pgtype.Text{
-			String: newTokenHash,
-			Valid:  true,
-		},
-		RefreshTokenExpiresAt: pgtype.Timestamptz{
+	client := clientinfo.FromContext(ctx)
+	rotated, err := s.Env.Database.RotateSession(ctx, database.RotateSessionParams{
+		NewRefreshTokenHash: newTokenHash,
+		UserAgent:           client.UserAgent,
+		IpAddress:           client.IPAddress,
+		ExpiresAt: pgtype.Timestamptz{
 			Time:  time.Now().Add(tokens.RefreshTokenDuration()),
 			Valid: true,
 		},
-		ID: userid,
+		ID:               sessionid,
+		RefreshTokenHash: session.RefreshTokenHash,
 	})
 	if err != nil {
-		s.Env.Logger.ErrorContext(ctx, "failed to update refresh token", slog.Any("error", err))
+		s.Env.Logger.ErrorContext(ctx, "failed to rotate refresh token", slog.Any("error", err))
 		return PostApiAuthRefresh500JSONResponse{
 			Status:  apierror.InternalServerError.Status(),
 			Code:    apierror.InternalServerError.String(),
@@ -345,6 +398,11 @@ func (s Server) PostApiAuthRefresh(ctx context.Context, request PostApiAuthRefre
 			ErrorId: reqid,
 		}, nil
 	}
+	if rotated == 0 {
+		// Another request rotated the token since it was read, so the same
+		// token was used twice
+		return revokeSession(), nil
+	}
 
 	csrf, err := tokens.CreateCSRFToken()
 	if err != nil {
@@ -357,7 +415,7 @@ func (s Server) PostApiAuthRefresh(ctx context.Context, request PostApiAuthRefre
 		}, nil
 	}
 
-	access, err := tokens.CreateAccessToken(s.Env, userid, role.DBToRole(userrole))
+	access, err := tokens.CreateAccessToken(s.Env, userid, sessionid, role.DBToRole(user.Role), user.TokenVersion)
 	if err != nil {
 		s.Env.Logger.ErrorContext(ctx, "failed to create user access token", slog.Any("error", err))
 		return PostApiAuthRefresh500JSONResponse{
@@ -381,6 +439,99 @@ func (s Server) PostApiAuthRefresh(ctx context.Context, request PostApiAuthRefre
 	}, nil
 }
 
+type logoutSuccessResponse struct {
+	accessCookie  *http.Cookie
+	refreshCookie *http.Cookie
+	csrfCookie    *http.Cookie
+}
+
+func (r logoutSuccessResponse) VisitPostApiLogoutResponse(w http.ResponseWriter) error {
+	http.SetCookie(w, r.accessCookie)
+	http.SetCookie(w, r.refreshCookie)
+	http.SetCookie(w, r.csrfCookie)
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s Server) PostApiLogout(ctx context.Context, request PostApiLogoutRequestObject) (
+	PostApiLogoutResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+	loggedOut := logoutSuccessResponse{
+		accessCookie:  tokens.NewExpiredCookie(tokens.AccessTokenName, s.Env.IsProd()),
+		refreshCookie: tokens.NewExpiredCookie(tokens.RefreshTokenName, s.Env.IsProd()),
+		csrfCookie:    tokens.NewExpiredCookie(tokens.CsrfTokenName, s.Env.IsProd()),
+	}
+
+	// Get refresh token
+	s.Env.Logger.DebugContext(ctx, "getting refresh token")
+	var refreshToken string
+	if request.Body != nil && request.Body.RefreshToken != nil {
+		refreshToken = *request.Body.RefreshToken
+	} else if request.Params.Refresh != nil {
+		refreshToken = *request.Params.Refresh
+	}
+	if refreshToken == "" {
+		s.Env.Logger.DebugContext(ctx, "refresh token not provided, clearing cookies")
+		return loggedOut, nil
+	}
+	sessionid, err := tokens.ParseRefreshToken(refreshToken)
+	if err != nil {
+		s.Env.Logger.DebugContext(ctx, "failed to parse refresh token, clearing cookies", slog.Any("error", err))
+		return loggedOut, nil
+	}
+
+	// Get session
+	s.Env.Logger.DebugContext(ctx, "getting session from db")
+	session, err := s.Env.Database.GetSession(ctx, sessionid)
+	if errors.Is(err, pgx.ErrNoRows) {
+		s.Env.Logger.DebugContext(ctx, "session does not exist, clearing cookies")
+		return loggedOut, nil
+	} else if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get session", slog.Any("error", err))
+		return PostApiLogout500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "internal server error",
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Only the holder of the current refresh token can end the session
+	s.Env.Logger.DebugContext(ctx, "comparing refresh tokens")
+	argonParams, salt, groundRefreshHash, err := argon2id.DecodeHash(session.RefreshTokenHash)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to decode refresh token hash", slog.Any("error", err))
+		return PostApiLogout500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "internal server error",
+			ErrorId: reqid,
+		}, nil
+	}
+	givenRefreshHash := argon2id.HashWithSalt(refreshToken, *argonParams, salt)
+	if subtle.ConstantTimeCompare(givenRefreshHash, groundRefreshHash) == 0 {
+		s.Env.Logger.DebugContext(ctx, "refresh tokens do not match, clearing cookies")
+		return loggedOut, nil
+	}
+
+	// Delete session
+	s.Env.Logger.DebugContext(ctx, "deleting session")
+	if err := s.Env.Database.DeleteSession(ctx, sessionid); err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to delete session", slog.Any("error", err))
+		return PostApiLogout500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "internal server error",
+			ErrorId: reqid,
+		}, nil
+	}
+
+	s.Env.Logger.InfoContext(ctx, "logged out", slog.String("user-id", session.UserID.String()))
+
+	return loggedOut, nil
+}
+
 func (s Server) GetApiAuthVerify(
 	ctx context.Context, request GetApiAuthVerifyRequestObject,
 ) (GetApiAuthVerifyResponseObject, error) {
@@ -0,0 +1,165 @@
+package openapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apierror "mars/internal/api/error"
+	"mars/internal/argon2id"
+	"mars/internal/database"
+	"mars/internal/database/databasetest"
+	"mars/internal/provider/spotify/spotifytest"
+	"mars/internal/tokens"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// sessionTest is a server with a user who has logged in once.
+type sessionTest struct {
+	server    Server
+	db        *databasetest.Querier
+	sessionID uuid.UUID
+	// refreshToken is the refresh token issued when the user logged in.
+	refreshToken string
+}
+
+func newSessionTest(t *testing.T) sessionTest {
+	e, _, db := spotifytest.NewEnv(t)
+	e.Set("APP_SECRET", "test-secret")
+
+	userID := uuid.New()
+	db.UserAuth[userID] = database.GetUserAuthRow{Role: database.RoleUser}
+	sessionID := uuid.New()
+	token, err := tokens.CreateRefreshToken(sessionID)
+	if err != nil {
+		t.Fatalf("CreateRefreshToken() = %v", err)
+	}
+	hash, err := argon2id.HashAndEncode(token, argon2id.DefaultParams)
+	if err != nil {
+		t.Fatalf("HashAndEncode() = %v", err)
+	}
+	db.Sessions[sessionID] = database.GetSessionRow{
+		UserID:           userID,
+		RefreshTokenHash: hash,
+		ExpiresAt: pgtype.Timestamptz{
+			Time:  time.Now().Add(tokens.RefreshTokenDuration()),
+			Valid: true,
+		},
+	}
+	return sessionTest{
+		server:       NewServer(e),
+		db:           db,
+		sessionID:    sessionID,
+		refreshToken: token,
+	}
+}
+
+func (e sessionTest) refresh(t *testing.T, token string) PostApiAuthRefreshResponseObject {
+	t.Helper()
+	res, err := e.server.PostApiAuthRefresh(context.Background(), PostApiAuthRefreshRequestObject{
+		Body: &PostApiAuthRefreshJSONRequestBody{RefreshToken: &token},
+	})
+	if err != nil {
+		t.Fatalf("PostApiAuthRefresh() = %v", err)
+	}
+	return res
+}
+
+// wantInvalidRefreshToken checks a refresh was rejected and the session is
+// gone.
+func (e sessionTest) wantInvalidRefreshToken(t *testing.T, res PostApiAuthRefreshResponseObject) {
+	t.Helper()
+	got, ok := res.(PostApiAuthRefresh401JSONResponse)
+	if !ok {
+		t.Fatalf("PostApiAuthRefresh() = %T, want %T", res, PostApiAuthRefresh401JSONResponse{})
+	}
+	if got.Code != apierror.InvalidRefreshToken.String() {
+		t.Errorf("PostApiAuthRefresh() code = %q, want %q", got.Code, apierror.InvalidRefreshToken)
+	}
+	if _, ok := e.db.Sessions[e.sessionID]; ok {
+		t.Error("session still exists, want it revoked")
+	}
+}
+
+func TestPostApiAuthRefreshRotatesToken(t *testing.T) {
+	e := newSessionTest(t)
+
+	res := e.refresh(t, e.refreshToken)
+	got, ok := res.(refreshSessionSuccessResponse)
+	if !ok {
+		t.Fatalf("PostApiAuthRefresh() = %T, want %T", res, refreshSessionSuccessResponse{})
+	}
+	rotated := got.refreshCookie.Value
+	if rotated == e.refreshToken {
+		t.Fatal("PostApiAuthRefresh() returned the same refresh token, want a new one")
+	}
+	if got.body.AccessToken == "" {
+		t.Error("PostApiAuthRefresh() returned no access token")
+	}
+	if id, err := tokens.ParseRefreshToken(rotated); err != nil || id != e.sessionID {
+		t.Errorf("new refresh token is for session %v (%v), want %v", id, err, e.sessionID)
+	}
+
+	// The new token keeps working, once each time
+	res = e.refresh(t, rotated)
+	if _, ok := res.(refreshSessionSuccessResponse); !ok {
+		t.Fatalf("PostApiAuthRefresh() with rotated token = %T, want %T", res, refreshSessionSuccessResponse{})
+	}
+}
+
+func TestPostApiAuthRefreshReusedTokenRevokesSession(t *testing.T) {
+	e := newSessionTest(t)
+
+	res := e.refresh(t, e.refreshToken)
+	got, ok := res.(refreshSessionSuccessResponse)
+	if !ok {
+		t.Fatalf("PostApiAuthRefresh() = %T, want %T", res, refreshSessionSuccessResponse{})
+	}
+
+	// Presenting the old token again means it leaked, so the whole session
+	// is revoked and the token issued in its place stops working too
+	e.wantInvalidRefreshToken(t, e.refresh(t, e.refreshToken))
+	res = e.refresh(t, got.refreshCookie.Value)
+	if _, ok := res.(PostApiAuthRefresh401JSONResponse); !ok {
+		t.Errorf("PostApiAuthRefresh() with rotated token after reuse = %T, want %T",
+			res, PostApiAuthRefresh401JSONResponse{})
+	}
+}
+
+// racingQuerier rotates a session's token right after it is read, as if
+// another request used the same token at the same time.
+type racingQuerier struct {
+	*databasetest.Querier
+}
+
+func (q racingQuerier) GetSession(ctx context.Context, id uuid.UUID) (database.GetSessionRow, error) {
+	row, err := q.Querier.GetSession(ctx, id)
+	if err != nil {
+		return row, err
+	}
+	_, err = q.Querier.RotateSession(ctx, database.RotateSessionParams{
+		NewRefreshTokenHash: "rotated",
+		ExpiresAt:           row.ExpiresAt,
+		ID:                  id,
+		RefreshTokenHash:    row.RefreshTokenHash,
+	})
+	return row, err
+}
+
+func TestPostApiAuthRefreshConcurrentReuseRevokesSession(t *testing.T) {
+	e := newSessionTest(t)
+	e.server.Env.Database = racingQuerier{e.db}
+
+	e.wantInvalidRefreshToken(t, e.refresh(t, e.refreshToken))
+}
+
+func TestPostApiAuthRefreshExpiredSession(t *testing.T) {
+	e := newSessionTest(t)
+	session := e.db.Sessions[e.sessionID]
+	session.ExpiresAt.Time = time.Now().Add(-time.Minute)
+	e.db.Sessions[e.sessionID] = session
+
+	e.wantInvalidRefreshToken(t, e.refresh(t, e.refreshToken))
+}
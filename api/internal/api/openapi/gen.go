@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"strings"
@@ -24,6 +25,7 @@ import (
 
 const (
 	BearerTokenAuthScopes = "BearerTokenAuth.Scopes"
+	ListenTokenAuthScopes = "ListenTokenAuth.Scopes"
 )
 
 // Defines values for CustomRequestType.
@@ -31,18 +33,96 @@ const (
 	Custom CustomRequestType = "custom"
 )
 
+// Defines values for ExportFormat.
+const (
+	Csv   ExportFormat = "csv"
+	Jsonl ExportFormat = "jsonl"
+)
+
+// Defines values for ListenType.
+const (
+	Import     ListenType = "import"
+	PlayingNow ListenType = "playing_now"
+	Single     ListenType = "single"
+)
+
+// Defines values for Period.
+const (
+	Day   Period = "day"
+	Month Period = "month"
+	Week  Period = "week"
+	Year  Period = "year"
+)
+
+// Defines values for PlaylistOrdering.
+const (
+	PlaylistOrderingChronological PlaylistOrdering = "chronological"
+	PlaylistOrderingFirstHeard    PlaylistOrdering = "first_heard"
+	PlaylistOrderingPlays         PlaylistOrdering = "plays"
+)
+
+// Defines values for RankBy.
+const (
+	RankByPlays RankBy = "plays"
+	RankByTime  RankBy = "time"
+)
+
 // Defines values for Role.
 const (
 	RoleAdmin Role = "admin"
 	RoleUser  Role = "user"
 )
 
+// Defines values for Weekday.
+const (
+	Friday    Weekday = "friday"
+	Monday    Weekday = "monday"
+	Saturday  Weekday = "saturday"
+	Sunday    Weekday = "sunday"
+	Thursday  Weekday = "thursday"
+	Tuesday   Weekday = "tuesday"
+	Wednesday Weekday = "wednesday"
+)
+
 // Defines values for WeeklyOrMonthlyRequestType.
 const (
-	Monthly WeeklyOrMonthlyRequestType = "monthly"
-	Weekly  WeeklyOrMonthlyRequestType = "weekly"
+	Monthly    WeeklyOrMonthlyRequestType = "monthly"
+	Rolling30d WeeklyOrMonthlyRequestType = "rolling_30d"
+	Weekly     WeeklyOrMonthlyRequestType = "weekly"
+	Yearly     WeeklyOrMonthlyRequestType = "yearly"
 )
 
+// ChangePasswordRequest defines model for ChangePasswordRequest.
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+// ConfirmPasswordResetRequest defines model for ConfirmPasswordResetRequest.
+type ConfirmPasswordResetRequest struct {
+	NewPassword string `json:"new_password"`
+
+	// Token Token from the password reset email.
+	Token string `json:"token"`
+}
+
+// CreateInviteRequest defines model for CreateInviteRequest.
+type CreateInviteRequest struct {
+	// Email If set, the invite can only be redeemed with this email.
+	Email *openapi_types.Email `json:"email,omitempty"`
+
+	// ExpiresIn Invite lifetime in seconds - defaults to 7 days.
+	ExpiresIn *int64 `json:"expires_in,omitempty"`
+}
+
+// CreateInviteResponse defines model for CreateInviteResponse.
+type CreateInviteResponse struct {
+	Email     *openapi_types.Email `json:"email,omitempty"`
+	ExpiresAt time.Time            `json:"expires_at"`
+	Id        openapi_types.UUID   `json:"id"`
+	Token     string               `json:"token"`
+}
+
 // CreatePlaylistRequest defines model for CreatePlaylistRequest.
 type CreatePlaylistRequest struct {
 	PlaylistId openapi_types.UUID `json:"playlist_id"`
@@ -85,6 +165,79 @@ type Error struct {
 	Status  int    `json:"status"`
 }
 
+// ExportFormat defines model for ExportFormat.
+type ExportFormat string
+
+// ImportListensResponse defines model for ImportListensResponse.
+type ImportListensResponse struct {
+	// Duplicates Number of listens that were already stored.
+	Duplicates int `json:"duplicates"`
+
+	// Files Number of files imported.
+	Files int `json:"files"`
+
+	// Imported Number of new listens stored.
+	Imported int `json:"imported"`
+
+	// Skipped Number of entries that are not track listens.
+	Skipped int `json:"skipped"`
+}
+
+// Invite defines model for Invite.
+type Invite struct {
+	CreatedAt time.Time            `json:"created_at"`
+	CreatedBy *openapi_types.UUID  `json:"created_by,omitempty"`
+	Email     *openapi_types.Email `json:"email,omitempty"`
+	ExpiresAt time.Time            `json:"expires_at"`
+	Id        openapi_types.UUID   `json:"id"`
+	UsedAt    *time.Time           `json:"used_at,omitempty"`
+	UsedBy    *openapi_types.UUID  `json:"used_by,omitempty"`
+}
+
+// Job defines model for Job.
+type Job struct {
+	// LastError Error of the last run, if it failed
+	LastError     *string    `json:"last_error,omitempty"`
+	LastRunAt     *time.Time `json:"last_run_at,omitempty"`
+	LastSuccessAt *time.Time `json:"last_success_at,omitempty"`
+	Name          string     `json:"name"`
+	NextRunAt     time.Time  `json:"next_run_at"`
+	Paused        bool       `json:"paused"`
+
+	// Schedule Five-field cron expression
+	Schedule string `json:"schedule"`
+	Timezone string `json:"timezone"`
+
+	// TriggeredAt Set when a manual run has been requested but not yet started
+	TriggeredAt *time.Time `json:"triggered_at,omitempty"`
+}
+
+// ListInvitesResponse defines model for ListInvitesResponse.
+type ListInvitesResponse struct {
+	Invites []Invite `json:"invites"`
+}
+
+// ListJobsResponse defines model for ListJobsResponse.
+type ListJobsResponse struct {
+	Jobs []Job `json:"jobs"`
+}
+
+// ListListensResponse defines model for ListListensResponse.
+type ListListensResponse struct {
+	Listens []Listen `json:"listens"`
+
+	// NextCursor Cursor of the next page. Omitted on the last page.
+	NextCursor *string `json:"next_cursor,omitempty"`
+
+	// Total Number of listens matching the filters.
+	Total int64 `json:"total"`
+}
+
+// ListPasskeysResponse defines model for ListPasskeysResponse.
+type ListPasskeysResponse struct {
+	Passkeys []Passkey `json:"passkeys"`
+}
+
 // ListPlaylistItem defines model for ListPlaylistItem.
 type ListPlaylistItem struct {
 	CreatedAt time.Time          `json:"created_at"`
@@ -98,11 +251,64 @@ type ListPlaylists struct {
 	Playlists []ListPlaylistItem `json:"playlists"`
 }
 
+// ListSessionsResponse defines model for ListSessionsResponse.
+type ListSessionsResponse struct {
+	Sessions []Session `json:"sessions"`
+}
+
 // ListUsersResponse defines model for ListUsersResponse.
 type ListUsersResponse struct {
 	Ids *[]openapi_types.UUID `json:"ids,omitempty"`
 }
 
+// Listen defines model for Listen.
+type Listen struct {
+	PlayedAt time.Time `json:"played_at"`
+	Track    Track     `json:"track"`
+}
+
+// ListenBrainzStatus defines model for ListenBrainzStatus.
+type ListenBrainzStatus struct {
+	Status string `json:"status"`
+}
+
+// ListenTokenResponse defines model for ListenTokenResponse.
+type ListenTokenResponse struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Token Token to submit listens with. Only returned once.
+	Token string `json:"token"`
+}
+
+// ListenType defines model for ListenType.
+type ListenType string
+
+// ListeningStats defines model for ListeningStats.
+type ListeningStats struct {
+	// CurrentStreak Consecutive days with at least one listen.
+	CurrentStreak *Streak   `json:"current_streak,omitempty"`
+	End           time.Time `json:"end"`
+
+	// Heatmap Plays by day of the week and hour of the day. There are 7 rows of 24 hours, starting on Sunday.
+	Heatmap [][]int64 `json:"heatmap"`
+
+	// LongestStreak Consecutive days with at least one listen.
+	LongestStreak *Streak `json:"longest_streak,omitempty"`
+
+	// NewArtists Artists listened to for the first time within the range.
+	NewArtists int64 `json:"new_artists"`
+
+	// NewTracks Tracks listened to for the first time within the range.
+	NewTracks int64     `json:"new_tracks"`
+	Start     time.Time `json:"start"`
+
+	// Timezone Timezone days and hours are computed in.
+	Timezone      string `json:"timezone"`
+	TotalPlays    int64  `json:"total_plays"`
+	UniqueArtists int64  `json:"unique_artists"`
+	UniqueTracks  int64  `json:"unique_tracks"`
+}
+
 // LoginRequest defines model for LoginRequest.
 type LoginRequest struct {
 	Email    openapi_types.Email `json:"email"`
@@ -119,13 +325,108 @@ type LoginResponse struct {
 	TokenType string `json:"token_type"`
 }
 
-// Playlist defines model for Playlist.
-type Playlist struct {
+// MFAChallenge defines model for MFAChallenge.
+type MFAChallenge struct {
+	// ExpiresIn MFA token lifetime in seconds.
+	ExpiresIn int64 `json:"expires_in"`
+
+	// MfaToken Token to complete the login with at /api/login/mfa.
+	MfaToken string `json:"mfa_token"`
+}
+
+// MFACodeRequest defines model for MFACodeRequest.
+type MFACodeRequest struct {
+	// Code TOTP code or recovery code.
+	Code string `json:"code"`
+}
+
+// MFALoginRequest defines model for MFALoginRequest.
+type MFALoginRequest struct {
+	// Code TOTP code or recovery code.
+	Code string `json:"code"`
+
+	// MfaToken Token returned by /api/login.
+	MfaToken string `json:"mfa_token"`
+}
+
+// MFAStatus defines model for MFAStatus.
+type MFAStatus struct {
+	RecoveryCodesRemaining int64 `json:"recovery_codes_remaining"`
+	TotpEnabled            bool  `json:"totp_enabled"`
+}
+
+// MonthInReview defines model for MonthInReview.
+type MonthInReview struct {
+	MinutesListened float64 `json:"minutes_listened"`
+	Month           int     `json:"month"`
+	Plays           int64   `json:"plays"`
+}
+
+// Passkey defines model for Passkey.
+type Passkey struct {
 	CreatedAt time.Time          `json:"created_at"`
 	Id        openapi_types.UUID `json:"id"`
-	Name      string             `json:"name"`
-	Tracks    []PlaylistTrack    `json:"tracks"`
-	Type      string             `json:"type"`
+
+	// LastUsedAt When the passkey was last used to log in. Unset if it never has been.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	Name       string     `json:"name"`
+}
+
+// PasskeyCeremony defines model for PasskeyCeremony.
+type PasskeyCeremony struct {
+	// Options WebAuthn options, in the form PublicKeyCredential.parseCreationOptionsFromJSON() or PublicKeyCredential.parseRequestOptionsFromJSON() expects under "publicKey".
+	Options map[string]interface{} `json:"options"`
+
+	// SessionId ID of the registration or login, to send back when finishing it.
+	SessionId openapi_types.UUID `json:"session_id"`
+}
+
+// PasskeyLoginRequest defines model for PasskeyLoginRequest.
+type PasskeyLoginRequest struct {
+	// Credential The PublicKeyCredential from navigator.credentials.get(), as JSON.
+	Credential map[string]interface{} `json:"credential"`
+	SessionId  openapi_types.UUID     `json:"session_id"`
+}
+
+// PasswordResetRequest defines model for PasswordResetRequest.
+type PasswordResetRequest struct {
+	Email openapi_types.Email `json:"email"`
+}
+
+// Period defines model for Period.
+type Period string
+
+// Playlist defines model for Playlist.
+type Playlist struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Description Summary of the playlist's period and plays, also used as the description of exported playlists. Empty for older playlists.
+	Description     string             `json:"description"`
+	Id              openapi_types.UUID `json:"id"`
+	Name            string             `json:"name"`
+	SpotifyPlaylist *SpotifyPlaylist   `json:"spotify_playlist,omitempty"`
+	Tracks          []PlaylistTrack    `json:"tracks"`
+	Type            string             `json:"type"`
+}
+
+// PlaylistOrdering Order of tracks within a playlist. Tracks are always chosen by play count, then ordered by plays, by when the user first ever heard them, or by their first play within the period.
+type PlaylistOrdering string
+
+// PlaylistRules defines model for PlaylistRules.
+type PlaylistRules struct {
+	MaxTracks int `json:"max_tracks"`
+
+	// MaxTracksPerArtist Most tracks by any one artist. 0 means no limit.
+	MaxTracksPerArtist int `json:"max_tracks_per_artist"`
+
+	// MinPlays Fewest plays within the period for a track to be included.
+	MinPlays int `json:"min_plays"`
+
+	// NameTemplate Playlist name. {year}, {yy}, {month}, {mon}, {mm}, {day}, {dd}, {week} and {type} are replaced using the start of the playlist's period.
+	NameTemplate string `json:"name_template"`
+
+	// Ordering Order of tracks within a playlist. Tracks are always chosen by play count, then ordered by plays, by when the user first ever heard them, or by their first play within the period.
+	Ordering PlaylistOrdering `json:"ordering"`
 }
 
 // PlaylistTrack defines model for PlaylistTrack.
@@ -134,8 +435,27 @@ type PlaylistTrack struct {
 	Href     string   `json:"href"`
 	Id       string   `json:"id"`
 	ImageUrl *string  `json:"image_url,omitempty"`
-	Name     string   `json:"name"`
-	Plays    int      `json:"plays"`
+
+	// MinutesListened Total time spent listening to the track, rounded to a tenth of a minute. Omitted if the length of the track is unknown.
+	MinutesListened *float64 `json:"minutes_listened,omitempty"`
+	Name            string   `json:"name"`
+	Plays           int      `json:"plays"`
+}
+
+// Preferences defines model for Preferences.
+type Preferences struct {
+	// Timezone IANA timezone name
+	Timezone  string  `json:"timezone"`
+	WeekStart Weekday `json:"week_start"`
+}
+
+// RankBy defines model for RankBy.
+type RankBy string
+
+// RecoveryCodes defines model for RecoveryCodes.
+type RecoveryCodes struct {
+	// RecoveryCodes Single-use codes, each written as two groups of 8 characters.
+	RecoveryCodes []string `json:"recovery_codes"`
 }
 
 // RefreshToken defines model for RefreshToken.
@@ -144,9 +464,47 @@ type RefreshToken struct {
 	RefreshToken *string `json:"refresh_token,omitempty"`
 }
 
+// RegisterPasskeyRequest defines model for RegisterPasskeyRequest.
+type RegisterPasskeyRequest struct {
+	// Credential The PublicKeyCredential from navigator.credentials.create(), as JSON.
+	Credential map[string]interface{} `json:"credential"`
+
+	// Name Name to tell the passkey apart by. Defaults to "Passkey".
+	Name      *string            `json:"name,omitempty"`
+	SessionId openapi_types.UUID `json:"session_id"`
+}
+
+// RegisterRequest defines model for RegisterRequest.
+type RegisterRequest struct {
+	Email openapi_types.Email `json:"email"`
+
+	// InviteToken Invite token issued by an admin.
+	InviteToken *string `json:"invite_token,omitempty"`
+	Password    string  `json:"password"`
+}
+
 // Role defines model for Role.
 type Role string
 
+// Session defines model for Session.
+type Session struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Current Whether this is the session making the request
+	Current   bool               `json:"current"`
+	ExpiresAt time.Time          `json:"expires_at"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// IpAddress IP address the session was last used from
+	IpAddress string `json:"ip_address"`
+
+	// LastUsedAt When the session was last logged in or refreshed
+	LastUsedAt time.Time `json:"last_used_at"`
+
+	// UserAgent User agent of the device the session was last used from
+	UserAgent string `json:"user_agent"`
+}
+
 // SpotifyOAuth defines model for SpotifyOAuth.
 type SpotifyOAuth struct {
 	ClientId     string `json:"client_id"`
@@ -171,16 +529,134 @@ type SpotifyStatusResponse struct {
 	Connected bool `json:"connected"`
 }
 
+// SpotifySyncStatus defines model for SpotifySyncStatus.
+type SpotifySyncStatus struct {
+	// Cursor Time of the most recent synced play.
+	Cursor *time.Time `json:"cursor,omitempty"`
+
+	// ItemsFetched Plays fetched during the last successful sync.
+	ItemsFetched int `json:"items_fetched"`
+
+	// ItemsInserted New plays stored during the last successful sync.
+	ItemsInserted int        `json:"items_inserted"`
+	LastError     *string    `json:"last_error,omitempty"`
+	LastErrorAt   *time.Time `json:"last_error_at,omitempty"`
+	LastSuccessAt *time.Time `json:"last_success_at,omitempty"`
+
+	// Pages Pages fetched during the last successful sync.
+	Pages int `json:"pages"`
+}
+
 // SpotifyTokenRequest defines model for SpotifyTokenRequest.
 type SpotifyTokenRequest struct {
 	Code string `json:"code"`
 }
 
+// Streak Consecutive days with at least one listen.
+type Streak struct {
+	Days  int                `json:"days"`
+	End   openapi_types.Date `json:"end"`
+	Start openapi_types.Date `json:"start"`
+}
+
+// SubmitListensRequest defines model for SubmitListensRequest.
+type SubmitListensRequest struct {
+	ListenType ListenType        `json:"listen_type"`
+	Payload    []SubmittedListen `json:"payload"`
+}
+
+// SubmittedListen defines model for SubmittedListen.
+type SubmittedListen struct {
+	// ListenedAt Unix timestamp of the listen. Omitted for playing now submissions.
+	ListenedAt    *int64        `json:"listened_at,omitempty"`
+	TrackMetadata TrackMetadata `json:"track_metadata"`
+}
+
 // SyncSpotifyTracksRequest defines model for SyncSpotifyTracksRequest.
 type SyncSpotifyTracksRequest struct {
 	UserId openapi_types.UUID `json:"user_id"`
 }
 
+// TOTPEnrollment defines model for TOTPEnrollment.
+type TOTPEnrollment struct {
+	// ProvisioningUri otpauth:// URI to show as a QR code.
+	ProvisioningUri string `json:"provisioning_uri"`
+
+	// Secret Base32 TOTP secret, for entering into authenticator apps by hand.
+	Secret string `json:"secret"`
+}
+
+// TopAlbum defines model for TopAlbum.
+type TopAlbum struct {
+	Artists  []string `json:"artists"`
+	Href     string   `json:"href"`
+	Id       string   `json:"id"`
+	ImageUrl *string  `json:"image_url,omitempty"`
+	Name     string   `json:"name"`
+	Plays    int      `json:"plays"`
+}
+
+// TopArtist defines model for TopArtist.
+type TopArtist struct {
+	Href     string  `json:"href"`
+	Id       string  `json:"id"`
+	ImageUrl *string `json:"image_url,omitempty"`
+	Name     string  `json:"name"`
+	Plays    int     `json:"plays"`
+}
+
+// Track defines model for Track.
+type Track struct {
+	Artists  []string `json:"artists"`
+	Href     string   `json:"href"`
+	Id       string   `json:"id"`
+	ImageUrl *string  `json:"image_url,omitempty"`
+	Name     string   `json:"name"`
+	Uri      string   `json:"uri"`
+}
+
+// TrackMetadata defines model for TrackMetadata.
+type TrackMetadata struct {
+	AdditionalInfo *TrackMetadata_AdditionalInfo `json:"additional_info,omitempty"`
+	ArtistName     string                        `json:"artist_name"`
+	ReleaseName    *string                       `json:"release_name,omitempty"`
+	TrackName      string                        `json:"track_name"`
+}
+
+// TrackMetadata_AdditionalInfo defines model for TrackMetadata.AdditionalInfo.
+type TrackMetadata_AdditionalInfo struct {
+	DurationMs    *int    `json:"duration_ms,omitempty"`
+	OriginUrl     *string `json:"origin_url,omitempty"`
+	RecordingMbid *string `json:"recording_mbid,omitempty"`
+
+	// SpotifyId Spotify track URL, e.g. https://open.spotify.com/track/<id>
+	SpotifyId            *string                `json:"spotify_id,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// UpdateJobRequest defines model for UpdateJobRequest.
+type UpdateJobRequest struct {
+	Paused bool `json:"paused"`
+}
+
+// UpdatePlaylistRulesRequest defines model for UpdatePlaylistRulesRequest.
+type UpdatePlaylistRulesRequest struct {
+	MaxTracks          *int    `json:"max_tracks,omitempty"`
+	MaxTracksPerArtist *int    `json:"max_tracks_per_artist,omitempty"`
+	MinPlays           *int    `json:"min_plays,omitempty"`
+	NameTemplate       *string `json:"name_template,omitempty"`
+
+	// Ordering Order of tracks within a playlist. Tracks are always chosen by play count, then ordered by plays, by when the user first ever heard them, or by their first play within the period.
+	Ordering *PlaylistOrdering `json:"ordering,omitempty"`
+}
+
+// UpdatePreferencesRequest defines model for UpdatePreferencesRequest.
+type UpdatePreferencesRequest struct {
+	// Timezone IANA timezone name
+	Timezone  *string  `json:"timezone,omitempty"`
+	WeekStart *Weekday `json:"week_start,omitempty"`
+}
+
 // User defines model for User.
 type User struct {
 	Email openapi_types.Email `json:"email"`
@@ -188,9 +664,22 @@ type User struct {
 	Role  Role                `json:"role"`
 }
 
+// ValidateListenTokenResponse defines model for ValidateListenTokenResponse.
+type ValidateListenTokenResponse struct {
+	Code     int    `json:"code"`
+	Message  string `json:"message"`
+	UserName string `json:"user_name"`
+	Valid    bool   `json:"valid"`
+}
+
+// Weekday defines model for Weekday.
+type Weekday string
+
 // WeeklyOrMonthlyRequest defines model for WeeklyOrMonthlyRequest.
 type WeeklyOrMonthlyRequest struct {
-	StartDate DateParts                  `json:"start_date"`
+	// AsOf Create the playlist for the most recent period that ended at or before this time. Only used when start_date is omitted. Defaults to now.
+	AsOf      *time.Time                 `json:"as_of,omitempty"`
+	StartDate *DateParts                 `json:"start_date,omitempty"`
 	Type      WeeklyOrMonthlyRequestType `json:"type"`
 	UserId    openapi_types.UUID         `json:"user_id"`
 }
@@ -198,12 +687,52 @@ type WeeklyOrMonthlyRequest struct {
 // WeeklyOrMonthlyRequestType defines model for WeeklyOrMonthlyRequest.Type.
 type WeeklyOrMonthlyRequestType string
 
+// YearInReview defines model for YearInReview.
+type YearInReview struct {
+	// LongestStreak Consecutive days with at least one listen.
+	LongestStreak *Streak `json:"longest_streak,omitempty"`
+
+	// MinutesListened Time spent listening to tracks of known length.
+	MinutesListened float64 `json:"minutes_listened"`
+
+	// Months Plays in each month of the year, January first.
+	Months     []MonthInReview `json:"months"`
+	NewArtists int64           `json:"new_artists"`
+	NewTracks  int64           `json:"new_tracks"`
+
+	// PlaylistId The yearly playlist, if it has been created.
+	PlaylistId    *openapi_types.UUID `json:"playlist_id,omitempty"`
+	Timezone      string              `json:"timezone"`
+	TopAlbums     []TopAlbum          `json:"top_albums"`
+	TopArtists    []TopArtist         `json:"top_artists"`
+	TopTracks     []PlaylistTrack     `json:"top_tracks"`
+	TotalPlays    int64               `json:"total_plays"`
+	UniqueArtists int64               `json:"unique_artists"`
+	UniqueTracks  int64               `json:"unique_tracks"`
+	Year          int                 `json:"year"`
+}
+
 // AccessTokenHeader defines model for AccessTokenHeader.
 type AccessTokenHeader = string
 
 // CsrfTokenHeader defines model for CsrfTokenHeader.
 type CsrfTokenHeader = string
 
+// ExportEnd defines model for ExportEnd.
+type ExportEnd = time.Time
+
+// ExportStart defines model for ExportStart.
+type ExportStart = time.Time
+
+// RangeEnd defines model for RangeEnd.
+type RangeEnd = int64
+
+// RangePeriod defines model for RangePeriod.
+type RangePeriod = Period
+
+// RangeStart defines model for RangeStart.
+type RangeStart = int64
+
 // RefreshTokenCookie defines model for RefreshTokenCookie.
 type RefreshTokenCookie = string
 
@@ -233,6 +762,18 @@ type PostApiIntegrationsSpotifyPlaylistParams struct {
 	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
+// DeleteApiIntegrationsSpotifyPlaylistIdParams defines parameters for DeleteApiIntegrationsSpotifyPlaylistId.
+type DeleteApiIntegrationsSpotifyPlaylistIdParams struct {
+	// DeleteRemote Also remove the playlist from spotify
+	DeleteRemote *bool `form:"delete_remote,omitempty" json:"delete_remote,omitempty"`
+
+	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
+	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
+
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
+}
+
 // PostApiIntegrationsSpotifyPlaylistIdParams defines parameters for PostApiIntegrationsSpotifyPlaylistId.
 type PostApiIntegrationsSpotifyPlaylistIdParams struct {
 	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
@@ -251,26 +792,23 @@ type PostApiIntegrationsSpotifyTracksSyncParams struct {
 	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-// GetApiMePlaylistsParams defines parameters for GetApiMePlaylists.
-type GetApiMePlaylistsParams struct {
+// GetApiInvitesParams defines parameters for GetApiInvites.
+type GetApiInvitesParams struct {
 	// Access Access token
 	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-// GetApiMeTracksTopParams defines parameters for GetApiMeTracksTop.
-type GetApiMeTracksTopParams struct {
-	// Start Start of time range (unix time) - defaults to 24 hours ago.
-	Start *int64 `form:"start,omitempty" json:"start,omitempty"`
-
-	// End End of time range (unix time) - defaults to now.
-	End *int64 `form:"end,omitempty" json:"end,omitempty"`
+// PostApiInvitesParams defines parameters for PostApiInvites.
+type PostApiInvitesParams struct {
+	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
+	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
 
 	// Access Access token
 	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-// PostApiOauthSpotifyTokenRefreshParams defines parameters for PostApiOauthSpotifyTokenRefresh.
-type PostApiOauthSpotifyTokenRefreshParams struct {
+// DeleteApiInvitesIdParams defines parameters for DeleteApiInvitesId.
+type DeleteApiInvitesIdParams struct {
 	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
 	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
 
@@ -278,8 +816,14 @@ type PostApiOauthSpotifyTokenRefreshParams struct {
 	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-// PostApiPlaylistsParams defines parameters for PostApiPlaylists.
-type PostApiPlaylistsParams struct {
+// GetApiJobsParams defines parameters for GetApiJobs.
+type GetApiJobsParams struct {
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
+}
+
+// PatchApiJobsNameParams defines parameters for PatchApiJobsName.
+type PatchApiJobsNameParams struct {
 	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
 	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
 
@@ -287,436 +831,893 @@ type PostApiPlaylistsParams struct {
 	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-// GetApiPlaylistsIdParams defines parameters for GetApiPlaylistsId.
-type GetApiPlaylistsIdParams struct {
+// PostApiJobsNameTriggerParams defines parameters for PostApiJobsNameTrigger.
+type PostApiJobsNameTriggerParams struct {
+	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
+	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
+
 	// Access Access token
 	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-// GetApiUsersParams defines parameters for GetApiUsers.
-type GetApiUsersParams struct {
-	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+// GetApiListensExportParams defines parameters for GetApiListensExport.
+type GetApiListensExportParams struct {
+	// Format Export format. Defaults to csv.
+	Format *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Start Only export listens played at or after this time.
+	Start *ExportStart `form:"start,omitempty" json:"start,omitempty"`
+
+	// End Only export listens played before this time.
+	End *ExportEnd `form:"end,omitempty" json:"end,omitempty"`
 
 	// Access Access token
 	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-// PostApiAuthRefreshJSONRequestBody defines body for PostApiAuthRefresh for application/json ContentType.
-type PostApiAuthRefreshJSONRequestBody = RefreshToken
+// PostApiLogoutParams defines parameters for PostApiLogout.
+type PostApiLogoutParams struct {
+	// Refresh Refresh token
+	Refresh *RefreshTokenCookie `form:"refresh,omitempty" json:"refresh,omitempty"`
+}
 
-// PostApiIntegrationsSpotifyPlaylistJSONRequestBody defines body for PostApiIntegrationsSpotifyPlaylist for application/json ContentType.
-type PostApiIntegrationsSpotifyPlaylistJSONRequestBody = CreatePlaylistRequest
+// GetApiMeAlbumsTopParams defines parameters for GetApiMeAlbumsTop.
+type GetApiMeAlbumsTopParams struct {
+	// Start Start of time range (unix time) - defaults to 24 hours ago.
+	Start *RangeStart `form:"start,omitempty" json:"start,omitempty"`
 
-// PostApiIntegrationsSpotifyTracksSyncJSONRequestBody defines body for PostApiIntegrationsSpotifyTracksSync for application/json ContentType.
-type PostApiIntegrationsSpotifyTracksSyncJSONRequestBody = SyncSpotifyTracksRequest
+	// End End of time range (unix time) - defaults to now.
+	End *RangeEnd `form:"end,omitempty" json:"end,omitempty"`
 
-// PostApiLoginJSONRequestBody defines body for PostApiLogin for application/json ContentType.
-type PostApiLoginJSONRequestBody = LoginRequest
+	// Period Start the time range at the beginning of the current day, week, month or year in the user's timezone. Ignored if start is given.
+	Period *RangePeriod `form:"period,omitempty" json:"period,omitempty"`
 
-// PostApiOauthSpotifyTokenJSONRequestBody defines body for PostApiOauthSpotifyToken for application/json ContentType.
-type PostApiOauthSpotifyTokenJSONRequestBody = SpotifyTokenRequest
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
+}
 
-// PostApiOauthSpotifyTokenRefreshJSONRequestBody defines body for PostApiOauthSpotifyTokenRefresh for application/json ContentType.
-type PostApiOauthSpotifyTokenRefreshJSONRequestBody = SpotifyRefreshTokenRequest
+// GetApiMeArtistsTopParams defines parameters for GetApiMeArtistsTop.
+type GetApiMeArtistsTopParams struct {
+	// Start Start of time range (unix time) - defaults to 24 hours ago.
+	Start *RangeStart `form:"start,omitempty" json:"start,omitempty"`
 
-// PostApiPlaylistsJSONRequestBody defines body for PostApiPlaylists for application/json ContentType.
-type PostApiPlaylistsJSONRequestBody = DateRangeRequest
+	// End End of time range (unix time) - defaults to now.
+	End *RangeEnd `form:"end,omitempty" json:"end,omitempty"`
 
-// AsWeeklyOrMonthlyRequest returns the union data inside the DateRangeRequest as a WeeklyOrMonthlyRequest
-func (t DateRangeRequest) AsWeeklyOrMonthlyRequest() (WeeklyOrMonthlyRequest, error) {
-	var body WeeklyOrMonthlyRequest
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+	// Period Start the time range at the beginning of the current day, week, month or year in the user's timezone. Ignored if start is given.
+	Period *RangePeriod `form:"period,omitempty" json:"period,omitempty"`
+
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-// FromWeeklyOrMonthlyRequest overwrites any union data inside the DateRangeRequest as the provided WeeklyOrMonthlyRequest
-func (t *DateRangeRequest) FromWeeklyOrMonthlyRequest(v WeeklyOrMonthlyRequest) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+// DeleteApiMeListenTokenParams defines parameters for DeleteApiMeListenToken.
+type DeleteApiMeListenTokenParams struct {
+	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
+	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
+
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-// MergeWeeklyOrMonthlyRequest performs a merge with any union data inside the DateRangeRequest, using the provided WeeklyOrMonthlyRequest
-func (t *DateRangeRequest) MergeWeeklyOrMonthlyRequest(v WeeklyOrMonthlyRequest) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+// PostApiMeListenTokenParams defines parameters for PostApiMeListenToken.
+type PostApiMeListenTokenParams struct {
+	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
+	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
 
-	merged, err := runtime.JSONMerge(t.union, b)
-	t.union = merged
-	return err
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-// AsCustomRequest returns the union data inside the DateRangeRequest as a CustomRequest
-func (t DateRangeRequest) AsCustomRequest() (CustomRequest, error) {
-	var body CustomRequest
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+// GetApiMeListensParams defines parameters for GetApiMeListens.
+type GetApiMeListensParams struct {
+	// Limit Maximum number of listens to return - defaults to 50.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Cursor of the page to return, from the next_cursor of the previous page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Artist Only return listens of tracks by an artist whose name contains this text, ignoring case.
+	Artist *string `form:"artist,omitempty" json:"artist,omitempty"`
+
+	// Track Only return listens of tracks whose name contains this text, ignoring case.
+	Track *string `form:"track,omitempty" json:"track,omitempty"`
+
+	// Start Start of time range (unix time).
+	Start *int64 `form:"start,omitempty" json:"start,omitempty"`
+
+	// End End of time range (unix time).
+	End *int64 `form:"end,omitempty" json:"end,omitempty"`
+
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-// FromCustomRequest overwrites any union data inside the DateRangeRequest as the provided CustomRequest
-func (t *DateRangeRequest) FromCustomRequest(v CustomRequest) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+// GetApiMeListensExportParams defines parameters for GetApiMeListensExport.
+type GetApiMeListensExportParams struct {
+	// Format Export format. Defaults to csv.
+	Format *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Start Only export listens played at or after this time.
+	Start *ExportStart `form:"start,omitempty" json:"start,omitempty"`
+
+	// End Only export listens played before this time.
+	End *ExportEnd `form:"end,omitempty" json:"end,omitempty"`
+
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-// MergeCustomRequest performs a merge with any union data inside the DateRangeRequest, using the provided CustomRequest
-func (t *DateRangeRequest) MergeCustomRequest(v CustomRequest) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+// PostApiMeListensImportMultipartBody defines parameters for PostApiMeListensImport.
+type PostApiMeListensImportMultipartBody struct {
+	Files []openapi_types.File `json:"files"`
+}
 
-	merged, err := runtime.JSONMerge(t.union, b)
-	t.union = merged
-	return err
+// PostApiMeListensImportParams defines parameters for PostApiMeListensImport.
+type PostApiMeListensImportParams struct {
+	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
+	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
+
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-func (t DateRangeRequest) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
+// GetApiMeMfaParams defines parameters for GetApiMeMfa.
+type GetApiMeMfaParams struct {
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-func (t *DateRangeRequest) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	return err
+// DeleteApiMeMfaTotpParams defines parameters for DeleteApiMeMfaTotp.
+type DeleteApiMeMfaTotpParams struct {
+	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
+	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
+
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+// PostApiMeMfaTotpParams defines parameters for PostApiMeMfaTotp.
+type PostApiMeMfaTotpParams struct {
+	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
+	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+// PostApiMeMfaTotpVerifyParams defines parameters for PostApiMeMfaTotpVerify.
+type PostApiMeMfaTotpVerifyParams struct {
+	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
+	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
+}
 
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
+// GetApiMePasskeysParams defines parameters for GetApiMePasskeys.
+type GetApiMePasskeysParams struct {
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+// PostApiMePasskeysRegisterBeginParams defines parameters for PostApiMePasskeysRegisterBegin.
+type PostApiMePasskeysRegisterBeginParams struct {
+	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
+	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
-		Server: server,
-	}
-	// mutate client and add all optional params
-	for _, o := range opts {
-		if err := o(&client); err != nil {
-			return nil, err
-		}
-	}
-	// ensure the server URL always has a trailing slash
-	if !strings.HasSuffix(client.Server, "/") {
-		client.Server += "/"
-	}
-	// create httpClient, if not already present
-	if client.Client == nil {
-		client.Client = &http.Client{}
-	}
-	return &client, nil
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
-	return func(c *Client) error {
-		c.Client = doer
-		return nil
-	}
+// PostApiMePasskeysRegisterFinishParams defines parameters for PostApiMePasskeysRegisterFinish.
+type PostApiMePasskeysRegisterFinishParams struct {
+	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
+	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
+
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-// WithRequestEditorFn allows setting up a callback function, which will be
-// called right before sending the request. This can be used to mutate the request.
-func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
-	return func(c *Client) error {
-		c.RequestEditors = append(c.RequestEditors, fn)
-		return nil
-	}
+// DeleteApiMePasskeysIdParams defines parameters for DeleteApiMePasskeysId.
+type DeleteApiMePasskeysIdParams struct {
+	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
+	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
+
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-// The interface specification for the client above.
-type ClientInterface interface {
-	// PostApiAuthRefreshWithBody request with any body
-	PostApiAuthRefreshWithBody(ctx context.Context, params *PostApiAuthRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostApiMePasswordParams defines parameters for PostApiMePassword.
+type PostApiMePasswordParams struct {
+	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
+	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
 
-	PostApiAuthRefresh(ctx context.Context, params *PostApiAuthRefreshParams, body PostApiAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
+}
 
-	// GetApiAuthVerify request
-	GetApiAuthVerify(ctx context.Context, params *GetApiAuthVerifyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// GetApiMePlaylistRulesParams defines parameters for GetApiMePlaylistRules.
+type GetApiMePlaylistRulesParams struct {
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
+}
 
-	// GetApiHealth request
-	GetApiHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PatchApiMePlaylistRulesParams defines parameters for PatchApiMePlaylistRules.
+type PatchApiMePlaylistRulesParams struct {
+	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
+	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
 
-	// PostApiIntegrationsSpotifyPlaylistWithBody request with any body
-	PostApiIntegrationsSpotifyPlaylistWithBody(ctx context.Context, params *PostApiIntegrationsSpotifyPlaylistParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
+}
 
-	PostApiIntegrationsSpotifyPlaylist(ctx context.Context, params *PostApiIntegrationsSpotifyPlaylistParams, body PostApiIntegrationsSpotifyPlaylistJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// GetApiMePlaylistsParams defines parameters for GetApiMePlaylists.
+type GetApiMePlaylistsParams struct {
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
+}
 
-	// PostApiIntegrationsSpotifyPlaylistId request
-	PostApiIntegrationsSpotifyPlaylistId(ctx context.Context, id openapi_types.UUID, params *PostApiIntegrationsSpotifyPlaylistIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// GetApiMePreferencesParams defines parameters for GetApiMePreferences.
+type GetApiMePreferencesParams struct {
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
+}
 
-	// PostApiIntegrationsSpotifyTracksSyncWithBody request with any body
-	PostApiIntegrationsSpotifyTracksSyncWithBody(ctx context.Context, params *PostApiIntegrationsSpotifyTracksSyncParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PatchApiMePreferencesParams defines parameters for PatchApiMePreferences.
+type PatchApiMePreferencesParams struct {
+	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
+	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
 
-	PostApiIntegrationsSpotifyTracksSync(ctx context.Context, params *PostApiIntegrationsSpotifyTracksSyncParams, body PostApiIntegrationsSpotifyTracksSyncJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
+}
 
-	// PostApiLoginWithBody request with any body
-	PostApiLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// GetApiMeSessionsParams defines parameters for GetApiMeSessions.
+type GetApiMeSessionsParams struct {
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
+}
 
-	PostApiLogin(ctx context.Context, body PostApiLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// DeleteApiMeSessionsIdParams defines parameters for DeleteApiMeSessionsId.
+type DeleteApiMeSessionsIdParams struct {
+	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
+	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
 
-	// GetApiMePlaylists request
-	GetApiMePlaylists(ctx context.Context, params *GetApiMePlaylistsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
+}
 
-	// GetApiMeTracksTop request
-	GetApiMeTracksTop(ctx context.Context, params *GetApiMeTracksTopParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// GetApiMeStatsParams defines parameters for GetApiMeStats.
+type GetApiMeStatsParams struct {
+	// Start Start of time range (unix time) - defaults to 24 hours ago.
+	Start *RangeStart `form:"start,omitempty" json:"start,omitempty"`
 
-	// GetApiOauthSpotifyConfigJson request
-	GetApiOauthSpotifyConfigJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// End End of time range (unix time) - defaults to now.
+	End *RangeEnd `form:"end,omitempty" json:"end,omitempty"`
 
-	// PostApiOauthSpotifyTokenWithBody request with any body
-	PostApiOauthSpotifyTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Period Start the time range at the beginning of the current day, week, month or year in the user's timezone. Ignored if start is given.
+	Period *RangePeriod `form:"period,omitempty" json:"period,omitempty"`
 
-	PostApiOauthSpotifyToken(ctx context.Context, body PostApiOauthSpotifyTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
+}
 
-	// PostApiOauthSpotifyTokenRefreshWithBody request with any body
-	PostApiOauthSpotifyTokenRefreshWithBody(ctx context.Context, params *PostApiOauthSpotifyTokenRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// GetApiMeTracksTopParams defines parameters for GetApiMeTracksTop.
+type GetApiMeTracksTopParams struct {
+	// Start Start of time range (unix time) - defaults to 24 hours ago.
+	Start *RangeStart `form:"start,omitempty" json:"start,omitempty"`
 
-	PostApiOauthSpotifyTokenRefresh(ctx context.Context, params *PostApiOauthSpotifyTokenRefreshParams, body PostApiOauthSpotifyTokenRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// End End of time range (unix time) - defaults to now.
+	End *RangeEnd `form:"end,omitempty" json:"end,omitempty"`
 
-	// GetApiOpenapiYaml request
-	GetApiOpenapiYaml(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Period Start the time range at the beginning of the current day, week, month or year in the user's timezone. Ignored if start is given.
+	Period *RangePeriod `form:"period,omitempty" json:"period,omitempty"`
 
-	// PostApiPlaylistsWithBody request with any body
-	PostApiPlaylistsWithBody(ctx context.Context, params *PostApiPlaylistsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// RankBy Rank tracks by number of plays or by total time listened. Defaults to plays. Tracks of unknown length rank last by time.
+	RankBy *RankBy `form:"rank_by,omitempty" json:"rank_by,omitempty"`
 
-	PostApiPlaylists(ctx context.Context, params *PostApiPlaylistsParams, body PostApiPlaylistsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
+}
 
-	// GetApiPlaylistsId request
-	GetApiPlaylistsId(ctx context.Context, id openapi_types.UUID, params *GetApiPlaylistsIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// GetApiMeYearInReviewYearParams defines parameters for GetApiMeYearInReviewYear.
+type GetApiMeYearInReviewYearParams struct {
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
+}
 
-	// GetApiSpotifyStatus request
-	GetApiSpotifyStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostApiOauthSpotifyTokenRefreshParams defines parameters for PostApiOauthSpotifyTokenRefresh.
+type PostApiOauthSpotifyTokenRefreshParams struct {
+	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
+	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
 
-	// GetApiUsers request
-	GetApiUsers(ctx context.Context, params *GetApiUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-func (c *Client) PostApiAuthRefreshWithBody(ctx context.Context, params *PostApiAuthRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiAuthRefreshRequestWithBody(c.Server, params, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// PostApiPlaylistsParams defines parameters for PostApiPlaylists.
+type PostApiPlaylistsParams struct {
+	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
+	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
+
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-func (c *Client) PostApiAuthRefresh(ctx context.Context, params *PostApiAuthRefreshParams, body PostApiAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiAuthRefreshRequest(c.Server, params, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// GetApiPlaylistsIdParams defines parameters for GetApiPlaylistsId.
+type GetApiPlaylistsIdParams struct {
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-func (c *Client) GetApiAuthVerify(ctx context.Context, params *GetApiAuthVerifyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiAuthVerifyRequest(c.Server, params)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// GetApiSpotifySyncParams defines parameters for GetApiSpotifySync.
+type GetApiSpotifySyncParams struct {
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-func (c *Client) GetApiHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiHealthRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// GetApiUsersParams defines parameters for GetApiUsers.
+type GetApiUsersParams struct {
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-func (c *Client) PostApiIntegrationsSpotifyPlaylistWithBody(ctx context.Context, params *PostApiIntegrationsSpotifyPlaylistParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiIntegrationsSpotifyPlaylistRequestWithBody(c.Server, params, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// PostApiUsersIdSignOutParams defines parameters for PostApiUsersIdSignOut.
+type PostApiUsersIdSignOutParams struct {
+	// XCSRFToken CSRF token required when authenticating via cookies. Must match the CSRF cookie value.
+	XCSRFToken *CsrfTokenHeader `json:"X-CSRF-Token,omitempty"`
+
+	// Access Access token
+	Access *AccessTokenHeader `form:"access,omitempty" json:"access,omitempty"`
 }
 
-func (c *Client) PostApiIntegrationsSpotifyPlaylist(ctx context.Context, params *PostApiIntegrationsSpotifyPlaylistParams, body PostApiIntegrationsSpotifyPlaylistJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiIntegrationsSpotifyPlaylistRequest(c.Server, params, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+// PostApiAuthRefreshJSONRequestBody defines body for PostApiAuthRefresh for application/json ContentType.
+type PostApiAuthRefreshJSONRequestBody = RefreshToken
+
+// PostApiIntegrationsSpotifyPlaylistJSONRequestBody defines body for PostApiIntegrationsSpotifyPlaylist for application/json ContentType.
+type PostApiIntegrationsSpotifyPlaylistJSONRequestBody = CreatePlaylistRequest
+
+// PostApiIntegrationsSpotifyTracksSyncJSONRequestBody defines body for PostApiIntegrationsSpotifyTracksSync for application/json ContentType.
+type PostApiIntegrationsSpotifyTracksSyncJSONRequestBody = SyncSpotifyTracksRequest
+
+// PostApiInvitesJSONRequestBody defines body for PostApiInvites for application/json ContentType.
+type PostApiInvitesJSONRequestBody = CreateInviteRequest
+
+// PatchApiJobsNameJSONRequestBody defines body for PatchApiJobsName for application/json ContentType.
+type PatchApiJobsNameJSONRequestBody = UpdateJobRequest
+
+// PostApiListenbrainz1SubmitListensJSONRequestBody defines body for PostApiListenbrainz1SubmitListens for application/json ContentType.
+type PostApiListenbrainz1SubmitListensJSONRequestBody = SubmitListensRequest
+
+// PostApiLoginJSONRequestBody defines body for PostApiLogin for application/json ContentType.
+type PostApiLoginJSONRequestBody = LoginRequest
+
+// PostApiLoginMfaJSONRequestBody defines body for PostApiLoginMfa for application/json ContentType.
+type PostApiLoginMfaJSONRequestBody = MFALoginRequest
+
+// PostApiLoginPasskeyFinishJSONRequestBody defines body for PostApiLoginPasskeyFinish for application/json ContentType.
+type PostApiLoginPasskeyFinishJSONRequestBody = PasskeyLoginRequest
+
+// PostApiLogoutJSONRequestBody defines body for PostApiLogout for application/json ContentType.
+type PostApiLogoutJSONRequestBody = RefreshToken
+
+// PostApiMeListensImportMultipartRequestBody defines body for PostApiMeListensImport for multipart/form-data ContentType.
+type PostApiMeListensImportMultipartRequestBody PostApiMeListensImportMultipartBody
+
+// DeleteApiMeMfaTotpJSONRequestBody defines body for DeleteApiMeMfaTotp for application/json ContentType.
+type DeleteApiMeMfaTotpJSONRequestBody = MFACodeRequest
+
+// PostApiMeMfaTotpVerifyJSONRequestBody defines body for PostApiMeMfaTotpVerify for application/json ContentType.
+type PostApiMeMfaTotpVerifyJSONRequestBody = MFACodeRequest
+
+// PostApiMePasskeysRegisterFinishJSONRequestBody defines body for PostApiMePasskeysRegisterFinish for application/json ContentType.
+type PostApiMePasskeysRegisterFinishJSONRequestBody = RegisterPasskeyRequest
+
+// PostApiMePasswordJSONRequestBody defines body for PostApiMePassword for application/json ContentType.
+type PostApiMePasswordJSONRequestBody = ChangePasswordRequest
+
+// PatchApiMePlaylistRulesJSONRequestBody defines body for PatchApiMePlaylistRules for application/json ContentType.
+type PatchApiMePlaylistRulesJSONRequestBody = UpdatePlaylistRulesRequest
+
+// PatchApiMePreferencesJSONRequestBody defines body for PatchApiMePreferences for application/json ContentType.
+type PatchApiMePreferencesJSONRequestBody = UpdatePreferencesRequest
+
+// PostApiOauthSpotifyTokenJSONRequestBody defines body for PostApiOauthSpotifyToken for application/json ContentType.
+type PostApiOauthSpotifyTokenJSONRequestBody = SpotifyTokenRequest
+
+// PostApiOauthSpotifyTokenRefreshJSONRequestBody defines body for PostApiOauthSpotifyTokenRefresh for application/json ContentType.
+type PostApiOauthSpotifyTokenRefreshJSONRequestBody = SpotifyRefreshTokenRequest
+
+// PostApiPasswordResetJSONRequestBody defines body for PostApiPasswordReset for application/json ContentType.
+type PostApiPasswordResetJSONRequestBody = PasswordResetRequest
+
+// PostApiPasswordResetConfirmJSONRequestBody defines body for PostApiPasswordResetConfirm for application/json ContentType.
+type PostApiPasswordResetConfirmJSONRequestBody = ConfirmPasswordResetRequest
+
+// PostApiPlaylistsJSONRequestBody defines body for PostApiPlaylists for application/json ContentType.
+type PostApiPlaylistsJSONRequestBody = DateRangeRequest
+
+// PostApiRegisterJSONRequestBody defines body for PostApiRegister for application/json ContentType.
+type PostApiRegisterJSONRequestBody = RegisterRequest
+
+// Getter for additional properties for TrackMetadata_AdditionalInfo. Returns the specified
+// element and whether it was found
+func (a TrackMetadata_AdditionalInfo) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
 	}
-	return c.Client.Do(req)
+	return
 }
 
-func (c *Client) PostApiIntegrationsSpotifyPlaylistId(ctx context.Context, id openapi_types.UUID, params *PostApiIntegrationsSpotifyPlaylistIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiIntegrationsSpotifyPlaylistIdRequest(c.Server, id, params)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+// Setter for additional properties for TrackMetadata_AdditionalInfo
+func (a *TrackMetadata_AdditionalInfo) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
 	}
-	return c.Client.Do(req)
+	a.AdditionalProperties[fieldName] = value
 }
 
-func (c *Client) PostApiIntegrationsSpotifyTracksSyncWithBody(ctx context.Context, params *PostApiIntegrationsSpotifyTracksSyncParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiIntegrationsSpotifyTracksSyncRequestWithBody(c.Server, params, contentType, body)
+// Override default JSON handling for TrackMetadata_AdditionalInfo to handle AdditionalProperties
+func (a *TrackMetadata_AdditionalInfo) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	if raw, found := object["duration_ms"]; found {
+		err = json.Unmarshal(raw, &a.DurationMs)
+		if err != nil {
+			return fmt.Errorf("error reading 'duration_ms': %w", err)
+		}
+		delete(object, "duration_ms")
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) PostApiIntegrationsSpotifyTracksSync(ctx context.Context, params *PostApiIntegrationsSpotifyTracksSyncParams, body PostApiIntegrationsSpotifyTracksSyncJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiIntegrationsSpotifyTracksSyncRequest(c.Server, params, body)
-	if err != nil {
-		return nil, err
+	if raw, found := object["origin_url"]; found {
+		err = json.Unmarshal(raw, &a.OriginUrl)
+		if err != nil {
+			return fmt.Errorf("error reading 'origin_url': %w", err)
+		}
+		delete(object, "origin_url")
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	if raw, found := object["recording_mbid"]; found {
+		err = json.Unmarshal(raw, &a.RecordingMbid)
+		if err != nil {
+			return fmt.Errorf("error reading 'recording_mbid': %w", err)
+		}
+		delete(object, "recording_mbid")
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) PostApiLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiLoginRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
+	if raw, found := object["spotify_id"]; found {
+		err = json.Unmarshal(raw, &a.SpotifyId)
+		if err != nil {
+			return fmt.Errorf("error reading 'spotify_id': %w", err)
+		}
+		delete(object, "spotify_id")
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
 	}
-	return c.Client.Do(req)
+	return nil
 }
 
-func (c *Client) PostApiLogin(ctx context.Context, body PostApiLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiLoginRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+// Override default JSON handling for TrackMetadata_AdditionalInfo to handle AdditionalProperties
+func (a TrackMetadata_AdditionalInfo) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.DurationMs != nil {
+		object["duration_ms"], err = json.Marshal(a.DurationMs)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'duration_ms': %w", err)
+		}
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetApiMePlaylists(ctx context.Context, params *GetApiMePlaylistsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiMePlaylistsRequest(c.Server, params)
-	if err != nil {
-		return nil, err
+	if a.OriginUrl != nil {
+		object["origin_url"], err = json.Marshal(a.OriginUrl)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'origin_url': %w", err)
+		}
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	if a.RecordingMbid != nil {
+		object["recording_mbid"], err = json.Marshal(a.RecordingMbid)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'recording_mbid': %w", err)
+		}
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetApiMeTracksTop(ctx context.Context, params *GetApiMeTracksTopParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiMeTracksTopRequest(c.Server, params)
-	if err != nil {
-		return nil, err
+	if a.SpotifyId != nil {
+		object["spotify_id"], err = json.Marshal(a.SpotifyId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'spotify_id': %w", err)
+		}
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
 	}
-	return c.Client.Do(req)
+	return json.Marshal(object)
 }
 
-func (c *Client) GetApiOauthSpotifyConfigJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiOauthSpotifyConfigJsonRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// AsWeeklyOrMonthlyRequest returns the union data inside the DateRangeRequest as a WeeklyOrMonthlyRequest
+func (t DateRangeRequest) AsWeeklyOrMonthlyRequest() (WeeklyOrMonthlyRequest, error) {
+	var body WeeklyOrMonthlyRequest
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-func (c *Client) PostApiOauthSpotifyTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiOauthSpotifyTokenRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// FromWeeklyOrMonthlyRequest overwrites any union data inside the DateRangeRequest as the provided WeeklyOrMonthlyRequest
+func (t *DateRangeRequest) FromWeeklyOrMonthlyRequest(v WeeklyOrMonthlyRequest) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
 }
 
-func (c *Client) PostApiOauthSpotifyToken(ctx context.Context, body PostApiOauthSpotifyTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiOauthSpotifyTokenRequest(c.Server, body)
+// MergeWeeklyOrMonthlyRequest performs a merge with any union data inside the DateRangeRequest, using the provided WeeklyOrMonthlyRequest
+func (t *DateRangeRequest) MergeWeeklyOrMonthlyRequest(v WeeklyOrMonthlyRequest) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+		return err
 	}
-	return c.Client.Do(req)
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-func (c *Client) PostApiOauthSpotifyTokenRefreshWithBody(ctx context.Context, params *PostApiOauthSpotifyTokenRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiOauthSpotifyTokenRefreshRequestWithBody(c.Server, params, contentType, body)
+// AsCustomRequest returns the union data inside the DateRangeRequest as a CustomRequest
+func (t DateRangeRequest) AsCustomRequest() (CustomRequest, error) {
+	var body CustomRequest
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCustomRequest overwrites any union data inside the DateRangeRequest as the provided CustomRequest
+func (t *DateRangeRequest) FromCustomRequest(v CustomRequest) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCustomRequest performs a merge with any union data inside the DateRangeRequest, using the provided CustomRequest
+func (t *DateRangeRequest) MergeCustomRequest(v CustomRequest) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t DateRangeRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *DateRangeRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// PostApiAuthRefreshWithBody request with any body
+	PostApiAuthRefreshWithBody(ctx context.Context, params *PostApiAuthRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAuthRefresh(ctx context.Context, params *PostApiAuthRefreshParams, body PostApiAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAuthVerify request
+	GetApiAuthVerify(ctx context.Context, params *GetApiAuthVerifyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiHealth request
+	GetApiHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiIntegrationsSpotifyPlaylistWithBody request with any body
+	PostApiIntegrationsSpotifyPlaylistWithBody(ctx context.Context, params *PostApiIntegrationsSpotifyPlaylistParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiIntegrationsSpotifyPlaylist(ctx context.Context, params *PostApiIntegrationsSpotifyPlaylistParams, body PostApiIntegrationsSpotifyPlaylistJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiIntegrationsSpotifyPlaylistId request
+	DeleteApiIntegrationsSpotifyPlaylistId(ctx context.Context, id openapi_types.UUID, params *DeleteApiIntegrationsSpotifyPlaylistIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiIntegrationsSpotifyPlaylistId request
+	PostApiIntegrationsSpotifyPlaylistId(ctx context.Context, id openapi_types.UUID, params *PostApiIntegrationsSpotifyPlaylistIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiIntegrationsSpotifyTracksSyncWithBody request with any body
+	PostApiIntegrationsSpotifyTracksSyncWithBody(ctx context.Context, params *PostApiIntegrationsSpotifyTracksSyncParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiIntegrationsSpotifyTracksSync(ctx context.Context, params *PostApiIntegrationsSpotifyTracksSyncParams, body PostApiIntegrationsSpotifyTracksSyncJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiInvites request
+	GetApiInvites(ctx context.Context, params *GetApiInvitesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiInvitesWithBody request with any body
+	PostApiInvitesWithBody(ctx context.Context, params *PostApiInvitesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiInvites(ctx context.Context, params *PostApiInvitesParams, body PostApiInvitesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiInvitesId request
+	DeleteApiInvitesId(ctx context.Context, id openapi_types.UUID, params *DeleteApiInvitesIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiJobs request
+	GetApiJobs(ctx context.Context, params *GetApiJobsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchApiJobsNameWithBody request with any body
+	PatchApiJobsNameWithBody(ctx context.Context, name string, params *PatchApiJobsNameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchApiJobsName(ctx context.Context, name string, params *PatchApiJobsNameParams, body PatchApiJobsNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiJobsNameTrigger request
+	PostApiJobsNameTrigger(ctx context.Context, name string, params *PostApiJobsNameTriggerParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiListenbrainz1SubmitListensWithBody request with any body
+	PostApiListenbrainz1SubmitListensWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiListenbrainz1SubmitListens(ctx context.Context, body PostApiListenbrainz1SubmitListensJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiListenbrainz1ValidateToken request
+	GetApiListenbrainz1ValidateToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiListensExport request
+	GetApiListensExport(ctx context.Context, params *GetApiListensExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiLoginWithBody request with any body
+	PostApiLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiLogin(ctx context.Context, body PostApiLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiLoginMfaWithBody request with any body
+	PostApiLoginMfaWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiLoginMfa(ctx context.Context, body PostApiLoginMfaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiLoginPasskeyBegin request
+	PostApiLoginPasskeyBegin(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiLoginPasskeyFinishWithBody request with any body
+	PostApiLoginPasskeyFinishWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiLoginPasskeyFinish(ctx context.Context, body PostApiLoginPasskeyFinishJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiLogoutWithBody request with any body
+	PostApiLogoutWithBody(ctx context.Context, params *PostApiLogoutParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiLogout(ctx context.Context, params *PostApiLogoutParams, body PostApiLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiMeAlbumsTop request
+	GetApiMeAlbumsTop(ctx context.Context, params *GetApiMeAlbumsTopParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiMeArtistsTop request
+	GetApiMeArtistsTop(ctx context.Context, params *GetApiMeArtistsTopParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiMeListenToken request
+	DeleteApiMeListenToken(ctx context.Context, params *DeleteApiMeListenTokenParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiMeListenToken request
+	PostApiMeListenToken(ctx context.Context, params *PostApiMeListenTokenParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiMeListens request
+	GetApiMeListens(ctx context.Context, params *GetApiMeListensParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiMeListensExport request
+	GetApiMeListensExport(ctx context.Context, params *GetApiMeListensExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiMeListensImportWithBody request with any body
+	PostApiMeListensImportWithBody(ctx context.Context, params *PostApiMeListensImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiMeMfa request
+	GetApiMeMfa(ctx context.Context, params *GetApiMeMfaParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiMeMfaTotpWithBody request with any body
+	DeleteApiMeMfaTotpWithBody(ctx context.Context, params *DeleteApiMeMfaTotpParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeleteApiMeMfaTotp(ctx context.Context, params *DeleteApiMeMfaTotpParams, body DeleteApiMeMfaTotpJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiMeMfaTotp request
+	PostApiMeMfaTotp(ctx context.Context, params *PostApiMeMfaTotpParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiMeMfaTotpVerifyWithBody request with any body
+	PostApiMeMfaTotpVerifyWithBody(ctx context.Context, params *PostApiMeMfaTotpVerifyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiMeMfaTotpVerify(ctx context.Context, params *PostApiMeMfaTotpVerifyParams, body PostApiMeMfaTotpVerifyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiMePasskeys request
+	GetApiMePasskeys(ctx context.Context, params *GetApiMePasskeysParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiMePasskeysRegisterBegin request
+	PostApiMePasskeysRegisterBegin(ctx context.Context, params *PostApiMePasskeysRegisterBeginParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiMePasskeysRegisterFinishWithBody request with any body
+	PostApiMePasskeysRegisterFinishWithBody(ctx context.Context, params *PostApiMePasskeysRegisterFinishParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiMePasskeysRegisterFinish(ctx context.Context, params *PostApiMePasskeysRegisterFinishParams, body PostApiMePasskeysRegisterFinishJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiMePasskeysId request
+	DeleteApiMePasskeysId(ctx context.Context, id openapi_types.UUID, params *DeleteApiMePasskeysIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiMePasswordWithBody request with any body
+	PostApiMePasswordWithBody(ctx context.Context, params *PostApiMePasswordParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiMePassword(ctx context.Context, params *PostApiMePasswordParams, body PostApiMePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiMePlaylistRules request
+	GetApiMePlaylistRules(ctx context.Context, params *GetApiMePlaylistRulesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchApiMePlaylistRulesWithBody request with any body
+	PatchApiMePlaylistRulesWithBody(ctx context.Context, params *PatchApiMePlaylistRulesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchApiMePlaylistRules(ctx context.Context, params *PatchApiMePlaylistRulesParams, body PatchApiMePlaylistRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiMePlaylists request
+	GetApiMePlaylists(ctx context.Context, params *GetApiMePlaylistsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiMePreferences request
+	GetApiMePreferences(ctx context.Context, params *GetApiMePreferencesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchApiMePreferencesWithBody request with any body
+	PatchApiMePreferencesWithBody(ctx context.Context, params *PatchApiMePreferencesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchApiMePreferences(ctx context.Context, params *PatchApiMePreferencesParams, body PatchApiMePreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiMeSessions request
+	GetApiMeSessions(ctx context.Context, params *GetApiMeSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiMeSessionsId request
+	DeleteApiMeSessionsId(ctx context.Context, id openapi_types.UUID, params *DeleteApiMeSessionsIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiMeStats request
+	GetApiMeStats(ctx context.Context, params *GetApiMeStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiMeTracksTop request
+	GetApiMeTracksTop(ctx context.Context, params *GetApiMeTracksTopParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiMeYearInReviewYear request
+	GetApiMeYearInReviewYear(ctx context.Context, year int, params *GetApiMeYearInReviewYearParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiOauthSpotifyConfigJson request
+	GetApiOauthSpotifyConfigJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiOauthSpotifyTokenWithBody request with any body
+	PostApiOauthSpotifyTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiOauthSpotifyToken(ctx context.Context, body PostApiOauthSpotifyTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiOauthSpotifyTokenRefreshWithBody request with any body
+	PostApiOauthSpotifyTokenRefreshWithBody(ctx context.Context, params *PostApiOauthSpotifyTokenRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiOauthSpotifyTokenRefresh(ctx context.Context, params *PostApiOauthSpotifyTokenRefreshParams, body PostApiOauthSpotifyTokenRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiOpenapiYaml request
+	GetApiOpenapiYaml(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiPasswordResetWithBody request with any body
+	PostApiPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiPasswordReset(ctx context.Context, body PostApiPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiPasswordResetConfirmWithBody request with any body
+	PostApiPasswordResetConfirmWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiPasswordResetConfirm(ctx context.Context, body PostApiPasswordResetConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiPlaylistsWithBody request with any body
+	PostApiPlaylistsWithBody(ctx context.Context, params *PostApiPlaylistsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiPlaylists(ctx context.Context, params *PostApiPlaylistsParams, body PostApiPlaylistsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiPlaylistsId request
+	GetApiPlaylistsId(ctx context.Context, id openapi_types.UUID, params *GetApiPlaylistsIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiRegisterWithBody request with any body
+	PostApiRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiRegister(ctx context.Context, body PostApiRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiSpotifyStatus request
+	GetApiSpotifyStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiSpotifySync request
+	GetApiSpotifySync(ctx context.Context, params *GetApiSpotifySyncParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiUsers request
+	GetApiUsers(ctx context.Context, params *GetApiUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiUsersIdSignOut request
+	PostApiUsersIdSignOut(ctx context.Context, id openapi_types.UUID, params *PostApiUsersIdSignOutParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) PostApiAuthRefreshWithBody(ctx context.Context, params *PostApiAuthRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthRefreshRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -727,8 +1728,8 @@ func (c *Client) PostApiOauthSpotifyTokenRefreshWithBody(ctx context.Context, pa
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostApiOauthSpotifyTokenRefresh(ctx context.Context, params *PostApiOauthSpotifyTokenRefreshParams, body PostApiOauthSpotifyTokenRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiOauthSpotifyTokenRefreshRequest(c.Server, params, body)
+func (c *Client) PostApiAuthRefresh(ctx context.Context, params *PostApiAuthRefreshParams, body PostApiAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthRefreshRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -739,8 +1740,8 @@ func (c *Client) PostApiOauthSpotifyTokenRefresh(ctx context.Context, params *Po
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetApiOpenapiYaml(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiOpenapiYamlRequest(c.Server)
+func (c *Client) GetApiAuthVerify(ctx context.Context, params *GetApiAuthVerifyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAuthVerifyRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -751,8 +1752,8 @@ func (c *Client) GetApiOpenapiYaml(ctx context.Context, reqEditors ...RequestEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostApiPlaylistsWithBody(ctx context.Context, params *PostApiPlaylistsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiPlaylistsRequestWithBody(c.Server, params, contentType, body)
+func (c *Client) GetApiHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiHealthRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -763,8 +1764,8 @@ func (c *Client) PostApiPlaylistsWithBody(ctx context.Context, params *PostApiPl
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostApiPlaylists(ctx context.Context, params *PostApiPlaylistsParams, body PostApiPlaylistsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiPlaylistsRequest(c.Server, params, body)
+func (c *Client) PostApiIntegrationsSpotifyPlaylistWithBody(ctx context.Context, params *PostApiIntegrationsSpotifyPlaylistParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiIntegrationsSpotifyPlaylistRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -775,8 +1776,8 @@ func (c *Client) PostApiPlaylists(ctx context.Context, params *PostApiPlaylistsP
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetApiPlaylistsId(ctx context.Context, id openapi_types.UUID, params *GetApiPlaylistsIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiPlaylistsIdRequest(c.Server, id, params)
+func (c *Client) PostApiIntegrationsSpotifyPlaylist(ctx context.Context, params *PostApiIntegrationsSpotifyPlaylistParams, body PostApiIntegrationsSpotifyPlaylistJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiIntegrationsSpotifyPlaylistRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -787,8 +1788,8 @@ func (c *Client) GetApiPlaylistsId(ctx context.Context, id openapi_types.UUID, p
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetApiSpotifyStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiSpotifyStatusRequest(c.Server)
+func (c *Client) DeleteApiIntegrationsSpotifyPlaylistId(ctx context.Context, id openapi_types.UUID, params *DeleteApiIntegrationsSpotifyPlaylistIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiIntegrationsSpotifyPlaylistIdRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -799,8 +1800,8 @@ func (c *Client) GetApiSpotifyStatus(ctx context.Context, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetApiUsers(ctx context.Context, params *GetApiUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiUsersRequest(c.Server, params)
+func (c *Client) PostApiIntegrationsSpotifyPlaylistId(ctx context.Context, id openapi_types.UUID, params *PostApiIntegrationsSpotifyPlaylistIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiIntegrationsSpotifyPlaylistIdRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -811,4302 +1812,17476 @@ func (c *Client) GetApiUsers(ctx context.Context, params *GetApiUsersParams, req
 	return c.Client.Do(req)
 }
 
-// NewPostApiAuthRefreshRequest calls the generic PostApiAuthRefresh builder with application/json body
-func NewPostApiAuthRefreshRequest(server string, params *PostApiAuthRefreshParams, body PostApiAuthRefreshJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostApiIntegrationsSpotifyTracksSyncWithBody(ctx context.Context, params *PostApiIntegrationsSpotifyTracksSyncParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiIntegrationsSpotifyTracksSyncRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiAuthRefreshRequestWithBody(server, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostApiAuthRefreshRequestWithBody generates requests for PostApiAuthRefresh with any type of body
-func NewPostApiAuthRefreshRequestWithBody(server string, params *PostApiAuthRefreshParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostApiIntegrationsSpotifyTracksSync(ctx context.Context, params *PostApiIntegrationsSpotifyTracksSyncParams, body PostApiIntegrationsSpotifyTracksSyncJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiIntegrationsSpotifyTracksSyncRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/auth/refresh")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetApiInvites(ctx context.Context, params *GetApiInvitesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiInvitesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
-
-	if params != nil {
-
-		if params.XCSRFToken != nil {
-			var headerParam0 string
-
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
-			if err != nil {
-				return nil, err
-			}
-
-			req.Header.Set("X-CSRF-Token", headerParam0)
-		}
-
+func (c *Client) PostApiInvitesWithBody(ctx context.Context, params *PostApiInvitesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiInvitesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-
-	if params != nil {
-
-		if params.Refresh != nil {
-			var cookieParam0 string
-
-			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "refresh", runtime.ParamLocationCookie, *params.Refresh)
-			if err != nil {
-				return nil, err
-			}
-
-			cookie0 := &http.Cookie{
-				Name:  "refresh",
-				Value: cookieParam0,
-			}
-			req.AddCookie(cookie0)
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetApiAuthVerifyRequest generates requests for GetApiAuthVerify
-func NewGetApiAuthVerifyRequest(server string, params *GetApiAuthVerifyParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostApiInvites(ctx context.Context, params *PostApiInvitesParams, body PostApiInvitesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiInvitesRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/auth/verify")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteApiInvitesId(ctx context.Context, id openapi_types.UUID, params *DeleteApiInvitesIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiInvitesIdRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Role != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "role", runtime.ParamLocationQuery, *params.Role); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetApiJobs(ctx context.Context, params *GetApiJobsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiJobsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-
-		if params.Access != nil {
-			var cookieParam0 string
-
-			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
-			if err != nil {
-				return nil, err
-			}
-
-			cookie0 := &http.Cookie{
-				Name:  "access",
-				Value: cookieParam0,
-			}
-			req.AddCookie(cookie0)
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetApiHealthRequest generates requests for GetApiHealth
-func NewGetApiHealthRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PatchApiJobsNameWithBody(ctx context.Context, name string, params *PatchApiJobsNameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchApiJobsNameRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/health")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PatchApiJobsName(ctx context.Context, name string, params *PatchApiJobsNameParams, body PatchApiJobsNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchApiJobsNameRequest(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostApiIntegrationsSpotifyPlaylistRequest calls the generic PostApiIntegrationsSpotifyPlaylist builder with application/json body
-func NewPostApiIntegrationsSpotifyPlaylistRequest(server string, params *PostApiIntegrationsSpotifyPlaylistParams, body PostApiIntegrationsSpotifyPlaylistJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostApiJobsNameTrigger(ctx context.Context, name string, params *PostApiJobsNameTriggerParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiJobsNameTriggerRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiIntegrationsSpotifyPlaylistRequestWithBody(server, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostApiIntegrationsSpotifyPlaylistRequestWithBody generates requests for PostApiIntegrationsSpotifyPlaylist with any type of body
-func NewPostApiIntegrationsSpotifyPlaylistRequestWithBody(server string, params *PostApiIntegrationsSpotifyPlaylistParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostApiListenbrainz1SubmitListensWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiListenbrainz1SubmitListensRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/integrations/spotify/playlist")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostApiListenbrainz1SubmitListens(ctx context.Context, body PostApiListenbrainz1SubmitListensJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiListenbrainz1SubmitListensRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
-
-	if params != nil {
-
-		if params.XCSRFToken != nil {
-			var headerParam0 string
-
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
-			if err != nil {
-				return nil, err
-			}
-
-			req.Header.Set("X-CSRF-Token", headerParam0)
-		}
-
+func (c *Client) GetApiListenbrainz1ValidateToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiListenbrainz1ValidateTokenRequest(c.Server)
+	if err != nil {
+		return nil, err
 	}
-
-	if params != nil {
-
-		if params.Access != nil {
-			var cookieParam0 string
-
-			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
-			if err != nil {
-				return nil, err
-			}
-
-			cookie0 := &http.Cookie{
-				Name:  "access",
-				Value: cookieParam0,
-			}
-			req.AddCookie(cookie0)
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostApiIntegrationsSpotifyPlaylistIdRequest generates requests for PostApiIntegrationsSpotifyPlaylistId
-func NewPostApiIntegrationsSpotifyPlaylistIdRequest(server string, id openapi_types.UUID, params *PostApiIntegrationsSpotifyPlaylistIdParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) GetApiListensExport(ctx context.Context, params *GetApiListensExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiListensExportRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/integrations/spotify/playlist/%s", pathParam0)
+func (c *Client) PostApiLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiLoginRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiLogin(ctx context.Context, body PostApiLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiLoginRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiLoginMfaWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiLoginMfaRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiLoginMfa(ctx context.Context, body PostApiLoginMfaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiLoginMfaRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiLoginPasskeyBegin(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiLoginPasskeyBeginRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiLoginPasskeyFinishWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiLoginPasskeyFinishRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiLoginPasskeyFinish(ctx context.Context, body PostApiLoginPasskeyFinishJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiLoginPasskeyFinishRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiLogoutWithBody(ctx context.Context, params *PostApiLogoutParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiLogoutRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiLogout(ctx context.Context, params *PostApiLogoutParams, body PostApiLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiLogoutRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiMeAlbumsTop(ctx context.Context, params *GetApiMeAlbumsTopParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiMeAlbumsTopRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiMeArtistsTop(ctx context.Context, params *GetApiMeArtistsTopParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiMeArtistsTopRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiMeListenToken(ctx context.Context, params *DeleteApiMeListenTokenParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiMeListenTokenRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMeListenToken(ctx context.Context, params *PostApiMeListenTokenParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMeListenTokenRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiMeListens(ctx context.Context, params *GetApiMeListensParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiMeListensRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiMeListensExport(ctx context.Context, params *GetApiMeListensExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiMeListensExportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMeListensImportWithBody(ctx context.Context, params *PostApiMeListensImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMeListensImportRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiMeMfa(ctx context.Context, params *GetApiMeMfaParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiMeMfaRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiMeMfaTotpWithBody(ctx context.Context, params *DeleteApiMeMfaTotpParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiMeMfaTotpRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiMeMfaTotp(ctx context.Context, params *DeleteApiMeMfaTotpParams, body DeleteApiMeMfaTotpJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiMeMfaTotpRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMeMfaTotp(ctx context.Context, params *PostApiMeMfaTotpParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMeMfaTotpRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMeMfaTotpVerifyWithBody(ctx context.Context, params *PostApiMeMfaTotpVerifyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMeMfaTotpVerifyRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMeMfaTotpVerify(ctx context.Context, params *PostApiMeMfaTotpVerifyParams, body PostApiMeMfaTotpVerifyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMeMfaTotpVerifyRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiMePasskeys(ctx context.Context, params *GetApiMePasskeysParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiMePasskeysRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMePasskeysRegisterBegin(ctx context.Context, params *PostApiMePasskeysRegisterBeginParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMePasskeysRegisterBeginRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMePasskeysRegisterFinishWithBody(ctx context.Context, params *PostApiMePasskeysRegisterFinishParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMePasskeysRegisterFinishRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMePasskeysRegisterFinish(ctx context.Context, params *PostApiMePasskeysRegisterFinishParams, body PostApiMePasskeysRegisterFinishJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMePasskeysRegisterFinishRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiMePasskeysId(ctx context.Context, id openapi_types.UUID, params *DeleteApiMePasskeysIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiMePasskeysIdRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMePasswordWithBody(ctx context.Context, params *PostApiMePasswordParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMePasswordRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMePassword(ctx context.Context, params *PostApiMePasswordParams, body PostApiMePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMePasswordRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiMePlaylistRules(ctx context.Context, params *GetApiMePlaylistRulesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiMePlaylistRulesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchApiMePlaylistRulesWithBody(ctx context.Context, params *PatchApiMePlaylistRulesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchApiMePlaylistRulesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchApiMePlaylistRules(ctx context.Context, params *PatchApiMePlaylistRulesParams, body PatchApiMePlaylistRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchApiMePlaylistRulesRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiMePlaylists(ctx context.Context, params *GetApiMePlaylistsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiMePlaylistsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiMePreferences(ctx context.Context, params *GetApiMePreferencesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiMePreferencesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchApiMePreferencesWithBody(ctx context.Context, params *PatchApiMePreferencesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchApiMePreferencesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchApiMePreferences(ctx context.Context, params *PatchApiMePreferencesParams, body PatchApiMePreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchApiMePreferencesRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiMeSessions(ctx context.Context, params *GetApiMeSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiMeSessionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiMeSessionsId(ctx context.Context, id openapi_types.UUID, params *DeleteApiMeSessionsIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiMeSessionsIdRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiMeStats(ctx context.Context, params *GetApiMeStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiMeStatsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiMeTracksTop(ctx context.Context, params *GetApiMeTracksTopParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiMeTracksTopRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiMeYearInReviewYear(ctx context.Context, year int, params *GetApiMeYearInReviewYearParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiMeYearInReviewYearRequest(c.Server, year, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiOauthSpotifyConfigJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiOauthSpotifyConfigJsonRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiOauthSpotifyTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiOauthSpotifyTokenRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiOauthSpotifyToken(ctx context.Context, body PostApiOauthSpotifyTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiOauthSpotifyTokenRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiOauthSpotifyTokenRefreshWithBody(ctx context.Context, params *PostApiOauthSpotifyTokenRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiOauthSpotifyTokenRefreshRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiOauthSpotifyTokenRefresh(ctx context.Context, params *PostApiOauthSpotifyTokenRefreshParams, body PostApiOauthSpotifyTokenRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiOauthSpotifyTokenRefreshRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiOpenapiYaml(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiOpenapiYamlRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiPasswordResetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiPasswordReset(ctx context.Context, body PostApiPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiPasswordResetRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiPasswordResetConfirmWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiPasswordResetConfirmRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiPasswordResetConfirm(ctx context.Context, body PostApiPasswordResetConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiPasswordResetConfirmRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiPlaylistsWithBody(ctx context.Context, params *PostApiPlaylistsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiPlaylistsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiPlaylists(ctx context.Context, params *PostApiPlaylistsParams, body PostApiPlaylistsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiPlaylistsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiPlaylistsId(ctx context.Context, id openapi_types.UUID, params *GetApiPlaylistsIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiPlaylistsIdRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiRegisterRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiRegister(ctx context.Context, body PostApiRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiRegisterRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiSpotifyStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiSpotifyStatusRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiSpotifySync(ctx context.Context, params *GetApiSpotifySyncParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiSpotifySyncRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiUsers(ctx context.Context, params *GetApiUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiUsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiUsersIdSignOut(ctx context.Context, id openapi_types.UUID, params *PostApiUsersIdSignOutParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiUsersIdSignOutRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewPostApiAuthRefreshRequest calls the generic PostApiAuthRefresh builder with application/json body
+func NewPostApiAuthRefreshRequest(server string, params *PostApiAuthRefreshParams, body PostApiAuthRefreshJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAuthRefreshRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostApiAuthRefreshRequestWithBody generates requests for PostApiAuthRefresh with any type of body
+func NewPostApiAuthRefreshRequestWithBody(server string, params *PostApiAuthRefreshParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Refresh != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "refresh", runtime.ParamLocationCookie, *params.Refresh)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "refresh",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiAuthVerifyRequest generates requests for GetApiAuthVerify
+func NewGetApiAuthVerifyRequest(server string, params *GetApiAuthVerifyParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/verify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Role != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "role", runtime.ParamLocationQuery, *params.Role); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiHealthRequest generates requests for GetApiHealth
+func NewGetApiHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiIntegrationsSpotifyPlaylistRequest calls the generic PostApiIntegrationsSpotifyPlaylist builder with application/json body
+func NewPostApiIntegrationsSpotifyPlaylistRequest(server string, params *PostApiIntegrationsSpotifyPlaylistParams, body PostApiIntegrationsSpotifyPlaylistJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiIntegrationsSpotifyPlaylistRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostApiIntegrationsSpotifyPlaylistRequestWithBody generates requests for PostApiIntegrationsSpotifyPlaylist with any type of body
+func NewPostApiIntegrationsSpotifyPlaylistRequestWithBody(server string, params *PostApiIntegrationsSpotifyPlaylistParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/integrations/spotify/playlist")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewDeleteApiIntegrationsSpotifyPlaylistIdRequest generates requests for DeleteApiIntegrationsSpotifyPlaylistId
+func NewDeleteApiIntegrationsSpotifyPlaylistIdRequest(server string, id openapi_types.UUID, params *DeleteApiIntegrationsSpotifyPlaylistIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/integrations/spotify/playlist/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DeleteRemote != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "delete_remote", runtime.ParamLocationQuery, *params.DeleteRemote); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewPostApiIntegrationsSpotifyPlaylistIdRequest generates requests for PostApiIntegrationsSpotifyPlaylistId
+func NewPostApiIntegrationsSpotifyPlaylistIdRequest(server string, id openapi_types.UUID, params *PostApiIntegrationsSpotifyPlaylistIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/integrations/spotify/playlist/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewPostApiIntegrationsSpotifyTracksSyncRequest calls the generic PostApiIntegrationsSpotifyTracksSync builder with application/json body
+func NewPostApiIntegrationsSpotifyTracksSyncRequest(server string, params *PostApiIntegrationsSpotifyTracksSyncParams, body PostApiIntegrationsSpotifyTracksSyncJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiIntegrationsSpotifyTracksSyncRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostApiIntegrationsSpotifyTracksSyncRequestWithBody generates requests for PostApiIntegrationsSpotifyTracksSync with any type of body
+func NewPostApiIntegrationsSpotifyTracksSyncRequestWithBody(server string, params *PostApiIntegrationsSpotifyTracksSyncParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/integrations/spotify/tracks/sync")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiInvitesRequest generates requests for GetApiInvites
+func NewGetApiInvitesRequest(server string, params *GetApiInvitesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invites")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewPostApiInvitesRequest calls the generic PostApiInvites builder with application/json body
+func NewPostApiInvitesRequest(server string, params *PostApiInvitesParams, body PostApiInvitesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiInvitesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostApiInvitesRequestWithBody generates requests for PostApiInvites with any type of body
+func NewPostApiInvitesRequestWithBody(server string, params *PostApiInvitesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invites")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewDeleteApiInvitesIdRequest generates requests for DeleteApiInvitesId
+func NewDeleteApiInvitesIdRequest(server string, id openapi_types.UUID, params *DeleteApiInvitesIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invites/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiJobsRequest generates requests for GetApiJobs
+func NewGetApiJobsRequest(server string, params *GetApiJobsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/jobs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewPatchApiJobsNameRequest calls the generic PatchApiJobsName builder with application/json body
+func NewPatchApiJobsNameRequest(server string, name string, params *PatchApiJobsNameParams, body PatchApiJobsNameJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchApiJobsNameRequestWithBody(server, name, params, "application/json", bodyReader)
+}
+
+// NewPatchApiJobsNameRequestWithBody generates requests for PatchApiJobsName with any type of body
+func NewPatchApiJobsNameRequestWithBody(server string, name string, params *PatchApiJobsNameParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/jobs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewPostApiJobsNameTriggerRequest generates requests for PostApiJobsNameTrigger
+func NewPostApiJobsNameTriggerRequest(server string, name string, params *PostApiJobsNameTriggerParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/jobs/%s/trigger", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewPostApiListenbrainz1SubmitListensRequest calls the generic PostApiListenbrainz1SubmitListens builder with application/json body
+func NewPostApiListenbrainz1SubmitListensRequest(server string, body PostApiListenbrainz1SubmitListensJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiListenbrainz1SubmitListensRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiListenbrainz1SubmitListensRequestWithBody generates requests for PostApiListenbrainz1SubmitListens with any type of body
+func NewPostApiListenbrainz1SubmitListensRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/listenbrainz/1/submit-listens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiListenbrainz1ValidateTokenRequest generates requests for GetApiListenbrainz1ValidateToken
+func NewGetApiListenbrainz1ValidateTokenRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/listenbrainz/1/validate-token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiListensExportRequest generates requests for GetApiListensExport
+func NewGetApiListensExportRequest(server string, params *GetApiListensExportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/listens/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Start != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, *params.Start); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.End != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, *params.End); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewPostApiLoginRequest calls the generic PostApiLogin builder with application/json body
+func NewPostApiLoginRequest(server string, body PostApiLoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiLoginRequestWithBody generates requests for PostApiLogin with any type of body
+func NewPostApiLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiLoginMfaRequest calls the generic PostApiLoginMfa builder with application/json body
+func NewPostApiLoginMfaRequest(server string, body PostApiLoginMfaJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiLoginMfaRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiLoginMfaRequestWithBody generates requests for PostApiLoginMfa with any type of body
+func NewPostApiLoginMfaRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/login/mfa")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiLoginPasskeyBeginRequest generates requests for PostApiLoginPasskeyBegin
+func NewPostApiLoginPasskeyBeginRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/login/passkey/begin")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiLoginPasskeyFinishRequest calls the generic PostApiLoginPasskeyFinish builder with application/json body
+func NewPostApiLoginPasskeyFinishRequest(server string, body PostApiLoginPasskeyFinishJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiLoginPasskeyFinishRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiLoginPasskeyFinishRequestWithBody generates requests for PostApiLoginPasskeyFinish with any type of body
+func NewPostApiLoginPasskeyFinishRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/login/passkey/finish")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiLogoutRequest calls the generic PostApiLogout builder with application/json body
+func NewPostApiLogoutRequest(server string, params *PostApiLogoutParams, body PostApiLogoutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiLogoutRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostApiLogoutRequestWithBody generates requests for PostApiLogout with any type of body
+func NewPostApiLogoutRequestWithBody(server string, params *PostApiLogoutParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Refresh != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "refresh", runtime.ParamLocationCookie, *params.Refresh)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "refresh",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiMeAlbumsTopRequest generates requests for GetApiMeAlbumsTop
+func NewGetApiMeAlbumsTopRequest(server string, params *GetApiMeAlbumsTopParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/albums/top")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Start != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, *params.Start); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.End != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, *params.End); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Period != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiMeArtistsTopRequest generates requests for GetApiMeArtistsTop
+func NewGetApiMeArtistsTopRequest(server string, params *GetApiMeArtistsTopParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/artists/top")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Start != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, *params.Start); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.End != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, *params.End); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Period != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewDeleteApiMeListenTokenRequest generates requests for DeleteApiMeListenToken
+func NewDeleteApiMeListenTokenRequest(server string, params *DeleteApiMeListenTokenParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/listen-token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewPostApiMeListenTokenRequest generates requests for PostApiMeListenToken
+func NewPostApiMeListenTokenRequest(server string, params *PostApiMeListenTokenParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/listen-token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiMeListensRequest generates requests for GetApiMeListens
+func NewGetApiMeListensRequest(server string, params *GetApiMeListensParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/listens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Artist != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "artist", runtime.ParamLocationQuery, *params.Artist); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Track != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "track", runtime.ParamLocationQuery, *params.Track); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Start != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, *params.Start); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.End != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, *params.End); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiMeListensExportRequest generates requests for GetApiMeListensExport
+func NewGetApiMeListensExportRequest(server string, params *GetApiMeListensExportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/listens/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Start != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, *params.Start); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.End != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, *params.End); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewPostApiMeListensImportRequestWithBody generates requests for PostApiMeListensImport with any type of body
+func NewPostApiMeListensImportRequestWithBody(server string, params *PostApiMeListensImportParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/listens/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiMeMfaRequest generates requests for GetApiMeMfa
+func NewGetApiMeMfaRequest(server string, params *GetApiMeMfaParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/mfa")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewDeleteApiMeMfaTotpRequest calls the generic DeleteApiMeMfaTotp builder with application/json body
+func NewDeleteApiMeMfaTotpRequest(server string, params *DeleteApiMeMfaTotpParams, body DeleteApiMeMfaTotpJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteApiMeMfaTotpRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewDeleteApiMeMfaTotpRequestWithBody generates requests for DeleteApiMeMfaTotp with any type of body
+func NewDeleteApiMeMfaTotpRequestWithBody(server string, params *DeleteApiMeMfaTotpParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/mfa/totp")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewPostApiMeMfaTotpRequest generates requests for PostApiMeMfaTotp
+func NewPostApiMeMfaTotpRequest(server string, params *PostApiMeMfaTotpParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/mfa/totp")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewPostApiMeMfaTotpVerifyRequest calls the generic PostApiMeMfaTotpVerify builder with application/json body
+func NewPostApiMeMfaTotpVerifyRequest(server string, params *PostApiMeMfaTotpVerifyParams, body PostApiMeMfaTotpVerifyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiMeMfaTotpVerifyRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostApiMeMfaTotpVerifyRequestWithBody generates requests for PostApiMeMfaTotpVerify with any type of body
+func NewPostApiMeMfaTotpVerifyRequestWithBody(server string, params *PostApiMeMfaTotpVerifyParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/mfa/totp/verify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiMePasskeysRequest generates requests for GetApiMePasskeys
+func NewGetApiMePasskeysRequest(server string, params *GetApiMePasskeysParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/passkeys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewPostApiMePasskeysRegisterBeginRequest generates requests for PostApiMePasskeysRegisterBegin
+func NewPostApiMePasskeysRegisterBeginRequest(server string, params *PostApiMePasskeysRegisterBeginParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/passkeys/register/begin")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewPostApiMePasskeysRegisterFinishRequest calls the generic PostApiMePasskeysRegisterFinish builder with application/json body
+func NewPostApiMePasskeysRegisterFinishRequest(server string, params *PostApiMePasskeysRegisterFinishParams, body PostApiMePasskeysRegisterFinishJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiMePasskeysRegisterFinishRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostApiMePasskeysRegisterFinishRequestWithBody generates requests for PostApiMePasskeysRegisterFinish with any type of body
+func NewPostApiMePasskeysRegisterFinishRequestWithBody(server string, params *PostApiMePasskeysRegisterFinishParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/passkeys/register/finish")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewDeleteApiMePasskeysIdRequest generates requests for DeleteApiMePasskeysId
+func NewDeleteApiMePasskeysIdRequest(server string, id openapi_types.UUID, params *DeleteApiMePasskeysIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/passkeys/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewPostApiMePasswordRequest calls the generic PostApiMePassword builder with application/json body
+func NewPostApiMePasswordRequest(server string, params *PostApiMePasswordParams, body PostApiMePasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiMePasswordRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostApiMePasswordRequestWithBody generates requests for PostApiMePassword with any type of body
+func NewPostApiMePasswordRequestWithBody(server string, params *PostApiMePasswordParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiMePlaylistRulesRequest generates requests for GetApiMePlaylistRules
+func NewGetApiMePlaylistRulesRequest(server string, params *GetApiMePlaylistRulesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/playlist-rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewPatchApiMePlaylistRulesRequest calls the generic PatchApiMePlaylistRules builder with application/json body
+func NewPatchApiMePlaylistRulesRequest(server string, params *PatchApiMePlaylistRulesParams, body PatchApiMePlaylistRulesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchApiMePlaylistRulesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPatchApiMePlaylistRulesRequestWithBody generates requests for PatchApiMePlaylistRules with any type of body
+func NewPatchApiMePlaylistRulesRequestWithBody(server string, params *PatchApiMePlaylistRulesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/playlist-rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiMePlaylistsRequest generates requests for GetApiMePlaylists
+func NewGetApiMePlaylistsRequest(server string, params *GetApiMePlaylistsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/playlists")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiMePreferencesRequest generates requests for GetApiMePreferences
+func NewGetApiMePreferencesRequest(server string, params *GetApiMePreferencesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewPatchApiMePreferencesRequest calls the generic PatchApiMePreferences builder with application/json body
+func NewPatchApiMePreferencesRequest(server string, params *PatchApiMePreferencesParams, body PatchApiMePreferencesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchApiMePreferencesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPatchApiMePreferencesRequestWithBody generates requests for PatchApiMePreferences with any type of body
+func NewPatchApiMePreferencesRequestWithBody(server string, params *PatchApiMePreferencesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiMeSessionsRequest generates requests for GetApiMeSessions
+func NewGetApiMeSessionsRequest(server string, params *GetApiMeSessionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewDeleteApiMeSessionsIdRequest generates requests for DeleteApiMeSessionsId
+func NewDeleteApiMeSessionsIdRequest(server string, id openapi_types.UUID, params *DeleteApiMeSessionsIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiMeStatsRequest generates requests for GetApiMeStats
+func NewGetApiMeStatsRequest(server string, params *GetApiMeStatsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/stats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Start != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, *params.Start); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.End != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, *params.End); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Period != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiMeTracksTopRequest generates requests for GetApiMeTracksTop
+func NewGetApiMeTracksTopRequest(server string, params *GetApiMeTracksTopParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/tracks/top")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Start != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, *params.Start); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.End != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, *params.End); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Period != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RankBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "rank_by", runtime.ParamLocationQuery, *params.RankBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiMeYearInReviewYearRequest generates requests for GetApiMeYearInReviewYear
+func NewGetApiMeYearInReviewYearRequest(server string, year int, params *GetApiMeYearInReviewYearParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "year", runtime.ParamLocationPath, year)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/year-in-review/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiOauthSpotifyConfigJsonRequest generates requests for GetApiOauthSpotifyConfigJson
+func NewGetApiOauthSpotifyConfigJsonRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/oauth/spotify/config.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiOauthSpotifyTokenRequest calls the generic PostApiOauthSpotifyToken builder with application/json body
+func NewPostApiOauthSpotifyTokenRequest(server string, body PostApiOauthSpotifyTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiOauthSpotifyTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiOauthSpotifyTokenRequestWithBody generates requests for PostApiOauthSpotifyToken with any type of body
+func NewPostApiOauthSpotifyTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/oauth/spotify/token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiOauthSpotifyTokenRefreshRequest calls the generic PostApiOauthSpotifyTokenRefresh builder with application/json body
+func NewPostApiOauthSpotifyTokenRefreshRequest(server string, params *PostApiOauthSpotifyTokenRefreshParams, body PostApiOauthSpotifyTokenRefreshJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiOauthSpotifyTokenRefreshRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostApiOauthSpotifyTokenRefreshRequestWithBody generates requests for PostApiOauthSpotifyTokenRefresh with any type of body
+func NewPostApiOauthSpotifyTokenRefreshRequestWithBody(server string, params *PostApiOauthSpotifyTokenRefreshParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/oauth/spotify/token/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiOpenapiYamlRequest generates requests for GetApiOpenapiYaml
+func NewGetApiOpenapiYamlRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/openapi.yaml")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiPasswordResetRequest calls the generic PostApiPasswordReset builder with application/json body
+func NewPostApiPasswordResetRequest(server string, body PostApiPasswordResetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiPasswordResetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiPasswordResetRequestWithBody generates requests for PostApiPasswordReset with any type of body
+func NewPostApiPasswordResetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/password-reset")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiPasswordResetConfirmRequest calls the generic PostApiPasswordResetConfirm builder with application/json body
+func NewPostApiPasswordResetConfirmRequest(server string, body PostApiPasswordResetConfirmJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiPasswordResetConfirmRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiPasswordResetConfirmRequestWithBody generates requests for PostApiPasswordResetConfirm with any type of body
+func NewPostApiPasswordResetConfirmRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/password-reset/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiPlaylistsRequest calls the generic PostApiPlaylists builder with application/json body
+func NewPostApiPlaylistsRequest(server string, params *PostApiPlaylistsParams, body PostApiPlaylistsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiPlaylistsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostApiPlaylistsRequestWithBody generates requests for PostApiPlaylists with any type of body
+func NewPostApiPlaylistsRequestWithBody(server string, params *PostApiPlaylistsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/playlists")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiPlaylistsIdRequest generates requests for GetApiPlaylistsId
+func NewGetApiPlaylistsIdRequest(server string, id openapi_types.UUID, params *GetApiPlaylistsIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/playlists/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewPostApiRegisterRequest calls the generic PostApiRegister builder with application/json body
+func NewPostApiRegisterRequest(server string, body PostApiRegisterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiRegisterRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiRegisterRequestWithBody generates requests for PostApiRegister with any type of body
+func NewPostApiRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiSpotifyStatusRequest generates requests for GetApiSpotifyStatus
+func NewGetApiSpotifyStatusRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/spotify/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiSpotifySyncRequest generates requests for GetApiSpotifySync
+func NewGetApiSpotifySyncRequest(server string, params *GetApiSpotifySyncParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/spotify/sync")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewGetApiUsersRequest generates requests for GetApiUsers
+func NewGetApiUsersRequest(server string, params *GetApiUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewPostApiUsersIdSignOutRequest generates requests for PostApiUsersIdSignOut
+func NewPostApiUsersIdSignOutRequest(server string, id openapi_types.UUID, params *PostApiUsersIdSignOutParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/users/%s/sign-out", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XCSRFToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CSRF-Token", headerParam0)
+		}
+
+	}
+
+	if params != nil {
+
+		if params.Access != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "access",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// PostApiAuthRefreshWithBodyWithResponse request with any body
+	PostApiAuthRefreshWithBodyWithResponse(ctx context.Context, params *PostApiAuthRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthRefreshResponse, error)
+
+	PostApiAuthRefreshWithResponse(ctx context.Context, params *PostApiAuthRefreshParams, body PostApiAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthRefreshResponse, error)
+
+	// GetApiAuthVerifyWithResponse request
+	GetApiAuthVerifyWithResponse(ctx context.Context, params *GetApiAuthVerifyParams, reqEditors ...RequestEditorFn) (*GetApiAuthVerifyResponse, error)
+
+	// GetApiHealthWithResponse request
+	GetApiHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiHealthResponse, error)
+
+	// PostApiIntegrationsSpotifyPlaylistWithBodyWithResponse request with any body
+	PostApiIntegrationsSpotifyPlaylistWithBodyWithResponse(ctx context.Context, params *PostApiIntegrationsSpotifyPlaylistParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiIntegrationsSpotifyPlaylistResponse, error)
+
+	PostApiIntegrationsSpotifyPlaylistWithResponse(ctx context.Context, params *PostApiIntegrationsSpotifyPlaylistParams, body PostApiIntegrationsSpotifyPlaylistJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiIntegrationsSpotifyPlaylistResponse, error)
+
+	// DeleteApiIntegrationsSpotifyPlaylistIdWithResponse request
+	DeleteApiIntegrationsSpotifyPlaylistIdWithResponse(ctx context.Context, id openapi_types.UUID, params *DeleteApiIntegrationsSpotifyPlaylistIdParams, reqEditors ...RequestEditorFn) (*DeleteApiIntegrationsSpotifyPlaylistIdResponse, error)
+
+	// PostApiIntegrationsSpotifyPlaylistIdWithResponse request
+	PostApiIntegrationsSpotifyPlaylistIdWithResponse(ctx context.Context, id openapi_types.UUID, params *PostApiIntegrationsSpotifyPlaylistIdParams, reqEditors ...RequestEditorFn) (*PostApiIntegrationsSpotifyPlaylistIdResponse, error)
+
+	// PostApiIntegrationsSpotifyTracksSyncWithBodyWithResponse request with any body
+	PostApiIntegrationsSpotifyTracksSyncWithBodyWithResponse(ctx context.Context, params *PostApiIntegrationsSpotifyTracksSyncParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiIntegrationsSpotifyTracksSyncResponse, error)
+
+	PostApiIntegrationsSpotifyTracksSyncWithResponse(ctx context.Context, params *PostApiIntegrationsSpotifyTracksSyncParams, body PostApiIntegrationsSpotifyTracksSyncJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiIntegrationsSpotifyTracksSyncResponse, error)
+
+	// GetApiInvitesWithResponse request
+	GetApiInvitesWithResponse(ctx context.Context, params *GetApiInvitesParams, reqEditors ...RequestEditorFn) (*GetApiInvitesResponse, error)
+
+	// PostApiInvitesWithBodyWithResponse request with any body
+	PostApiInvitesWithBodyWithResponse(ctx context.Context, params *PostApiInvitesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiInvitesResponse, error)
+
+	PostApiInvitesWithResponse(ctx context.Context, params *PostApiInvitesParams, body PostApiInvitesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiInvitesResponse, error)
+
+	// DeleteApiInvitesIdWithResponse request
+	DeleteApiInvitesIdWithResponse(ctx context.Context, id openapi_types.UUID, params *DeleteApiInvitesIdParams, reqEditors ...RequestEditorFn) (*DeleteApiInvitesIdResponse, error)
+
+	// GetApiJobsWithResponse request
+	GetApiJobsWithResponse(ctx context.Context, params *GetApiJobsParams, reqEditors ...RequestEditorFn) (*GetApiJobsResponse, error)
+
+	// PatchApiJobsNameWithBodyWithResponse request with any body
+	PatchApiJobsNameWithBodyWithResponse(ctx context.Context, name string, params *PatchApiJobsNameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiJobsNameResponse, error)
+
+	PatchApiJobsNameWithResponse(ctx context.Context, name string, params *PatchApiJobsNameParams, body PatchApiJobsNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiJobsNameResponse, error)
+
+	// PostApiJobsNameTriggerWithResponse request
+	PostApiJobsNameTriggerWithResponse(ctx context.Context, name string, params *PostApiJobsNameTriggerParams, reqEditors ...RequestEditorFn) (*PostApiJobsNameTriggerResponse, error)
+
+	// PostApiListenbrainz1SubmitListensWithBodyWithResponse request with any body
+	PostApiListenbrainz1SubmitListensWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiListenbrainz1SubmitListensResponse, error)
+
+	PostApiListenbrainz1SubmitListensWithResponse(ctx context.Context, body PostApiListenbrainz1SubmitListensJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiListenbrainz1SubmitListensResponse, error)
+
+	// GetApiListenbrainz1ValidateTokenWithResponse request
+	GetApiListenbrainz1ValidateTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiListenbrainz1ValidateTokenResponse, error)
+
+	// GetApiListensExportWithResponse request
+	GetApiListensExportWithResponse(ctx context.Context, params *GetApiListensExportParams, reqEditors ...RequestEditorFn) (*GetApiListensExportResponse, error)
+
+	// PostApiLoginWithBodyWithResponse request with any body
+	PostApiLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiLoginResponse, error)
+
+	PostApiLoginWithResponse(ctx context.Context, body PostApiLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiLoginResponse, error)
+
+	// PostApiLoginMfaWithBodyWithResponse request with any body
+	PostApiLoginMfaWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiLoginMfaResponse, error)
+
+	PostApiLoginMfaWithResponse(ctx context.Context, body PostApiLoginMfaJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiLoginMfaResponse, error)
+
+	// PostApiLoginPasskeyBeginWithResponse request
+	PostApiLoginPasskeyBeginWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiLoginPasskeyBeginResponse, error)
+
+	// PostApiLoginPasskeyFinishWithBodyWithResponse request with any body
+	PostApiLoginPasskeyFinishWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiLoginPasskeyFinishResponse, error)
+
+	PostApiLoginPasskeyFinishWithResponse(ctx context.Context, body PostApiLoginPasskeyFinishJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiLoginPasskeyFinishResponse, error)
+
+	// PostApiLogoutWithBodyWithResponse request with any body
+	PostApiLogoutWithBodyWithResponse(ctx context.Context, params *PostApiLogoutParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiLogoutResponse, error)
+
+	PostApiLogoutWithResponse(ctx context.Context, params *PostApiLogoutParams, body PostApiLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiLogoutResponse, error)
+
+	// GetApiMeAlbumsTopWithResponse request
+	GetApiMeAlbumsTopWithResponse(ctx context.Context, params *GetApiMeAlbumsTopParams, reqEditors ...RequestEditorFn) (*GetApiMeAlbumsTopResponse, error)
+
+	// GetApiMeArtistsTopWithResponse request
+	GetApiMeArtistsTopWithResponse(ctx context.Context, params *GetApiMeArtistsTopParams, reqEditors ...RequestEditorFn) (*GetApiMeArtistsTopResponse, error)
+
+	// DeleteApiMeListenTokenWithResponse request
+	DeleteApiMeListenTokenWithResponse(ctx context.Context, params *DeleteApiMeListenTokenParams, reqEditors ...RequestEditorFn) (*DeleteApiMeListenTokenResponse, error)
+
+	// PostApiMeListenTokenWithResponse request
+	PostApiMeListenTokenWithResponse(ctx context.Context, params *PostApiMeListenTokenParams, reqEditors ...RequestEditorFn) (*PostApiMeListenTokenResponse, error)
+
+	// GetApiMeListensWithResponse request
+	GetApiMeListensWithResponse(ctx context.Context, params *GetApiMeListensParams, reqEditors ...RequestEditorFn) (*GetApiMeListensResponse, error)
+
+	// GetApiMeListensExportWithResponse request
+	GetApiMeListensExportWithResponse(ctx context.Context, params *GetApiMeListensExportParams, reqEditors ...RequestEditorFn) (*GetApiMeListensExportResponse, error)
+
+	// PostApiMeListensImportWithBodyWithResponse request with any body
+	PostApiMeListensImportWithBodyWithResponse(ctx context.Context, params *PostApiMeListensImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiMeListensImportResponse, error)
+
+	// GetApiMeMfaWithResponse request
+	GetApiMeMfaWithResponse(ctx context.Context, params *GetApiMeMfaParams, reqEditors ...RequestEditorFn) (*GetApiMeMfaResponse, error)
+
+	// DeleteApiMeMfaTotpWithBodyWithResponse request with any body
+	DeleteApiMeMfaTotpWithBodyWithResponse(ctx context.Context, params *DeleteApiMeMfaTotpParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteApiMeMfaTotpResponse, error)
+
+	DeleteApiMeMfaTotpWithResponse(ctx context.Context, params *DeleteApiMeMfaTotpParams, body DeleteApiMeMfaTotpJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteApiMeMfaTotpResponse, error)
+
+	// PostApiMeMfaTotpWithResponse request
+	PostApiMeMfaTotpWithResponse(ctx context.Context, params *PostApiMeMfaTotpParams, reqEditors ...RequestEditorFn) (*PostApiMeMfaTotpResponse, error)
+
+	// PostApiMeMfaTotpVerifyWithBodyWithResponse request with any body
+	PostApiMeMfaTotpVerifyWithBodyWithResponse(ctx context.Context, params *PostApiMeMfaTotpVerifyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiMeMfaTotpVerifyResponse, error)
+
+	PostApiMeMfaTotpVerifyWithResponse(ctx context.Context, params *PostApiMeMfaTotpVerifyParams, body PostApiMeMfaTotpVerifyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiMeMfaTotpVerifyResponse, error)
+
+	// GetApiMePasskeysWithResponse request
+	GetApiMePasskeysWithResponse(ctx context.Context, params *GetApiMePasskeysParams, reqEditors ...RequestEditorFn) (*GetApiMePasskeysResponse, error)
+
+	// PostApiMePasskeysRegisterBeginWithResponse request
+	PostApiMePasskeysRegisterBeginWithResponse(ctx context.Context, params *PostApiMePasskeysRegisterBeginParams, reqEditors ...RequestEditorFn) (*PostApiMePasskeysRegisterBeginResponse, error)
+
+	// PostApiMePasskeysRegisterFinishWithBodyWithResponse request with any body
+	PostApiMePasskeysRegisterFinishWithBodyWithResponse(ctx context.Context, params *PostApiMePasskeysRegisterFinishParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiMePasskeysRegisterFinishResponse, error)
+
+	PostApiMePasskeysRegisterFinishWithResponse(ctx context.Context, params *PostApiMePasskeysRegisterFinishParams, body PostApiMePasskeysRegisterFinishJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiMePasskeysRegisterFinishResponse, error)
+
+	// DeleteApiMePasskeysIdWithResponse request
+	DeleteApiMePasskeysIdWithResponse(ctx context.Context, id openapi_types.UUID, params *DeleteApiMePasskeysIdParams, reqEditors ...RequestEditorFn) (*DeleteApiMePasskeysIdResponse, error)
+
+	// PostApiMePasswordWithBodyWithResponse request with any body
+	PostApiMePasswordWithBodyWithResponse(ctx context.Context, params *PostApiMePasswordParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiMePasswordResponse, error)
+
+	PostApiMePasswordWithResponse(ctx context.Context, params *PostApiMePasswordParams, body PostApiMePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiMePasswordResponse, error)
+
+	// GetApiMePlaylistRulesWithResponse request
+	GetApiMePlaylistRulesWithResponse(ctx context.Context, params *GetApiMePlaylistRulesParams, reqEditors ...RequestEditorFn) (*GetApiMePlaylistRulesResponse, error)
+
+	// PatchApiMePlaylistRulesWithBodyWithResponse request with any body
+	PatchApiMePlaylistRulesWithBodyWithResponse(ctx context.Context, params *PatchApiMePlaylistRulesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiMePlaylistRulesResponse, error)
+
+	PatchApiMePlaylistRulesWithResponse(ctx context.Context, params *PatchApiMePlaylistRulesParams, body PatchApiMePlaylistRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiMePlaylistRulesResponse, error)
+
+	// GetApiMePlaylistsWithResponse request
+	GetApiMePlaylistsWithResponse(ctx context.Context, params *GetApiMePlaylistsParams, reqEditors ...RequestEditorFn) (*GetApiMePlaylistsResponse, error)
+
+	// GetApiMePreferencesWithResponse request
+	GetApiMePreferencesWithResponse(ctx context.Context, params *GetApiMePreferencesParams, reqEditors ...RequestEditorFn) (*GetApiMePreferencesResponse, error)
+
+	// PatchApiMePreferencesWithBodyWithResponse request with any body
+	PatchApiMePreferencesWithBodyWithResponse(ctx context.Context, params *PatchApiMePreferencesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiMePreferencesResponse, error)
+
+	PatchApiMePreferencesWithResponse(ctx context.Context, params *PatchApiMePreferencesParams, body PatchApiMePreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiMePreferencesResponse, error)
+
+	// GetApiMeSessionsWithResponse request
+	GetApiMeSessionsWithResponse(ctx context.Context, params *GetApiMeSessionsParams, reqEditors ...RequestEditorFn) (*GetApiMeSessionsResponse, error)
+
+	// DeleteApiMeSessionsIdWithResponse request
+	DeleteApiMeSessionsIdWithResponse(ctx context.Context, id openapi_types.UUID, params *DeleteApiMeSessionsIdParams, reqEditors ...RequestEditorFn) (*DeleteApiMeSessionsIdResponse, error)
+
+	// GetApiMeStatsWithResponse request
+	GetApiMeStatsWithResponse(ctx context.Context, params *GetApiMeStatsParams, reqEditors ...RequestEditorFn) (*GetApiMeStatsResponse, error)
+
+	// GetApiMeTracksTopWithResponse request
+	GetApiMeTracksTopWithResponse(ctx context.Context, params *GetApiMeTracksTopParams, reqEditors ...RequestEditorFn) (*GetApiMeTracksTopResponse, error)
+
+	// GetApiMeYearInReviewYearWithResponse request
+	GetApiMeYearInReviewYearWithResponse(ctx context.Context, year int, params *GetApiMeYearInReviewYearParams, reqEditors ...RequestEditorFn) (*GetApiMeYearInReviewYearResponse, error)
+
+	// GetApiOauthSpotifyConfigJsonWithResponse request
+	GetApiOauthSpotifyConfigJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiOauthSpotifyConfigJsonResponse, error)
+
+	// PostApiOauthSpotifyTokenWithBodyWithResponse request with any body
+	PostApiOauthSpotifyTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiOauthSpotifyTokenResponse, error)
+
+	PostApiOauthSpotifyTokenWithResponse(ctx context.Context, body PostApiOauthSpotifyTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiOauthSpotifyTokenResponse, error)
+
+	// PostApiOauthSpotifyTokenRefreshWithBodyWithResponse request with any body
+	PostApiOauthSpotifyTokenRefreshWithBodyWithResponse(ctx context.Context, params *PostApiOauthSpotifyTokenRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiOauthSpotifyTokenRefreshResponse, error)
+
+	PostApiOauthSpotifyTokenRefreshWithResponse(ctx context.Context, params *PostApiOauthSpotifyTokenRefreshParams, body PostApiOauthSpotifyTokenRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiOauthSpotifyTokenRefreshResponse, error)
+
+	// GetApiOpenapiYamlWithResponse request
+	GetApiOpenapiYamlWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiOpenapiYamlResponse, error)
+
+	// PostApiPasswordResetWithBodyWithResponse request with any body
+	PostApiPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiPasswordResetResponse, error)
+
+	PostApiPasswordResetWithResponse(ctx context.Context, body PostApiPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiPasswordResetResponse, error)
+
+	// PostApiPasswordResetConfirmWithBodyWithResponse request with any body
+	PostApiPasswordResetConfirmWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiPasswordResetConfirmResponse, error)
+
+	PostApiPasswordResetConfirmWithResponse(ctx context.Context, body PostApiPasswordResetConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiPasswordResetConfirmResponse, error)
+
+	// PostApiPlaylistsWithBodyWithResponse request with any body
+	PostApiPlaylistsWithBodyWithResponse(ctx context.Context, params *PostApiPlaylistsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiPlaylistsResponse, error)
+
+	PostApiPlaylistsWithResponse(ctx context.Context, params *PostApiPlaylistsParams, body PostApiPlaylistsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiPlaylistsResponse, error)
+
+	// GetApiPlaylistsIdWithResponse request
+	GetApiPlaylistsIdWithResponse(ctx context.Context, id openapi_types.UUID, params *GetApiPlaylistsIdParams, reqEditors ...RequestEditorFn) (*GetApiPlaylistsIdResponse, error)
+
+	// PostApiRegisterWithBodyWithResponse request with any body
+	PostApiRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiRegisterResponse, error)
+
+	PostApiRegisterWithResponse(ctx context.Context, body PostApiRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiRegisterResponse, error)
+
+	// GetApiSpotifyStatusWithResponse request
+	GetApiSpotifyStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiSpotifyStatusResponse, error)
+
+	// GetApiSpotifySyncWithResponse request
+	GetApiSpotifySyncWithResponse(ctx context.Context, params *GetApiSpotifySyncParams, reqEditors ...RequestEditorFn) (*GetApiSpotifySyncResponse, error)
+
+	// GetApiUsersWithResponse request
+	GetApiUsersWithResponse(ctx context.Context, params *GetApiUsersParams, reqEditors ...RequestEditorFn) (*GetApiUsersResponse, error)
+
+	// PostApiUsersIdSignOutWithResponse request
+	PostApiUsersIdSignOutWithResponse(ctx context.Context, id openapi_types.UUID, params *PostApiUsersIdSignOutParams, reqEditors ...RequestEditorFn) (*PostApiUsersIdSignOutResponse, error)
+}
+
+type PostApiAuthRefreshResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResponse
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAuthRefreshResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAuthRefreshResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAuthVerifyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSON401      *Error
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAuthVerifyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAuthVerifyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiIntegrationsSpotifyPlaylistResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SpotifyPlaylist
+	JSON201      *SpotifyPlaylist
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiIntegrationsSpotifyPlaylistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiIntegrationsSpotifyPlaylistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiIntegrationsSpotifyPlaylistIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiIntegrationsSpotifyPlaylistIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiIntegrationsSpotifyPlaylistIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiIntegrationsSpotifyPlaylistIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SpotifyPlaylist
+	JSON201      *SpotifyPlaylist
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiIntegrationsSpotifyPlaylistIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiIntegrationsSpotifyPlaylistIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiIntegrationsSpotifyTracksSyncResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiIntegrationsSpotifyTracksSyncResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiIntegrationsSpotifyTracksSyncResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiInvitesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListInvitesResponse
+	JSON401      *Error
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiInvitesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiInvitesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiInvitesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreateInviteResponse
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiInvitesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiInvitesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiInvitesIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiInvitesIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiInvitesIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiJobsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListJobsResponse
+	JSON401      *Error
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiJobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiJobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchApiJobsNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Job
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchApiJobsNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchApiJobsNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiJobsNameTriggerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *Job
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiJobsNameTriggerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiJobsNameTriggerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiListenbrainz1SubmitListensResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListenBrainzStatus
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiListenbrainz1SubmitListensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiListenbrainz1SubmitListensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiListenbrainz1ValidateTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ValidateListenTokenResponse
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiListenbrainz1ValidateTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiListenbrainz1ValidateTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiListensExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiListensExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiListensExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResponse
+	JSON202      *MFAChallenge
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiLoginMfaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResponse
+	JSON400      *Error
+	JSON401      *Error
+	JSON429      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiLoginMfaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiLoginMfaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiLoginPasskeyBeginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PasskeyCeremony
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiLoginPasskeyBeginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiLoginPasskeyBeginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiLoginPasskeyFinishResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResponse
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiLoginPasskeyFinishResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiLoginPasskeyFinishResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiLogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiLogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiLogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiMeAlbumsTopResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Albums []TopAlbum `json:"albums"`
+	}
+	JSON400 *Error
+	JSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiMeAlbumsTopResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiMeAlbumsTopResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiMeArtistsTopResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Artists []TopArtist `json:"artists"`
+	}
+	JSON400 *Error
+	JSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiMeArtistsTopResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiMeArtistsTopResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiMeListenTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiMeListenTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiMeListenTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiMeListenTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ListenTokenResponse
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiMeListenTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiMeListenTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiMeListensResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListListensResponse
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiMeListensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiMeListensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiMeListensExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiMeListensExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiMeListensExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiMeListensImportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportListensResponse
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiMeListensImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiMeListensImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiMeMfaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MFAStatus
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiMeMfaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiMeMfaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiMeMfaTotpResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON429      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiMeMfaTotpResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiMeMfaTotpResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiMeMfaTotpResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TOTPEnrollment
+	JSON401      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiMeMfaTotpResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiMeMfaTotpResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiMeMfaTotpVerifyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecoveryCodes
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiMeMfaTotpVerifyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiMeMfaTotpVerifyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiMePasskeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListPasskeysResponse
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiMePasskeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiMePasskeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiMePasskeysRegisterBeginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PasskeyCeremony
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiMePasskeysRegisterBeginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiMePasskeysRegisterBeginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiMePasskeysRegisterFinishResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Passkey
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiMePasskeysRegisterFinishResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiMePasskeysRegisterFinishResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiMePasskeysIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiMePasskeysIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiMePasskeysIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiMePasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON401      *Error
+	JSON422      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiMePasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiMePasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiMePlaylistRulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PlaylistRules
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiMePlaylistRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiMePlaylistRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchApiMePlaylistRulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PlaylistRules
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchApiMePlaylistRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchApiMePlaylistRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiMePlaylistsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListPlaylists
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiMePlaylistsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiMePlaylistsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiMePreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Preferences
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiMePreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiMePreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchApiMePreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Preferences
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchApiMePreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchApiMePreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiMeSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListSessionsResponse
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiMeSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiMeSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiMeSessionsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiMeSessionsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiMeSessionsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiMeStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListeningStats
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiMeStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiMeStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiMeTracksTopResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Tracks []PlaylistTrack `json:"tracks"`
+	}
+	JSON400 *Error
+	JSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiMeTracksTopResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiMeTracksTopResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiMeYearInReviewYearResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *YearInReview
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiMeYearInReviewYearResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiMeYearInReviewYearResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiOauthSpotifyConfigJsonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SpotifyOAuth
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiOauthSpotifyConfigJsonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiOauthSpotifyConfigJsonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiOauthSpotifyTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiOauthSpotifyTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiOauthSpotifyTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiOauthSpotifyTokenRefreshResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiOauthSpotifyTokenRefreshResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiOauthSpotifyTokenRefreshResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiOpenapiYamlResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	YAML200      *string
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiOpenapiYamlResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiOpenapiYamlResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiPasswordResetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiPasswordResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiPasswordResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiPasswordResetConfirmResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON403      *Error
+	JSON422      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiPasswordResetConfirmResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiPasswordResetConfirmResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiPlaylistsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreatePlaylistResponse
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiPlaylistsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiPlaylistsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiPlaylistsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Playlist
+	JSON401      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiPlaylistsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiPlaylistsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiRegisterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *User
+	JSON400      *Error
+	JSON403      *Error
+	JSON409      *Error
+	JSON422      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiRegisterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiRegisterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiSpotifyStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SpotifyStatusResponse
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiSpotifyStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiSpotifyStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiSpotifySyncResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SpotifySyncStatus
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiSpotifySyncResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiSpotifySyncResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListUsersResponse
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiUsersIdSignOutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiUsersIdSignOutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiUsersIdSignOutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// PostApiAuthRefreshWithBodyWithResponse request with arbitrary body returning *PostApiAuthRefreshResponse
+func (c *ClientWithResponses) PostApiAuthRefreshWithBodyWithResponse(ctx context.Context, params *PostApiAuthRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthRefreshResponse, error) {
+	rsp, err := c.PostApiAuthRefreshWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthRefreshResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAuthRefreshWithResponse(ctx context.Context, params *PostApiAuthRefreshParams, body PostApiAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthRefreshResponse, error) {
+	rsp, err := c.PostApiAuthRefresh(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthRefreshResponse(rsp)
+}
+
+// GetApiAuthVerifyWithResponse request returning *GetApiAuthVerifyResponse
+func (c *ClientWithResponses) GetApiAuthVerifyWithResponse(ctx context.Context, params *GetApiAuthVerifyParams, reqEditors ...RequestEditorFn) (*GetApiAuthVerifyResponse, error) {
+	rsp, err := c.GetApiAuthVerify(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAuthVerifyResponse(rsp)
+}
+
+// GetApiHealthWithResponse request returning *GetApiHealthResponse
+func (c *ClientWithResponses) GetApiHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiHealthResponse, error) {
+	rsp, err := c.GetApiHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiHealthResponse(rsp)
+}
+
+// PostApiIntegrationsSpotifyPlaylistWithBodyWithResponse request with arbitrary body returning *PostApiIntegrationsSpotifyPlaylistResponse
+func (c *ClientWithResponses) PostApiIntegrationsSpotifyPlaylistWithBodyWithResponse(ctx context.Context, params *PostApiIntegrationsSpotifyPlaylistParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiIntegrationsSpotifyPlaylistResponse, error) {
+	rsp, err := c.PostApiIntegrationsSpotifyPlaylistWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiIntegrationsSpotifyPlaylistResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiIntegrationsSpotifyPlaylistWithResponse(ctx context.Context, params *PostApiIntegrationsSpotifyPlaylistParams, body PostApiIntegrationsSpotifyPlaylistJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiIntegrationsSpotifyPlaylistResponse, error) {
+	rsp, err := c.PostApiIntegrationsSpotifyPlaylist(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiIntegrationsSpotifyPlaylistResponse(rsp)
+}
+
+// DeleteApiIntegrationsSpotifyPlaylistIdWithResponse request returning *DeleteApiIntegrationsSpotifyPlaylistIdResponse
+func (c *ClientWithResponses) DeleteApiIntegrationsSpotifyPlaylistIdWithResponse(ctx context.Context, id openapi_types.UUID, params *DeleteApiIntegrationsSpotifyPlaylistIdParams, reqEditors ...RequestEditorFn) (*DeleteApiIntegrationsSpotifyPlaylistIdResponse, error) {
+	rsp, err := c.DeleteApiIntegrationsSpotifyPlaylistId(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiIntegrationsSpotifyPlaylistIdResponse(rsp)
+}
+
+// PostApiIntegrationsSpotifyPlaylistIdWithResponse request returning *PostApiIntegrationsSpotifyPlaylistIdResponse
+func (c *ClientWithResponses) PostApiIntegrationsSpotifyPlaylistIdWithResponse(ctx context.Context, id openapi_types.UUID, params *PostApiIntegrationsSpotifyPlaylistIdParams, reqEditors ...RequestEditorFn) (*PostApiIntegrationsSpotifyPlaylistIdResponse, error) {
+	rsp, err := c.PostApiIntegrationsSpotifyPlaylistId(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiIntegrationsSpotifyPlaylistIdResponse(rsp)
+}
+
+// PostApiIntegrationsSpotifyTracksSyncWithBodyWithResponse request with arbitrary body returning *PostApiIntegrationsSpotifyTracksSyncResponse
+func (c *ClientWithResponses) PostApiIntegrationsSpotifyTracksSyncWithBodyWithResponse(ctx context.Context, params *PostApiIntegrationsSpotifyTracksSyncParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiIntegrationsSpotifyTracksSyncResponse, error) {
+	rsp, err := c.PostApiIntegrationsSpotifyTracksSyncWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiIntegrationsSpotifyTracksSyncResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiIntegrationsSpotifyTracksSyncWithResponse(ctx context.Context, params *PostApiIntegrationsSpotifyTracksSyncParams, body PostApiIntegrationsSpotifyTracksSyncJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiIntegrationsSpotifyTracksSyncResponse, error) {
+	rsp, err := c.PostApiIntegrationsSpotifyTracksSync(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiIntegrationsSpotifyTracksSyncResponse(rsp)
+}
+
+// GetApiInvitesWithResponse request returning *GetApiInvitesResponse
+func (c *ClientWithResponses) GetApiInvitesWithResponse(ctx context.Context, params *GetApiInvitesParams, reqEditors ...RequestEditorFn) (*GetApiInvitesResponse, error) {
+	rsp, err := c.GetApiInvites(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiInvitesResponse(rsp)
+}
+
+// PostApiInvitesWithBodyWithResponse request with arbitrary body returning *PostApiInvitesResponse
+func (c *ClientWithResponses) PostApiInvitesWithBodyWithResponse(ctx context.Context, params *PostApiInvitesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiInvitesResponse, error) {
+	rsp, err := c.PostApiInvitesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiInvitesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiInvitesWithResponse(ctx context.Context, params *PostApiInvitesParams, body PostApiInvitesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiInvitesResponse, error) {
+	rsp, err := c.PostApiInvites(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiInvitesResponse(rsp)
+}
+
+// DeleteApiInvitesIdWithResponse request returning *DeleteApiInvitesIdResponse
+func (c *ClientWithResponses) DeleteApiInvitesIdWithResponse(ctx context.Context, id openapi_types.UUID, params *DeleteApiInvitesIdParams, reqEditors ...RequestEditorFn) (*DeleteApiInvitesIdResponse, error) {
+	rsp, err := c.DeleteApiInvitesId(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiInvitesIdResponse(rsp)
+}
+
+// GetApiJobsWithResponse request returning *GetApiJobsResponse
+func (c *ClientWithResponses) GetApiJobsWithResponse(ctx context.Context, params *GetApiJobsParams, reqEditors ...RequestEditorFn) (*GetApiJobsResponse, error) {
+	rsp, err := c.GetApiJobs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiJobsResponse(rsp)
+}
+
+// PatchApiJobsNameWithBodyWithResponse request with arbitrary body returning *PatchApiJobsNameResponse
+func (c *ClientWithResponses) PatchApiJobsNameWithBodyWithResponse(ctx context.Context, name string, params *PatchApiJobsNameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiJobsNameResponse, error) {
+	rsp, err := c.PatchApiJobsNameWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiJobsNameResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchApiJobsNameWithResponse(ctx context.Context, name string, params *PatchApiJobsNameParams, body PatchApiJobsNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiJobsNameResponse, error) {
+	rsp, err := c.PatchApiJobsName(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiJobsNameResponse(rsp)
+}
+
+// PostApiJobsNameTriggerWithResponse request returning *PostApiJobsNameTriggerResponse
+func (c *ClientWithResponses) PostApiJobsNameTriggerWithResponse(ctx context.Context, name string, params *PostApiJobsNameTriggerParams, reqEditors ...RequestEditorFn) (*PostApiJobsNameTriggerResponse, error) {
+	rsp, err := c.PostApiJobsNameTrigger(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiJobsNameTriggerResponse(rsp)
+}
+
+// PostApiListenbrainz1SubmitListensWithBodyWithResponse request with arbitrary body returning *PostApiListenbrainz1SubmitListensResponse
+func (c *ClientWithResponses) PostApiListenbrainz1SubmitListensWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiListenbrainz1SubmitListensResponse, error) {
+	rsp, err := c.PostApiListenbrainz1SubmitListensWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiListenbrainz1SubmitListensResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiListenbrainz1SubmitListensWithResponse(ctx context.Context, body PostApiListenbrainz1SubmitListensJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiListenbrainz1SubmitListensResponse, error) {
+	rsp, err := c.PostApiListenbrainz1SubmitListens(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiListenbrainz1SubmitListensResponse(rsp)
+}
+
+// GetApiListenbrainz1ValidateTokenWithResponse request returning *GetApiListenbrainz1ValidateTokenResponse
+func (c *ClientWithResponses) GetApiListenbrainz1ValidateTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiListenbrainz1ValidateTokenResponse, error) {
+	rsp, err := c.GetApiListenbrainz1ValidateToken(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiListenbrainz1ValidateTokenResponse(rsp)
+}
+
+// GetApiListensExportWithResponse request returning *GetApiListensExportResponse
+func (c *ClientWithResponses) GetApiListensExportWithResponse(ctx context.Context, params *GetApiListensExportParams, reqEditors ...RequestEditorFn) (*GetApiListensExportResponse, error) {
+	rsp, err := c.GetApiListensExport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiListensExportResponse(rsp)
+}
+
+// PostApiLoginWithBodyWithResponse request with arbitrary body returning *PostApiLoginResponse
+func (c *ClientWithResponses) PostApiLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiLoginResponse, error) {
+	rsp, err := c.PostApiLoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiLoginWithResponse(ctx context.Context, body PostApiLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiLoginResponse, error) {
+	rsp, err := c.PostApiLogin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiLoginResponse(rsp)
+}
+
+// PostApiLoginMfaWithBodyWithResponse request with arbitrary body returning *PostApiLoginMfaResponse
+func (c *ClientWithResponses) PostApiLoginMfaWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiLoginMfaResponse, error) {
+	rsp, err := c.PostApiLoginMfaWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiLoginMfaResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiLoginMfaWithResponse(ctx context.Context, body PostApiLoginMfaJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiLoginMfaResponse, error) {
+	rsp, err := c.PostApiLoginMfa(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiLoginMfaResponse(rsp)
+}
+
+// PostApiLoginPasskeyBeginWithResponse request returning *PostApiLoginPasskeyBeginResponse
+func (c *ClientWithResponses) PostApiLoginPasskeyBeginWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiLoginPasskeyBeginResponse, error) {
+	rsp, err := c.PostApiLoginPasskeyBegin(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiLoginPasskeyBeginResponse(rsp)
+}
+
+// PostApiLoginPasskeyFinishWithBodyWithResponse request with arbitrary body returning *PostApiLoginPasskeyFinishResponse
+func (c *ClientWithResponses) PostApiLoginPasskeyFinishWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiLoginPasskeyFinishResponse, error) {
+	rsp, err := c.PostApiLoginPasskeyFinishWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiLoginPasskeyFinishResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiLoginPasskeyFinishWithResponse(ctx context.Context, body PostApiLoginPasskeyFinishJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiLoginPasskeyFinishResponse, error) {
+	rsp, err := c.PostApiLoginPasskeyFinish(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiLoginPasskeyFinishResponse(rsp)
+}
+
+// PostApiLogoutWithBodyWithResponse request with arbitrary body returning *PostApiLogoutResponse
+func (c *ClientWithResponses) PostApiLogoutWithBodyWithResponse(ctx context.Context, params *PostApiLogoutParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiLogoutResponse, error) {
+	rsp, err := c.PostApiLogoutWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiLogoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiLogoutWithResponse(ctx context.Context, params *PostApiLogoutParams, body PostApiLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiLogoutResponse, error) {
+	rsp, err := c.PostApiLogout(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiLogoutResponse(rsp)
+}
+
+// GetApiMeAlbumsTopWithResponse request returning *GetApiMeAlbumsTopResponse
+func (c *ClientWithResponses) GetApiMeAlbumsTopWithResponse(ctx context.Context, params *GetApiMeAlbumsTopParams, reqEditors ...RequestEditorFn) (*GetApiMeAlbumsTopResponse, error) {
+	rsp, err := c.GetApiMeAlbumsTop(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiMeAlbumsTopResponse(rsp)
+}
+
+// GetApiMeArtistsTopWithResponse request returning *GetApiMeArtistsTopResponse
+func (c *ClientWithResponses) GetApiMeArtistsTopWithResponse(ctx context.Context, params *GetApiMeArtistsTopParams, reqEditors ...RequestEditorFn) (*GetApiMeArtistsTopResponse, error) {
+	rsp, err := c.GetApiMeArtistsTop(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiMeArtistsTopResponse(rsp)
+}
+
+// DeleteApiMeListenTokenWithResponse request returning *DeleteApiMeListenTokenResponse
+func (c *ClientWithResponses) DeleteApiMeListenTokenWithResponse(ctx context.Context, params *DeleteApiMeListenTokenParams, reqEditors ...RequestEditorFn) (*DeleteApiMeListenTokenResponse, error) {
+	rsp, err := c.DeleteApiMeListenToken(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiMeListenTokenResponse(rsp)
+}
+
+// PostApiMeListenTokenWithResponse request returning *PostApiMeListenTokenResponse
+func (c *ClientWithResponses) PostApiMeListenTokenWithResponse(ctx context.Context, params *PostApiMeListenTokenParams, reqEditors ...RequestEditorFn) (*PostApiMeListenTokenResponse, error) {
+	rsp, err := c.PostApiMeListenToken(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMeListenTokenResponse(rsp)
+}
+
+// GetApiMeListensWithResponse request returning *GetApiMeListensResponse
+func (c *ClientWithResponses) GetApiMeListensWithResponse(ctx context.Context, params *GetApiMeListensParams, reqEditors ...RequestEditorFn) (*GetApiMeListensResponse, error) {
+	rsp, err := c.GetApiMeListens(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiMeListensResponse(rsp)
+}
+
+// GetApiMeListensExportWithResponse request returning *GetApiMeListensExportResponse
+func (c *ClientWithResponses) GetApiMeListensExportWithResponse(ctx context.Context, params *GetApiMeListensExportParams, reqEditors ...RequestEditorFn) (*GetApiMeListensExportResponse, error) {
+	rsp, err := c.GetApiMeListensExport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiMeListensExportResponse(rsp)
+}
+
+// PostApiMeListensImportWithBodyWithResponse request with arbitrary body returning *PostApiMeListensImportResponse
+func (c *ClientWithResponses) PostApiMeListensImportWithBodyWithResponse(ctx context.Context, params *PostApiMeListensImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiMeListensImportResponse, error) {
+	rsp, err := c.PostApiMeListensImportWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMeListensImportResponse(rsp)
+}
+
+// GetApiMeMfaWithResponse request returning *GetApiMeMfaResponse
+func (c *ClientWithResponses) GetApiMeMfaWithResponse(ctx context.Context, params *GetApiMeMfaParams, reqEditors ...RequestEditorFn) (*GetApiMeMfaResponse, error) {
+	rsp, err := c.GetApiMeMfa(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiMeMfaResponse(rsp)
+}
+
+// DeleteApiMeMfaTotpWithBodyWithResponse request with arbitrary body returning *DeleteApiMeMfaTotpResponse
+func (c *ClientWithResponses) DeleteApiMeMfaTotpWithBodyWithResponse(ctx context.Context, params *DeleteApiMeMfaTotpParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteApiMeMfaTotpResponse, error) {
+	rsp, err := c.DeleteApiMeMfaTotpWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiMeMfaTotpResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeleteApiMeMfaTotpWithResponse(ctx context.Context, params *DeleteApiMeMfaTotpParams, body DeleteApiMeMfaTotpJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteApiMeMfaTotpResponse, error) {
+	rsp, err := c.DeleteApiMeMfaTotp(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiMeMfaTotpResponse(rsp)
+}
+
+// PostApiMeMfaTotpWithResponse request returning *PostApiMeMfaTotpResponse
+func (c *ClientWithResponses) PostApiMeMfaTotpWithResponse(ctx context.Context, params *PostApiMeMfaTotpParams, reqEditors ...RequestEditorFn) (*PostApiMeMfaTotpResponse, error) {
+	rsp, err := c.PostApiMeMfaTotp(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMeMfaTotpResponse(rsp)
+}
+
+// PostApiMeMfaTotpVerifyWithBodyWithResponse request with arbitrary body returning *PostApiMeMfaTotpVerifyResponse
+func (c *ClientWithResponses) PostApiMeMfaTotpVerifyWithBodyWithResponse(ctx context.Context, params *PostApiMeMfaTotpVerifyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiMeMfaTotpVerifyResponse, error) {
+	rsp, err := c.PostApiMeMfaTotpVerifyWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMeMfaTotpVerifyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiMeMfaTotpVerifyWithResponse(ctx context.Context, params *PostApiMeMfaTotpVerifyParams, body PostApiMeMfaTotpVerifyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiMeMfaTotpVerifyResponse, error) {
+	rsp, err := c.PostApiMeMfaTotpVerify(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMeMfaTotpVerifyResponse(rsp)
+}
+
+// GetApiMePasskeysWithResponse request returning *GetApiMePasskeysResponse
+func (c *ClientWithResponses) GetApiMePasskeysWithResponse(ctx context.Context, params *GetApiMePasskeysParams, reqEditors ...RequestEditorFn) (*GetApiMePasskeysResponse, error) {
+	rsp, err := c.GetApiMePasskeys(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiMePasskeysResponse(rsp)
+}
+
+// PostApiMePasskeysRegisterBeginWithResponse request returning *PostApiMePasskeysRegisterBeginResponse
+func (c *ClientWithResponses) PostApiMePasskeysRegisterBeginWithResponse(ctx context.Context, params *PostApiMePasskeysRegisterBeginParams, reqEditors ...RequestEditorFn) (*PostApiMePasskeysRegisterBeginResponse, error) {
+	rsp, err := c.PostApiMePasskeysRegisterBegin(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMePasskeysRegisterBeginResponse(rsp)
+}
+
+// PostApiMePasskeysRegisterFinishWithBodyWithResponse request with arbitrary body returning *PostApiMePasskeysRegisterFinishResponse
+func (c *ClientWithResponses) PostApiMePasskeysRegisterFinishWithBodyWithResponse(ctx context.Context, params *PostApiMePasskeysRegisterFinishParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiMePasskeysRegisterFinishResponse, error) {
+	rsp, err := c.PostApiMePasskeysRegisterFinishWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMePasskeysRegisterFinishResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiMePasskeysRegisterFinishWithResponse(ctx context.Context, params *PostApiMePasskeysRegisterFinishParams, body PostApiMePasskeysRegisterFinishJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiMePasskeysRegisterFinishResponse, error) {
+	rsp, err := c.PostApiMePasskeysRegisterFinish(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMePasskeysRegisterFinishResponse(rsp)
+}
+
+// DeleteApiMePasskeysIdWithResponse request returning *DeleteApiMePasskeysIdResponse
+func (c *ClientWithResponses) DeleteApiMePasskeysIdWithResponse(ctx context.Context, id openapi_types.UUID, params *DeleteApiMePasskeysIdParams, reqEditors ...RequestEditorFn) (*DeleteApiMePasskeysIdResponse, error) {
+	rsp, err := c.DeleteApiMePasskeysId(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiMePasskeysIdResponse(rsp)
+}
+
+// PostApiMePasswordWithBodyWithResponse request with arbitrary body returning *PostApiMePasswordResponse
+func (c *ClientWithResponses) PostApiMePasswordWithBodyWithResponse(ctx context.Context, params *PostApiMePasswordParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiMePasswordResponse, error) {
+	rsp, err := c.PostApiMePasswordWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMePasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiMePasswordWithResponse(ctx context.Context, params *PostApiMePasswordParams, body PostApiMePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiMePasswordResponse, error) {
+	rsp, err := c.PostApiMePassword(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMePasswordResponse(rsp)
+}
+
+// GetApiMePlaylistRulesWithResponse request returning *GetApiMePlaylistRulesResponse
+func (c *ClientWithResponses) GetApiMePlaylistRulesWithResponse(ctx context.Context, params *GetApiMePlaylistRulesParams, reqEditors ...RequestEditorFn) (*GetApiMePlaylistRulesResponse, error) {
+	rsp, err := c.GetApiMePlaylistRules(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiMePlaylistRulesResponse(rsp)
+}
+
+// PatchApiMePlaylistRulesWithBodyWithResponse request with arbitrary body returning *PatchApiMePlaylistRulesResponse
+func (c *ClientWithResponses) PatchApiMePlaylistRulesWithBodyWithResponse(ctx context.Context, params *PatchApiMePlaylistRulesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiMePlaylistRulesResponse, error) {
+	rsp, err := c.PatchApiMePlaylistRulesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiMePlaylistRulesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchApiMePlaylistRulesWithResponse(ctx context.Context, params *PatchApiMePlaylistRulesParams, body PatchApiMePlaylistRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiMePlaylistRulesResponse, error) {
+	rsp, err := c.PatchApiMePlaylistRules(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiMePlaylistRulesResponse(rsp)
+}
+
+// GetApiMePlaylistsWithResponse request returning *GetApiMePlaylistsResponse
+func (c *ClientWithResponses) GetApiMePlaylistsWithResponse(ctx context.Context, params *GetApiMePlaylistsParams, reqEditors ...RequestEditorFn) (*GetApiMePlaylistsResponse, error) {
+	rsp, err := c.GetApiMePlaylists(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiMePlaylistsResponse(rsp)
+}
+
+// GetApiMePreferencesWithResponse request returning *GetApiMePreferencesResponse
+func (c *ClientWithResponses) GetApiMePreferencesWithResponse(ctx context.Context, params *GetApiMePreferencesParams, reqEditors ...RequestEditorFn) (*GetApiMePreferencesResponse, error) {
+	rsp, err := c.GetApiMePreferences(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiMePreferencesResponse(rsp)
+}
+
+// PatchApiMePreferencesWithBodyWithResponse request with arbitrary body returning *PatchApiMePreferencesResponse
+func (c *ClientWithResponses) PatchApiMePreferencesWithBodyWithResponse(ctx context.Context, params *PatchApiMePreferencesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiMePreferencesResponse, error) {
+	rsp, err := c.PatchApiMePreferencesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiMePreferencesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchApiMePreferencesWithResponse(ctx context.Context, params *PatchApiMePreferencesParams, body PatchApiMePreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiMePreferencesResponse, error) {
+	rsp, err := c.PatchApiMePreferences(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiMePreferencesResponse(rsp)
+}
+
+// GetApiMeSessionsWithResponse request returning *GetApiMeSessionsResponse
+func (c *ClientWithResponses) GetApiMeSessionsWithResponse(ctx context.Context, params *GetApiMeSessionsParams, reqEditors ...RequestEditorFn) (*GetApiMeSessionsResponse, error) {
+	rsp, err := c.GetApiMeSessions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiMeSessionsResponse(rsp)
+}
+
+// DeleteApiMeSessionsIdWithResponse request returning *DeleteApiMeSessionsIdResponse
+func (c *ClientWithResponses) DeleteApiMeSessionsIdWithResponse(ctx context.Context, id openapi_types.UUID, params *DeleteApiMeSessionsIdParams, reqEditors ...RequestEditorFn) (*DeleteApiMeSessionsIdResponse, error) {
+	rsp, err := c.DeleteApiMeSessionsId(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiMeSessionsIdResponse(rsp)
+}
+
+// GetApiMeStatsWithResponse request returning *GetApiMeStatsResponse
+func (c *ClientWithResponses) GetApiMeStatsWithResponse(ctx context.Context, params *GetApiMeStatsParams, reqEditors ...RequestEditorFn) (*GetApiMeStatsResponse, error) {
+	rsp, err := c.GetApiMeStats(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiMeStatsResponse(rsp)
+}
+
+// GetApiMeTracksTopWithResponse request returning *GetApiMeTracksTopResponse
+func (c *ClientWithResponses) GetApiMeTracksTopWithResponse(ctx context.Context, params *GetApiMeTracksTopParams, reqEditors ...RequestEditorFn) (*GetApiMeTracksTopResponse, error) {
+	rsp, err := c.GetApiMeTracksTop(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiMeTracksTopResponse(rsp)
+}
+
+// GetApiMeYearInReviewYearWithResponse request returning *GetApiMeYearInReviewYearResponse
+func (c *ClientWithResponses) GetApiMeYearInReviewYearWithResponse(ctx context.Context, year int, params *GetApiMeYearInReviewYearParams, reqEditors ...RequestEditorFn) (*GetApiMeYearInReviewYearResponse, error) {
+	rsp, err := c.GetApiMeYearInReviewYear(ctx, year, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiMeYearInReviewYearResponse(rsp)
+}
+
+// GetApiOauthSpotifyConfigJsonWithResponse request returning *GetApiOauthSpotifyConfigJsonResponse
+func (c *ClientWithResponses) GetApiOauthSpotifyConfigJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiOauthSpotifyConfigJsonResponse, error) {
+	rsp, err := c.GetApiOauthSpotifyConfigJson(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiOauthSpotifyConfigJsonResponse(rsp)
+}
+
+// PostApiOauthSpotifyTokenWithBodyWithResponse request with arbitrary body returning *PostApiOauthSpotifyTokenResponse
+func (c *ClientWithResponses) PostApiOauthSpotifyTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiOauthSpotifyTokenResponse, error) {
+	rsp, err := c.PostApiOauthSpotifyTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiOauthSpotifyTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiOauthSpotifyTokenWithResponse(ctx context.Context, body PostApiOauthSpotifyTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiOauthSpotifyTokenResponse, error) {
+	rsp, err := c.PostApiOauthSpotifyToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiOauthSpotifyTokenResponse(rsp)
+}
+
+// PostApiOauthSpotifyTokenRefreshWithBodyWithResponse request with arbitrary body returning *PostApiOauthSpotifyTokenRefreshResponse
+func (c *ClientWithResponses) PostApiOauthSpotifyTokenRefreshWithBodyWithResponse(ctx context.Context, params *PostApiOauthSpotifyTokenRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiOauthSpotifyTokenRefreshResponse, error) {
+	rsp, err := c.PostApiOauthSpotifyTokenRefreshWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiOauthSpotifyTokenRefreshResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiOauthSpotifyTokenRefreshWithResponse(ctx context.Context, params *PostApiOauthSpotifyTokenRefreshParams, body PostApiOauthSpotifyTokenRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiOauthSpotifyTokenRefreshResponse, error) {
+	rsp, err := c.PostApiOauthSpotifyTokenRefresh(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiOauthSpotifyTokenRefreshResponse(rsp)
+}
+
+// GetApiOpenapiYamlWithResponse request returning *GetApiOpenapiYamlResponse
+func (c *ClientWithResponses) GetApiOpenapiYamlWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiOpenapiYamlResponse, error) {
+	rsp, err := c.GetApiOpenapiYaml(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiOpenapiYamlResponse(rsp)
+}
+
+// PostApiPasswordResetWithBodyWithResponse request with arbitrary body returning *PostApiPasswordResetResponse
+func (c *ClientWithResponses) PostApiPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiPasswordResetResponse, error) {
+	rsp, err := c.PostApiPasswordResetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiPasswordResetResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiPasswordResetWithResponse(ctx context.Context, body PostApiPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiPasswordResetResponse, error) {
+	rsp, err := c.PostApiPasswordReset(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiPasswordResetResponse(rsp)
+}
+
+// PostApiPasswordResetConfirmWithBodyWithResponse request with arbitrary body returning *PostApiPasswordResetConfirmResponse
+func (c *ClientWithResponses) PostApiPasswordResetConfirmWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiPasswordResetConfirmResponse, error) {
+	rsp, err := c.PostApiPasswordResetConfirmWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiPasswordResetConfirmResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiPasswordResetConfirmWithResponse(ctx context.Context, body PostApiPasswordResetConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiPasswordResetConfirmResponse, error) {
+	rsp, err := c.PostApiPasswordResetConfirm(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiPasswordResetConfirmResponse(rsp)
+}
+
+// PostApiPlaylistsWithBodyWithResponse request with arbitrary body returning *PostApiPlaylistsResponse
+func (c *ClientWithResponses) PostApiPlaylistsWithBodyWithResponse(ctx context.Context, params *PostApiPlaylistsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiPlaylistsResponse, error) {
+	rsp, err := c.PostApiPlaylistsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiPlaylistsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiPlaylistsWithResponse(ctx context.Context, params *PostApiPlaylistsParams, body PostApiPlaylistsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiPlaylistsResponse, error) {
+	rsp, err := c.PostApiPlaylists(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiPlaylistsResponse(rsp)
+}
+
+// GetApiPlaylistsIdWithResponse request returning *GetApiPlaylistsIdResponse
+func (c *ClientWithResponses) GetApiPlaylistsIdWithResponse(ctx context.Context, id openapi_types.UUID, params *GetApiPlaylistsIdParams, reqEditors ...RequestEditorFn) (*GetApiPlaylistsIdResponse, error) {
+	rsp, err := c.GetApiPlaylistsId(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiPlaylistsIdResponse(rsp)
+}
+
+// PostApiRegisterWithBodyWithResponse request with arbitrary body returning *PostApiRegisterResponse
+func (c *ClientWithResponses) PostApiRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiRegisterResponse, error) {
+	rsp, err := c.PostApiRegisterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiRegisterResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiRegisterWithResponse(ctx context.Context, body PostApiRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiRegisterResponse, error) {
+	rsp, err := c.PostApiRegister(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiRegisterResponse(rsp)
+}
+
+// GetApiSpotifyStatusWithResponse request returning *GetApiSpotifyStatusResponse
+func (c *ClientWithResponses) GetApiSpotifyStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiSpotifyStatusResponse, error) {
+	rsp, err := c.GetApiSpotifyStatus(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiSpotifyStatusResponse(rsp)
+}
+
+// GetApiSpotifySyncWithResponse request returning *GetApiSpotifySyncResponse
+func (c *ClientWithResponses) GetApiSpotifySyncWithResponse(ctx context.Context, params *GetApiSpotifySyncParams, reqEditors ...RequestEditorFn) (*GetApiSpotifySyncResponse, error) {
+	rsp, err := c.GetApiSpotifySync(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiSpotifySyncResponse(rsp)
+}
+
+// GetApiUsersWithResponse request returning *GetApiUsersResponse
+func (c *ClientWithResponses) GetApiUsersWithResponse(ctx context.Context, params *GetApiUsersParams, reqEditors ...RequestEditorFn) (*GetApiUsersResponse, error) {
+	rsp, err := c.GetApiUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiUsersResponse(rsp)
+}
+
+// PostApiUsersIdSignOutWithResponse request returning *PostApiUsersIdSignOutResponse
+func (c *ClientWithResponses) PostApiUsersIdSignOutWithResponse(ctx context.Context, id openapi_types.UUID, params *PostApiUsersIdSignOutParams, reqEditors ...RequestEditorFn) (*PostApiUsersIdSignOutResponse, error) {
+	rsp, err := c.PostApiUsersIdSignOut(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiUsersIdSignOutResponse(rsp)
+}
+
+// ParsePostApiAuthRefreshResponse parses an HTTP response from a PostApiAuthRefreshWithResponse call
+func ParsePostApiAuthRefreshResponse(rsp *http.Response) (*PostApiAuthRefreshResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAuthRefreshResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAuthVerifyResponse parses an HTTP response from a GetApiAuthVerifyWithResponse call
+func ParseGetApiAuthVerifyResponse(rsp *http.Response) (*GetApiAuthVerifyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAuthVerifyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiHealthResponse parses an HTTP response from a GetApiHealthWithResponse call
+func ParseGetApiHealthResponse(rsp *http.Response) (*GetApiHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiIntegrationsSpotifyPlaylistResponse parses an HTTP response from a PostApiIntegrationsSpotifyPlaylistWithResponse call
+func ParsePostApiIntegrationsSpotifyPlaylistResponse(rsp *http.Response) (*PostApiIntegrationsSpotifyPlaylistResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiIntegrationsSpotifyPlaylistResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SpotifyPlaylist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SpotifyPlaylist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiIntegrationsSpotifyPlaylistIdResponse parses an HTTP response from a DeleteApiIntegrationsSpotifyPlaylistIdWithResponse call
+func ParseDeleteApiIntegrationsSpotifyPlaylistIdResponse(rsp *http.Response) (*DeleteApiIntegrationsSpotifyPlaylistIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiIntegrationsSpotifyPlaylistIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiIntegrationsSpotifyPlaylistIdResponse parses an HTTP response from a PostApiIntegrationsSpotifyPlaylistIdWithResponse call
+func ParsePostApiIntegrationsSpotifyPlaylistIdResponse(rsp *http.Response) (*PostApiIntegrationsSpotifyPlaylistIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiIntegrationsSpotifyPlaylistIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SpotifyPlaylist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SpotifyPlaylist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiIntegrationsSpotifyTracksSyncResponse parses an HTTP response from a PostApiIntegrationsSpotifyTracksSyncWithResponse call
+func ParsePostApiIntegrationsSpotifyTracksSyncResponse(rsp *http.Response) (*PostApiIntegrationsSpotifyTracksSyncResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiIntegrationsSpotifyTracksSyncResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiInvitesResponse parses an HTTP response from a GetApiInvitesWithResponse call
+func ParseGetApiInvitesResponse(rsp *http.Response) (*GetApiInvitesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiInvitesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListInvitesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiInvitesResponse parses an HTTP response from a PostApiInvitesWithResponse call
+func ParsePostApiInvitesResponse(rsp *http.Response) (*PostApiInvitesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiInvitesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreateInviteResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiInvitesIdResponse parses an HTTP response from a DeleteApiInvitesIdWithResponse call
+func ParseDeleteApiInvitesIdResponse(rsp *http.Response) (*DeleteApiInvitesIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiInvitesIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiJobsResponse parses an HTTP response from a GetApiJobsWithResponse call
+func ParseGetApiJobsResponse(rsp *http.Response) (*GetApiJobsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiJobsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListJobsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchApiJobsNameResponse parses an HTTP response from a PatchApiJobsNameWithResponse call
+func ParsePatchApiJobsNameResponse(rsp *http.Response) (*PatchApiJobsNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchApiJobsNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiJobsNameTriggerResponse parses an HTTP response from a PostApiJobsNameTriggerWithResponse call
+func ParsePostApiJobsNameTriggerResponse(rsp *http.Response) (*PostApiJobsNameTriggerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiJobsNameTriggerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiListenbrainz1SubmitListensResponse parses an HTTP response from a PostApiListenbrainz1SubmitListensWithResponse call
+func ParsePostApiListenbrainz1SubmitListensResponse(rsp *http.Response) (*PostApiListenbrainz1SubmitListensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiListenbrainz1SubmitListensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListenBrainzStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiListenbrainz1ValidateTokenResponse parses an HTTP response from a GetApiListenbrainz1ValidateTokenWithResponse call
+func ParseGetApiListenbrainz1ValidateTokenResponse(rsp *http.Response) (*GetApiListenbrainz1ValidateTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiListenbrainz1ValidateTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ValidateListenTokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiListensExportResponse parses an HTTP response from a GetApiListensExportWithResponse call
+func ParseGetApiListensExportResponse(rsp *http.Response) (*GetApiListensExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiListensExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiLoginResponse parses an HTTP response from a PostApiLoginWithResponse call
+func ParsePostApiLoginResponse(rsp *http.Response) (*PostApiLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest MFAChallenge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiLoginMfaResponse parses an HTTP response from a PostApiLoginMfaWithResponse call
+func ParsePostApiLoginMfaResponse(rsp *http.Response) (*PostApiLoginMfaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiLoginMfaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiLoginPasskeyBeginResponse parses an HTTP response from a PostApiLoginPasskeyBeginWithResponse call
+func ParsePostApiLoginPasskeyBeginResponse(rsp *http.Response) (*PostApiLoginPasskeyBeginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiLoginPasskeyBeginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PasskeyCeremony
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiLoginPasskeyFinishResponse parses an HTTP response from a PostApiLoginPasskeyFinishWithResponse call
+func ParsePostApiLoginPasskeyFinishResponse(rsp *http.Response) (*PostApiLoginPasskeyFinishResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiLoginPasskeyFinishResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiLogoutResponse parses an HTTP response from a PostApiLogoutWithResponse call
+func ParsePostApiLogoutResponse(rsp *http.Response) (*PostApiLogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiLogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiMeAlbumsTopResponse parses an HTTP response from a GetApiMeAlbumsTopWithResponse call
+func ParseGetApiMeAlbumsTopResponse(rsp *http.Response) (*GetApiMeAlbumsTopResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiMeAlbumsTopResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Albums []TopAlbum `json:"albums"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiMeArtistsTopResponse parses an HTTP response from a GetApiMeArtistsTopWithResponse call
+func ParseGetApiMeArtistsTopResponse(rsp *http.Response) (*GetApiMeArtistsTopResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiMeArtistsTopResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Artists []TopArtist `json:"artists"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiMeListenTokenResponse parses an HTTP response from a DeleteApiMeListenTokenWithResponse call
+func ParseDeleteApiMeListenTokenResponse(rsp *http.Response) (*DeleteApiMeListenTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiMeListenTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiMeListenTokenResponse parses an HTTP response from a PostApiMeListenTokenWithResponse call
+func ParsePostApiMeListenTokenResponse(rsp *http.Response) (*PostApiMeListenTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiMeListenTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ListenTokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiMeListensResponse parses an HTTP response from a GetApiMeListensWithResponse call
+func ParseGetApiMeListensResponse(rsp *http.Response) (*GetApiMeListensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiMeListensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListListensResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiMeListensExportResponse parses an HTTP response from a GetApiMeListensExportWithResponse call
+func ParseGetApiMeListensExportResponse(rsp *http.Response) (*GetApiMeListensExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiMeListensExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiMeListensImportResponse parses an HTTP response from a PostApiMeListensImportWithResponse call
+func ParsePostApiMeListensImportResponse(rsp *http.Response) (*PostApiMeListensImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiMeListensImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportListensResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiMeMfaResponse parses an HTTP response from a GetApiMeMfaWithResponse call
+func ParseGetApiMeMfaResponse(rsp *http.Response) (*GetApiMeMfaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiMeMfaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MFAStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiMeMfaTotpResponse parses an HTTP response from a DeleteApiMeMfaTotpWithResponse call
+func ParseDeleteApiMeMfaTotpResponse(rsp *http.Response) (*DeleteApiMeMfaTotpResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiMeMfaTotpResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiMeMfaTotpResponse parses an HTTP response from a PostApiMeMfaTotpWithResponse call
+func ParsePostApiMeMfaTotpResponse(rsp *http.Response) (*PostApiMeMfaTotpResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiMeMfaTotpResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TOTPEnrollment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiMeMfaTotpVerifyResponse parses an HTTP response from a PostApiMeMfaTotpVerifyWithResponse call
+func ParsePostApiMeMfaTotpVerifyResponse(rsp *http.Response) (*PostApiMeMfaTotpVerifyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiMeMfaTotpVerifyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecoveryCodes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiMePasskeysResponse parses an HTTP response from a GetApiMePasskeysWithResponse call
+func ParseGetApiMePasskeysResponse(rsp *http.Response) (*GetApiMePasskeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiMePasskeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListPasskeysResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiMePasskeysRegisterBeginResponse parses an HTTP response from a PostApiMePasskeysRegisterBeginWithResponse call
+func ParsePostApiMePasskeysRegisterBeginResponse(rsp *http.Response) (*PostApiMePasskeysRegisterBeginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiMePasskeysRegisterBeginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PasskeyCeremony
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiMePasskeysRegisterFinishResponse parses an HTTP response from a PostApiMePasskeysRegisterFinishWithResponse call
+func ParsePostApiMePasskeysRegisterFinishResponse(rsp *http.Response) (*PostApiMePasskeysRegisterFinishResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiMePasskeysRegisterFinishResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Passkey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiMePasskeysIdResponse parses an HTTP response from a DeleteApiMePasskeysIdWithResponse call
+func ParseDeleteApiMePasskeysIdResponse(rsp *http.Response) (*DeleteApiMePasskeysIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiMePasskeysIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiMePasswordResponse parses an HTTP response from a PostApiMePasswordWithResponse call
+func ParsePostApiMePasswordResponse(rsp *http.Response) (*PostApiMePasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiMePasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiMePlaylistRulesResponse parses an HTTP response from a GetApiMePlaylistRulesWithResponse call
+func ParseGetApiMePlaylistRulesResponse(rsp *http.Response) (*GetApiMePlaylistRulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiMePlaylistRulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PlaylistRules
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchApiMePlaylistRulesResponse parses an HTTP response from a PatchApiMePlaylistRulesWithResponse call
+func ParsePatchApiMePlaylistRulesResponse(rsp *http.Response) (*PatchApiMePlaylistRulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchApiMePlaylistRulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PlaylistRules
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiMePlaylistsResponse parses an HTTP response from a GetApiMePlaylistsWithResponse call
+func ParseGetApiMePlaylistsResponse(rsp *http.Response) (*GetApiMePlaylistsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiMePlaylistsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListPlaylists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiMePreferencesResponse parses an HTTP response from a GetApiMePreferencesWithResponse call
+func ParseGetApiMePreferencesResponse(rsp *http.Response) (*GetApiMePreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiMePreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Preferences
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchApiMePreferencesResponse parses an HTTP response from a PatchApiMePreferencesWithResponse call
+func ParsePatchApiMePreferencesResponse(rsp *http.Response) (*PatchApiMePreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchApiMePreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Preferences
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiMeSessionsResponse parses an HTTP response from a GetApiMeSessionsWithResponse call
+func ParseGetApiMeSessionsResponse(rsp *http.Response) (*GetApiMeSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiMeSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListSessionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiMeSessionsIdResponse parses an HTTP response from a DeleteApiMeSessionsIdWithResponse call
+func ParseDeleteApiMeSessionsIdResponse(rsp *http.Response) (*DeleteApiMeSessionsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiMeSessionsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiMeStatsResponse parses an HTTP response from a GetApiMeStatsWithResponse call
+func ParseGetApiMeStatsResponse(rsp *http.Response) (*GetApiMeStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiMeStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListeningStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiMeTracksTopResponse parses an HTTP response from a GetApiMeTracksTopWithResponse call
+func ParseGetApiMeTracksTopResponse(rsp *http.Response) (*GetApiMeTracksTopResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiMeTracksTopResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Tracks []PlaylistTrack `json:"tracks"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiMeYearInReviewYearResponse parses an HTTP response from a GetApiMeYearInReviewYearWithResponse call
+func ParseGetApiMeYearInReviewYearResponse(rsp *http.Response) (*GetApiMeYearInReviewYearResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiMeYearInReviewYearResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest YearInReview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiOauthSpotifyConfigJsonResponse parses an HTTP response from a GetApiOauthSpotifyConfigJsonWithResponse call
+func ParseGetApiOauthSpotifyConfigJsonResponse(rsp *http.Response) (*GetApiOauthSpotifyConfigJsonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiOauthSpotifyConfigJsonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SpotifyOAuth
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiOauthSpotifyTokenResponse parses an HTTP response from a PostApiOauthSpotifyTokenWithResponse call
+func ParsePostApiOauthSpotifyTokenResponse(rsp *http.Response) (*PostApiOauthSpotifyTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiOauthSpotifyTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiOauthSpotifyTokenRefreshResponse parses an HTTP response from a PostApiOauthSpotifyTokenRefreshWithResponse call
+func ParsePostApiOauthSpotifyTokenRefreshResponse(rsp *http.Response) (*PostApiOauthSpotifyTokenRefreshResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiOauthSpotifyTokenRefreshResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiOpenapiYamlResponse parses an HTTP response from a GetApiOpenapiYamlWithResponse call
+func ParseGetApiOpenapiYamlResponse(rsp *http.Response) (*GetApiOpenapiYamlResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiOpenapiYamlResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
+		var dest string
+		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.YAML200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiPasswordResetResponse parses an HTTP response from a PostApiPasswordResetWithResponse call
+func ParsePostApiPasswordResetResponse(rsp *http.Response) (*PostApiPasswordResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiPasswordResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiPasswordResetConfirmResponse parses an HTTP response from a PostApiPasswordResetConfirmWithResponse call
+func ParsePostApiPasswordResetConfirmResponse(rsp *http.Response) (*PostApiPasswordResetConfirmResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiPasswordResetConfirmResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiPlaylistsResponse parses an HTTP response from a PostApiPlaylistsWithResponse call
+func ParsePostApiPlaylistsResponse(rsp *http.Response) (*PostApiPlaylistsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiPlaylistsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatePlaylistResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiPlaylistsIdResponse parses an HTTP response from a GetApiPlaylistsIdWithResponse call
+func ParseGetApiPlaylistsIdResponse(rsp *http.Response) (*GetApiPlaylistsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiPlaylistsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Playlist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiRegisterResponse parses an HTTP response from a PostApiRegisterWithResponse call
+func ParsePostApiRegisterResponse(rsp *http.Response) (*PostApiRegisterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiRegisterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiSpotifyStatusResponse parses an HTTP response from a GetApiSpotifyStatusWithResponse call
+func ParseGetApiSpotifyStatusResponse(rsp *http.Response) (*GetApiSpotifyStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiSpotifyStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SpotifyStatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiSpotifySyncResponse parses an HTTP response from a GetApiSpotifySyncWithResponse call
+func ParseGetApiSpotifySyncResponse(rsp *http.Response) (*GetApiSpotifySyncResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiSpotifySyncResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SpotifySyncStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiUsersResponse parses an HTTP response from a GetApiUsersWithResponse call
+func ParseGetApiUsersResponse(rsp *http.Response) (*GetApiUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListUsersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiUsersIdSignOutResponse parses an HTTP response from a PostApiUsersIdSignOutWithResponse call
+func ParsePostApiUsersIdSignOutResponse(rsp *http.Response) (*PostApiUsersIdSignOutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiUsersIdSignOutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Refresh session tokens
+	// (POST /api/auth/refresh)
+	PostApiAuthRefresh(w http.ResponseWriter, r *http.Request, params PostApiAuthRefreshParams)
+	// Verify user session
+	// (GET /api/auth/verify)
+	GetApiAuthVerify(w http.ResponseWriter, r *http.Request, params GetApiAuthVerifyParams)
+	// Health check
+	// (GET /api/health)
+	GetApiHealth(w http.ResponseWriter, r *http.Request)
+	// Export a mars playlist to spotify for a user.
+	// (POST /api/integrations/spotify/playlist)
+	PostApiIntegrationsSpotifyPlaylist(w http.ResponseWriter, r *http.Request, params PostApiIntegrationsSpotifyPlaylistParams)
+	// Unlink a mars playlist from its spotify playlist.
+	// (DELETE /api/integrations/spotify/playlist/{id})
+	DeleteApiIntegrationsSpotifyPlaylistId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteApiIntegrationsSpotifyPlaylistIdParams)
+	// Export a mars playlist to the user's spotify account.
+	// (POST /api/integrations/spotify/playlist/{id})
+	PostApiIntegrationsSpotifyPlaylistId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostApiIntegrationsSpotifyPlaylistIdParams)
+	// Sync recent spotify tracks
+	// (POST /api/integrations/spotify/tracks/sync)
+	PostApiIntegrationsSpotifyTracksSync(w http.ResponseWriter, r *http.Request, params PostApiIntegrationsSpotifyTracksSyncParams)
+	// List invites
+	// (GET /api/invites)
+	GetApiInvites(w http.ResponseWriter, r *http.Request, params GetApiInvitesParams)
+	// Create an invite
+	// (POST /api/invites)
+	PostApiInvites(w http.ResponseWriter, r *http.Request, params PostApiInvitesParams)
+	// Revoke an invite
+	// (DELETE /api/invites/{id})
+	DeleteApiInvitesId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteApiInvitesIdParams)
+	// List scheduled jobs
+	// (GET /api/jobs)
+	GetApiJobs(w http.ResponseWriter, r *http.Request, params GetApiJobsParams)
+	// Update a scheduled job
+	// (PATCH /api/jobs/{name})
+	PatchApiJobsName(w http.ResponseWriter, r *http.Request, name string, params PatchApiJobsNameParams)
+	// Trigger a scheduled job
+	// (POST /api/jobs/{name}/trigger)
+	PostApiJobsNameTrigger(w http.ResponseWriter, r *http.Request, name string, params PostApiJobsNameTriggerParams)
+	// Submit listens.
+	// (POST /api/listenbrainz/1/submit-listens)
+	PostApiListenbrainz1SubmitListens(w http.ResponseWriter, r *http.Request)
+	// Validate a listen submission token.
+	// (GET /api/listenbrainz/1/validate-token)
+	GetApiListenbrainz1ValidateToken(w http.ResponseWriter, r *http.Request)
+	// Export the listening history of every user.
+	// (GET /api/listens/export)
+	GetApiListensExport(w http.ResponseWriter, r *http.Request, params GetApiListensExportParams)
+	// User login
+	// (POST /api/login)
+	PostApiLogin(w http.ResponseWriter, r *http.Request)
+	// Complete a two-factor login
+	// (POST /api/login/mfa)
+	PostApiLoginMfa(w http.ResponseWriter, r *http.Request)
+	// Start a passkey login
+	// (POST /api/login/passkey/begin)
+	PostApiLoginPasskeyBegin(w http.ResponseWriter, r *http.Request)
+	// Finish a passkey login
+	// (POST /api/login/passkey/finish)
+	PostApiLoginPasskeyFinish(w http.ResponseWriter, r *http.Request)
+	// Log out
+	// (POST /api/logout)
+	PostApiLogout(w http.ResponseWriter, r *http.Request, params PostApiLogoutParams)
+	// Get top albums for a user within a time range.
+	// (GET /api/me/albums/top)
+	GetApiMeAlbumsTop(w http.ResponseWriter, r *http.Request, params GetApiMeAlbumsTopParams)
+	// Get top artists for a user within a time range.
+	// (GET /api/me/artists/top)
+	GetApiMeArtistsTop(w http.ResponseWriter, r *http.Request, params GetApiMeArtistsTopParams)
+	// Revoke the listen submission token
+	// (DELETE /api/me/listen-token)
+	DeleteApiMeListenToken(w http.ResponseWriter, r *http.Request, params DeleteApiMeListenTokenParams)
+	// Create a listen submission token
+	// (POST /api/me/listen-token)
+	PostApiMeListenToken(w http.ResponseWriter, r *http.Request, params PostApiMeListenTokenParams)
+	// List listens.
+	// (GET /api/me/listens)
+	GetApiMeListens(w http.ResponseWriter, r *http.Request, params GetApiMeListensParams)
+	// Export listening history.
+	// (GET /api/me/listens/export)
+	GetApiMeListensExport(w http.ResponseWriter, r *http.Request, params GetApiMeListensExportParams)
+	// Import Spotify extended streaming history.
+	// (POST /api/me/listens/import)
+	PostApiMeListensImport(w http.ResponseWriter, r *http.Request, params PostApiMeListensImportParams)
+	// Get two-factor authentication status
+	// (GET /api/me/mfa)
+	GetApiMeMfa(w http.ResponseWriter, r *http.Request, params GetApiMeMfaParams)
+	// Disable TOTP
+	// (DELETE /api/me/mfa/totp)
+	DeleteApiMeMfaTotp(w http.ResponseWriter, r *http.Request, params DeleteApiMeMfaTotpParams)
+	// Start TOTP enrollment
+	// (POST /api/me/mfa/totp)
+	PostApiMeMfaTotp(w http.ResponseWriter, r *http.Request, params PostApiMeMfaTotpParams)
+	// Confirm TOTP enrollment
+	// (POST /api/me/mfa/totp/verify)
+	PostApiMeMfaTotpVerify(w http.ResponseWriter, r *http.Request, params PostApiMeMfaTotpVerifyParams)
+	// List passkeys
+	// (GET /api/me/passkeys)
+	GetApiMePasskeys(w http.ResponseWriter, r *http.Request, params GetApiMePasskeysParams)
+	// Start registering a passkey
+	// (POST /api/me/passkeys/register/begin)
+	PostApiMePasskeysRegisterBegin(w http.ResponseWriter, r *http.Request, params PostApiMePasskeysRegisterBeginParams)
+	// Finish registering a passkey
+	// (POST /api/me/passkeys/register/finish)
+	PostApiMePasskeysRegisterFinish(w http.ResponseWriter, r *http.Request, params PostApiMePasskeysRegisterFinishParams)
+	// Delete a passkey
+	// (DELETE /api/me/passkeys/{id})
+	DeleteApiMePasskeysId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteApiMePasskeysIdParams)
+	// Change password
+	// (POST /api/me/password)
+	PostApiMePassword(w http.ResponseWriter, r *http.Request, params PostApiMePasswordParams)
+	// Get personal playlist rules
+	// (GET /api/me/playlist-rules)
+	GetApiMePlaylistRules(w http.ResponseWriter, r *http.Request, params GetApiMePlaylistRulesParams)
+	// Update personal playlist rules
+	// (PATCH /api/me/playlist-rules)
+	PatchApiMePlaylistRules(w http.ResponseWriter, r *http.Request, params PatchApiMePlaylistRulesParams)
+	// Get personal playlists
+	// (GET /api/me/playlists)
+	GetApiMePlaylists(w http.ResponseWriter, r *http.Request, params GetApiMePlaylistsParams)
+	// Get personal preferences
+	// (GET /api/me/preferences)
+	GetApiMePreferences(w http.ResponseWriter, r *http.Request, params GetApiMePreferencesParams)
+	// Update personal preferences
+	// (PATCH /api/me/preferences)
+	PatchApiMePreferences(w http.ResponseWriter, r *http.Request, params PatchApiMePreferencesParams)
+	// List sessions
+	// (GET /api/me/sessions)
+	GetApiMeSessions(w http.ResponseWriter, r *http.Request, params GetApiMeSessionsParams)
+	// Revoke a session
+	// (DELETE /api/me/sessions/{id})
+	DeleteApiMeSessionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteApiMeSessionsIdParams)
+	// Get listening statistics for a user within a time range.
+	// (GET /api/me/stats)
+	GetApiMeStats(w http.ResponseWriter, r *http.Request, params GetApiMeStatsParams)
+	// Get top tracks for a user within a time range.
+	// (GET /api/me/tracks/top)
+	GetApiMeTracksTop(w http.ResponseWriter, r *http.Request, params GetApiMeTracksTopParams)
+	// Get a year in review
+	// (GET /api/me/year-in-review/{year})
+	GetApiMeYearInReviewYear(w http.ResponseWriter, r *http.Request, year int, params GetApiMeYearInReviewYearParams)
+	// Get Spotify OAuth2.0 configuration.
+	// (GET /api/oauth/spotify/config.json)
+	GetApiOauthSpotifyConfigJson(w http.ResponseWriter, r *http.Request)
+	// Get Spotify OAuth2.0 tokens.
+	// (POST /api/oauth/spotify/token)
+	PostApiOauthSpotifyToken(w http.ResponseWriter, r *http.Request)
+	// Refresh a Spotify OAuth2.0 token.
+	// (POST /api/oauth/spotify/token/refresh)
+	PostApiOauthSpotifyTokenRefresh(w http.ResponseWriter, r *http.Request, params PostApiOauthSpotifyTokenRefreshParams)
+	// Get OpenAPI specification.
+	// (GET /api/openapi.yaml)
+	GetApiOpenapiYaml(w http.ResponseWriter, r *http.Request)
+	// Request a password reset
+	// (POST /api/password-reset)
+	PostApiPasswordReset(w http.ResponseWriter, r *http.Request)
+	// Reset a password
+	// (POST /api/password-reset/confirm)
+	PostApiPasswordResetConfirm(w http.ResponseWriter, r *http.Request)
+	// Create a playlist from listening history
+	// (POST /api/playlists)
+	PostApiPlaylists(w http.ResponseWriter, r *http.Request, params PostApiPlaylistsParams)
+	// Get a personal playlist
+	// (GET /api/playlists/{id})
+	GetApiPlaylistsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetApiPlaylistsIdParams)
+	// Register a new user
+	// (POST /api/register)
+	PostApiRegister(w http.ResponseWriter, r *http.Request)
+	// Get Spotify OAuth2.0 status.
+	// (GET /api/spotify/status)
+	GetApiSpotifyStatus(w http.ResponseWriter, r *http.Request)
+	// Get Spotify track sync status.
+	// (GET /api/spotify/sync)
+	GetApiSpotifySync(w http.ResponseWriter, r *http.Request, params GetApiSpotifySyncParams)
+	// List users
+	// (GET /api/users)
+	GetApiUsers(w http.ResponseWriter, r *http.Request, params GetApiUsersParams)
+	// Sign a user out everywhere
+	// (POST /api/users/{id}/sign-out)
+	PostApiUsersIdSignOut(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostApiUsersIdSignOutParams)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Refresh session tokens
+// (POST /api/auth/refresh)
+func (_ Unimplemented) PostApiAuthRefresh(w http.ResponseWriter, r *http.Request, params PostApiAuthRefreshParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Verify user session
+// (GET /api/auth/verify)
+func (_ Unimplemented) GetApiAuthVerify(w http.ResponseWriter, r *http.Request, params GetApiAuthVerifyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Health check
+// (GET /api/health)
+func (_ Unimplemented) GetApiHealth(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export a mars playlist to spotify for a user.
+// (POST /api/integrations/spotify/playlist)
+func (_ Unimplemented) PostApiIntegrationsSpotifyPlaylist(w http.ResponseWriter, r *http.Request, params PostApiIntegrationsSpotifyPlaylistParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unlink a mars playlist from its spotify playlist.
+// (DELETE /api/integrations/spotify/playlist/{id})
+func (_ Unimplemented) DeleteApiIntegrationsSpotifyPlaylistId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteApiIntegrationsSpotifyPlaylistIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export a mars playlist to the user's spotify account.
+// (POST /api/integrations/spotify/playlist/{id})
+func (_ Unimplemented) PostApiIntegrationsSpotifyPlaylistId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostApiIntegrationsSpotifyPlaylistIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Sync recent spotify tracks
+// (POST /api/integrations/spotify/tracks/sync)
+func (_ Unimplemented) PostApiIntegrationsSpotifyTracksSync(w http.ResponseWriter, r *http.Request, params PostApiIntegrationsSpotifyTracksSyncParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List invites
+// (GET /api/invites)
+func (_ Unimplemented) GetApiInvites(w http.ResponseWriter, r *http.Request, params GetApiInvitesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create an invite
+// (POST /api/invites)
+func (_ Unimplemented) PostApiInvites(w http.ResponseWriter, r *http.Request, params PostApiInvitesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke an invite
+// (DELETE /api/invites/{id})
+func (_ Unimplemented) DeleteApiInvitesId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteApiInvitesIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List scheduled jobs
+// (GET /api/jobs)
+func (_ Unimplemented) GetApiJobs(w http.ResponseWriter, r *http.Request, params GetApiJobsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a scheduled job
+// (PATCH /api/jobs/{name})
+func (_ Unimplemented) PatchApiJobsName(w http.ResponseWriter, r *http.Request, name string, params PatchApiJobsNameParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Trigger a scheduled job
+// (POST /api/jobs/{name}/trigger)
+func (_ Unimplemented) PostApiJobsNameTrigger(w http.ResponseWriter, r *http.Request, name string, params PostApiJobsNameTriggerParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Submit listens.
+// (POST /api/listenbrainz/1/submit-listens)
+func (_ Unimplemented) PostApiListenbrainz1SubmitListens(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Validate a listen submission token.
+// (GET /api/listenbrainz/1/validate-token)
+func (_ Unimplemented) GetApiListenbrainz1ValidateToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export the listening history of every user.
+// (GET /api/listens/export)
+func (_ Unimplemented) GetApiListensExport(w http.ResponseWriter, r *http.Request, params GetApiListensExportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// User login
+// (POST /api/login)
+func (_ Unimplemented) PostApiLogin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Complete a two-factor login
+// (POST /api/login/mfa)
+func (_ Unimplemented) PostApiLoginMfa(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start a passkey login
+// (POST /api/login/passkey/begin)
+func (_ Unimplemented) PostApiLoginPasskeyBegin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Finish a passkey login
+// (POST /api/login/passkey/finish)
+func (_ Unimplemented) PostApiLoginPasskeyFinish(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Log out
+// (POST /api/logout)
+func (_ Unimplemented) PostApiLogout(w http.ResponseWriter, r *http.Request, params PostApiLogoutParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get top albums for a user within a time range.
+// (GET /api/me/albums/top)
+func (_ Unimplemented) GetApiMeAlbumsTop(w http.ResponseWriter, r *http.Request, params GetApiMeAlbumsTopParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get top artists for a user within a time range.
+// (GET /api/me/artists/top)
+func (_ Unimplemented) GetApiMeArtistsTop(w http.ResponseWriter, r *http.Request, params GetApiMeArtistsTopParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke the listen submission token
+// (DELETE /api/me/listen-token)
+func (_ Unimplemented) DeleteApiMeListenToken(w http.ResponseWriter, r *http.Request, params DeleteApiMeListenTokenParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a listen submission token
+// (POST /api/me/listen-token)
+func (_ Unimplemented) PostApiMeListenToken(w http.ResponseWriter, r *http.Request, params PostApiMeListenTokenParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List listens.
+// (GET /api/me/listens)
+func (_ Unimplemented) GetApiMeListens(w http.ResponseWriter, r *http.Request, params GetApiMeListensParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export listening history.
+// (GET /api/me/listens/export)
+func (_ Unimplemented) GetApiMeListensExport(w http.ResponseWriter, r *http.Request, params GetApiMeListensExportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import Spotify extended streaming history.
+// (POST /api/me/listens/import)
+func (_ Unimplemented) PostApiMeListensImport(w http.ResponseWriter, r *http.Request, params PostApiMeListensImportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get two-factor authentication status
+// (GET /api/me/mfa)
+func (_ Unimplemented) GetApiMeMfa(w http.ResponseWriter, r *http.Request, params GetApiMeMfaParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Disable TOTP
+// (DELETE /api/me/mfa/totp)
+func (_ Unimplemented) DeleteApiMeMfaTotp(w http.ResponseWriter, r *http.Request, params DeleteApiMeMfaTotpParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start TOTP enrollment
+// (POST /api/me/mfa/totp)
+func (_ Unimplemented) PostApiMeMfaTotp(w http.ResponseWriter, r *http.Request, params PostApiMeMfaTotpParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Confirm TOTP enrollment
+// (POST /api/me/mfa/totp/verify)
+func (_ Unimplemented) PostApiMeMfaTotpVerify(w http.ResponseWriter, r *http.Request, params PostApiMeMfaTotpVerifyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List passkeys
+// (GET /api/me/passkeys)
+func (_ Unimplemented) GetApiMePasskeys(w http.ResponseWriter, r *http.Request, params GetApiMePasskeysParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start registering a passkey
+// (POST /api/me/passkeys/register/begin)
+func (_ Unimplemented) PostApiMePasskeysRegisterBegin(w http.ResponseWriter, r *http.Request, params PostApiMePasskeysRegisterBeginParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Finish registering a passkey
+// (POST /api/me/passkeys/register/finish)
+func (_ Unimplemented) PostApiMePasskeysRegisterFinish(w http.ResponseWriter, r *http.Request, params PostApiMePasskeysRegisterFinishParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a passkey
+// (DELETE /api/me/passkeys/{id})
+func (_ Unimplemented) DeleteApiMePasskeysId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteApiMePasskeysIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Change password
+// (POST /api/me/password)
+func (_ Unimplemented) PostApiMePassword(w http.ResponseWriter, r *http.Request, params PostApiMePasswordParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get personal playlist rules
+// (GET /api/me/playlist-rules)
+func (_ Unimplemented) GetApiMePlaylistRules(w http.ResponseWriter, r *http.Request, params GetApiMePlaylistRulesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update personal playlist rules
+// (PATCH /api/me/playlist-rules)
+func (_ Unimplemented) PatchApiMePlaylistRules(w http.ResponseWriter, r *http.Request, params PatchApiMePlaylistRulesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get personal playlists
+// (GET /api/me/playlists)
+func (_ Unimplemented) GetApiMePlaylists(w http.ResponseWriter, r *http.Request, params GetApiMePlaylistsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get personal preferences
+// (GET /api/me/preferences)
+func (_ Unimplemented) GetApiMePreferences(w http.ResponseWriter, r *http.Request, params GetApiMePreferencesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update personal preferences
+// (PATCH /api/me/preferences)
+func (_ Unimplemented) PatchApiMePreferences(w http.ResponseWriter, r *http.Request, params PatchApiMePreferencesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List sessions
+// (GET /api/me/sessions)
+func (_ Unimplemented) GetApiMeSessions(w http.ResponseWriter, r *http.Request, params GetApiMeSessionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke a session
+// (DELETE /api/me/sessions/{id})
+func (_ Unimplemented) DeleteApiMeSessionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteApiMeSessionsIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get listening statistics for a user within a time range.
+// (GET /api/me/stats)
+func (_ Unimplemented) GetApiMeStats(w http.ResponseWriter, r *http.Request, params GetApiMeStatsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get top tracks for a user within a time range.
+// (GET /api/me/tracks/top)
+func (_ Unimplemented) GetApiMeTracksTop(w http.ResponseWriter, r *http.Request, params GetApiMeTracksTopParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a year in review
+// (GET /api/me/year-in-review/{year})
+func (_ Unimplemented) GetApiMeYearInReviewYear(w http.ResponseWriter, r *http.Request, year int, params GetApiMeYearInReviewYearParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Spotify OAuth2.0 configuration.
+// (GET /api/oauth/spotify/config.json)
+func (_ Unimplemented) GetApiOauthSpotifyConfigJson(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Spotify OAuth2.0 tokens.
+// (POST /api/oauth/spotify/token)
+func (_ Unimplemented) PostApiOauthSpotifyToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Refresh a Spotify OAuth2.0 token.
+// (POST /api/oauth/spotify/token/refresh)
+func (_ Unimplemented) PostApiOauthSpotifyTokenRefresh(w http.ResponseWriter, r *http.Request, params PostApiOauthSpotifyTokenRefreshParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get OpenAPI specification.
+// (GET /api/openapi.yaml)
+func (_ Unimplemented) GetApiOpenapiYaml(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Request a password reset
+// (POST /api/password-reset)
+func (_ Unimplemented) PostApiPasswordReset(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reset a password
+// (POST /api/password-reset/confirm)
+func (_ Unimplemented) PostApiPasswordResetConfirm(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a playlist from listening history
+// (POST /api/playlists)
+func (_ Unimplemented) PostApiPlaylists(w http.ResponseWriter, r *http.Request, params PostApiPlaylistsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a personal playlist
+// (GET /api/playlists/{id})
+func (_ Unimplemented) GetApiPlaylistsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetApiPlaylistsIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Register a new user
+// (POST /api/register)
+func (_ Unimplemented) PostApiRegister(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Spotify OAuth2.0 status.
+// (GET /api/spotify/status)
+func (_ Unimplemented) GetApiSpotifyStatus(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Spotify track sync status.
+// (GET /api/spotify/sync)
+func (_ Unimplemented) GetApiSpotifySync(w http.ResponseWriter, r *http.Request, params GetApiSpotifySyncParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List users
+// (GET /api/users)
+func (_ Unimplemented) GetApiUsers(w http.ResponseWriter, r *http.Request, params GetApiUsersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Sign a user out everywhere
+// (POST /api/users/{id}/sign-out)
+func (_ Unimplemented) PostApiUsersIdSignOut(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostApiUsersIdSignOutParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// PostApiAuthRefresh operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAuthRefresh(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiAuthRefreshParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("refresh"); err == nil {
+			var value RefreshTokenCookie
+			err = runtime.BindStyledParameterWithOptions("simple", "refresh", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refresh", Err: err})
+				return
+			}
+			params.Refresh = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiAuthRefresh(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiAuthVerify operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAuthVerify(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiAuthVerifyParams
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role", r.URL.Query(), &params.Role)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
+		return
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiAuthVerify(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetApiHealth(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiIntegrationsSpotifyPlaylist operation middleware
+func (siw *ServerInterfaceWrapper) PostApiIntegrationsSpotifyPlaylist(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiIntegrationsSpotifyPlaylistParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiIntegrationsSpotifyPlaylist(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiIntegrationsSpotifyPlaylistId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiIntegrationsSpotifyPlaylistId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteApiIntegrationsSpotifyPlaylistIdParams
+
+	// ------------- Optional query parameter "delete_remote" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "delete_remote", r.URL.Query(), &params.DeleteRemote)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "delete_remote", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiIntegrationsSpotifyPlaylistId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiIntegrationsSpotifyPlaylistId operation middleware
+func (siw *ServerInterfaceWrapper) PostApiIntegrationsSpotifyPlaylistId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiIntegrationsSpotifyPlaylistIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiIntegrationsSpotifyPlaylistId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiIntegrationsSpotifyTracksSync operation middleware
+func (siw *ServerInterfaceWrapper) PostApiIntegrationsSpotifyTracksSync(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiIntegrationsSpotifyTracksSyncParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiIntegrationsSpotifyTracksSync(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiInvites operation middleware
+func (siw *ServerInterfaceWrapper) GetApiInvites(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiInvitesParams
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiInvites(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiInvites operation middleware
+func (siw *ServerInterfaceWrapper) PostApiInvites(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiInvitesParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiInvites(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiInvitesId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiInvitesId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteApiInvitesIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiInvitesId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiJobs operation middleware
+func (siw *ServerInterfaceWrapper) GetApiJobs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiJobsParams
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiJobs(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchApiJobsName operation middleware
+func (siw *ServerInterfaceWrapper) PatchApiJobsName(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchApiJobsNameParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchApiJobsName(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiJobsNameTrigger operation middleware
+func (siw *ServerInterfaceWrapper) PostApiJobsNameTrigger(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiJobsNameTriggerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiJobsNameTrigger(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiListenbrainz1SubmitListens operation middleware
+func (siw *ServerInterfaceWrapper) PostApiListenbrainz1SubmitListens(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ListenTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiListenbrainz1SubmitListens(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiListenbrainz1ValidateToken operation middleware
+func (siw *ServerInterfaceWrapper) GetApiListenbrainz1ValidateToken(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ListenTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiListenbrainz1ValidateToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiListensExport operation middleware
+func (siw *ServerInterfaceWrapper) GetApiListensExport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiListensExportParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start", r.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end", r.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
+		return
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiListensExport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostApiLogin(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiLoginMfa operation middleware
+func (siw *ServerInterfaceWrapper) PostApiLoginMfa(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiLoginMfa(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiLoginPasskeyBegin operation middleware
+func (siw *ServerInterfaceWrapper) PostApiLoginPasskeyBegin(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiLoginPasskeyBegin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiLoginPasskeyFinish operation middleware
+func (siw *ServerInterfaceWrapper) PostApiLoginPasskeyFinish(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiLoginPasskeyFinish(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiLogout operation middleware
+func (siw *ServerInterfaceWrapper) PostApiLogout(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiLogoutParams
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("refresh"); err == nil {
+			var value RefreshTokenCookie
+			err = runtime.BindStyledParameterWithOptions("simple", "refresh", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refresh", Err: err})
+				return
+			}
+			params.Refresh = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiLogout(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiMeAlbumsTop operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMeAlbumsTop(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiMeAlbumsTopParams
+
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start", r.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end", r.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", r.URL.Query(), &params.Period)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "period", Err: err})
+		return
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiMeAlbumsTop(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiMeArtistsTop operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMeArtistsTop(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiMeArtistsTopParams
+
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start", r.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end", r.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", r.URL.Query(), &params.Period)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "period", Err: err})
+		return
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiMeArtistsTop(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiMeListenToken operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiMeListenToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteApiMeListenTokenParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiMeListenToken(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiMeListenToken operation middleware
+func (siw *ServerInterfaceWrapper) PostApiMeListenToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiMeListenTokenParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiMeListenToken(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiMeListens operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMeListens(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiMeListensParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "artist" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "artist", r.URL.Query(), &params.Artist)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artist", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "track" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "track", r.URL.Query(), &params.Track)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "track", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start", r.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end", r.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
+		return
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiMeListens(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiMeListensExport operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMeListensExport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiMeListensExportParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start", r.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end", r.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
+		return
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiMeListensExport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiMeListensImport operation middleware
+func (siw *ServerInterfaceWrapper) PostApiMeListensImport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiMeListensImportParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiMeListensImport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiMeMfa operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMeMfa(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiMeMfaParams
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiMeMfa(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiMeMfaTotp operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiMeMfaTotp(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteApiMeMfaTotpParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiMeMfaTotp(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiMeMfaTotp operation middleware
+func (siw *ServerInterfaceWrapper) PostApiMeMfaTotp(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiMeMfaTotpParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiMeMfaTotp(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiMeMfaTotpVerify operation middleware
+func (siw *ServerInterfaceWrapper) PostApiMeMfaTotpVerify(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiMeMfaTotpVerifyParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiMeMfaTotpVerify(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiMePasskeys operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMePasskeys(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiMePasskeysParams
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiMePasskeys(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiMePasskeysRegisterBegin operation middleware
+func (siw *ServerInterfaceWrapper) PostApiMePasskeysRegisterBegin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiMePasskeysRegisterBeginParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiMePasskeysRegisterBegin(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiMePasskeysRegisterFinish operation middleware
+func (siw *ServerInterfaceWrapper) PostApiMePasskeysRegisterFinish(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiMePasskeysRegisterFinishParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiMePasskeysRegisterFinish(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiMePasskeysId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiMePasskeysId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteApiMePasskeysIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiMePasskeysId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiMePassword operation middleware
+func (siw *ServerInterfaceWrapper) PostApiMePassword(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiMePasswordParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiMePassword(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiMePlaylistRules operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMePlaylistRules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiMePlaylistRulesParams
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiMePlaylistRules(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchApiMePlaylistRules operation middleware
+func (siw *ServerInterfaceWrapper) PatchApiMePlaylistRules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchApiMePlaylistRulesParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchApiMePlaylistRules(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiMePlaylists operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMePlaylists(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiMePlaylistsParams
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiMePlaylists(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiMePreferences operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMePreferences(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiMePreferencesParams
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiMePreferences(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchApiMePreferences operation middleware
+func (siw *ServerInterfaceWrapper) PatchApiMePreferences(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchApiMePreferencesParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchApiMePreferences(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiMeSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMeSessions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiMeSessionsParams
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiMeSessions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiMeSessionsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiMeSessionsId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteApiMeSessionsIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiMeSessionsId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiMeStats operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMeStats(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiMeStatsParams
+
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start", r.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end", r.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", r.URL.Query(), &params.Period)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "period", Err: err})
+		return
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiMeStats(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiMeTracksTop operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMeTracksTop(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiMeTracksTopParams
+
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start", r.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end", r.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", r.URL.Query(), &params.Period)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "period", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "rank_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "rank_by", r.URL.Query(), &params.RankBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rank_by", Err: err})
+		return
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiMeTracksTop(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiMeYearInReviewYear operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMeYearInReviewYear(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "year" -------------
+	var year int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "year", chi.URLParam(r, "year"), &year, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiMeYearInReviewYearParams
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiMeYearInReviewYear(w, r, year, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiOauthSpotifyConfigJson operation middleware
+func (siw *ServerInterfaceWrapper) GetApiOauthSpotifyConfigJson(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiOauthSpotifyConfigJson(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiOauthSpotifyToken operation middleware
+func (siw *ServerInterfaceWrapper) PostApiOauthSpotifyToken(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiOauthSpotifyToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiOauthSpotifyTokenRefresh operation middleware
+func (siw *ServerInterfaceWrapper) PostApiOauthSpotifyTokenRefresh(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiOauthSpotifyTokenRefreshParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiOauthSpotifyTokenRefresh(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiOpenapiYaml operation middleware
+func (siw *ServerInterfaceWrapper) GetApiOpenapiYaml(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiOpenapiYaml(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiPasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) PostApiPasswordReset(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiPasswordReset(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiPasswordResetConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PostApiPasswordResetConfirm(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiPasswordResetConfirm(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiPlaylists operation middleware
+func (siw *ServerInterfaceWrapper) PostApiPlaylists(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiPlaylistsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiPlaylists(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiPlaylistsId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiPlaylistsId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiPlaylistsIdParams
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiPlaylistsId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiRegister operation middleware
+func (siw *ServerInterfaceWrapper) PostApiRegister(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiRegister(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiSpotifyStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetApiSpotifyStatus(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiSpotifyStatus(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiSpotifySync operation middleware
+func (siw *ServerInterfaceWrapper) GetApiSpotifySync(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiSpotifySyncParams
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiSpotifySync(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiUsers operation middleware
+func (siw *ServerInterfaceWrapper) GetApiUsers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiUsersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiUsers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiUsersIdSignOut operation middleware
+func (siw *ServerInterfaceWrapper) PostApiUsersIdSignOut(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiUsersIdSignOutParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-CSRF-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
+		var XCSRFToken CsrfTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
+			return
+		}
+
+		params.XCSRFToken = &XCSRFToken
+
+	}
+
+	{
+		var cookie *http.Cookie
+
+		if cookie, err = r.Cookie("access"); err == nil {
+			var value AccessTokenHeader
+			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+				return
+			}
+			params.Access = &value
+
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiUsersIdSignOut(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/auth/refresh", wrapper.PostApiAuthRefresh)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/auth/verify", wrapper.GetApiAuthVerify)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/health", wrapper.GetApiHealth)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/integrations/spotify/playlist", wrapper.PostApiIntegrationsSpotifyPlaylist)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/integrations/spotify/playlist/{id}", wrapper.DeleteApiIntegrationsSpotifyPlaylistId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/integrations/spotify/playlist/{id}", wrapper.PostApiIntegrationsSpotifyPlaylistId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/integrations/spotify/tracks/sync", wrapper.PostApiIntegrationsSpotifyTracksSync)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/invites", wrapper.GetApiInvites)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/invites", wrapper.PostApiInvites)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/invites/{id}", wrapper.DeleteApiInvitesId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/jobs", wrapper.GetApiJobs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/api/jobs/{name}", wrapper.PatchApiJobsName)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/jobs/{name}/trigger", wrapper.PostApiJobsNameTrigger)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/listenbrainz/1/submit-listens", wrapper.PostApiListenbrainz1SubmitListens)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/listenbrainz/1/validate-token", wrapper.GetApiListenbrainz1ValidateToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/listens/export", wrapper.GetApiListensExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/login", wrapper.PostApiLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/login/mfa", wrapper.PostApiLoginMfa)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/login/passkey/begin", wrapper.PostApiLoginPasskeyBegin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/login/passkey/finish", wrapper.PostApiLoginPasskeyFinish)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/logout", wrapper.PostApiLogout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/me/albums/top", wrapper.GetApiMeAlbumsTop)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/me/artists/top", wrapper.GetApiMeArtistsTop)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/me/listen-token", wrapper.DeleteApiMeListenToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/me/listen-token", wrapper.PostApiMeListenToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/me/listens", wrapper.GetApiMeListens)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/me/listens/export", wrapper.GetApiMeListensExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/me/listens/import", wrapper.PostApiMeListensImport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/me/mfa", wrapper.GetApiMeMfa)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/me/mfa/totp", wrapper.DeleteApiMeMfaTotp)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/me/mfa/totp", wrapper.PostApiMeMfaTotp)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/me/mfa/totp/verify", wrapper.PostApiMeMfaTotpVerify)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/me/passkeys", wrapper.GetApiMePasskeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/me/passkeys/register/begin", wrapper.PostApiMePasskeysRegisterBegin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/me/passkeys/register/finish", wrapper.PostApiMePasskeysRegisterFinish)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/me/passkeys/{id}", wrapper.DeleteApiMePasskeysId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/me/password", wrapper.PostApiMePassword)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/me/playlist-rules", wrapper.GetApiMePlaylistRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/api/me/playlist-rules", wrapper.PatchApiMePlaylistRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/me/playlists", wrapper.GetApiMePlaylists)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/me/preferences", wrapper.GetApiMePreferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/api/me/preferences", wrapper.PatchApiMePreferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/me/sessions", wrapper.GetApiMeSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/me/sessions/{id}", wrapper.DeleteApiMeSessionsId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/me/stats", wrapper.GetApiMeStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/me/tracks/top", wrapper.GetApiMeTracksTop)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/me/year-in-review/{year}", wrapper.GetApiMeYearInReviewYear)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/oauth/spotify/config.json", wrapper.GetApiOauthSpotifyConfigJson)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/oauth/spotify/token", wrapper.PostApiOauthSpotifyToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/oauth/spotify/token/refresh", wrapper.PostApiOauthSpotifyTokenRefresh)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/openapi.yaml", wrapper.GetApiOpenapiYaml)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/password-reset", wrapper.PostApiPasswordReset)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/password-reset/confirm", wrapper.PostApiPasswordResetConfirm)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/playlists", wrapper.PostApiPlaylists)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/playlists/{id}", wrapper.GetApiPlaylistsId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/register", wrapper.PostApiRegister)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/spotify/status", wrapper.GetApiSpotifyStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/spotify/sync", wrapper.GetApiSpotifySync)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/users", wrapper.GetApiUsers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/users/{id}/sign-out", wrapper.PostApiUsersIdSignOut)
+	})
+
+	return r
+}
+
+type PostApiAuthRefreshRequestObject struct {
+	Params PostApiAuthRefreshParams
+	Body   *PostApiAuthRefreshJSONRequestBody
+}
+
+type PostApiAuthRefreshResponseObject interface {
+	VisitPostApiAuthRefreshResponse(w http.ResponseWriter) error
+}
+
+type PostApiAuthRefresh200ResponseHeaders struct {
+	SetCookie string
+}
+
+type PostApiAuthRefresh200JSONResponse struct {
+	Body    LoginResponse
+	Headers PostApiAuthRefresh200ResponseHeaders
+}
+
+func (response PostApiAuthRefresh200JSONResponse) VisitPostApiAuthRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostApiAuthRefresh401JSONResponse Error
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+func (response PostApiAuthRefresh401JSONResponse) VisitPostApiAuthRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	if params != nil {
+	return json.NewEncoder(w).Encode(response)
+}
 
-		if params.XCSRFToken != nil {
-			var headerParam0 string
+type PostApiAuthRefresh500JSONResponse Error
 
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
-			if err != nil {
-				return nil, err
-			}
+func (response PostApiAuthRefresh500JSONResponse) VisitPostApiAuthRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-			req.Header.Set("X-CSRF-Token", headerParam0)
-		}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	}
+type GetApiAuthVerifyRequestObject struct {
+	Params GetApiAuthVerifyParams
+}
 
-	if params != nil {
+type GetApiAuthVerifyResponseObject interface {
+	VisitGetApiAuthVerifyResponse(w http.ResponseWriter) error
+}
 
-		if params.Access != nil {
-			var cookieParam0 string
+type GetApiAuthVerify200JSONResponse User
 
-			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
-			if err != nil {
-				return nil, err
-			}
+func (response GetApiAuthVerify200JSONResponse) VisitGetApiAuthVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-			cookie0 := &http.Cookie{
-				Name:  "access",
-				Value: cookieParam0,
-			}
-			req.AddCookie(cookie0)
-		}
-	}
-	return req, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// NewPostApiIntegrationsSpotifyTracksSyncRequest calls the generic PostApiIntegrationsSpotifyTracksSync builder with application/json body
-func NewPostApiIntegrationsSpotifyTracksSyncRequest(server string, params *PostApiIntegrationsSpotifyTracksSyncParams, body PostApiIntegrationsSpotifyTracksSyncJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiIntegrationsSpotifyTracksSyncRequestWithBody(server, params, "application/json", bodyReader)
-}
+type GetApiAuthVerify401JSONResponse Error
 
-// NewPostApiIntegrationsSpotifyTracksSyncRequestWithBody generates requests for PostApiIntegrationsSpotifyTracksSync with any type of body
-func NewPostApiIntegrationsSpotifyTracksSyncRequestWithBody(server string, params *PostApiIntegrationsSpotifyTracksSyncParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (response GetApiAuthVerify401JSONResponse) VisitGetApiAuthVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	operationPath := fmt.Sprintf("/api/integrations/spotify/tracks/sync")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+type GetApiAuthVerify403JSONResponse Error
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+func (response GetApiAuthVerify403JSONResponse) VisitGetApiAuthVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	req.Header.Add("Content-Type", contentType)
+type GetApiAuthVerify500JSONResponse Error
 
-	if params != nil {
+func (response GetApiAuthVerify500JSONResponse) VisitGetApiAuthVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-		if params.XCSRFToken != nil {
-			var headerParam0 string
+	return json.NewEncoder(w).Encode(response)
+}
 
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
-			if err != nil {
-				return nil, err
-			}
+type GetApiHealthRequestObject struct {
+}
 
-			req.Header.Set("X-CSRF-Token", headerParam0)
-		}
+type GetApiHealthResponseObject interface {
+	VisitGetApiHealthResponse(w http.ResponseWriter) error
+}
 
-	}
+type GetApiHealth204Response struct {
+}
 
-	if params != nil {
+func (response GetApiHealth204Response) VisitGetApiHealthResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
 
-		if params.Access != nil {
-			var cookieParam0 string
+type GetApiHealth500JSONResponse Error
 
-			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
-			if err != nil {
-				return nil, err
-			}
+func (response GetApiHealth500JSONResponse) VisitGetApiHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-			cookie0 := &http.Cookie{
-				Name:  "access",
-				Value: cookieParam0,
-			}
-			req.AddCookie(cookie0)
-		}
-	}
-	return req, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// NewPostApiLoginRequest calls the generic PostApiLogin builder with application/json body
-func NewPostApiLoginRequest(server string, body PostApiLoginJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiLoginRequestWithBody(server, "application/json", bodyReader)
+type PostApiIntegrationsSpotifyPlaylistRequestObject struct {
+	Params PostApiIntegrationsSpotifyPlaylistParams
+	Body   *PostApiIntegrationsSpotifyPlaylistJSONRequestBody
 }
 
-// NewPostApiLoginRequestWithBody generates requests for PostApiLogin with any type of body
-func NewPostApiLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+type PostApiIntegrationsSpotifyPlaylistResponseObject interface {
+	VisitPostApiIntegrationsSpotifyPlaylistResponse(w http.ResponseWriter) error
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+type PostApiIntegrationsSpotifyPlaylist200JSONResponse SpotifyPlaylist
 
-	operationPath := fmt.Sprintf("/api/login")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+func (response PostApiIntegrationsSpotifyPlaylist200JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+type PostApiIntegrationsSpotifyPlaylist201JSONResponse SpotifyPlaylist
 
-	req.Header.Add("Content-Type", contentType)
+func (response PostApiIntegrationsSpotifyPlaylist201JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
 
-	return req, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// NewGetApiMePlaylistsRequest generates requests for GetApiMePlaylists
-func NewGetApiMePlaylistsRequest(server string, params *GetApiMePlaylistsParams) (*http.Request, error) {
-	var err error
+type PostApiIntegrationsSpotifyPlaylist401JSONResponse Error
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+func (response PostApiIntegrationsSpotifyPlaylist401JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	operationPath := fmt.Sprintf("/api/me/playlists")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+type PostApiIntegrationsSpotifyPlaylist403JSONResponse Error
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+func (response PostApiIntegrationsSpotifyPlaylist403JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	if params != nil {
+	return json.NewEncoder(w).Encode(response)
+}
 
-		if params.Access != nil {
-			var cookieParam0 string
+type PostApiIntegrationsSpotifyPlaylist404JSONResponse Error
 
-			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
-			if err != nil {
-				return nil, err
-			}
+func (response PostApiIntegrationsSpotifyPlaylist404JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-			cookie0 := &http.Cookie{
-				Name:  "access",
-				Value: cookieParam0,
-			}
-			req.AddCookie(cookie0)
-		}
-	}
-	return req, nil
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiIntegrationsSpotifyPlaylist409JSONResponse Error
+
+func (response PostApiIntegrationsSpotifyPlaylist409JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiIntegrationsSpotifyPlaylist500JSONResponse Error
+
+func (response PostApiIntegrationsSpotifyPlaylist500JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// NewGetApiMeTracksTopRequest generates requests for GetApiMeTracksTop
-func NewGetApiMeTracksTopRequest(server string, params *GetApiMeTracksTopParams) (*http.Request, error) {
-	var err error
+type DeleteApiIntegrationsSpotifyPlaylistIdRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params DeleteApiIntegrationsSpotifyPlaylistIdParams
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+type DeleteApiIntegrationsSpotifyPlaylistIdResponseObject interface {
+	VisitDeleteApiIntegrationsSpotifyPlaylistIdResponse(w http.ResponseWriter) error
+}
 
-	operationPath := fmt.Sprintf("/api/me/tracks/top")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+type DeleteApiIntegrationsSpotifyPlaylistId204Response struct {
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+func (response DeleteApiIntegrationsSpotifyPlaylistId204Response) VisitDeleteApiIntegrationsSpotifyPlaylistIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+type DeleteApiIntegrationsSpotifyPlaylistId401JSONResponse Error
 
-		if params.Start != nil {
+func (response DeleteApiIntegrationsSpotifyPlaylistId401JSONResponse) VisitDeleteApiIntegrationsSpotifyPlaylistIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, *params.Start); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return json.NewEncoder(w).Encode(response)
+}
 
-		}
+type DeleteApiIntegrationsSpotifyPlaylistId403JSONResponse Error
 
-		if params.End != nil {
+func (response DeleteApiIntegrationsSpotifyPlaylistId403JSONResponse) VisitDeleteApiIntegrationsSpotifyPlaylistIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, *params.End); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return json.NewEncoder(w).Encode(response)
+}
 
-		}
+type DeleteApiIntegrationsSpotifyPlaylistId404JSONResponse Error
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+func (response DeleteApiIntegrationsSpotifyPlaylistId404JSONResponse) VisitDeleteApiIntegrationsSpotifyPlaylistIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	if params != nil {
+type DeleteApiIntegrationsSpotifyPlaylistId500JSONResponse Error
 
-		if params.Access != nil {
-			var cookieParam0 string
+func (response DeleteApiIntegrationsSpotifyPlaylistId500JSONResponse) VisitDeleteApiIntegrationsSpotifyPlaylistIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
-			if err != nil {
-				return nil, err
-			}
+	return json.NewEncoder(w).Encode(response)
+}
 
-			cookie0 := &http.Cookie{
-				Name:  "access",
-				Value: cookieParam0,
-			}
-			req.AddCookie(cookie0)
-		}
-	}
-	return req, nil
+type PostApiIntegrationsSpotifyPlaylistIdRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params PostApiIntegrationsSpotifyPlaylistIdParams
 }
 
-// NewGetApiOauthSpotifyConfigJsonRequest generates requests for GetApiOauthSpotifyConfigJson
-func NewGetApiOauthSpotifyConfigJsonRequest(server string) (*http.Request, error) {
-	var err error
+type PostApiIntegrationsSpotifyPlaylistIdResponseObject interface {
+	VisitPostApiIntegrationsSpotifyPlaylistIdResponse(w http.ResponseWriter) error
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+type PostApiIntegrationsSpotifyPlaylistId200JSONResponse SpotifyPlaylist
 
-	operationPath := fmt.Sprintf("/api/oauth/spotify/config.json")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+func (response PostApiIntegrationsSpotifyPlaylistId200JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+type PostApiIntegrationsSpotifyPlaylistId201JSONResponse SpotifyPlaylist
 
-	return req, nil
-}
+func (response PostApiIntegrationsSpotifyPlaylistId201JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
 
-// NewPostApiOauthSpotifyTokenRequest calls the generic PostApiOauthSpotifyToken builder with application/json body
-func NewPostApiOauthSpotifyTokenRequest(server string, body PostApiOauthSpotifyTokenJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiOauthSpotifyTokenRequestWithBody(server, "application/json", bodyReader)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// NewPostApiOauthSpotifyTokenRequestWithBody generates requests for PostApiOauthSpotifyToken with any type of body
-func NewPostApiOauthSpotifyTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+type PostApiIntegrationsSpotifyPlaylistId401JSONResponse Error
 
-	operationPath := fmt.Sprintf("/api/oauth/spotify/token")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+func (response PostApiIntegrationsSpotifyPlaylistId401JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+type PostApiIntegrationsSpotifyPlaylistId403JSONResponse Error
 
-	req.Header.Add("Content-Type", contentType)
+func (response PostApiIntegrationsSpotifyPlaylistId403JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	return req, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// NewPostApiOauthSpotifyTokenRefreshRequest calls the generic PostApiOauthSpotifyTokenRefresh builder with application/json body
-func NewPostApiOauthSpotifyTokenRefreshRequest(server string, params *PostApiOauthSpotifyTokenRefreshParams, body PostApiOauthSpotifyTokenRefreshJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiOauthSpotifyTokenRefreshRequestWithBody(server, params, "application/json", bodyReader)
-}
+type PostApiIntegrationsSpotifyPlaylistId404JSONResponse Error
 
-// NewPostApiOauthSpotifyTokenRefreshRequestWithBody generates requests for PostApiOauthSpotifyTokenRefresh with any type of body
-func NewPostApiOauthSpotifyTokenRefreshRequestWithBody(server string, params *PostApiOauthSpotifyTokenRefreshParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (response PostApiIntegrationsSpotifyPlaylistId404JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	operationPath := fmt.Sprintf("/api/oauth/spotify/token/refresh")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+type PostApiIntegrationsSpotifyPlaylistId409JSONResponse Error
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+func (response PostApiIntegrationsSpotifyPlaylistId409JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	req.Header.Add("Content-Type", contentType)
+type PostApiIntegrationsSpotifyPlaylistId500JSONResponse Error
 
-	if params != nil {
+func (response PostApiIntegrationsSpotifyPlaylistId500JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-		if params.XCSRFToken != nil {
-			var headerParam0 string
+	return json.NewEncoder(w).Encode(response)
+}
 
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
-			if err != nil {
-				return nil, err
-			}
+type PostApiIntegrationsSpotifyTracksSyncRequestObject struct {
+	Params PostApiIntegrationsSpotifyTracksSyncParams
+	Body   *PostApiIntegrationsSpotifyTracksSyncJSONRequestBody
+}
 
-			req.Header.Set("X-CSRF-Token", headerParam0)
-		}
+type PostApiIntegrationsSpotifyTracksSyncResponseObject interface {
+	VisitPostApiIntegrationsSpotifyTracksSyncResponse(w http.ResponseWriter) error
+}
 
-	}
+type PostApiIntegrationsSpotifyTracksSync204Response struct {
+}
 
-	if params != nil {
+func (response PostApiIntegrationsSpotifyTracksSync204Response) VisitPostApiIntegrationsSpotifyTracksSyncResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
 
-		if params.Access != nil {
-			var cookieParam0 string
+type PostApiIntegrationsSpotifyTracksSync400JSONResponse Error
 
-			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
-			if err != nil {
-				return nil, err
-			}
+func (response PostApiIntegrationsSpotifyTracksSync400JSONResponse) VisitPostApiIntegrationsSpotifyTracksSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-			cookie0 := &http.Cookie{
-				Name:  "access",
-				Value: cookieParam0,
-			}
-			req.AddCookie(cookie0)
-		}
-	}
-	return req, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// NewGetApiOpenapiYamlRequest generates requests for GetApiOpenapiYaml
-func NewGetApiOpenapiYamlRequest(server string) (*http.Request, error) {
-	var err error
+type PostApiIntegrationsSpotifyTracksSync401JSONResponse Error
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+func (response PostApiIntegrationsSpotifyTracksSync401JSONResponse) VisitPostApiIntegrationsSpotifyTracksSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	operationPath := fmt.Sprintf("/api/openapi.yaml")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+type PostApiIntegrationsSpotifyTracksSync403JSONResponse Error
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+func (response PostApiIntegrationsSpotifyTracksSync403JSONResponse) VisitPostApiIntegrationsSpotifyTracksSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	return req, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// NewPostApiPlaylistsRequest calls the generic PostApiPlaylists builder with application/json body
-func NewPostApiPlaylistsRequest(server string, params *PostApiPlaylistsParams, body PostApiPlaylistsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiPlaylistsRequestWithBody(server, params, "application/json", bodyReader)
-}
+type PostApiIntegrationsSpotifyTracksSync404JSONResponse Error
 
-// NewPostApiPlaylistsRequestWithBody generates requests for PostApiPlaylists with any type of body
-func NewPostApiPlaylistsRequestWithBody(server string, params *PostApiPlaylistsParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (response PostApiIntegrationsSpotifyTracksSync404JSONResponse) VisitPostApiIntegrationsSpotifyTracksSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	operationPath := fmt.Sprintf("/api/playlists")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+type PostApiIntegrationsSpotifyTracksSync500JSONResponse Error
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+func (response PostApiIntegrationsSpotifyTracksSync500JSONResponse) VisitPostApiIntegrationsSpotifyTracksSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	req.Header.Add("Content-Type", contentType)
+type GetApiInvitesRequestObject struct {
+	Params GetApiInvitesParams
+}
 
-	if params != nil {
+type GetApiInvitesResponseObject interface {
+	VisitGetApiInvitesResponse(w http.ResponseWriter) error
+}
 
-		if params.XCSRFToken != nil {
-			var headerParam0 string
+type GetApiInvites200JSONResponse ListInvitesResponse
 
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CSRF-Token", runtime.ParamLocationHeader, *params.XCSRFToken)
-			if err != nil {
-				return nil, err
-			}
+func (response GetApiInvites200JSONResponse) VisitGetApiInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-			req.Header.Set("X-CSRF-Token", headerParam0)
-		}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	}
+type GetApiInvites401JSONResponse Error
 
-	if params != nil {
+func (response GetApiInvites401JSONResponse) VisitGetApiInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-		if params.Access != nil {
-			var cookieParam0 string
+	return json.NewEncoder(w).Encode(response)
+}
 
-			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
-			if err != nil {
-				return nil, err
-			}
+type GetApiInvites403JSONResponse Error
 
-			cookie0 := &http.Cookie{
-				Name:  "access",
-				Value: cookieParam0,
-			}
-			req.AddCookie(cookie0)
-		}
-	}
-	return req, nil
+func (response GetApiInvites403JSONResponse) VisitGetApiInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// NewGetApiPlaylistsIdRequest generates requests for GetApiPlaylistsId
-func NewGetApiPlaylistsIdRequest(server string, id openapi_types.UUID, params *GetApiPlaylistsIdParams) (*http.Request, error) {
-	var err error
+type GetApiInvites500JSONResponse Error
 
-	var pathParam0 string
+func (response GetApiInvites500JSONResponse) VisitGetApiInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+type PostApiInvitesRequestObject struct {
+	Params PostApiInvitesParams
+	Body   *PostApiInvitesJSONRequestBody
+}
 
-	operationPath := fmt.Sprintf("/api/playlists/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+type PostApiInvitesResponseObject interface {
+	VisitPostApiInvitesResponse(w http.ResponseWriter) error
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+type PostApiInvites201JSONResponse CreateInviteResponse
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+func (response PostApiInvites201JSONResponse) VisitPostApiInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
 
-	if params != nil {
+	return json.NewEncoder(w).Encode(response)
+}
 
-		if params.Access != nil {
-			var cookieParam0 string
+type PostApiInvites400JSONResponse Error
 
-			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
-			if err != nil {
-				return nil, err
-			}
+func (response PostApiInvites400JSONResponse) VisitPostApiInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-			cookie0 := &http.Cookie{
-				Name:  "access",
-				Value: cookieParam0,
-			}
-			req.AddCookie(cookie0)
-		}
-	}
-	return req, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// NewGetApiSpotifyStatusRequest generates requests for GetApiSpotifyStatus
-func NewGetApiSpotifyStatusRequest(server string) (*http.Request, error) {
-	var err error
+type PostApiInvites401JSONResponse Error
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+func (response PostApiInvites401JSONResponse) VisitPostApiInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	operationPath := fmt.Sprintf("/api/spotify/status")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+type PostApiInvites403JSONResponse Error
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+func (response PostApiInvites403JSONResponse) VisitPostApiInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	return req, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// NewGetApiUsersRequest generates requests for GetApiUsers
-func NewGetApiUsersRequest(server string, params *GetApiUsersParams) (*http.Request, error) {
-	var err error
+type PostApiInvites500JSONResponse Error
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+func (response PostApiInvites500JSONResponse) VisitPostApiInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	operationPath := fmt.Sprintf("/api/users")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+type DeleteApiInvitesIdRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params DeleteApiInvitesIdParams
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+type DeleteApiInvitesIdResponseObject interface {
+	VisitDeleteApiInvitesIdResponse(w http.ResponseWriter) error
+}
 
-		if params.Limit != nil {
+type DeleteApiInvitesId204Response struct {
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+func (response DeleteApiInvitesId204Response) VisitDeleteApiInvitesIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
 
-		}
+type DeleteApiInvitesId401JSONResponse Error
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+func (response DeleteApiInvitesId401JSONResponse) VisitDeleteApiInvitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	if params != nil {
+type DeleteApiInvitesId403JSONResponse Error
 
-		if params.Access != nil {
-			var cookieParam0 string
+func (response DeleteApiInvitesId403JSONResponse) VisitDeleteApiInvitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "access", runtime.ParamLocationCookie, *params.Access)
-			if err != nil {
-				return nil, err
-			}
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiInvitesId404JSONResponse Error
+
+func (response DeleteApiInvitesId404JSONResponse) VisitDeleteApiInvitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-			cookie0 := &http.Cookie{
-				Name:  "access",
-				Value: cookieParam0,
-			}
-			req.AddCookie(cookie0)
-		}
-	}
-	return req, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
+type DeleteApiInvitesId500JSONResponse Error
+
+func (response DeleteApiInvitesId500JSONResponse) VisitDeleteApiInvitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+type GetApiJobsRequestObject struct {
+	Params GetApiJobsParams
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return &ClientWithResponses{client}, nil
+type GetApiJobsResponseObject interface {
+	VisitGetApiJobsResponse(w http.ResponseWriter) error
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
+type GetApiJobs200JSONResponse ListJobsResponse
+
+func (response GetApiJobs200JSONResponse) VisitGetApiJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostApiAuthRefreshWithBodyWithResponse request with any body
-	PostApiAuthRefreshWithBodyWithResponse(ctx context.Context, params *PostApiAuthRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthRefreshResponse, error)
+type GetApiJobs401JSONResponse Error
 
-	PostApiAuthRefreshWithResponse(ctx context.Context, params *PostApiAuthRefreshParams, body PostApiAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthRefreshResponse, error)
+func (response GetApiJobs401JSONResponse) VisitGetApiJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	// GetApiAuthVerifyWithResponse request
-	GetApiAuthVerifyWithResponse(ctx context.Context, params *GetApiAuthVerifyParams, reqEditors ...RequestEditorFn) (*GetApiAuthVerifyResponse, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// GetApiHealthWithResponse request
-	GetApiHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiHealthResponse, error)
+type GetApiJobs403JSONResponse Error
 
-	// PostApiIntegrationsSpotifyPlaylistWithBodyWithResponse request with any body
-	PostApiIntegrationsSpotifyPlaylistWithBodyWithResponse(ctx context.Context, params *PostApiIntegrationsSpotifyPlaylistParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiIntegrationsSpotifyPlaylistResponse, error)
+func (response GetApiJobs403JSONResponse) VisitGetApiJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	PostApiIntegrationsSpotifyPlaylistWithResponse(ctx context.Context, params *PostApiIntegrationsSpotifyPlaylistParams, body PostApiIntegrationsSpotifyPlaylistJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiIntegrationsSpotifyPlaylistResponse, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// PostApiIntegrationsSpotifyPlaylistIdWithResponse request
-	PostApiIntegrationsSpotifyPlaylistIdWithResponse(ctx context.Context, id openapi_types.UUID, params *PostApiIntegrationsSpotifyPlaylistIdParams, reqEditors ...RequestEditorFn) (*PostApiIntegrationsSpotifyPlaylistIdResponse, error)
+type GetApiJobs500JSONResponse Error
 
-	// PostApiIntegrationsSpotifyTracksSyncWithBodyWithResponse request with any body
-	PostApiIntegrationsSpotifyTracksSyncWithBodyWithResponse(ctx context.Context, params *PostApiIntegrationsSpotifyTracksSyncParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiIntegrationsSpotifyTracksSyncResponse, error)
+func (response GetApiJobs500JSONResponse) VisitGetApiJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	PostApiIntegrationsSpotifyTracksSyncWithResponse(ctx context.Context, params *PostApiIntegrationsSpotifyTracksSyncParams, body PostApiIntegrationsSpotifyTracksSyncJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiIntegrationsSpotifyTracksSyncResponse, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// PostApiLoginWithBodyWithResponse request with any body
-	PostApiLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiLoginResponse, error)
+type PatchApiJobsNameRequestObject struct {
+	Name   string `json:"name"`
+	Params PatchApiJobsNameParams
+	Body   *PatchApiJobsNameJSONRequestBody
+}
 
-	PostApiLoginWithResponse(ctx context.Context, body PostApiLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiLoginResponse, error)
+type PatchApiJobsNameResponseObject interface {
+	VisitPatchApiJobsNameResponse(w http.ResponseWriter) error
+}
 
-	// GetApiMePlaylistsWithResponse request
-	GetApiMePlaylistsWithResponse(ctx context.Context, params *GetApiMePlaylistsParams, reqEditors ...RequestEditorFn) (*GetApiMePlaylistsResponse, error)
+type PatchApiJobsName200JSONResponse Job
 
-	// GetApiMeTracksTopWithResponse request
-	GetApiMeTracksTopWithResponse(ctx context.Context, params *GetApiMeTracksTopParams, reqEditors ...RequestEditorFn) (*GetApiMeTracksTopResponse, error)
+func (response PatchApiJobsName200JSONResponse) VisitPatchApiJobsNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// GetApiOauthSpotifyConfigJsonWithResponse request
-	GetApiOauthSpotifyConfigJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiOauthSpotifyConfigJsonResponse, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// PostApiOauthSpotifyTokenWithBodyWithResponse request with any body
-	PostApiOauthSpotifyTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiOauthSpotifyTokenResponse, error)
+type PatchApiJobsName400JSONResponse Error
 
-	PostApiOauthSpotifyTokenWithResponse(ctx context.Context, body PostApiOauthSpotifyTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiOauthSpotifyTokenResponse, error)
+func (response PatchApiJobsName400JSONResponse) VisitPatchApiJobsNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	// PostApiOauthSpotifyTokenRefreshWithBodyWithResponse request with any body
-	PostApiOauthSpotifyTokenRefreshWithBodyWithResponse(ctx context.Context, params *PostApiOauthSpotifyTokenRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiOauthSpotifyTokenRefreshResponse, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	PostApiOauthSpotifyTokenRefreshWithResponse(ctx context.Context, params *PostApiOauthSpotifyTokenRefreshParams, body PostApiOauthSpotifyTokenRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiOauthSpotifyTokenRefreshResponse, error)
+type PatchApiJobsName401JSONResponse Error
 
-	// GetApiOpenapiYamlWithResponse request
-	GetApiOpenapiYamlWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiOpenapiYamlResponse, error)
+func (response PatchApiJobsName401JSONResponse) VisitPatchApiJobsNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	// PostApiPlaylistsWithBodyWithResponse request with any body
-	PostApiPlaylistsWithBodyWithResponse(ctx context.Context, params *PostApiPlaylistsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiPlaylistsResponse, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	PostApiPlaylistsWithResponse(ctx context.Context, params *PostApiPlaylistsParams, body PostApiPlaylistsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiPlaylistsResponse, error)
+type PatchApiJobsName403JSONResponse Error
 
-	// GetApiPlaylistsIdWithResponse request
-	GetApiPlaylistsIdWithResponse(ctx context.Context, id openapi_types.UUID, params *GetApiPlaylistsIdParams, reqEditors ...RequestEditorFn) (*GetApiPlaylistsIdResponse, error)
+func (response PatchApiJobsName403JSONResponse) VisitPatchApiJobsNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	// GetApiSpotifyStatusWithResponse request
-	GetApiSpotifyStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiSpotifyStatusResponse, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// GetApiUsersWithResponse request
-	GetApiUsersWithResponse(ctx context.Context, params *GetApiUsersParams, reqEditors ...RequestEditorFn) (*GetApiUsersResponse, error)
+type PatchApiJobsName404JSONResponse Error
+
+func (response PatchApiJobsName404JSONResponse) VisitPatchApiJobsNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PostApiAuthRefreshResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *LoginResponse
-	JSON401      *Error
-	JSON500      *Error
+type PatchApiJobsName500JSONResponse Error
+
+func (response PatchApiJobsName500JSONResponse) VisitPatchApiJobsNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiAuthRefreshResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type PostApiJobsNameTriggerRequestObject struct {
+	Name   string `json:"name"`
+	Params PostApiJobsNameTriggerParams
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiAuthRefreshResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type PostApiJobsNameTriggerResponseObject interface {
+	VisitPostApiJobsNameTriggerResponse(w http.ResponseWriter) error
 }
 
-type GetApiAuthVerifyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-	JSON401      *Error
-	JSON403      *Error
-	JSON500      *Error
+type PostApiJobsNameTrigger202JSONResponse Job
+
+func (response PostApiJobsNameTrigger202JSONResponse) VisitPostApiJobsNameTriggerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiAuthVerifyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type PostApiJobsNameTrigger401JSONResponse Error
+
+func (response PostApiJobsNameTrigger401JSONResponse) VisitPostApiJobsNameTriggerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiAuthVerifyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type PostApiJobsNameTrigger403JSONResponse Error
+
+func (response PostApiJobsNameTrigger403JSONResponse) VisitPostApiJobsNameTriggerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetApiHealthResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON500      *Error
+type PostApiJobsNameTrigger404JSONResponse Error
+
+func (response PostApiJobsNameTrigger404JSONResponse) VisitPostApiJobsNameTriggerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiHealthResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type PostApiJobsNameTrigger500JSONResponse Error
+
+func (response PostApiJobsNameTrigger500JSONResponse) VisitPostApiJobsNameTriggerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiHealthResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type PostApiListenbrainz1SubmitListensRequestObject struct {
+	Body *PostApiListenbrainz1SubmitListensJSONRequestBody
 }
 
-type PostApiIntegrationsSpotifyPlaylistResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *SpotifyPlaylist
-	JSON401      *Error
-	JSON403      *Error
-	JSON404      *Error
-	JSON500      *Error
+type PostApiListenbrainz1SubmitListensResponseObject interface {
+	VisitPostApiListenbrainz1SubmitListensResponse(w http.ResponseWriter) error
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiIntegrationsSpotifyPlaylistResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type PostApiListenbrainz1SubmitListens200JSONResponse ListenBrainzStatus
+
+func (response PostApiListenbrainz1SubmitListens200JSONResponse) VisitPostApiListenbrainz1SubmitListensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiIntegrationsSpotifyPlaylistResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type PostApiListenbrainz1SubmitListens400JSONResponse Error
+
+func (response PostApiListenbrainz1SubmitListens400JSONResponse) VisitPostApiListenbrainz1SubmitListensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PostApiIntegrationsSpotifyPlaylistIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *SpotifyPlaylist
-	JSON401      *Error
-	JSON403      *Error
-	JSON404      *Error
-	JSON500      *Error
+type PostApiListenbrainz1SubmitListens401JSONResponse Error
+
+func (response PostApiListenbrainz1SubmitListens401JSONResponse) VisitPostApiListenbrainz1SubmitListensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiIntegrationsSpotifyPlaylistIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type PostApiListenbrainz1SubmitListens500JSONResponse Error
+
+func (response PostApiListenbrainz1SubmitListens500JSONResponse) VisitPostApiListenbrainz1SubmitListensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiIntegrationsSpotifyPlaylistIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type GetApiListenbrainz1ValidateTokenRequestObject struct {
 }
 
-type PostApiIntegrationsSpotifyTracksSyncResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON401      *Error
-	JSON403      *Error
-	JSON404      *Error
-	JSON500      *Error
+type GetApiListenbrainz1ValidateTokenResponseObject interface {
+	VisitGetApiListenbrainz1ValidateTokenResponse(w http.ResponseWriter) error
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiIntegrationsSpotifyTracksSyncResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type GetApiListenbrainz1ValidateToken200JSONResponse ValidateListenTokenResponse
+
+func (response GetApiListenbrainz1ValidateToken200JSONResponse) VisitGetApiListenbrainz1ValidateTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiIntegrationsSpotifyTracksSyncResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type GetApiListenbrainz1ValidateToken401JSONResponse Error
+
+func (response GetApiListenbrainz1ValidateToken401JSONResponse) VisitGetApiListenbrainz1ValidateTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PostApiLoginResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *LoginResponse
-	JSON401      *Error
-	JSON500      *Error
+type GetApiListenbrainz1ValidateToken500JSONResponse Error
+
+func (response GetApiListenbrainz1ValidateToken500JSONResponse) VisitGetApiListenbrainz1ValidateTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiLoginResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type GetApiListensExportRequestObject struct {
+	Params GetApiListensExportParams
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiLoginResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type GetApiListensExportResponseObject interface {
+	VisitGetApiListensExportResponse(w http.ResponseWriter) error
 }
 
-type GetApiMePlaylistsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ListPlaylists
-	JSON401      *Error
-	JSON500      *Error
+type GetApiListensExport200ResponseHeaders struct {
+	ContentDisposition string
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiMePlaylistsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type GetApiListensExport200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	Headers       GetApiListensExport200ResponseHeaders
+	ContentLength int64
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiMePlaylistsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (response GetApiListensExport200ApplicationxNdjsonResponse) VisitGetApiListensExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
-	return 0
-}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
 
-type GetApiMeTracksTopResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Tracks []PlaylistTrack `json:"tracks"`
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
 	}
-	JSON400 *Error
-	JSON500 *Error
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiMeTracksTopResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type GetApiListensExport200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       GetApiListensExport200ResponseHeaders
+	ContentLength int64
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiMeTracksTopResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (response GetApiListensExport200TextcsvResponse) VisitGetApiListensExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
-	return 0
-}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
 
-type GetApiOauthSpotifyConfigJsonResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SpotifyOAuth
-	JSON500      *Error
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiOauthSpotifyConfigJsonResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type GetApiListensExport400JSONResponse Error
+
+func (response GetApiListensExport400JSONResponse) VisitGetApiListensExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiOauthSpotifyConfigJsonResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type GetApiListensExport401JSONResponse Error
+
+func (response GetApiListensExport401JSONResponse) VisitGetApiListensExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PostApiOauthSpotifyTokenResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON500      *Error
+type GetApiListensExport403JSONResponse Error
+
+func (response GetApiListensExport403JSONResponse) VisitGetApiListensExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiOauthSpotifyTokenResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type GetApiListensExport500JSONResponse Error
+
+func (response GetApiListensExport500JSONResponse) VisitGetApiListensExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiOauthSpotifyTokenResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type PostApiLoginRequestObject struct {
+	Body *PostApiLoginJSONRequestBody
 }
 
-type PostApiOauthSpotifyTokenRefreshResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON401      *Error
-	JSON403      *Error
-	JSON404      *Error
-	JSON500      *Error
+type PostApiLoginResponseObject interface {
+	VisitPostApiLoginResponse(w http.ResponseWriter) error
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiOauthSpotifyTokenRefreshResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type PostApiLogin200ResponseHeaders struct {
+	SetCookie string
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiOauthSpotifyTokenRefreshResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type PostApiLogin200JSONResponse struct {
+	Body    LoginResponse
+	Headers PostApiLogin200ResponseHeaders
 }
 
-type GetApiOpenapiYamlResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	YAML200      *string
-	JSON500      *Error
+func (response PostApiLogin200JSONResponse) VisitPostApiLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiOpenapiYamlResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type PostApiLogin202JSONResponse MFAChallenge
+
+func (response PostApiLogin202JSONResponse) VisitPostApiLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiOpenapiYamlResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type PostApiLogin401JSONResponse Error
+
+func (response PostApiLogin401JSONResponse) VisitPostApiLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PostApiPlaylistsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CreatePlaylistResponse
-	JSON400      *Error
-	JSON401      *Error
-	JSON403      *Error
-	JSON404      *Error
-	JSON409      *Error
-	JSON500      *Error
+type PostApiLogin500JSONResponse Error
+
+func (response PostApiLogin500JSONResponse) VisitPostApiLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiPlaylistsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type PostApiLoginMfaRequestObject struct {
+	Body *PostApiLoginMfaJSONRequestBody
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiPlaylistsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type PostApiLoginMfaResponseObject interface {
+	VisitPostApiLoginMfaResponse(w http.ResponseWriter) error
 }
 
-type GetApiPlaylistsIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Playlist
-	JSON401      *Error
-	JSON404      *Error
-	JSON500      *Error
+type PostApiLoginMfa200ResponseHeaders struct {
+	SetCookie string
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiPlaylistsIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type PostApiLoginMfa200JSONResponse struct {
+	Body    LoginResponse
+	Headers PostApiLoginMfa200ResponseHeaders
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiPlaylistsIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+func (response PostApiLoginMfa200JSONResponse) VisitPostApiLoginMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetApiSpotifyStatusResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SpotifyStatusResponse
-	JSON500      *Error
+type PostApiLoginMfa400JSONResponse Error
+
+func (response PostApiLoginMfa400JSONResponse) VisitPostApiLoginMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiSpotifyStatusResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type PostApiLoginMfa401JSONResponse Error
+
+func (response PostApiLoginMfa401JSONResponse) VisitPostApiLoginMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiSpotifyStatusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type PostApiLoginMfa429JSONResponse Error
+
+func (response PostApiLoginMfa429JSONResponse) VisitPostApiLoginMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetApiUsersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ListUsersResponse
-	JSON400      *Error
-	JSON401      *Error
-	JSON403      *Error
-	JSON500      *Error
+type PostApiLoginMfa500JSONResponse Error
+
+func (response PostApiLoginMfa500JSONResponse) VisitPostApiLoginMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiUsersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type PostApiLoginPasskeyBeginRequestObject struct {
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiUsersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type PostApiLoginPasskeyBeginResponseObject interface {
+	VisitPostApiLoginPasskeyBeginResponse(w http.ResponseWriter) error
 }
 
-// PostApiAuthRefreshWithBodyWithResponse request with arbitrary body returning *PostApiAuthRefreshResponse
-func (c *ClientWithResponses) PostApiAuthRefreshWithBodyWithResponse(ctx context.Context, params *PostApiAuthRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthRefreshResponse, error) {
-	rsp, err := c.PostApiAuthRefreshWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiAuthRefreshResponse(rsp)
+type PostApiLoginPasskeyBegin200JSONResponse PasskeyCeremony
+
+func (response PostApiLoginPasskeyBegin200JSONResponse) VisitPostApiLoginPasskeyBeginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (c *ClientWithResponses) PostApiAuthRefreshWithResponse(ctx context.Context, params *PostApiAuthRefreshParams, body PostApiAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthRefreshResponse, error) {
-	rsp, err := c.PostApiAuthRefresh(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiAuthRefreshResponse(rsp)
+type PostApiLoginPasskeyBegin500JSONResponse Error
+
+func (response PostApiLoginPasskeyBegin500JSONResponse) VisitPostApiLoginPasskeyBeginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetApiAuthVerifyWithResponse request returning *GetApiAuthVerifyResponse
-func (c *ClientWithResponses) GetApiAuthVerifyWithResponse(ctx context.Context, params *GetApiAuthVerifyParams, reqEditors ...RequestEditorFn) (*GetApiAuthVerifyResponse, error) {
-	rsp, err := c.GetApiAuthVerify(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiAuthVerifyResponse(rsp)
+type PostApiLoginPasskeyFinishRequestObject struct {
+	Body *PostApiLoginPasskeyFinishJSONRequestBody
 }
 
-// GetApiHealthWithResponse request returning *GetApiHealthResponse
-func (c *ClientWithResponses) GetApiHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiHealthResponse, error) {
-	rsp, err := c.GetApiHealth(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiHealthResponse(rsp)
+type PostApiLoginPasskeyFinishResponseObject interface {
+	VisitPostApiLoginPasskeyFinishResponse(w http.ResponseWriter) error
 }
 
-// PostApiIntegrationsSpotifyPlaylistWithBodyWithResponse request with arbitrary body returning *PostApiIntegrationsSpotifyPlaylistResponse
-func (c *ClientWithResponses) PostApiIntegrationsSpotifyPlaylistWithBodyWithResponse(ctx context.Context, params *PostApiIntegrationsSpotifyPlaylistParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiIntegrationsSpotifyPlaylistResponse, error) {
-	rsp, err := c.PostApiIntegrationsSpotifyPlaylistWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiIntegrationsSpotifyPlaylistResponse(rsp)
+type PostApiLoginPasskeyFinish200ResponseHeaders struct {
+	SetCookie string
 }
 
-func (c *ClientWithResponses) PostApiIntegrationsSpotifyPlaylistWithResponse(ctx context.Context, params *PostApiIntegrationsSpotifyPlaylistParams, body PostApiIntegrationsSpotifyPlaylistJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiIntegrationsSpotifyPlaylistResponse, error) {
-	rsp, err := c.PostApiIntegrationsSpotifyPlaylist(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiIntegrationsSpotifyPlaylistResponse(rsp)
+type PostApiLoginPasskeyFinish200JSONResponse struct {
+	Body    LoginResponse
+	Headers PostApiLoginPasskeyFinish200ResponseHeaders
 }
 
-// PostApiIntegrationsSpotifyPlaylistIdWithResponse request returning *PostApiIntegrationsSpotifyPlaylistIdResponse
-func (c *ClientWithResponses) PostApiIntegrationsSpotifyPlaylistIdWithResponse(ctx context.Context, id openapi_types.UUID, params *PostApiIntegrationsSpotifyPlaylistIdParams, reqEditors ...RequestEditorFn) (*PostApiIntegrationsSpotifyPlaylistIdResponse, error) {
-	rsp, err := c.PostApiIntegrationsSpotifyPlaylistId(ctx, id, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiIntegrationsSpotifyPlaylistIdResponse(rsp)
+func (response PostApiLoginPasskeyFinish200JSONResponse) VisitPostApiLoginPasskeyFinishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-// PostApiIntegrationsSpotifyTracksSyncWithBodyWithResponse request with arbitrary body returning *PostApiIntegrationsSpotifyTracksSyncResponse
-func (c *ClientWithResponses) PostApiIntegrationsSpotifyTracksSyncWithBodyWithResponse(ctx context.Context, params *PostApiIntegrationsSpotifyTracksSyncParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiIntegrationsSpotifyTracksSyncResponse, error) {
-	rsp, err := c.PostApiIntegrationsSpotifyTracksSyncWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiIntegrationsSpotifyTracksSyncResponse(rsp)
+type PostApiLoginPasskeyFinish401JSONResponse Error
+
+func (response PostApiLoginPasskeyFinish401JSONResponse) VisitPostApiLoginPasskeyFinishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (c *ClientWithResponses) PostApiIntegrationsSpotifyTracksSyncWithResponse(ctx context.Context, params *PostApiIntegrationsSpotifyTracksSyncParams, body PostApiIntegrationsSpotifyTracksSyncJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiIntegrationsSpotifyTracksSyncResponse, error) {
-	rsp, err := c.PostApiIntegrationsSpotifyTracksSync(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiIntegrationsSpotifyTracksSyncResponse(rsp)
+type PostApiLoginPasskeyFinish500JSONResponse Error
+
+func (response PostApiLoginPasskeyFinish500JSONResponse) VisitPostApiLoginPasskeyFinishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// PostApiLoginWithBodyWithResponse request with arbitrary body returning *PostApiLoginResponse
-func (c *ClientWithResponses) PostApiLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiLoginResponse, error) {
-	rsp, err := c.PostApiLoginWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiLoginResponse(rsp)
+type PostApiLogoutRequestObject struct {
+	Params PostApiLogoutParams
+	Body   *PostApiLogoutJSONRequestBody
 }
 
-func (c *ClientWithResponses) PostApiLoginWithResponse(ctx context.Context, body PostApiLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiLoginResponse, error) {
-	rsp, err := c.PostApiLogin(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiLoginResponse(rsp)
+type PostApiLogoutResponseObject interface {
+	VisitPostApiLogoutResponse(w http.ResponseWriter) error
 }
 
-// GetApiMePlaylistsWithResponse request returning *GetApiMePlaylistsResponse
-func (c *ClientWithResponses) GetApiMePlaylistsWithResponse(ctx context.Context, params *GetApiMePlaylistsParams, reqEditors ...RequestEditorFn) (*GetApiMePlaylistsResponse, error) {
-	rsp, err := c.GetApiMePlaylists(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiMePlaylistsResponse(rsp)
+type PostApiLogout204ResponseHeaders struct {
+	SetCookie string
 }
 
-// GetApiMeTracksTopWithResponse request returning *GetApiMeTracksTopResponse
-func (c *ClientWithResponses) GetApiMeTracksTopWithResponse(ctx context.Context, params *GetApiMeTracksTopParams, reqEditors ...RequestEditorFn) (*GetApiMeTracksTopResponse, error) {
-	rsp, err := c.GetApiMeTracksTop(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiMeTracksTopResponse(rsp)
+type PostApiLogout204Response struct {
+	Headers PostApiLogout204ResponseHeaders
 }
 
-// GetApiOauthSpotifyConfigJsonWithResponse request returning *GetApiOauthSpotifyConfigJsonResponse
-func (c *ClientWithResponses) GetApiOauthSpotifyConfigJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiOauthSpotifyConfigJsonResponse, error) {
-	rsp, err := c.GetApiOauthSpotifyConfigJson(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiOauthSpotifyConfigJsonResponse(rsp)
+func (response PostApiLogout204Response) VisitPostApiLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(204)
+	return nil
 }
 
-// PostApiOauthSpotifyTokenWithBodyWithResponse request with arbitrary body returning *PostApiOauthSpotifyTokenResponse
-func (c *ClientWithResponses) PostApiOauthSpotifyTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiOauthSpotifyTokenResponse, error) {
-	rsp, err := c.PostApiOauthSpotifyTokenWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiOauthSpotifyTokenResponse(rsp)
+type PostApiLogout500JSONResponse Error
+
+func (response PostApiLogout500JSONResponse) VisitPostApiLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (c *ClientWithResponses) PostApiOauthSpotifyTokenWithResponse(ctx context.Context, body PostApiOauthSpotifyTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiOauthSpotifyTokenResponse, error) {
-	rsp, err := c.PostApiOauthSpotifyToken(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiOauthSpotifyTokenResponse(rsp)
+type GetApiMeAlbumsTopRequestObject struct {
+	Params GetApiMeAlbumsTopParams
 }
 
-// PostApiOauthSpotifyTokenRefreshWithBodyWithResponse request with arbitrary body returning *PostApiOauthSpotifyTokenRefreshResponse
-func (c *ClientWithResponses) PostApiOauthSpotifyTokenRefreshWithBodyWithResponse(ctx context.Context, params *PostApiOauthSpotifyTokenRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiOauthSpotifyTokenRefreshResponse, error) {
-	rsp, err := c.PostApiOauthSpotifyTokenRefreshWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiOauthSpotifyTokenRefreshResponse(rsp)
+type GetApiMeAlbumsTopResponseObject interface {
+	VisitGetApiMeAlbumsTopResponse(w http.ResponseWriter) error
 }
 
-func (c *ClientWithResponses) PostApiOauthSpotifyTokenRefreshWithResponse(ctx context.Context, params *PostApiOauthSpotifyTokenRefreshParams, body PostApiOauthSpotifyTokenRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiOauthSpotifyTokenRefreshResponse, error) {
-	rsp, err := c.PostApiOauthSpotifyTokenRefresh(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiOauthSpotifyTokenRefreshResponse(rsp)
+type GetApiMeAlbumsTop200JSONResponse struct {
+	Albums []TopAlbum `json:"albums"`
+}
+
+func (response GetApiMeAlbumsTop200JSONResponse) VisitGetApiMeAlbumsTopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetApiOpenapiYamlWithResponse request returning *GetApiOpenapiYamlResponse
-func (c *ClientWithResponses) GetApiOpenapiYamlWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiOpenapiYamlResponse, error) {
-	rsp, err := c.GetApiOpenapiYaml(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiOpenapiYamlResponse(rsp)
+type GetApiMeAlbumsTop400JSONResponse Error
+
+func (response GetApiMeAlbumsTop400JSONResponse) VisitGetApiMeAlbumsTopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// PostApiPlaylistsWithBodyWithResponse request with arbitrary body returning *PostApiPlaylistsResponse
-func (c *ClientWithResponses) PostApiPlaylistsWithBodyWithResponse(ctx context.Context, params *PostApiPlaylistsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiPlaylistsResponse, error) {
-	rsp, err := c.PostApiPlaylistsWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiPlaylistsResponse(rsp)
+type GetApiMeAlbumsTop500JSONResponse Error
+
+func (response GetApiMeAlbumsTop500JSONResponse) VisitGetApiMeAlbumsTopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (c *ClientWithResponses) PostApiPlaylistsWithResponse(ctx context.Context, params *PostApiPlaylistsParams, body PostApiPlaylistsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiPlaylistsResponse, error) {
-	rsp, err := c.PostApiPlaylists(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiPlaylistsResponse(rsp)
+type GetApiMeArtistsTopRequestObject struct {
+	Params GetApiMeArtistsTopParams
 }
 
-// GetApiPlaylistsIdWithResponse request returning *GetApiPlaylistsIdResponse
-func (c *ClientWithResponses) GetApiPlaylistsIdWithResponse(ctx context.Context, id openapi_types.UUID, params *GetApiPlaylistsIdParams, reqEditors ...RequestEditorFn) (*GetApiPlaylistsIdResponse, error) {
-	rsp, err := c.GetApiPlaylistsId(ctx, id, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiPlaylistsIdResponse(rsp)
+type GetApiMeArtistsTopResponseObject interface {
+	VisitGetApiMeArtistsTopResponse(w http.ResponseWriter) error
 }
 
-// GetApiSpotifyStatusWithResponse request returning *GetApiSpotifyStatusResponse
-func (c *ClientWithResponses) GetApiSpotifyStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiSpotifyStatusResponse, error) {
-	rsp, err := c.GetApiSpotifyStatus(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiSpotifyStatusResponse(rsp)
+type GetApiMeArtistsTop200JSONResponse struct {
+	Artists []TopArtist `json:"artists"`
 }
 
-// GetApiUsersWithResponse request returning *GetApiUsersResponse
-func (c *ClientWithResponses) GetApiUsersWithResponse(ctx context.Context, params *GetApiUsersParams, reqEditors ...RequestEditorFn) (*GetApiUsersResponse, error) {
-	rsp, err := c.GetApiUsers(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiUsersResponse(rsp)
+func (response GetApiMeArtistsTop200JSONResponse) VisitGetApiMeArtistsTopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParsePostApiAuthRefreshResponse parses an HTTP response from a PostApiAuthRefreshWithResponse call
-func ParsePostApiAuthRefreshResponse(rsp *http.Response) (*PostApiAuthRefreshResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type GetApiMeArtistsTop400JSONResponse Error
 
-	response := &PostApiAuthRefreshResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response GetApiMeArtistsTop400JSONResponse) VisitGetApiMeArtistsTopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LoginResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type GetApiMeArtistsTop500JSONResponse Error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+func (response GetApiMeArtistsTop500JSONResponse) VisitGetApiMeArtistsTopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	return response, nil
+type DeleteApiMeListenTokenRequestObject struct {
+	Params DeleteApiMeListenTokenParams
 }
 
-// ParseGetApiAuthVerifyResponse parses an HTTP response from a GetApiAuthVerifyWithResponse call
-func ParseGetApiAuthVerifyResponse(rsp *http.Response) (*GetApiAuthVerifyResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type DeleteApiMeListenTokenResponseObject interface {
+	VisitDeleteApiMeListenTokenResponse(w http.ResponseWriter) error
+}
 
-	response := &GetApiAuthVerifyResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type DeleteApiMeListenToken204Response struct {
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+func (response DeleteApiMeListenToken204Response) VisitDeleteApiMeListenTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type DeleteApiMeListenToken401JSONResponse Error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+func (response DeleteApiMeListenToken401JSONResponse) VisitDeleteApiMeListenTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	}
+type DeleteApiMeListenToken404JSONResponse Error
 
-	return response, nil
+func (response DeleteApiMeListenToken404JSONResponse) VisitDeleteApiMeListenTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParseGetApiHealthResponse parses an HTTP response from a GetApiHealthWithResponse call
-func ParseGetApiHealthResponse(rsp *http.Response) (*GetApiHealthResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type DeleteApiMeListenToken500JSONResponse Error
 
-	response := &GetApiHealthResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response DeleteApiMeListenToken500JSONResponse) VisitDeleteApiMeListenTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	}
+type PostApiMeListenTokenRequestObject struct {
+	Params PostApiMeListenTokenParams
+}
 
-	return response, nil
+type PostApiMeListenTokenResponseObject interface {
+	VisitPostApiMeListenTokenResponse(w http.ResponseWriter) error
 }
 
-// ParsePostApiIntegrationsSpotifyPlaylistResponse parses an HTTP response from a PostApiIntegrationsSpotifyPlaylistWithResponse call
-func ParsePostApiIntegrationsSpotifyPlaylistResponse(rsp *http.Response) (*PostApiIntegrationsSpotifyPlaylistResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type PostApiMeListenToken201JSONResponse ListenTokenResponse
 
-	response := &PostApiIntegrationsSpotifyPlaylistResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response PostApiMeListenToken201JSONResponse) VisitPostApiMeListenTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest SpotifyPlaylist
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type PostApiMeListenToken401JSONResponse Error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+func (response PostApiMeListenToken401JSONResponse) VisitPostApiMeListenTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type PostApiMeListenToken500JSONResponse Error
 
-	}
+func (response PostApiMeListenToken500JSONResponse) VisitPostApiMeListenTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	return response, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParsePostApiIntegrationsSpotifyPlaylistIdResponse parses an HTTP response from a PostApiIntegrationsSpotifyPlaylistIdWithResponse call
-func ParsePostApiIntegrationsSpotifyPlaylistIdResponse(rsp *http.Response) (*PostApiIntegrationsSpotifyPlaylistIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type GetApiMeListensRequestObject struct {
+	Params GetApiMeListensParams
+}
 
-	response := &PostApiIntegrationsSpotifyPlaylistIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type GetApiMeListensResponseObject interface {
+	VisitGetApiMeListensResponse(w http.ResponseWriter) error
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest SpotifyPlaylist
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+type GetApiMeListens200JSONResponse ListListensResponse
+
+func (response GetApiMeListens200JSONResponse) VisitGetApiMeListensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type GetApiMeListens400JSONResponse Error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+func (response GetApiMeListens400JSONResponse) VisitGetApiMeListensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type GetApiMeListens401JSONResponse Error
 
-	}
+func (response GetApiMeListens401JSONResponse) VisitGetApiMeListensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	return response, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParsePostApiIntegrationsSpotifyTracksSyncResponse parses an HTTP response from a PostApiIntegrationsSpotifyTracksSyncWithResponse call
-func ParsePostApiIntegrationsSpotifyTracksSyncResponse(rsp *http.Response) (*PostApiIntegrationsSpotifyTracksSyncResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type GetApiMeListens500JSONResponse Error
 
-	response := &PostApiIntegrationsSpotifyTracksSyncResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response GetApiMeListens500JSONResponse) VisitGetApiMeListensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type GetApiMeListensExportRequestObject struct {
+	Params GetApiMeListensExportParams
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+type GetApiMeListensExportResponseObject interface {
+	VisitGetApiMeListensExportResponse(w http.ResponseWriter) error
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+type GetApiMeListensExport200ResponseHeaders struct {
+	ContentDisposition string
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type GetApiMeListensExport200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	Headers       GetApiMeListensExport200ResponseHeaders
+	ContentLength int64
+}
 
+func (response GetApiMeListensExport200ApplicationxNdjsonResponse) VisitGetApiMeListensExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
 
-	return response, nil
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-// ParsePostApiLoginResponse parses an HTTP response from a PostApiLoginWithResponse call
-func ParsePostApiLoginResponse(rsp *http.Response) (*PostApiLoginResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+type GetApiMeListensExport200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       GetApiMeListensExport200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetApiMeListensExport200TextcsvResponse) VisitGetApiMeListensExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
 
-	response := &PostApiLoginResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
 	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LoginResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+type GetApiMeListensExport400JSONResponse Error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+func (response GetApiMeListensExport400JSONResponse) VisitGetApiMeListensExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	}
+type GetApiMeListensExport401JSONResponse Error
 
-	return response, nil
+func (response GetApiMeListensExport401JSONResponse) VisitGetApiMeListensExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParseGetApiMePlaylistsResponse parses an HTTP response from a GetApiMePlaylistsWithResponse call
-func ParseGetApiMePlaylistsResponse(rsp *http.Response) (*GetApiMePlaylistsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type GetApiMeListensExport500JSONResponse Error
 
-	response := &GetApiMePlaylistsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response GetApiMeListensExport500JSONResponse) VisitGetApiMeListensExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListPlaylists
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type PostApiMeListensImportRequestObject struct {
+	Params PostApiMeListensImportParams
+	Body   *multipart.Reader
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type PostApiMeListensImportResponseObject interface {
+	VisitPostApiMeListensImportResponse(w http.ResponseWriter) error
+}
 
-	}
+type PostApiMeListensImport200JSONResponse ImportListensResponse
 
-	return response, nil
+func (response PostApiMeListensImport200JSONResponse) VisitPostApiMeListensImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParseGetApiMeTracksTopResponse parses an HTTP response from a GetApiMeTracksTopWithResponse call
-func ParseGetApiMeTracksTopResponse(rsp *http.Response) (*GetApiMeTracksTopResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type PostApiMeListensImport400JSONResponse Error
 
-	response := &GetApiMeTracksTopResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response PostApiMeListensImport400JSONResponse) VisitPostApiMeListensImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Tracks []PlaylistTrack `json:"tracks"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type PostApiMeListensImport401JSONResponse Error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+func (response PostApiMeListensImport401JSONResponse) VisitPostApiMeListensImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	return response, nil
+type PostApiMeListensImport500JSONResponse Error
+
+func (response PostApiMeListensImport500JSONResponse) VisitPostApiMeListensImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParseGetApiOauthSpotifyConfigJsonResponse parses an HTTP response from a GetApiOauthSpotifyConfigJsonWithResponse call
-func ParseGetApiOauthSpotifyConfigJsonResponse(rsp *http.Response) (*GetApiOauthSpotifyConfigJsonResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type GetApiMeMfaRequestObject struct {
+	Params GetApiMeMfaParams
+}
 
-	response := &GetApiOauthSpotifyConfigJsonResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type GetApiMeMfaResponseObject interface {
+	VisitGetApiMeMfaResponse(w http.ResponseWriter) error
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SpotifyOAuth
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+type GetApiMeMfa200JSONResponse MFAStatus
+
+func (response GetApiMeMfa200JSONResponse) VisitGetApiMeMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	}
+type GetApiMeMfa401JSONResponse Error
 
-	return response, nil
+func (response GetApiMeMfa401JSONResponse) VisitGetApiMeMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParsePostApiOauthSpotifyTokenResponse parses an HTTP response from a PostApiOauthSpotifyTokenWithResponse call
-func ParsePostApiOauthSpotifyTokenResponse(rsp *http.Response) (*PostApiOauthSpotifyTokenResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type GetApiMeMfa500JSONResponse Error
 
-	response := &PostApiOauthSpotifyTokenResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response GetApiMeMfa500JSONResponse) VisitGetApiMeMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type DeleteApiMeMfaTotpRequestObject struct {
+	Params DeleteApiMeMfaTotpParams
+	Body   *DeleteApiMeMfaTotpJSONRequestBody
+}
 
-	}
+type DeleteApiMeMfaTotpResponseObject interface {
+	VisitDeleteApiMeMfaTotpResponse(w http.ResponseWriter) error
+}
 
-	return response, nil
+type DeleteApiMeMfaTotp204Response struct {
 }
 
-// ParsePostApiOauthSpotifyTokenRefreshResponse parses an HTTP response from a PostApiOauthSpotifyTokenRefreshWithResponse call
-func ParsePostApiOauthSpotifyTokenRefreshResponse(rsp *http.Response) (*PostApiOauthSpotifyTokenRefreshResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+func (response DeleteApiMeMfaTotp204Response) VisitDeleteApiMeMfaTotpResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
 
-	response := &PostApiOauthSpotifyTokenRefreshResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type DeleteApiMeMfaTotp400JSONResponse Error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+func (response DeleteApiMeMfaTotp400JSONResponse) VisitDeleteApiMeMfaTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+type DeleteApiMeMfaTotp401JSONResponse Error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+func (response DeleteApiMeMfaTotp401JSONResponse) VisitDeleteApiMeMfaTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	}
+type DeleteApiMeMfaTotp404JSONResponse Error
 
-	return response, nil
+func (response DeleteApiMeMfaTotp404JSONResponse) VisitDeleteApiMeMfaTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParseGetApiOpenapiYamlResponse parses an HTTP response from a GetApiOpenapiYamlWithResponse call
-func ParseGetApiOpenapiYamlResponse(rsp *http.Response) (*GetApiOpenapiYamlResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type DeleteApiMeMfaTotp429JSONResponse Error
 
-	response := &GetApiOpenapiYamlResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response DeleteApiMeMfaTotp429JSONResponse) VisitDeleteApiMeMfaTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
-		var dest string
-		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.YAML200 = &dest
+type DeleteApiMeMfaTotp500JSONResponse Error
 
-	}
+func (response DeleteApiMeMfaTotp500JSONResponse) VisitDeleteApiMeMfaTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	return response, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParsePostApiPlaylistsResponse parses an HTTP response from a PostApiPlaylistsWithResponse call
-func ParsePostApiPlaylistsResponse(rsp *http.Response) (*PostApiPlaylistsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type PostApiMeMfaTotpRequestObject struct {
+	Params PostApiMeMfaTotpParams
+}
 
-	response := &PostApiPlaylistsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type PostApiMeMfaTotpResponseObject interface {
+	VisitPostApiMeMfaTotpResponse(w http.ResponseWriter) error
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CreatePlaylistResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+type PostApiMeMfaTotp200JSONResponse TOTPEnrollment
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+func (response PostApiMeMfaTotp200JSONResponse) VisitPostApiMeMfaTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+type PostApiMeMfaTotp401JSONResponse Error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+func (response PostApiMeMfaTotp401JSONResponse) VisitPostApiMeMfaTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type PostApiMeMfaTotp409JSONResponse Error
 
-	}
+func (response PostApiMeMfaTotp409JSONResponse) VisitPostApiMeMfaTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
 
-	return response, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParseGetApiPlaylistsIdResponse parses an HTTP response from a GetApiPlaylistsIdWithResponse call
-func ParseGetApiPlaylistsIdResponse(rsp *http.Response) (*GetApiPlaylistsIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type PostApiMeMfaTotp500JSONResponse Error
 
-	response := &GetApiPlaylistsIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response PostApiMeMfaTotp500JSONResponse) VisitPostApiMeMfaTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Playlist
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type PostApiMeMfaTotpVerifyRequestObject struct {
+	Params PostApiMeMfaTotpVerifyParams
+	Body   *PostApiMeMfaTotpVerifyJSONRequestBody
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+type PostApiMeMfaTotpVerifyResponseObject interface {
+	VisitPostApiMeMfaTotpVerifyResponse(w http.ResponseWriter) error
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type PostApiMeMfaTotpVerify200JSONResponse RecoveryCodes
 
-	}
+func (response PostApiMeMfaTotpVerify200JSONResponse) VisitPostApiMeMfaTotpVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMeMfaTotpVerify400JSONResponse Error
+
+func (response PostApiMeMfaTotpVerify400JSONResponse) VisitPostApiMeMfaTotpVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	return response, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParseGetApiSpotifyStatusResponse parses an HTTP response from a GetApiSpotifyStatusWithResponse call
-func ParseGetApiSpotifyStatusResponse(rsp *http.Response) (*GetApiSpotifyStatusResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type PostApiMeMfaTotpVerify401JSONResponse Error
 
-	response := &GetApiSpotifyStatusResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response PostApiMeMfaTotpVerify401JSONResponse) VisitPostApiMeMfaTotpVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SpotifyStatusResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type PostApiMeMfaTotpVerify404JSONResponse Error
 
-	}
+func (response PostApiMeMfaTotpVerify404JSONResponse) VisitPostApiMeMfaTotpVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	return response, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParseGetApiUsersResponse parses an HTTP response from a GetApiUsersWithResponse call
-func ParseGetApiUsersResponse(rsp *http.Response) (*GetApiUsersResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type PostApiMeMfaTotpVerify409JSONResponse Error
 
-	response := &GetApiUsersResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response PostApiMeMfaTotpVerify409JSONResponse) VisitPostApiMeMfaTotpVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListUsersResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type PostApiMeMfaTotpVerify500JSONResponse Error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+func (response PostApiMeMfaTotpVerify500JSONResponse) VisitPostApiMeMfaTotpVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type GetApiMePasskeysRequestObject struct {
+	Params GetApiMePasskeysParams
+}
 
-	}
+type GetApiMePasskeysResponseObject interface {
+	VisitGetApiMePasskeysResponse(w http.ResponseWriter) error
+}
 
-	return response, nil
+type GetApiMePasskeys200JSONResponse ListPasskeysResponse
+
+func (response GetApiMePasskeys200JSONResponse) VisitGetApiMePasskeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Refresh session tokens
-	// (POST /api/auth/refresh)
-	PostApiAuthRefresh(w http.ResponseWriter, r *http.Request, params PostApiAuthRefreshParams)
-	// Verify user session
-	// (GET /api/auth/verify)
-	GetApiAuthVerify(w http.ResponseWriter, r *http.Request, params GetApiAuthVerifyParams)
-	// Health check
-	// (GET /api/health)
-	GetApiHealth(w http.ResponseWriter, r *http.Request)
-	// Create a spotify playlist based on a mars playlist for a user.
-	// (POST /api/integrations/spotify/playlist)
-	PostApiIntegrationsSpotifyPlaylist(w http.ResponseWriter, r *http.Request, params PostApiIntegrationsSpotifyPlaylistParams)
-	// Create a personal spotify playlist based on mars playlist.
-	// (POST /api/integrations/spotify/playlist/{id})
-	PostApiIntegrationsSpotifyPlaylistId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostApiIntegrationsSpotifyPlaylistIdParams)
-	// Sync recent spotify tracks
-	// (POST /api/integrations/spotify/tracks/sync)
-	PostApiIntegrationsSpotifyTracksSync(w http.ResponseWriter, r *http.Request, params PostApiIntegrationsSpotifyTracksSyncParams)
-	// User login
-	// (POST /api/login)
-	PostApiLogin(w http.ResponseWriter, r *http.Request)
-	// Get personal playlists
-	// (GET /api/me/playlists)
-	GetApiMePlaylists(w http.ResponseWriter, r *http.Request, params GetApiMePlaylistsParams)
-	// Get top tracks for a user within a time range.
-	// (GET /api/me/tracks/top)
-	GetApiMeTracksTop(w http.ResponseWriter, r *http.Request, params GetApiMeTracksTopParams)
-	// Get Spotify OAuth2.0 configuration.
-	// (GET /api/oauth/spotify/config.json)
-	GetApiOauthSpotifyConfigJson(w http.ResponseWriter, r *http.Request)
-	// Get Spotify OAuth2.0 tokens.
-	// (POST /api/oauth/spotify/token)
-	PostApiOauthSpotifyToken(w http.ResponseWriter, r *http.Request)
-	// Refresh a Spotify OAuth2.0 token.
-	// (POST /api/oauth/spotify/token/refresh)
-	PostApiOauthSpotifyTokenRefresh(w http.ResponseWriter, r *http.Request, params PostApiOauthSpotifyTokenRefreshParams)
-	// Get OpenAPI specification.
-	// (GET /api/openapi.yaml)
-	GetApiOpenapiYaml(w http.ResponseWriter, r *http.Request)
-	// Create a playlist from listening history
-	// (POST /api/playlists)
-	PostApiPlaylists(w http.ResponseWriter, r *http.Request, params PostApiPlaylistsParams)
-	// Get a personal playlist
-	// (GET /api/playlists/{id})
-	GetApiPlaylistsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetApiPlaylistsIdParams)
-	// Get Spotify OAuth2.0 status.
-	// (GET /api/spotify/status)
-	GetApiSpotifyStatus(w http.ResponseWriter, r *http.Request)
-	// List users
-	// (GET /api/users)
-	GetApiUsers(w http.ResponseWriter, r *http.Request, params GetApiUsersParams)
+type GetApiMePasskeys401JSONResponse Error
+
+func (response GetApiMePasskeys401JSONResponse) VisitGetApiMePasskeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+type GetApiMePasskeys500JSONResponse Error
 
-type Unimplemented struct{}
+func (response GetApiMePasskeys500JSONResponse) VisitGetApiMePasskeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-// Refresh session tokens
-// (POST /api/auth/refresh)
-func (_ Unimplemented) PostApiAuthRefresh(w http.ResponseWriter, r *http.Request, params PostApiAuthRefreshParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Verify user session
-// (GET /api/auth/verify)
-func (_ Unimplemented) GetApiAuthVerify(w http.ResponseWriter, r *http.Request, params GetApiAuthVerifyParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+type PostApiMePasskeysRegisterBeginRequestObject struct {
+	Params PostApiMePasskeysRegisterBeginParams
 }
 
-// Health check
-// (GET /api/health)
-func (_ Unimplemented) GetApiHealth(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+type PostApiMePasskeysRegisterBeginResponseObject interface {
+	VisitPostApiMePasskeysRegisterBeginResponse(w http.ResponseWriter) error
 }
 
-// Create a spotify playlist based on a mars playlist for a user.
-// (POST /api/integrations/spotify/playlist)
-func (_ Unimplemented) PostApiIntegrationsSpotifyPlaylist(w http.ResponseWriter, r *http.Request, params PostApiIntegrationsSpotifyPlaylistParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+type PostApiMePasskeysRegisterBegin200JSONResponse PasskeyCeremony
+
+func (response PostApiMePasskeysRegisterBegin200JSONResponse) VisitPostApiMePasskeysRegisterBeginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Create a personal spotify playlist based on mars playlist.
-// (POST /api/integrations/spotify/playlist/{id})
-func (_ Unimplemented) PostApiIntegrationsSpotifyPlaylistId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostApiIntegrationsSpotifyPlaylistIdParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+type PostApiMePasskeysRegisterBegin401JSONResponse Error
+
+func (response PostApiMePasskeysRegisterBegin401JSONResponse) VisitPostApiMePasskeysRegisterBeginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Sync recent spotify tracks
-// (POST /api/integrations/spotify/tracks/sync)
-func (_ Unimplemented) PostApiIntegrationsSpotifyTracksSync(w http.ResponseWriter, r *http.Request, params PostApiIntegrationsSpotifyTracksSyncParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+type PostApiMePasskeysRegisterBegin500JSONResponse Error
+
+func (response PostApiMePasskeysRegisterBegin500JSONResponse) VisitPostApiMePasskeysRegisterBeginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// User login
-// (POST /api/login)
-func (_ Unimplemented) PostApiLogin(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+type PostApiMePasskeysRegisterFinishRequestObject struct {
+	Params PostApiMePasskeysRegisterFinishParams
+	Body   *PostApiMePasskeysRegisterFinishJSONRequestBody
 }
 
-// Get personal playlists
-// (GET /api/me/playlists)
-func (_ Unimplemented) GetApiMePlaylists(w http.ResponseWriter, r *http.Request, params GetApiMePlaylistsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+type PostApiMePasskeysRegisterFinishResponseObject interface {
+	VisitPostApiMePasskeysRegisterFinishResponse(w http.ResponseWriter) error
 }
 
-// Get top tracks for a user within a time range.
-// (GET /api/me/tracks/top)
-func (_ Unimplemented) GetApiMeTracksTop(w http.ResponseWriter, r *http.Request, params GetApiMeTracksTopParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+type PostApiMePasskeysRegisterFinish201JSONResponse Passkey
+
+func (response PostApiMePasskeysRegisterFinish201JSONResponse) VisitPostApiMePasskeysRegisterFinishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Get Spotify OAuth2.0 configuration.
-// (GET /api/oauth/spotify/config.json)
-func (_ Unimplemented) GetApiOauthSpotifyConfigJson(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+type PostApiMePasskeysRegisterFinish400JSONResponse Error
+
+func (response PostApiMePasskeysRegisterFinish400JSONResponse) VisitPostApiMePasskeysRegisterFinishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Get Spotify OAuth2.0 tokens.
-// (POST /api/oauth/spotify/token)
-func (_ Unimplemented) PostApiOauthSpotifyToken(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+type PostApiMePasskeysRegisterFinish401JSONResponse Error
+
+func (response PostApiMePasskeysRegisterFinish401JSONResponse) VisitPostApiMePasskeysRegisterFinishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Refresh a Spotify OAuth2.0 token.
-// (POST /api/oauth/spotify/token/refresh)
-func (_ Unimplemented) PostApiOauthSpotifyTokenRefresh(w http.ResponseWriter, r *http.Request, params PostApiOauthSpotifyTokenRefreshParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+type PostApiMePasskeysRegisterFinish500JSONResponse Error
+
+func (response PostApiMePasskeysRegisterFinish500JSONResponse) VisitPostApiMePasskeysRegisterFinishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Get OpenAPI specification.
-// (GET /api/openapi.yaml)
-func (_ Unimplemented) GetApiOpenapiYaml(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+type DeleteApiMePasskeysIdRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params DeleteApiMePasskeysIdParams
 }
 
-// Create a playlist from listening history
-// (POST /api/playlists)
-func (_ Unimplemented) PostApiPlaylists(w http.ResponseWriter, r *http.Request, params PostApiPlaylistsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+type DeleteApiMePasskeysIdResponseObject interface {
+	VisitDeleteApiMePasskeysIdResponse(w http.ResponseWriter) error
 }
 
-// Get a personal playlist
-// (GET /api/playlists/{id})
-func (_ Unimplemented) GetApiPlaylistsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetApiPlaylistsIdParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+type DeleteApiMePasskeysId204Response struct {
 }
 
-// Get Spotify OAuth2.0 status.
-// (GET /api/spotify/status)
-func (_ Unimplemented) GetApiSpotifyStatus(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+func (response DeleteApiMePasskeysId204Response) VisitDeleteApiMePasskeysIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-// List users
-// (GET /api/users)
-func (_ Unimplemented) GetApiUsers(w http.ResponseWriter, r *http.Request, params GetApiUsersParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+type DeleteApiMePasskeysId401JSONResponse Error
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+func (response DeleteApiMePasskeysId401JSONResponse) VisitDeleteApiMePasskeysIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+type DeleteApiMePasskeysId404JSONResponse Error
 
-// PostApiAuthRefresh operation middleware
-func (siw *ServerInterfaceWrapper) PostApiAuthRefresh(w http.ResponseWriter, r *http.Request) {
+func (response DeleteApiMePasskeysId404JSONResponse) VisitDeleteApiMePasskeysIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	var err error
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PostApiAuthRefreshParams
+type DeleteApiMePasskeysId500JSONResponse Error
 
-	headers := r.Header
+func (response DeleteApiMePasskeysId500JSONResponse) VisitDeleteApiMePasskeysIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// ------------- Optional header parameter "X-CSRF-Token" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
-		var XCSRFToken CsrfTokenHeader
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
-			return
-		}
+	return json.NewEncoder(w).Encode(response)
+}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
-			return
-		}
+type PostApiMePasswordRequestObject struct {
+	Params PostApiMePasswordParams
+	Body   *PostApiMePasswordJSONRequestBody
+}
 
-		params.XCSRFToken = &XCSRFToken
+type PostApiMePasswordResponseObject interface {
+	VisitPostApiMePasswordResponse(w http.ResponseWriter) error
+}
 
-	}
+type PostApiMePassword204Response struct {
+}
 
-	{
-		var cookie *http.Cookie
+func (response PostApiMePassword204Response) VisitPostApiMePasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
 
-		if cookie, err = r.Cookie("refresh"); err == nil {
-			var value RefreshTokenCookie
-			err = runtime.BindStyledParameterWithOptions("simple", "refresh", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
-			if err != nil {
-				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refresh", Err: err})
-				return
-			}
-			params.Refresh = &value
+type PostApiMePassword400JSONResponse Error
 
-		}
-	}
+func (response PostApiMePassword400JSONResponse) VisitPostApiMePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostApiAuthRefresh(w, r, params)
-	}))
+	return json.NewEncoder(w).Encode(response)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type PostApiMePassword401JSONResponse Error
 
-	handler.ServeHTTP(w, r)
+func (response PostApiMePassword401JSONResponse) VisitPostApiMePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetApiAuthVerify operation middleware
-func (siw *ServerInterfaceWrapper) GetApiAuthVerify(w http.ResponseWriter, r *http.Request) {
+type PostApiMePassword422JSONResponse Error
 
-	var err error
+func (response PostApiMePassword422JSONResponse) VisitPostApiMePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
 
-	ctx := r.Context()
+	return json.NewEncoder(w).Encode(response)
+}
 
-	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+type PostApiMePassword500JSONResponse Error
 
-	r = r.WithContext(ctx)
+func (response PostApiMePassword500JSONResponse) VisitPostApiMePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetApiAuthVerifyParams
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// ------------- Optional query parameter "role" -------------
+type GetApiMePlaylistRulesRequestObject struct {
+	Params GetApiMePlaylistRulesParams
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "role", r.URL.Query(), &params.Role)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
-		return
-	}
+type GetApiMePlaylistRulesResponseObject interface {
+	VisitGetApiMePlaylistRulesResponse(w http.ResponseWriter) error
+}
 
-	{
-		var cookie *http.Cookie
+type GetApiMePlaylistRules200JSONResponse PlaylistRules
 
-		if cookie, err = r.Cookie("access"); err == nil {
-			var value string
-			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
-			if err != nil {
-				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
-				return
-			}
-			params.Access = &value
+func (response GetApiMePlaylistRules200JSONResponse) VisitGetApiMePlaylistRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-		}
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiAuthVerify(w, r, params)
-	}))
+type GetApiMePlaylistRules401JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response GetApiMePlaylistRules401JSONResponse) VisitGetApiMePlaylistRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetApiHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetApiHealth(w http.ResponseWriter, r *http.Request) {
+type GetApiMePlaylistRules500JSONResponse Error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiHealth(w, r)
-	}))
+func (response GetApiMePlaylistRules500JSONResponse) VisitGetApiMePlaylistRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type PatchApiMePlaylistRulesRequestObject struct {
+	Params PatchApiMePlaylistRulesParams
+	Body   *PatchApiMePlaylistRulesJSONRequestBody
 }
 
-// PostApiIntegrationsSpotifyPlaylist operation middleware
-func (siw *ServerInterfaceWrapper) PostApiIntegrationsSpotifyPlaylist(w http.ResponseWriter, r *http.Request) {
+type PatchApiMePlaylistRulesResponseObject interface {
+	VisitPatchApiMePlaylistRulesResponse(w http.ResponseWriter) error
+}
 
-	var err error
+type PatchApiMePlaylistRules200JSONResponse PlaylistRules
 
-	ctx := r.Context()
+func (response PatchApiMePlaylistRules200JSONResponse) VisitPatchApiMePlaylistRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r = r.WithContext(ctx)
+type PatchApiMePlaylistRules400JSONResponse Error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PostApiIntegrationsSpotifyPlaylistParams
+func (response PatchApiMePlaylistRules400JSONResponse) VisitPatchApiMePlaylistRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	headers := r.Header
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// ------------- Optional header parameter "X-CSRF-Token" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
-		var XCSRFToken CsrfTokenHeader
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
-			return
-		}
+type PatchApiMePlaylistRules401JSONResponse Error
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
-			return
-		}
+func (response PatchApiMePlaylistRules401JSONResponse) VisitPatchApiMePlaylistRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-		params.XCSRFToken = &XCSRFToken
+	return json.NewEncoder(w).Encode(response)
+}
 
-	}
+type PatchApiMePlaylistRules500JSONResponse Error
 
-	{
-		var cookie *http.Cookie
+func (response PatchApiMePlaylistRules500JSONResponse) VisitPatchApiMePlaylistRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-		if cookie, err = r.Cookie("access"); err == nil {
-			var value AccessTokenHeader
-			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
-			if err != nil {
-				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
-				return
-			}
-			params.Access = &value
+	return json.NewEncoder(w).Encode(response)
+}
 
-		}
-	}
+type GetApiMePlaylistsRequestObject struct {
+	Params GetApiMePlaylistsParams
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostApiIntegrationsSpotifyPlaylist(w, r, params)
-	}))
+type GetApiMePlaylistsResponseObject interface {
+	VisitGetApiMePlaylistsResponse(w http.ResponseWriter) error
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type GetApiMePlaylists200JSONResponse ListPlaylists
 
-	handler.ServeHTTP(w, r)
+func (response GetApiMePlaylists200JSONResponse) VisitGetApiMePlaylistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// PostApiIntegrationsSpotifyPlaylistId operation middleware
-func (siw *ServerInterfaceWrapper) PostApiIntegrationsSpotifyPlaylistId(w http.ResponseWriter, r *http.Request) {
+type GetApiMePlaylists401JSONResponse Error
 
-	var err error
+func (response GetApiMePlaylists401JSONResponse) VisitGetApiMePlaylistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+type GetApiMePlaylists500JSONResponse Error
 
-	ctx := r.Context()
+func (response GetApiMePlaylists500JSONResponse) VisitGetApiMePlaylistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r = r.WithContext(ctx)
+type GetApiMePreferencesRequestObject struct {
+	Params GetApiMePreferencesParams
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PostApiIntegrationsSpotifyPlaylistIdParams
+type GetApiMePreferencesResponseObject interface {
+	VisitGetApiMePreferencesResponse(w http.ResponseWriter) error
+}
 
-	headers := r.Header
+type GetApiMePreferences200JSONResponse Preferences
 
-	// ------------- Optional header parameter "X-CSRF-Token" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
-		var XCSRFToken CsrfTokenHeader
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
-			return
-		}
+func (response GetApiMePreferences200JSONResponse) VisitGetApiMePreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiMePreferences401JSONResponse Error
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
-			return
-		}
+func (response GetApiMePreferences401JSONResponse) VisitGetApiMePreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-		params.XCSRFToken = &XCSRFToken
+	return json.NewEncoder(w).Encode(response)
+}
 
-	}
+type GetApiMePreferences500JSONResponse Error
 
-	{
-		var cookie *http.Cookie
+func (response GetApiMePreferences500JSONResponse) VisitGetApiMePreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-		if cookie, err = r.Cookie("access"); err == nil {
-			var value AccessTokenHeader
-			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
-			if err != nil {
-				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
-				return
-			}
-			params.Access = &value
+	return json.NewEncoder(w).Encode(response)
+}
 
-		}
-	}
+type PatchApiMePreferencesRequestObject struct {
+	Params PatchApiMePreferencesParams
+	Body   *PatchApiMePreferencesJSONRequestBody
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostApiIntegrationsSpotifyPlaylistId(w, r, id, params)
-	}))
+type PatchApiMePreferencesResponseObject interface {
+	VisitPatchApiMePreferencesResponse(w http.ResponseWriter) error
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type PatchApiMePreferences200JSONResponse Preferences
 
-	handler.ServeHTTP(w, r)
+func (response PatchApiMePreferences200JSONResponse) VisitPatchApiMePreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// PostApiIntegrationsSpotifyTracksSync operation middleware
-func (siw *ServerInterfaceWrapper) PostApiIntegrationsSpotifyTracksSync(w http.ResponseWriter, r *http.Request) {
+type PatchApiMePreferences400JSONResponse Error
 
-	var err error
+func (response PatchApiMePreferences400JSONResponse) VisitPatchApiMePreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	ctx := r.Context()
+	return json.NewEncoder(w).Encode(response)
+}
 
-	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+type PatchApiMePreferences401JSONResponse Error
 
-	r = r.WithContext(ctx)
+func (response PatchApiMePreferences401JSONResponse) VisitPatchApiMePreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PostApiIntegrationsSpotifyTracksSyncParams
+	return json.NewEncoder(w).Encode(response)
+}
 
-	headers := r.Header
+type PatchApiMePreferences500JSONResponse Error
 
-	// ------------- Optional header parameter "X-CSRF-Token" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
-		var XCSRFToken CsrfTokenHeader
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
-			return
-		}
+func (response PatchApiMePreferences500JSONResponse) VisitPatchApiMePreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
-			return
-		}
+	return json.NewEncoder(w).Encode(response)
+}
 
-		params.XCSRFToken = &XCSRFToken
+type GetApiMeSessionsRequestObject struct {
+	Params GetApiMeSessionsParams
+}
 
-	}
+type GetApiMeSessionsResponseObject interface {
+	VisitGetApiMeSessionsResponse(w http.ResponseWriter) error
+}
 
-	{
-		var cookie *http.Cookie
+type GetApiMeSessions200JSONResponse ListSessionsResponse
 
-		if cookie, err = r.Cookie("access"); err == nil {
-			var value AccessTokenHeader
-			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
-			if err != nil {
-				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
-				return
-			}
-			params.Access = &value
+func (response GetApiMeSessions200JSONResponse) VisitGetApiMeSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-		}
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostApiIntegrationsSpotifyTracksSync(w, r, params)
-	}))
+type GetApiMeSessions401JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response GetApiMeSessions401JSONResponse) VisitGetApiMeSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// PostApiLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostApiLogin(w http.ResponseWriter, r *http.Request) {
+type GetApiMeSessions500JSONResponse Error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostApiLogin(w, r)
-	}))
+func (response GetApiMeSessions500JSONResponse) VisitGetApiMeSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type DeleteApiMeSessionsIdRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params DeleteApiMeSessionsIdParams
 }
 
-// GetApiMePlaylists operation middleware
-func (siw *ServerInterfaceWrapper) GetApiMePlaylists(w http.ResponseWriter, r *http.Request) {
+type DeleteApiMeSessionsIdResponseObject interface {
+	VisitDeleteApiMeSessionsIdResponse(w http.ResponseWriter) error
+}
 
-	var err error
+type DeleteApiMeSessionsId204Response struct {
+}
 
-	ctx := r.Context()
+func (response DeleteApiMeSessionsId204Response) VisitDeleteApiMeSessionsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
 
-	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+type DeleteApiMeSessionsId401JSONResponse Error
 
-	r = r.WithContext(ctx)
+func (response DeleteApiMeSessionsId401JSONResponse) VisitDeleteApiMeSessionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetApiMePlaylistsParams
+	return json.NewEncoder(w).Encode(response)
+}
 
-	{
-		var cookie *http.Cookie
+type DeleteApiMeSessionsId404JSONResponse Error
 
-		if cookie, err = r.Cookie("access"); err == nil {
-			var value AccessTokenHeader
-			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
-			if err != nil {
-				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
-				return
-			}
-			params.Access = &value
+func (response DeleteApiMeSessionsId404JSONResponse) VisitDeleteApiMeSessionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-		}
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiMePlaylists(w, r, params)
-	}))
+type DeleteApiMeSessionsId500JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response DeleteApiMeSessionsId500JSONResponse) VisitDeleteApiMeSessionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetApiMeTracksTop operation middleware
-func (siw *ServerInterfaceWrapper) GetApiMeTracksTop(w http.ResponseWriter, r *http.Request) {
+type GetApiMeStatsRequestObject struct {
+	Params GetApiMeStatsParams
+}
 
-	var err error
+type GetApiMeStatsResponseObject interface {
+	VisitGetApiMeStatsResponse(w http.ResponseWriter) error
+}
 
-	ctx := r.Context()
+type GetApiMeStats200JSONResponse ListeningStats
 
-	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+func (response GetApiMeStats200JSONResponse) VisitGetApiMeStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	r = r.WithContext(ctx)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetApiMeTracksTopParams
+type GetApiMeStats400JSONResponse Error
 
-	// ------------- Optional query parameter "start" -------------
+func (response GetApiMeStats400JSONResponse) VisitGetApiMeStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	err = runtime.BindQueryParameter("form", true, false, "start", r.URL.Query(), &params.Start)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// ------------- Optional query parameter "end" -------------
+type GetApiMeStats500JSONResponse Error
 
-	err = runtime.BindQueryParameter("form", true, false, "end", r.URL.Query(), &params.End)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
-		return
-	}
+func (response GetApiMeStats500JSONResponse) VisitGetApiMeStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	{
-		var cookie *http.Cookie
+	return json.NewEncoder(w).Encode(response)
+}
 
-		if cookie, err = r.Cookie("access"); err == nil {
-			var value AccessTokenHeader
-			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
-			if err != nil {
-				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
-				return
-			}
-			params.Access = &value
+type GetApiMeTracksTopRequestObject struct {
+	Params GetApiMeTracksTopParams
+}
 
-		}
-	}
+type GetApiMeTracksTopResponseObject interface {
+	VisitGetApiMeTracksTopResponse(w http.ResponseWriter) error
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiMeTracksTop(w, r, params)
-	}))
+type GetApiMeTracksTop200JSONResponse struct {
+	Tracks []PlaylistTrack `json:"tracks"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response GetApiMeTracksTop200JSONResponse) VisitGetApiMeTracksTopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetApiOauthSpotifyConfigJson operation middleware
-func (siw *ServerInterfaceWrapper) GetApiOauthSpotifyConfigJson(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiOauthSpotifyConfigJson(w, r)
-	}))
+type GetApiMeTracksTop400JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response GetApiMeTracksTop400JSONResponse) VisitGetApiMeTracksTopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// PostApiOauthSpotifyToken operation middleware
-func (siw *ServerInterfaceWrapper) PostApiOauthSpotifyToken(w http.ResponseWriter, r *http.Request) {
+type GetApiMeTracksTop500JSONResponse Error
 
-	ctx := r.Context()
+func (response GetApiMeTracksTop500JSONResponse) VisitGetApiMeTracksTopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r = r.WithContext(ctx)
+type GetApiMeYearInReviewYearRequestObject struct {
+	Year   int `json:"year"`
+	Params GetApiMeYearInReviewYearParams
+}
+
+type GetApiMeYearInReviewYearResponseObject interface {
+	VisitGetApiMeYearInReviewYearResponse(w http.ResponseWriter) error
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostApiOauthSpotifyToken(w, r)
-	}))
+type GetApiMeYearInReviewYear200JSONResponse YearInReview
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response GetApiMeYearInReviewYear200JSONResponse) VisitGetApiMeYearInReviewYearResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// PostApiOauthSpotifyTokenRefresh operation middleware
-func (siw *ServerInterfaceWrapper) PostApiOauthSpotifyTokenRefresh(w http.ResponseWriter, r *http.Request) {
+type GetApiMeYearInReviewYear400JSONResponse Error
 
-	var err error
+func (response GetApiMeYearInReviewYear400JSONResponse) VisitGetApiMeYearInReviewYearResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	ctx := r.Context()
+	return json.NewEncoder(w).Encode(response)
+}
 
-	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+type GetApiMeYearInReviewYear401JSONResponse Error
 
-	r = r.WithContext(ctx)
+func (response GetApiMeYearInReviewYear401JSONResponse) VisitGetApiMeYearInReviewYearResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PostApiOauthSpotifyTokenRefreshParams
+	return json.NewEncoder(w).Encode(response)
+}
 
-	headers := r.Header
+type GetApiMeYearInReviewYear500JSONResponse Error
 
-	// ------------- Optional header parameter "X-CSRF-Token" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
-		var XCSRFToken CsrfTokenHeader
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
-			return
-		}
+func (response GetApiMeYearInReviewYear500JSONResponse) VisitGetApiMeYearInReviewYearResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
-			return
-		}
+	return json.NewEncoder(w).Encode(response)
+}
 
-		params.XCSRFToken = &XCSRFToken
+type GetApiOauthSpotifyConfigJsonRequestObject struct {
+}
 
-	}
+type GetApiOauthSpotifyConfigJsonResponseObject interface {
+	VisitGetApiOauthSpotifyConfigJsonResponse(w http.ResponseWriter) error
+}
 
-	{
-		var cookie *http.Cookie
+type GetApiOauthSpotifyConfigJson200JSONResponse SpotifyOAuth
 
-		if cookie, err = r.Cookie("access"); err == nil {
-			var value AccessTokenHeader
-			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
-			if err != nil {
-				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
-				return
-			}
-			params.Access = &value
+func (response GetApiOauthSpotifyConfigJson200JSONResponse) VisitGetApiOauthSpotifyConfigJsonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-		}
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostApiOauthSpotifyTokenRefresh(w, r, params)
-	}))
+type GetApiOauthSpotifyConfigJson500JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response GetApiOauthSpotifyConfigJson500JSONResponse) VisitGetApiOauthSpotifyConfigJsonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetApiOpenapiYaml operation middleware
-func (siw *ServerInterfaceWrapper) GetApiOpenapiYaml(w http.ResponseWriter, r *http.Request) {
+type PostApiOauthSpotifyTokenRequestObject struct {
+	Body *PostApiOauthSpotifyTokenJSONRequestBody
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiOpenapiYaml(w, r)
-	}))
+type PostApiOauthSpotifyTokenResponseObject interface {
+	VisitPostApiOauthSpotifyTokenResponse(w http.ResponseWriter) error
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type PostApiOauthSpotifyToken204Response struct {
+}
 
-	handler.ServeHTTP(w, r)
+func (response PostApiOauthSpotifyToken204Response) VisitPostApiOauthSpotifyTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-// PostApiPlaylists operation middleware
-func (siw *ServerInterfaceWrapper) PostApiPlaylists(w http.ResponseWriter, r *http.Request) {
+type PostApiOauthSpotifyToken400JSONResponse Error
 
-	var err error
+func (response PostApiOauthSpotifyToken400JSONResponse) VisitPostApiOauthSpotifyTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	ctx := r.Context()
+	return json.NewEncoder(w).Encode(response)
+}
 
-	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+type PostApiOauthSpotifyToken500JSONResponse Error
 
-	r = r.WithContext(ctx)
+func (response PostApiOauthSpotifyToken500JSONResponse) VisitPostApiOauthSpotifyTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PostApiPlaylistsParams
+	return json.NewEncoder(w).Encode(response)
+}
 
-	headers := r.Header
+type PostApiOauthSpotifyTokenRefreshRequestObject struct {
+	Params PostApiOauthSpotifyTokenRefreshParams
+	Body   *PostApiOauthSpotifyTokenRefreshJSONRequestBody
+}
 
-	// ------------- Optional header parameter "X-CSRF-Token" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-CSRF-Token")]; found {
-		var XCSRFToken CsrfTokenHeader
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-CSRF-Token", Count: n})
-			return
-		}
+type PostApiOauthSpotifyTokenRefreshResponseObject interface {
+	VisitPostApiOauthSpotifyTokenRefreshResponse(w http.ResponseWriter) error
+}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-CSRF-Token", valueList[0], &XCSRFToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-CSRF-Token", Err: err})
-			return
-		}
+type PostApiOauthSpotifyTokenRefresh204Response struct {
+}
 
-		params.XCSRFToken = &XCSRFToken
+func (response PostApiOauthSpotifyTokenRefresh204Response) VisitPostApiOauthSpotifyTokenRefreshResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
 
-	}
+type PostApiOauthSpotifyTokenRefresh400JSONResponse Error
 
-	{
-		var cookie *http.Cookie
+func (response PostApiOauthSpotifyTokenRefresh400JSONResponse) VisitPostApiOauthSpotifyTokenRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-		if cookie, err = r.Cookie("access"); err == nil {
-			var value AccessTokenHeader
-			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
-			if err != nil {
-				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
-				return
-			}
-			params.Access = &value
+	return json.NewEncoder(w).Encode(response)
+}
 
-		}
-	}
+type PostApiOauthSpotifyTokenRefresh401JSONResponse Error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostApiPlaylists(w, r, params)
-	}))
+func (response PostApiOauthSpotifyTokenRefresh401JSONResponse) VisitPostApiOauthSpotifyTokenRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type PostApiOauthSpotifyTokenRefresh403JSONResponse Error
+
+func (response PostApiOauthSpotifyTokenRefresh403JSONResponse) VisitPostApiOauthSpotifyTokenRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetApiPlaylistsId operation middleware
-func (siw *ServerInterfaceWrapper) GetApiPlaylistsId(w http.ResponseWriter, r *http.Request) {
+type PostApiOauthSpotifyTokenRefresh404JSONResponse Error
 
-	var err error
+func (response PostApiOauthSpotifyTokenRefresh404JSONResponse) VisitPostApiOauthSpotifyTokenRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+type PostApiOauthSpotifyTokenRefresh500JSONResponse Error
 
-	ctx := r.Context()
+func (response PostApiOauthSpotifyTokenRefresh500JSONResponse) VisitPostApiOauthSpotifyTokenRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r = r.WithContext(ctx)
+type GetApiOpenapiYamlRequestObject struct {
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetApiPlaylistsIdParams
+type GetApiOpenapiYamlResponseObject interface {
+	VisitGetApiOpenapiYamlResponse(w http.ResponseWriter) error
+}
 
-	{
-		var cookie *http.Cookie
+type GetApiOpenapiYaml200ApplicationxYamlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
 
-		if cookie, err = r.Cookie("access"); err == nil {
-			var value AccessTokenHeader
-			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
-			if err != nil {
-				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
-				return
-			}
-			params.Access = &value
+func (response GetApiOpenapiYaml200ApplicationxYamlResponse) VisitGetApiOpenapiYamlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-yaml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
 
-		}
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
 	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiPlaylistsId(w, r, id, params)
-	}))
+type GetApiOpenapiYaml500JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response GetApiOpenapiYaml500JSONResponse) VisitGetApiOpenapiYamlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetApiSpotifyStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetApiSpotifyStatus(w http.ResponseWriter, r *http.Request) {
+type PostApiPasswordResetRequestObject struct {
+	Body *PostApiPasswordResetJSONRequestBody
+}
 
-	ctx := r.Context()
+type PostApiPasswordResetResponseObject interface {
+	VisitPostApiPasswordResetResponse(w http.ResponseWriter) error
+}
 
-	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+type PostApiPasswordReset202Response struct {
+}
 
-	r = r.WithContext(ctx)
+func (response PostApiPasswordReset202Response) VisitPostApiPasswordResetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiSpotifyStatus(w, r)
-	}))
+type PostApiPasswordReset400JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response PostApiPasswordReset400JSONResponse) VisitPostApiPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetApiUsers operation middleware
-func (siw *ServerInterfaceWrapper) GetApiUsers(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+type PostApiPasswordResetConfirmRequestObject struct {
+	Body *PostApiPasswordResetConfirmJSONRequestBody
+}
 
-	ctx := r.Context()
+type PostApiPasswordResetConfirmResponseObject interface {
+	VisitPostApiPasswordResetConfirmResponse(w http.ResponseWriter) error
+}
 
-	ctx = context.WithValue(ctx, BearerTokenAuthScopes, []string{})
+type PostApiPasswordResetConfirm204Response struct {
+}
 
-	r = r.WithContext(ctx)
+func (response PostApiPasswordResetConfirm204Response) VisitPostApiPasswordResetConfirmResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetApiUsersParams
+type PostApiPasswordResetConfirm400JSONResponse Error
 
-	// ------------- Optional query parameter "limit" -------------
+func (response PostApiPasswordResetConfirm400JSONResponse) VisitPostApiPasswordResetConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	{
-		var cookie *http.Cookie
+type PostApiPasswordResetConfirm403JSONResponse Error
 
-		if cookie, err = r.Cookie("access"); err == nil {
-			var value AccessTokenHeader
-			err = runtime.BindStyledParameterWithOptions("simple", "access", cookie.Value, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
-			if err != nil {
-				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
-				return
-			}
-			params.Access = &value
+func (response PostApiPasswordResetConfirm403JSONResponse) VisitPostApiPasswordResetConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-		}
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiUsers(w, r, params)
-	}))
+type PostApiPasswordResetConfirm422JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response PostApiPasswordResetConfirm422JSONResponse) VisitPostApiPasswordResetConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+type PostApiPasswordResetConfirm500JSONResponse Error
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+func (response PostApiPasswordResetConfirm500JSONResponse) VisitPostApiPasswordResetConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+type PostApiPlaylistsRequestObject struct {
+	Params PostApiPlaylistsParams
+	Body   *PostApiPlaylistsJSONRequestBody
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+type PostApiPlaylistsResponseObject interface {
+	VisitPostApiPlaylistsResponse(w http.ResponseWriter) error
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+type PostApiPlaylists201JSONResponse CreatePlaylistResponse
 
-type RequiredParamError struct {
-	ParamName string
-}
+func (response PostApiPlaylists201JSONResponse) VisitPostApiPlaylistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+type PostApiPlaylists400JSONResponse Error
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+func (response PostApiPlaylists400JSONResponse) VisitPostApiPlaylistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+	return json.NewEncoder(w).Encode(response)
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+type PostApiPlaylists401JSONResponse Error
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+func (response PostApiPlaylists401JSONResponse) VisitPostApiPlaylistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+type PostApiPlaylists403JSONResponse Error
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+func (response PostApiPlaylists403JSONResponse) VisitPostApiPlaylistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+type PostApiPlaylists404JSONResponse Error
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+func (response PostApiPlaylists404JSONResponse) VisitPostApiPlaylistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+	return json.NewEncoder(w).Encode(response)
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
-
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+type PostApiPlaylists409JSONResponse Error
 
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/auth/refresh", wrapper.PostApiAuthRefresh)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/auth/verify", wrapper.GetApiAuthVerify)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/health", wrapper.GetApiHealth)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/integrations/spotify/playlist", wrapper.PostApiIntegrationsSpotifyPlaylist)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/integrations/spotify/playlist/{id}", wrapper.PostApiIntegrationsSpotifyPlaylistId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/integrations/spotify/tracks/sync", wrapper.PostApiIntegrationsSpotifyTracksSync)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/login", wrapper.PostApiLogin)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/me/playlists", wrapper.GetApiMePlaylists)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/me/tracks/top", wrapper.GetApiMeTracksTop)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/oauth/spotify/config.json", wrapper.GetApiOauthSpotifyConfigJson)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/oauth/spotify/token", wrapper.PostApiOauthSpotifyToken)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/oauth/spotify/token/refresh", wrapper.PostApiOauthSpotifyTokenRefresh)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/openapi.yaml", wrapper.GetApiOpenapiYaml)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/playlists", wrapper.PostApiPlaylists)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/playlists/{id}", wrapper.GetApiPlaylistsId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/spotify/status", wrapper.GetApiSpotifyStatus)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/users", wrapper.GetApiUsers)
-	})
+func (response PostApiPlaylists409JSONResponse) VisitPostApiPlaylistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
 
-	return r
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PostApiAuthRefreshRequestObject struct {
-	Params PostApiAuthRefreshParams
-	Body   *PostApiAuthRefreshJSONRequestBody
+type PostApiPlaylists500JSONResponse Error
+
+func (response PostApiPlaylists500JSONResponse) VisitPostApiPlaylistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PostApiAuthRefreshResponseObject interface {
-	VisitPostApiAuthRefreshResponse(w http.ResponseWriter) error
+type GetApiPlaylistsIdRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetApiPlaylistsIdParams
 }
 
-type PostApiAuthRefresh200ResponseHeaders struct {
-	SetCookie string
+type GetApiPlaylistsIdResponseObject interface {
+	VisitGetApiPlaylistsIdResponse(w http.ResponseWriter) error
 }
 
-type PostApiAuthRefresh200JSONResponse struct {
-	Body    LoginResponse
-	Headers PostApiAuthRefresh200ResponseHeaders
-}
+type GetApiPlaylistsId200JSONResponse Playlist
 
-func (response PostApiAuthRefresh200JSONResponse) VisitPostApiAuthRefreshResponse(w http.ResponseWriter) error {
+func (response GetApiPlaylistsId200JSONResponse) VisitGetApiPlaylistsIdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response.Body)
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PostApiAuthRefresh401JSONResponse Error
+type GetApiPlaylistsId401JSONResponse Error
 
-func (response PostApiAuthRefresh401JSONResponse) VisitPostApiAuthRefreshResponse(w http.ResponseWriter) error {
+func (response GetApiPlaylistsId401JSONResponse) VisitGetApiPlaylistsIdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostApiAuthRefresh500JSONResponse Error
+type GetApiPlaylistsId404JSONResponse Error
 
-func (response PostApiAuthRefresh500JSONResponse) VisitPostApiAuthRefreshResponse(w http.ResponseWriter) error {
+func (response GetApiPlaylistsId404JSONResponse) VisitGetApiPlaylistsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiPlaylistsId500JSONResponse Error
+
+func (response GetApiPlaylistsId500JSONResponse) VisitGetApiPlaylistsIdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetApiAuthVerifyRequestObject struct {
-	Params GetApiAuthVerifyParams
+type PostApiRegisterRequestObject struct {
+	Body *PostApiRegisterJSONRequestBody
 }
 
-type GetApiAuthVerifyResponseObject interface {
-	VisitGetApiAuthVerifyResponse(w http.ResponseWriter) error
+type PostApiRegisterResponseObject interface {
+	VisitPostApiRegisterResponse(w http.ResponseWriter) error
 }
 
-type GetApiAuthVerify200JSONResponse User
+type PostApiRegister201JSONResponse User
 
-func (response GetApiAuthVerify200JSONResponse) VisitGetApiAuthVerifyResponse(w http.ResponseWriter) error {
+func (response PostApiRegister201JSONResponse) VisitPostApiRegisterResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetApiAuthVerify401JSONResponse Error
+type PostApiRegister400JSONResponse Error
 
-func (response GetApiAuthVerify401JSONResponse) VisitGetApiAuthVerifyResponse(w http.ResponseWriter) error {
+func (response PostApiRegister400JSONResponse) VisitPostApiRegisterResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetApiAuthVerify403JSONResponse Error
+type PostApiRegister403JSONResponse Error
 
-func (response GetApiAuthVerify403JSONResponse) VisitGetApiAuthVerifyResponse(w http.ResponseWriter) error {
+func (response PostApiRegister403JSONResponse) VisitPostApiRegisterResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetApiAuthVerify500JSONResponse Error
+type PostApiRegister409JSONResponse Error
 
-func (response GetApiAuthVerify500JSONResponse) VisitGetApiAuthVerifyResponse(w http.ResponseWriter) error {
+func (response PostApiRegister409JSONResponse) VisitPostApiRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiRegister422JSONResponse Error
+
+func (response PostApiRegister422JSONResponse) VisitPostApiRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiRegister500JSONResponse Error
+
+func (response PostApiRegister500JSONResponse) VisitPostApiRegisterResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetApiHealthRequestObject struct {
+type GetApiSpotifyStatusRequestObject struct {
 }
 
-type GetApiHealthResponseObject interface {
-	VisitGetApiHealthResponse(w http.ResponseWriter) error
+type GetApiSpotifyStatusResponseObject interface {
+	VisitGetApiSpotifyStatusResponse(w http.ResponseWriter) error
 }
 
-type GetApiHealth204Response struct {
+type GetApiSpotifyStatus200JSONResponse SpotifyStatusResponse
+
+func (response GetApiSpotifyStatus200JSONResponse) VisitGetApiSpotifyStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response GetApiHealth204Response) VisitGetApiHealthResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type GetApiSpotifyStatus500JSONResponse Error
+
+func (response GetApiSpotifyStatus500JSONResponse) VisitGetApiSpotifyStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetApiHealth500JSONResponse Error
+type GetApiSpotifySyncRequestObject struct {
+	Params GetApiSpotifySyncParams
+}
 
-func (response GetApiHealth500JSONResponse) VisitGetApiHealthResponse(w http.ResponseWriter) error {
+type GetApiSpotifySyncResponseObject interface {
+	VisitGetApiSpotifySyncResponse(w http.ResponseWriter) error
+}
+
+type GetApiSpotifySync200JSONResponse SpotifySyncStatus
+
+func (response GetApiSpotifySync200JSONResponse) VisitGetApiSpotifySyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiSpotifySync401JSONResponse Error
+
+func (response GetApiSpotifySync401JSONResponse) VisitGetApiSpotifySyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiSpotifySync500JSONResponse Error
+
+func (response GetApiSpotifySync500JSONResponse) VisitGetApiSpotifySyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostApiIntegrationsSpotifyPlaylistRequestObject struct {
-	Params PostApiIntegrationsSpotifyPlaylistParams
-	Body   *PostApiIntegrationsSpotifyPlaylistJSONRequestBody
+type GetApiUsersRequestObject struct {
+	Params GetApiUsersParams
 }
 
-type PostApiIntegrationsSpotifyPlaylistResponseObject interface {
-	VisitPostApiIntegrationsSpotifyPlaylistResponse(w http.ResponseWriter) error
+type GetApiUsersResponseObject interface {
+	VisitGetApiUsersResponse(w http.ResponseWriter) error
 }
 
-type PostApiIntegrationsSpotifyPlaylist201JSONResponse SpotifyPlaylist
+type GetApiUsers200JSONResponse ListUsersResponse
 
-func (response PostApiIntegrationsSpotifyPlaylist201JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistResponse(w http.ResponseWriter) error {
+func (response GetApiUsers200JSONResponse) VisitGetApiUsersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostApiIntegrationsSpotifyPlaylist401JSONResponse Error
+type GetApiUsers400JSONResponse Error
 
-func (response PostApiIntegrationsSpotifyPlaylist401JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistResponse(w http.ResponseWriter) error {
+func (response GetApiUsers400JSONResponse) VisitGetApiUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiUsers401JSONResponse Error
+
+func (response GetApiUsers401JSONResponse) VisitGetApiUsersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostApiIntegrationsSpotifyPlaylist403JSONResponse Error
+type GetApiUsers403JSONResponse Error
 
-func (response PostApiIntegrationsSpotifyPlaylist403JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistResponse(w http.ResponseWriter) error {
+func (response GetApiUsers403JSONResponse) VisitGetApiUsersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostApiIntegrationsSpotifyPlaylist404JSONResponse Error
+type GetApiUsers500JSONResponse Error
 
-func (response PostApiIntegrationsSpotifyPlaylist404JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistResponse(w http.ResponseWriter) error {
+func (response GetApiUsers500JSONResponse) VisitGetApiUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiUsersIdSignOutRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params PostApiUsersIdSignOutParams
+}
+
+type PostApiUsersIdSignOutResponseObject interface {
+	VisitPostApiUsersIdSignOutResponse(w http.ResponseWriter) error
+}
+
+type PostApiUsersIdSignOut204Response struct {
+}
+
+func (response PostApiUsersIdSignOut204Response) VisitPostApiUsersIdSignOutResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostApiUsersIdSignOut401JSONResponse Error
+
+func (response PostApiUsersIdSignOut401JSONResponse) VisitPostApiUsersIdSignOutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiUsersIdSignOut403JSONResponse Error
+
+func (response PostApiUsersIdSignOut403JSONResponse) VisitPostApiUsersIdSignOutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiUsersIdSignOut404JSONResponse Error
+
+func (response PostApiUsersIdSignOut404JSONResponse) VisitPostApiUsersIdSignOutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostApiIntegrationsSpotifyPlaylist500JSONResponse Error
+type PostApiUsersIdSignOut500JSONResponse Error
 
-func (response PostApiIntegrationsSpotifyPlaylist500JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistResponse(w http.ResponseWriter) error {
+func (response PostApiUsersIdSignOut500JSONResponse) VisitPostApiUsersIdSignOutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostApiIntegrationsSpotifyPlaylistIdRequestObject struct {
-	Id     openapi_types.UUID `json:"id"`
-	Params PostApiIntegrationsSpotifyPlaylistIdParams
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Refresh session tokens
+	// (POST /api/auth/refresh)
+	PostApiAuthRefresh(ctx context.Context, request PostApiAuthRefreshRequestObject) (PostApiAuthRefreshResponseObject, error)
+	// Verify user session
+	// (GET /api/auth/verify)
+	GetApiAuthVerify(ctx context.Context, request GetApiAuthVerifyRequestObject) (GetApiAuthVerifyResponseObject, error)
+	// Health check
+	// (GET /api/health)
+	GetApiHealth(ctx context.Context, request GetApiHealthRequestObject) (GetApiHealthResponseObject, error)
+	// Export a mars playlist to spotify for a user.
+	// (POST /api/integrations/spotify/playlist)
+	PostApiIntegrationsSpotifyPlaylist(ctx context.Context, request PostApiIntegrationsSpotifyPlaylistRequestObject) (PostApiIntegrationsSpotifyPlaylistResponseObject, error)
+	// Unlink a mars playlist from its spotify playlist.
+	// (DELETE /api/integrations/spotify/playlist/{id})
+	DeleteApiIntegrationsSpotifyPlaylistId(ctx context.Context, request DeleteApiIntegrationsSpotifyPlaylistIdRequestObject) (DeleteApiIntegrationsSpotifyPlaylistIdResponseObject, error)
+	// Export a mars playlist to the user's spotify account.
+	// (POST /api/integrations/spotify/playlist/{id})
+	PostApiIntegrationsSpotifyPlaylistId(ctx context.Context, request PostApiIntegrationsSpotifyPlaylistIdRequestObject) (PostApiIntegrationsSpotifyPlaylistIdResponseObject, error)
+	// Sync recent spotify tracks
+	// (POST /api/integrations/spotify/tracks/sync)
+	PostApiIntegrationsSpotifyTracksSync(ctx context.Context, request PostApiIntegrationsSpotifyTracksSyncRequestObject) (PostApiIntegrationsSpotifyTracksSyncResponseObject, error)
+	// List invites
+	// (GET /api/invites)
+	GetApiInvites(ctx context.Context, request GetApiInvitesRequestObject) (GetApiInvitesResponseObject, error)
+	// Create an invite
+	// (POST /api/invites)
+	PostApiInvites(ctx context.Context, request PostApiInvitesRequestObject) (PostApiInvitesResponseObject, error)
+	// Revoke an invite
+	// (DELETE /api/invites/{id})
+	DeleteApiInvitesId(ctx context.Context, request DeleteApiInvitesIdRequestObject) (DeleteApiInvitesIdResponseObject, error)
+	// List scheduled jobs
+	// (GET /api/jobs)
+	GetApiJobs(ctx context.Context, request GetApiJobsRequestObject) (GetApiJobsResponseObject, error)
+	// Update a scheduled job
+	// (PATCH /api/jobs/{name})
+	PatchApiJobsName(ctx context.Context, request PatchApiJobsNameRequestObject) (PatchApiJobsNameResponseObject, error)
+	// Trigger a scheduled job
+	// (POST /api/jobs/{name}/trigger)
+	PostApiJobsNameTrigger(ctx context.Context, request PostApiJobsNameTriggerRequestObject) (PostApiJobsNameTriggerResponseObject, error)
+	// Submit listens.
+	// (POST /api/listenbrainz/1/submit-listens)
+	PostApiListenbrainz1SubmitListens(ctx context.Context, request PostApiListenbrainz1SubmitListensRequestObject) (PostApiListenbrainz1SubmitListensResponseObject, error)
+	// Validate a listen submission token.
+	// (GET /api/listenbrainz/1/validate-token)
+	GetApiListenbrainz1ValidateToken(ctx context.Context, request GetApiListenbrainz1ValidateTokenRequestObject) (GetApiListenbrainz1ValidateTokenResponseObject, error)
+	// Export the listening history of every user.
+	// (GET /api/listens/export)
+	GetApiListensExport(ctx context.Context, request GetApiListensExportRequestObject) (GetApiListensExportResponseObject, error)
+	// User login
+	// (POST /api/login)
+	PostApiLogin(ctx context.Context, request PostApiLoginRequestObject) (PostApiLoginResponseObject, error)
+	// Complete a two-factor login
+	// (POST /api/login/mfa)
+	PostApiLoginMfa(ctx context.Context, request PostApiLoginMfaRequestObject) (PostApiLoginMfaResponseObject, error)
+	// Start a passkey login
+	// (POST /api/login/passkey/begin)
+	PostApiLoginPasskeyBegin(ctx context.Context, request PostApiLoginPasskeyBeginRequestObject) (PostApiLoginPasskeyBeginResponseObject, error)
+	// Finish a passkey login
+	// (POST /api/login/passkey/finish)
+	PostApiLoginPasskeyFinish(ctx context.Context, request PostApiLoginPasskeyFinishRequestObject) (PostApiLoginPasskeyFinishResponseObject, error)
+	// Log out
+	// (POST /api/logout)
+	PostApiLogout(ctx context.Context, request PostApiLogoutRequestObject) (PostApiLogoutResponseObject, error)
+	// Get top albums for a user within a time range.
+	// (GET /api/me/albums/top)
+	GetApiMeAlbumsTop(ctx context.Context, request GetApiMeAlbumsTopRequestObject) (GetApiMeAlbumsTopResponseObject, error)
+	// Get top artists for a user within a time range.
+	// (GET /api/me/artists/top)
+	GetApiMeArtistsTop(ctx context.Context, request GetApiMeArtistsTopRequestObject) (GetApiMeArtistsTopResponseObject, error)
+	// Revoke the listen submission token
+	// (DELETE /api/me/listen-token)
+	DeleteApiMeListenToken(ctx context.Context, request DeleteApiMeListenTokenRequestObject) (DeleteApiMeListenTokenResponseObject, error)
+	// Create a listen submission token
+	// (POST /api/me/listen-token)
+	PostApiMeListenToken(ctx context.Context, request PostApiMeListenTokenRequestObject) (PostApiMeListenTokenResponseObject, error)
+	// List listens.
+	// (GET /api/me/listens)
+	GetApiMeListens(ctx context.Context, request GetApiMeListensRequestObject) (GetApiMeListensResponseObject, error)
+	// Export listening history.
+	// (GET /api/me/listens/export)
+	GetApiMeListensExport(ctx context.Context, request GetApiMeListensExportRequestObject) (GetApiMeListensExportResponseObject, error)
+	// Import Spotify extended streaming history.
+	// (POST /api/me/listens/import)
+	PostApiMeListensImport(ctx context.Context, request PostApiMeListensImportRequestObject) (PostApiMeListensImportResponseObject, error)
+	// Get two-factor authentication status
+	// (GET /api/me/mfa)
+	GetApiMeMfa(ctx context.Context, request GetApiMeMfaRequestObject) (GetApiMeMfaResponseObject, error)
+	// Disable TOTP
+	// (DELETE /api/me/mfa/totp)
+	DeleteApiMeMfaTotp(ctx context.Context, request DeleteApiMeMfaTotpRequestObject) (DeleteApiMeMfaTotpResponseObject, error)
+	// Start TOTP enrollment
+	// (POST /api/me/mfa/totp)
+	PostApiMeMfaTotp(ctx context.Context, request PostApiMeMfaTotpRequestObject) (PostApiMeMfaTotpResponseObject, error)
+	// Confirm TOTP enrollment
+	// (POST /api/me/mfa/totp/verify)
+	PostApiMeMfaTotpVerify(ctx context.Context, request PostApiMeMfaTotpVerifyRequestObject) (PostApiMeMfaTotpVerifyResponseObject, error)
+	// List passkeys
+	// (GET /api/me/passkeys)
+	GetApiMePasskeys(ctx context.Context, request GetApiMePasskeysRequestObject) (GetApiMePasskeysResponseObject, error)
+	// Start registering a passkey
+	// (POST /api/me/passkeys/register/begin)
+	PostApiMePasskeysRegisterBegin(ctx context.Context, request PostApiMePasskeysRegisterBeginRequestObject) (PostApiMePasskeysRegisterBeginResponseObject, error)
+	// Finish registering a passkey
+	// (POST /api/me/passkeys/register/finish)
+	PostApiMePasskeysRegisterFinish(ctx context.Context, request PostApiMePasskeysRegisterFinishRequestObject) (PostApiMePasskeysRegisterFinishResponseObject, error)
+	// Delete a passkey
+	// (DELETE /api/me/passkeys/{id})
+	DeleteApiMePasskeysId(ctx context.Context, request DeleteApiMePasskeysIdRequestObject) (DeleteApiMePasskeysIdResponseObject, error)
+	// Change password
+	// (POST /api/me/password)
+	PostApiMePassword(ctx context.Context, request PostApiMePasswordRequestObject) (PostApiMePasswordResponseObject, error)
+	// Get personal playlist rules
+	// (GET /api/me/playlist-rules)
+	GetApiMePlaylistRules(ctx context.Context, request GetApiMePlaylistRulesRequestObject) (GetApiMePlaylistRulesResponseObject, error)
+	// Update personal playlist rules
+	// (PATCH /api/me/playlist-rules)
+	PatchApiMePlaylistRules(ctx context.Context, request PatchApiMePlaylistRulesRequestObject) (PatchApiMePlaylistRulesResponseObject, error)
+	// Get personal playlists
+	// (GET /api/me/playlists)
+	GetApiMePlaylists(ctx context.Context, request GetApiMePlaylistsRequestObject) (GetApiMePlaylistsResponseObject, error)
+	// Get personal preferences
+	// (GET /api/me/preferences)
+	GetApiMePreferences(ctx context.Context, request GetApiMePreferencesRequestObject) (GetApiMePreferencesResponseObject, error)
+	// Update personal preferences
+	// (PATCH /api/me/preferences)
+	PatchApiMePreferences(ctx context.Context, request PatchApiMePreferencesRequestObject) (PatchApiMePreferencesResponseObject, error)
+	// List sessions
+	// (GET /api/me/sessions)
+	GetApiMeSessions(ctx context.Context, request GetApiMeSessionsRequestObject) (GetApiMeSessionsResponseObject, error)
+	// Revoke a session
+	// (DELETE /api/me/sessions/{id})
+	DeleteApiMeSessionsId(ctx context.Context, request DeleteApiMeSessionsIdRequestObject) (DeleteApiMeSessionsIdResponseObject, error)
+	// Get listening statistics for a user within a time range.
+	// (GET /api/me/stats)
+	GetApiMeStats(ctx context.Context, request GetApiMeStatsRequestObject) (GetApiMeStatsResponseObject, error)
+	// Get top tracks for a user within a time range.
+	// (GET /api/me/tracks/top)
+	GetApiMeTracksTop(ctx context.Context, request GetApiMeTracksTopRequestObject) (GetApiMeTracksTopResponseObject, error)
+	// Get a year in review
+	// (GET /api/me/year-in-review/{year})
+	GetApiMeYearInReviewYear(ctx context.Context, request GetApiMeYearInReviewYearRequestObject) (GetApiMeYearInReviewYearResponseObject, error)
+	// Get Spotify OAuth2.0 configuration.
+	// (GET /api/oauth/spotify/config.json)
+	GetApiOauthSpotifyConfigJson(ctx context.Context, request GetApiOauthSpotifyConfigJsonRequestObject) (GetApiOauthSpotifyConfigJsonResponseObject, error)
+	// Get Spotify OAuth2.0 tokens.
+	// (POST /api/oauth/spotify/token)
+	PostApiOauthSpotifyToken(ctx context.Context, request PostApiOauthSpotifyTokenRequestObject) (PostApiOauthSpotifyTokenResponseObject, error)
+	// Refresh a Spotify OAuth2.0 token.
+	// (POST /api/oauth/spotify/token/refresh)
+	PostApiOauthSpotifyTokenRefresh(ctx context.Context, request PostApiOauthSpotifyTokenRefreshRequestObject) (PostApiOauthSpotifyTokenRefreshResponseObject, error)
+	// Get OpenAPI specification.
+	// (GET /api/openapi.yaml)
+	GetApiOpenapiYaml(ctx context.Context, request GetApiOpenapiYamlRequestObject) (GetApiOpenapiYamlResponseObject, error)
+	// Request a password reset
+	// (POST /api/password-reset)
+	PostApiPasswordReset(ctx context.Context, request PostApiPasswordResetRequestObject) (PostApiPasswordResetResponseObject, error)
+	// Reset a password
+	// (POST /api/password-reset/confirm)
+	PostApiPasswordResetConfirm(ctx context.Context, request PostApiPasswordResetConfirmRequestObject) (PostApiPasswordResetConfirmResponseObject, error)
+	// Create a playlist from listening history
+	// (POST /api/playlists)
+	PostApiPlaylists(ctx context.Context, request PostApiPlaylistsRequestObject) (PostApiPlaylistsResponseObject, error)
+	// Get a personal playlist
+	// (GET /api/playlists/{id})
+	GetApiPlaylistsId(ctx context.Context, request GetApiPlaylistsIdRequestObject) (GetApiPlaylistsIdResponseObject, error)
+	// Register a new user
+	// (POST /api/register)
+	PostApiRegister(ctx context.Context, request PostApiRegisterRequestObject) (PostApiRegisterResponseObject, error)
+	// Get Spotify OAuth2.0 status.
+	// (GET /api/spotify/status)
+	GetApiSpotifyStatus(ctx context.Context, request GetApiSpotifyStatusRequestObject) (GetApiSpotifyStatusResponseObject, error)
+	// Get Spotify track sync status.
+	// (GET /api/spotify/sync)
+	GetApiSpotifySync(ctx context.Context, request GetApiSpotifySyncRequestObject) (GetApiSpotifySyncResponseObject, error)
+	// List users
+	// (GET /api/users)
+	GetApiUsers(ctx context.Context, request GetApiUsersRequestObject) (GetApiUsersResponseObject, error)
+	// Sign a user out everywhere
+	// (POST /api/users/{id}/sign-out)
+	PostApiUsersIdSignOut(ctx context.Context, request PostApiUsersIdSignOutRequestObject) (PostApiUsersIdSignOutResponseObject, error)
 }
 
-type PostApiIntegrationsSpotifyPlaylistIdResponseObject interface {
-	VisitPostApiIntegrationsSpotifyPlaylistIdResponse(w http.ResponseWriter) error
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type PostApiIntegrationsSpotifyPlaylistId201JSONResponse SpotifyPlaylist
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
 
-func (response PostApiIntegrationsSpotifyPlaylistId201JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
 
-	return json.NewEncoder(w).Encode(response)
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
 }
 
-type PostApiIntegrationsSpotifyPlaylistId401JSONResponse Error
+// PostApiAuthRefresh operation middleware
+func (sh *strictHandler) PostApiAuthRefresh(w http.ResponseWriter, r *http.Request, params PostApiAuthRefreshParams) {
+	var request PostApiAuthRefreshRequestObject
 
-func (response PostApiIntegrationsSpotifyPlaylistId401JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostApiAuthRefreshJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PostApiIntegrationsSpotifyPlaylistId403JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAuthRefresh(ctx, request.(PostApiAuthRefreshRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAuthRefresh")
+	}
 
-func (response PostApiIntegrationsSpotifyPlaylistId403JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiAuthRefreshResponseObject); ok {
+		if err := validResponse.VisitPostApiAuthRefreshResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiIntegrationsSpotifyPlaylistId404JSONResponse Error
-
-func (response PostApiIntegrationsSpotifyPlaylistId404JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetApiAuthVerify operation middleware
+func (sh *strictHandler) GetApiAuthVerify(w http.ResponseWriter, r *http.Request, params GetApiAuthVerifyParams) {
+	var request GetApiAuthVerifyRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type PostApiIntegrationsSpotifyPlaylistId500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiAuthVerify(ctx, request.(GetApiAuthVerifyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiAuthVerify")
+	}
 
-func (response PostApiIntegrationsSpotifyPlaylistId500JSONResponse) VisitPostApiIntegrationsSpotifyPlaylistIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiAuthVerifyResponseObject); ok {
+		if err := validResponse.VisitGetApiAuthVerifyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiIntegrationsSpotifyTracksSyncRequestObject struct {
-	Params PostApiIntegrationsSpotifyTracksSyncParams
-	Body   *PostApiIntegrationsSpotifyTracksSyncJSONRequestBody
-}
+// GetApiHealth operation middleware
+func (sh *strictHandler) GetApiHealth(w http.ResponseWriter, r *http.Request) {
+	var request GetApiHealthRequestObject
 
-type PostApiIntegrationsSpotifyTracksSyncResponseObject interface {
-	VisitPostApiIntegrationsSpotifyTracksSyncResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiHealth(ctx, request.(GetApiHealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiHealth")
+	}
 
-type PostApiIntegrationsSpotifyTracksSync204Response struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (response PostApiIntegrationsSpotifyTracksSync204Response) VisitPostApiIntegrationsSpotifyTracksSyncResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiHealthResponseObject); ok {
+		if err := validResponse.VisitGetApiHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiIntegrationsSpotifyTracksSync400JSONResponse Error
+// PostApiIntegrationsSpotifyPlaylist operation middleware
+func (sh *strictHandler) PostApiIntegrationsSpotifyPlaylist(w http.ResponseWriter, r *http.Request, params PostApiIntegrationsSpotifyPlaylistParams) {
+	var request PostApiIntegrationsSpotifyPlaylistRequestObject
 
-func (response PostApiIntegrationsSpotifyTracksSync400JSONResponse) VisitPostApiIntegrationsSpotifyTracksSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostApiIntegrationsSpotifyPlaylistJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PostApiIntegrationsSpotifyTracksSync401JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiIntegrationsSpotifyPlaylist(ctx, request.(PostApiIntegrationsSpotifyPlaylistRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiIntegrationsSpotifyPlaylist")
+	}
 
-func (response PostApiIntegrationsSpotifyTracksSync401JSONResponse) VisitPostApiIntegrationsSpotifyTracksSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiIntegrationsSpotifyPlaylistResponseObject); ok {
+		if err := validResponse.VisitPostApiIntegrationsSpotifyPlaylistResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiIntegrationsSpotifyTracksSync403JSONResponse Error
-
-func (response PostApiIntegrationsSpotifyTracksSync403JSONResponse) VisitPostApiIntegrationsSpotifyTracksSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// DeleteApiIntegrationsSpotifyPlaylistId operation middleware
+func (sh *strictHandler) DeleteApiIntegrationsSpotifyPlaylistId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteApiIntegrationsSpotifyPlaylistIdParams) {
+	var request DeleteApiIntegrationsSpotifyPlaylistIdRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
+	request.Params = params
 
-type PostApiIntegrationsSpotifyTracksSync404JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiIntegrationsSpotifyPlaylistId(ctx, request.(DeleteApiIntegrationsSpotifyPlaylistIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiIntegrationsSpotifyPlaylistId")
+	}
 
-func (response PostApiIntegrationsSpotifyTracksSync404JSONResponse) VisitPostApiIntegrationsSpotifyTracksSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApiIntegrationsSpotifyPlaylistIdResponseObject); ok {
+		if err := validResponse.VisitDeleteApiIntegrationsSpotifyPlaylistIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiIntegrationsSpotifyTracksSync500JSONResponse Error
-
-func (response PostApiIntegrationsSpotifyTracksSync500JSONResponse) VisitPostApiIntegrationsSpotifyTracksSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// PostApiIntegrationsSpotifyPlaylistId operation middleware
+func (sh *strictHandler) PostApiIntegrationsSpotifyPlaylistId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostApiIntegrationsSpotifyPlaylistIdParams) {
+	var request PostApiIntegrationsSpotifyPlaylistIdRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
+	request.Params = params
 
-type PostApiLoginRequestObject struct {
-	Body *PostApiLoginJSONRequestBody
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiIntegrationsSpotifyPlaylistId(ctx, request.(PostApiIntegrationsSpotifyPlaylistIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiIntegrationsSpotifyPlaylistId")
+	}
 
-type PostApiLoginResponseObject interface {
-	VisitPostApiLoginResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PostApiLogin200ResponseHeaders struct {
-	SetCookie string
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiIntegrationsSpotifyPlaylistIdResponseObject); ok {
+		if err := validResponse.VisitPostApiIntegrationsSpotifyPlaylistIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiLogin200JSONResponse struct {
-	Body    LoginResponse
-	Headers PostApiLogin200ResponseHeaders
-}
+// PostApiIntegrationsSpotifyTracksSync operation middleware
+func (sh *strictHandler) PostApiIntegrationsSpotifyTracksSync(w http.ResponseWriter, r *http.Request, params PostApiIntegrationsSpotifyTracksSyncParams) {
+	var request PostApiIntegrationsSpotifyTracksSyncRequestObject
 
-func (response PostApiLogin200JSONResponse) VisitPostApiLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	var body PostApiIntegrationsSpotifyTracksSyncJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PostApiLogin401JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiIntegrationsSpotifyTracksSync(ctx, request.(PostApiIntegrationsSpotifyTracksSyncRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiIntegrationsSpotifyTracksSync")
+	}
 
-func (response PostApiLogin401JSONResponse) VisitPostApiLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiIntegrationsSpotifyTracksSyncResponseObject); ok {
+		if err := validResponse.VisitPostApiIntegrationsSpotifyTracksSyncResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiLogin500JSONResponse Error
+// GetApiInvites operation middleware
+func (sh *strictHandler) GetApiInvites(w http.ResponseWriter, r *http.Request, params GetApiInvitesParams) {
+	var request GetApiInvitesRequestObject
 
-func (response PostApiLogin500JSONResponse) VisitPostApiLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiInvites(ctx, request.(GetApiInvitesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiInvites")
+	}
 
-type GetApiMePlaylistsRequestObject struct {
-	Params GetApiMePlaylistsParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetApiMePlaylistsResponseObject interface {
-	VisitGetApiMePlaylistsResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiInvitesResponseObject); ok {
+		if err := validResponse.VisitGetApiInvitesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiMePlaylists200JSONResponse ListPlaylists
+// PostApiInvites operation middleware
+func (sh *strictHandler) PostApiInvites(w http.ResponseWriter, r *http.Request, params PostApiInvitesParams) {
+	var request PostApiInvitesRequestObject
 
-func (response GetApiMePlaylists200JSONResponse) VisitGetApiMePlaylistsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostApiInvitesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetApiMePlaylists401JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiInvites(ctx, request.(PostApiInvitesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiInvites")
+	}
 
-func (response GetApiMePlaylists401JSONResponse) VisitGetApiMePlaylistsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiInvitesResponseObject); ok {
+		if err := validResponse.VisitPostApiInvitesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiMePlaylists500JSONResponse Error
+// DeleteApiInvitesId operation middleware
+func (sh *strictHandler) DeleteApiInvitesId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteApiInvitesIdParams) {
+	var request DeleteApiInvitesIdRequestObject
 
-func (response GetApiMePlaylists500JSONResponse) VisitGetApiMePlaylistsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiInvitesId(ctx, request.(DeleteApiInvitesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiInvitesId")
+	}
 
-type GetApiMeTracksTopRequestObject struct {
-	Params GetApiMeTracksTopParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetApiMeTracksTopResponseObject interface {
-	VisitGetApiMeTracksTopResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApiInvitesIdResponseObject); ok {
+		if err := validResponse.VisitDeleteApiInvitesIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiMeTracksTop200JSONResponse struct {
-	Tracks []PlaylistTrack `json:"tracks"`
-}
+// GetApiJobs operation middleware
+func (sh *strictHandler) GetApiJobs(w http.ResponseWriter, r *http.Request, params GetApiJobsParams) {
+	var request GetApiJobsRequestObject
 
-func (response GetApiMeTracksTop200JSONResponse) VisitGetApiMeTracksTopResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiJobs(ctx, request.(GetApiJobsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiJobs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiJobsResponseObject); ok {
+		if err := validResponse.VisitGetApiJobsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiMeTracksTop400JSONResponse Error
+// PatchApiJobsName operation middleware
+func (sh *strictHandler) PatchApiJobsName(w http.ResponseWriter, r *http.Request, name string, params PatchApiJobsNameParams) {
+	var request PatchApiJobsNameRequestObject
 
-func (response GetApiMeTracksTop400JSONResponse) VisitGetApiMeTracksTopResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Name = name
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PatchApiJobsNameJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetApiMeTracksTop500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchApiJobsName(ctx, request.(PatchApiJobsNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchApiJobsName")
+	}
 
-func (response GetApiMeTracksTop500JSONResponse) VisitGetApiMeTracksTopResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchApiJobsNameResponseObject); ok {
+		if err := validResponse.VisitPatchApiJobsNameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiOauthSpotifyConfigJsonRequestObject struct {
-}
+// PostApiJobsNameTrigger operation middleware
+func (sh *strictHandler) PostApiJobsNameTrigger(w http.ResponseWriter, r *http.Request, name string, params PostApiJobsNameTriggerParams) {
+	var request PostApiJobsNameTriggerRequestObject
 
-type GetApiOauthSpotifyConfigJsonResponseObject interface {
-	VisitGetApiOauthSpotifyConfigJsonResponse(w http.ResponseWriter) error
-}
+	request.Name = name
+	request.Params = params
 
-type GetApiOauthSpotifyConfigJson200JSONResponse SpotifyOAuth
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiJobsNameTrigger(ctx, request.(PostApiJobsNameTriggerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiJobsNameTrigger")
+	}
 
-func (response GetApiOauthSpotifyConfigJson200JSONResponse) VisitGetApiOauthSpotifyConfigJsonResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiJobsNameTriggerResponseObject); ok {
+		if err := validResponse.VisitPostApiJobsNameTriggerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiOauthSpotifyConfigJson500JSONResponse Error
-
-func (response GetApiOauthSpotifyConfigJson500JSONResponse) VisitGetApiOauthSpotifyConfigJsonResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// PostApiListenbrainz1SubmitListens operation middleware
+func (sh *strictHandler) PostApiListenbrainz1SubmitListens(w http.ResponseWriter, r *http.Request) {
+	var request PostApiListenbrainz1SubmitListensRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostApiListenbrainz1SubmitListensJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PostApiOauthSpotifyTokenRequestObject struct {
-	Body *PostApiOauthSpotifyTokenJSONRequestBody
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiListenbrainz1SubmitListens(ctx, request.(PostApiListenbrainz1SubmitListensRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiListenbrainz1SubmitListens")
+	}
 
-type PostApiOauthSpotifyTokenResponseObject interface {
-	VisitPostApiOauthSpotifyTokenResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PostApiOauthSpotifyToken204Response struct {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiListenbrainz1SubmitListensResponseObject); ok {
+		if err := validResponse.VisitPostApiListenbrainz1SubmitListensResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response PostApiOauthSpotifyToken204Response) VisitPostApiOauthSpotifyTokenResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+// GetApiListenbrainz1ValidateToken operation middleware
+func (sh *strictHandler) GetApiListenbrainz1ValidateToken(w http.ResponseWriter, r *http.Request) {
+	var request GetApiListenbrainz1ValidateTokenRequestObject
 
-type PostApiOauthSpotifyToken400JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiListenbrainz1ValidateToken(ctx, request.(GetApiListenbrainz1ValidateTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiListenbrainz1ValidateToken")
+	}
 
-func (response PostApiOauthSpotifyToken400JSONResponse) VisitPostApiOauthSpotifyTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiListenbrainz1ValidateTokenResponseObject); ok {
+		if err := validResponse.VisitGetApiListenbrainz1ValidateTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiOauthSpotifyToken500JSONResponse Error
+// GetApiListensExport operation middleware
+func (sh *strictHandler) GetApiListensExport(w http.ResponseWriter, r *http.Request, params GetApiListensExportParams) {
+	var request GetApiListensExportRequestObject
 
-func (response PostApiOauthSpotifyToken500JSONResponse) VisitPostApiOauthSpotifyTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiListensExport(ctx, request.(GetApiListensExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiListensExport")
+	}
 
-type PostApiOauthSpotifyTokenRefreshRequestObject struct {
-	Params PostApiOauthSpotifyTokenRefreshParams
-	Body   *PostApiOauthSpotifyTokenRefreshJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PostApiOauthSpotifyTokenRefreshResponseObject interface {
-	VisitPostApiOauthSpotifyTokenRefreshResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiListensExportResponseObject); ok {
+		if err := validResponse.VisitGetApiListensExportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiOauthSpotifyTokenRefresh204Response struct {
-}
+// PostApiLogin operation middleware
+func (sh *strictHandler) PostApiLogin(w http.ResponseWriter, r *http.Request) {
+	var request PostApiLoginRequestObject
 
-func (response PostApiOauthSpotifyTokenRefresh204Response) VisitPostApiOauthSpotifyTokenRefreshResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	var body PostApiLoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PostApiOauthSpotifyTokenRefresh400JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiLogin(ctx, request.(PostApiLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiLogin")
+	}
 
-func (response PostApiOauthSpotifyTokenRefresh400JSONResponse) VisitPostApiOauthSpotifyTokenRefreshResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiLoginResponseObject); ok {
+		if err := validResponse.VisitPostApiLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiOauthSpotifyTokenRefresh401JSONResponse Error
-
-func (response PostApiOauthSpotifyTokenRefresh401JSONResponse) VisitPostApiOauthSpotifyTokenRefreshResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// PostApiLoginMfa operation middleware
+func (sh *strictHandler) PostApiLoginMfa(w http.ResponseWriter, r *http.Request) {
+	var request PostApiLoginMfaRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostApiLoginMfaJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PostApiOauthSpotifyTokenRefresh403JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiLoginMfa(ctx, request.(PostApiLoginMfaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiLoginMfa")
+	}
 
-func (response PostApiOauthSpotifyTokenRefresh403JSONResponse) VisitPostApiOauthSpotifyTokenRefreshResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiLoginMfaResponseObject); ok {
+		if err := validResponse.VisitPostApiLoginMfaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiOauthSpotifyTokenRefresh404JSONResponse Error
+// PostApiLoginPasskeyBegin operation middleware
+func (sh *strictHandler) PostApiLoginPasskeyBegin(w http.ResponseWriter, r *http.Request) {
+	var request PostApiLoginPasskeyBeginRequestObject
 
-func (response PostApiOauthSpotifyTokenRefresh404JSONResponse) VisitPostApiOauthSpotifyTokenRefreshResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiLoginPasskeyBegin(ctx, request.(PostApiLoginPasskeyBeginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiLoginPasskeyBegin")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiLoginPasskeyBeginResponseObject); ok {
+		if err := validResponse.VisitPostApiLoginPasskeyBeginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiOauthSpotifyTokenRefresh500JSONResponse Error
+// PostApiLoginPasskeyFinish operation middleware
+func (sh *strictHandler) PostApiLoginPasskeyFinish(w http.ResponseWriter, r *http.Request) {
+	var request PostApiLoginPasskeyFinishRequestObject
 
-func (response PostApiOauthSpotifyTokenRefresh500JSONResponse) VisitPostApiOauthSpotifyTokenRefreshResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var body PostApiLoginPasskeyFinishJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiLoginPasskeyFinish(ctx, request.(PostApiLoginPasskeyFinishRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiLoginPasskeyFinish")
+	}
 
-type GetApiOpenapiYamlRequestObject struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetApiOpenapiYamlResponseObject interface {
-	VisitGetApiOpenapiYamlResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiLoginPasskeyFinishResponseObject); ok {
+		if err := validResponse.VisitPostApiLoginPasskeyFinishResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiOpenapiYaml200ApplicationxYamlResponse struct {
-	Body          io.Reader
-	ContentLength int64
-}
+// PostApiLogout operation middleware
+func (sh *strictHandler) PostApiLogout(w http.ResponseWriter, r *http.Request, params PostApiLogoutParams) {
+	var request PostApiLogoutRequestObject
 
-func (response GetApiOpenapiYaml200ApplicationxYamlResponse) VisitGetApiOpenapiYamlResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/x-yaml")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
-	}
-	w.WriteHeader(200)
+	request.Params = params
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
+	var body PostApiLogoutJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
 	}
-	_, err := io.Copy(w, response.Body)
-	return err
-}
+	request.Body = &body
 
-type GetApiOpenapiYaml500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiLogout(ctx, request.(PostApiLogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiLogout")
+	}
 
-func (response GetApiOpenapiYaml500JSONResponse) VisitGetApiOpenapiYamlResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiLogoutResponseObject); ok {
+		if err := validResponse.VisitPostApiLogoutResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiPlaylistsRequestObject struct {
-	Params PostApiPlaylistsParams
-	Body   *PostApiPlaylistsJSONRequestBody
-}
+// GetApiMeAlbumsTop operation middleware
+func (sh *strictHandler) GetApiMeAlbumsTop(w http.ResponseWriter, r *http.Request, params GetApiMeAlbumsTopParams) {
+	var request GetApiMeAlbumsTopRequestObject
 
-type PostApiPlaylistsResponseObject interface {
-	VisitPostApiPlaylistsResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type PostApiPlaylists201JSONResponse CreatePlaylistResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiMeAlbumsTop(ctx, request.(GetApiMeAlbumsTopRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiMeAlbumsTop")
+	}
 
-func (response PostApiPlaylists201JSONResponse) VisitPostApiPlaylistsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiMeAlbumsTopResponseObject); ok {
+		if err := validResponse.VisitGetApiMeAlbumsTopResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiPlaylists400JSONResponse Error
-
-func (response PostApiPlaylists400JSONResponse) VisitPostApiPlaylistsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetApiMeArtistsTop operation middleware
+func (sh *strictHandler) GetApiMeArtistsTop(w http.ResponseWriter, r *http.Request, params GetApiMeArtistsTopParams) {
+	var request GetApiMeArtistsTopRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type PostApiPlaylists401JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiMeArtistsTop(ctx, request.(GetApiMeArtistsTopRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiMeArtistsTop")
+	}
 
-func (response PostApiPlaylists401JSONResponse) VisitPostApiPlaylistsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiMeArtistsTopResponseObject); ok {
+		if err := validResponse.VisitGetApiMeArtistsTopResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiPlaylists403JSONResponse Error
-
-func (response PostApiPlaylists403JSONResponse) VisitPostApiPlaylistsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// DeleteApiMeListenToken operation middleware
+func (sh *strictHandler) DeleteApiMeListenToken(w http.ResponseWriter, r *http.Request, params DeleteApiMeListenTokenParams) {
+	var request DeleteApiMeListenTokenRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type PostApiPlaylists404JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiMeListenToken(ctx, request.(DeleteApiMeListenTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiMeListenToken")
+	}
 
-func (response PostApiPlaylists404JSONResponse) VisitPostApiPlaylistsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApiMeListenTokenResponseObject); ok {
+		if err := validResponse.VisitDeleteApiMeListenTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiPlaylists409JSONResponse Error
-
-func (response PostApiPlaylists409JSONResponse) VisitPostApiPlaylistsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+// PostApiMeListenToken operation middleware
+func (sh *strictHandler) PostApiMeListenToken(w http.ResponseWriter, r *http.Request, params PostApiMeListenTokenParams) {
+	var request PostApiMeListenTokenRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type PostApiPlaylists500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiMeListenToken(ctx, request.(PostApiMeListenTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiMeListenToken")
+	}
 
-func (response PostApiPlaylists500JSONResponse) VisitPostApiPlaylistsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiMeListenTokenResponseObject); ok {
+		if err := validResponse.VisitPostApiMeListenTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiPlaylistsIdRequestObject struct {
-	Id     openapi_types.UUID `json:"id"`
-	Params GetApiPlaylistsIdParams
-}
+// GetApiMeListens operation middleware
+func (sh *strictHandler) GetApiMeListens(w http.ResponseWriter, r *http.Request, params GetApiMeListensParams) {
+	var request GetApiMeListensRequestObject
 
-type GetApiPlaylistsIdResponseObject interface {
-	VisitGetApiPlaylistsIdResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type GetApiPlaylistsId200JSONResponse Playlist
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiMeListens(ctx, request.(GetApiMeListensRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiMeListens")
+	}
 
-func (response GetApiPlaylistsId200JSONResponse) VisitGetApiPlaylistsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiMeListensResponseObject); ok {
+		if err := validResponse.VisitGetApiMeListensResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiPlaylistsId401JSONResponse Error
+// GetApiMeListensExport operation middleware
+func (sh *strictHandler) GetApiMeListensExport(w http.ResponseWriter, r *http.Request, params GetApiMeListensExportParams) {
+	var request GetApiMeListensExportRequestObject
 
-func (response GetApiPlaylistsId401JSONResponse) VisitGetApiPlaylistsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiMeListensExport(ctx, request.(GetApiMeListensExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiMeListensExport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiMeListensExportResponseObject); ok {
+		if err := validResponse.VisitGetApiMeListensExportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiPlaylistsId404JSONResponse Error
+// PostApiMeListensImport operation middleware
+func (sh *strictHandler) PostApiMeListensImport(w http.ResponseWriter, r *http.Request, params PostApiMeListensImportParams) {
+	var request PostApiMeListensImportRequestObject
 
-func (response GetApiPlaylistsId404JSONResponse) VisitGetApiPlaylistsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
+	}
 
-type GetApiPlaylistsId500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiMeListensImport(ctx, request.(PostApiMeListensImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiMeListensImport")
+	}
 
-func (response GetApiPlaylistsId500JSONResponse) VisitGetApiPlaylistsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiMeListensImportResponseObject); ok {
+		if err := validResponse.VisitPostApiMeListensImportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiSpotifyStatusRequestObject struct {
-}
+// GetApiMeMfa operation middleware
+func (sh *strictHandler) GetApiMeMfa(w http.ResponseWriter, r *http.Request, params GetApiMeMfaParams) {
+	var request GetApiMeMfaRequestObject
 
-type GetApiSpotifyStatusResponseObject interface {
-	VisitGetApiSpotifyStatusResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type GetApiSpotifyStatus200JSONResponse SpotifyStatusResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiMeMfa(ctx, request.(GetApiMeMfaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiMeMfa")
+	}
 
-func (response GetApiSpotifyStatus200JSONResponse) VisitGetApiSpotifyStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiMeMfaResponseObject); ok {
+		if err := validResponse.VisitGetApiMeMfaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiSpotifyStatus500JSONResponse Error
+// DeleteApiMeMfaTotp operation middleware
+func (sh *strictHandler) DeleteApiMeMfaTotp(w http.ResponseWriter, r *http.Request, params DeleteApiMeMfaTotpParams) {
+	var request DeleteApiMeMfaTotpRequestObject
 
-func (response GetApiSpotifyStatus500JSONResponse) VisitGetApiSpotifyStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body DeleteApiMeMfaTotpJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetApiUsersRequestObject struct {
-	Params GetApiUsersParams
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiMeMfaTotp(ctx, request.(DeleteApiMeMfaTotpRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiMeMfaTotp")
+	}
 
-type GetApiUsersResponseObject interface {
-	VisitGetApiUsersResponse(w http.ResponseWriter) error
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApiMeMfaTotpResponseObject); ok {
+		if err := validResponse.VisitDeleteApiMeMfaTotpResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiUsers200JSONResponse ListUsersResponse
+// PostApiMeMfaTotp operation middleware
+func (sh *strictHandler) PostApiMeMfaTotp(w http.ResponseWriter, r *http.Request, params PostApiMeMfaTotpParams) {
+	var request PostApiMeMfaTotpRequestObject
 
-func (response GetApiUsers200JSONResponse) VisitGetApiUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiMeMfaTotp(ctx, request.(PostApiMeMfaTotpRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiMeMfaTotp")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiMeMfaTotpResponseObject); ok {
+		if err := validResponse.VisitPostApiMeMfaTotpResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiUsers400JSONResponse Error
+// PostApiMeMfaTotpVerify operation middleware
+func (sh *strictHandler) PostApiMeMfaTotpVerify(w http.ResponseWriter, r *http.Request, params PostApiMeMfaTotpVerifyParams) {
+	var request PostApiMeMfaTotpVerifyRequestObject
 
-func (response GetApiUsers400JSONResponse) VisitGetApiUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostApiMeMfaTotpVerifyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetApiUsers401JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiMeMfaTotpVerify(ctx, request.(PostApiMeMfaTotpVerifyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiMeMfaTotpVerify")
+	}
 
-func (response GetApiUsers401JSONResponse) VisitGetApiUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiMeMfaTotpVerifyResponseObject); ok {
+		if err := validResponse.VisitPostApiMeMfaTotpVerifyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiUsers403JSONResponse Error
+// GetApiMePasskeys operation middleware
+func (sh *strictHandler) GetApiMePasskeys(w http.ResponseWriter, r *http.Request, params GetApiMePasskeysParams) {
+	var request GetApiMePasskeysRequestObject
 
-func (response GetApiUsers403JSONResponse) VisitGetApiUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiMePasskeys(ctx, request.(GetApiMePasskeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiMePasskeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiMePasskeysResponseObject); ok {
+		if err := validResponse.VisitGetApiMePasskeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiUsers500JSONResponse Error
+// PostApiMePasskeysRegisterBegin operation middleware
+func (sh *strictHandler) PostApiMePasskeysRegisterBegin(w http.ResponseWriter, r *http.Request, params PostApiMePasskeysRegisterBeginParams) {
+	var request PostApiMePasskeysRegisterBeginRequestObject
 
-func (response GetApiUsers500JSONResponse) VisitGetApiUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiMePasskeysRegisterBegin(ctx, request.(PostApiMePasskeysRegisterBeginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiMePasskeysRegisterBegin")
+	}
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Refresh session tokens
-	// (POST /api/auth/refresh)
-	PostApiAuthRefresh(ctx context.Context, request PostApiAuthRefreshRequestObject) (PostApiAuthRefreshResponseObject, error)
-	// Verify user session
-	// (GET /api/auth/verify)
-	GetApiAuthVerify(ctx context.Context, request GetApiAuthVerifyRequestObject) (GetApiAuthVerifyResponseObject, error)
-	// Health check
-	// (GET /api/health)
-	GetApiHealth(ctx context.Context, request GetApiHealthRequestObject) (GetApiHealthResponseObject, error)
-	// Create a spotify playlist based on a mars playlist for a user.
-	// (POST /api/integrations/spotify/playlist)
-	PostApiIntegrationsSpotifyPlaylist(ctx context.Context, request PostApiIntegrationsSpotifyPlaylistRequestObject) (PostApiIntegrationsSpotifyPlaylistResponseObject, error)
-	// Create a personal spotify playlist based on mars playlist.
-	// (POST /api/integrations/spotify/playlist/{id})
-	PostApiIntegrationsSpotifyPlaylistId(ctx context.Context, request PostApiIntegrationsSpotifyPlaylistIdRequestObject) (PostApiIntegrationsSpotifyPlaylistIdResponseObject, error)
-	// Sync recent spotify tracks
-	// (POST /api/integrations/spotify/tracks/sync)
-	PostApiIntegrationsSpotifyTracksSync(ctx context.Context, request PostApiIntegrationsSpotifyTracksSyncRequestObject) (PostApiIntegrationsSpotifyTracksSyncResponseObject, error)
-	// User login
-	// (POST /api/login)
-	PostApiLogin(ctx context.Context, request PostApiLoginRequestObject) (PostApiLoginResponseObject, error)
-	// Get personal playlists
-	// (GET /api/me/playlists)
-	GetApiMePlaylists(ctx context.Context, request GetApiMePlaylistsRequestObject) (GetApiMePlaylistsResponseObject, error)
-	// Get top tracks for a user within a time range.
-	// (GET /api/me/tracks/top)
-	GetApiMeTracksTop(ctx context.Context, request GetApiMeTracksTopRequestObject) (GetApiMeTracksTopResponseObject, error)
-	// Get Spotify OAuth2.0 configuration.
-	// (GET /api/oauth/spotify/config.json)
-	GetApiOauthSpotifyConfigJson(ctx context.Context, request GetApiOauthSpotifyConfigJsonRequestObject) (GetApiOauthSpotifyConfigJsonResponseObject, error)
-	// Get Spotify OAuth2.0 tokens.
-	// (POST /api/oauth/spotify/token)
-	PostApiOauthSpotifyToken(ctx context.Context, request PostApiOauthSpotifyTokenRequestObject) (PostApiOauthSpotifyTokenResponseObject, error)
-	// Refresh a Spotify OAuth2.0 token.
-	// (POST /api/oauth/spotify/token/refresh)
-	PostApiOauthSpotifyTokenRefresh(ctx context.Context, request PostApiOauthSpotifyTokenRefreshRequestObject) (PostApiOauthSpotifyTokenRefreshResponseObject, error)
-	// Get OpenAPI specification.
-	// (GET /api/openapi.yaml)
-	GetApiOpenapiYaml(ctx context.Context, request GetApiOpenapiYamlRequestObject) (GetApiOpenapiYamlResponseObject, error)
-	// Create a playlist from listening history
-	// (POST /api/playlists)
-	PostApiPlaylists(ctx context.Context, request PostApiPlaylistsRequestObject) (PostApiPlaylistsResponseObject, error)
-	// Get a personal playlist
-	// (GET /api/playlists/{id})
-	GetApiPlaylistsId(ctx context.Context, request GetApiPlaylistsIdRequestObject) (GetApiPlaylistsIdResponseObject, error)
-	// Get Spotify OAuth2.0 status.
-	// (GET /api/spotify/status)
-	GetApiSpotifyStatus(ctx context.Context, request GetApiSpotifyStatusRequestObject) (GetApiSpotifyStatusResponseObject, error)
-	// List users
-	// (GET /api/users)
-	GetApiUsers(ctx context.Context, request GetApiUsersRequestObject) (GetApiUsersResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiMePasskeysRegisterBeginResponseObject); ok {
+		if err := validResponse.VisitPostApiMePasskeysRegisterBeginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// PostApiMePasskeysRegisterFinish operation middleware
+func (sh *strictHandler) PostApiMePasskeysRegisterFinish(w http.ResponseWriter, r *http.Request, params PostApiMePasskeysRegisterFinishParams) {
+	var request PostApiMePasskeysRegisterFinishRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	request.Params = params
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	var body PostApiMePasskeysRegisterFinishJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiMePasskeysRegisterFinish(ctx, request.(PostApiMePasskeysRegisterFinishRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiMePasskeysRegisterFinish")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiMePasskeysRegisterFinishResponseObject); ok {
+		if err := validResponse.VisitPostApiMePasskeysRegisterFinishResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// DeleteApiMePasskeysId operation middleware
+func (sh *strictHandler) DeleteApiMePasskeysId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteApiMePasskeysIdParams) {
+	var request DeleteApiMePasskeysIdRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiMePasskeysId(ctx, request.(DeleteApiMePasskeysIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiMePasskeysId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApiMePasskeysIdResponseObject); ok {
+		if err := validResponse.VisitDeleteApiMePasskeysIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// PostApiAuthRefresh operation middleware
-func (sh *strictHandler) PostApiAuthRefresh(w http.ResponseWriter, r *http.Request, params PostApiAuthRefreshParams) {
-	var request PostApiAuthRefreshRequestObject
+// PostApiMePassword operation middleware
+func (sh *strictHandler) PostApiMePassword(w http.ResponseWriter, r *http.Request, params PostApiMePasswordParams) {
+	var request PostApiMePasswordRequestObject
 
 	request.Params = params
 
-	var body PostApiAuthRefreshJSONRequestBody
+	var body PostApiMePasswordJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5114,18 +19289,18 @@ func (sh *strictHandler) PostApiAuthRefresh(w http.ResponseWriter, r *http.Reque
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostApiAuthRefresh(ctx, request.(PostApiAuthRefreshRequestObject))
+		return sh.ssi.PostApiMePassword(ctx, request.(PostApiMePasswordRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostApiAuthRefresh")
+		handler = middleware(handler, "PostApiMePassword")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostApiAuthRefreshResponseObject); ok {
-		if err := validResponse.VisitPostApiAuthRefreshResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostApiMePasswordResponseObject); ok {
+		if err := validResponse.VisitPostApiMePasswordResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5133,25 +19308,25 @@ func (sh *strictHandler) PostApiAuthRefresh(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetApiAuthVerify operation middleware
-func (sh *strictHandler) GetApiAuthVerify(w http.ResponseWriter, r *http.Request, params GetApiAuthVerifyParams) {
-	var request GetApiAuthVerifyRequestObject
+// GetApiMePlaylistRules operation middleware
+func (sh *strictHandler) GetApiMePlaylistRules(w http.ResponseWriter, r *http.Request, params GetApiMePlaylistRulesParams) {
+	var request GetApiMePlaylistRulesRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetApiAuthVerify(ctx, request.(GetApiAuthVerifyRequestObject))
+		return sh.ssi.GetApiMePlaylistRules(ctx, request.(GetApiMePlaylistRulesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetApiAuthVerify")
+		handler = middleware(handler, "GetApiMePlaylistRules")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetApiAuthVerifyResponseObject); ok {
-		if err := validResponse.VisitGetApiAuthVerifyResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetApiMePlaylistRulesResponseObject); ok {
+		if err := validResponse.VisitGetApiMePlaylistRulesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5159,23 +19334,32 @@ func (sh *strictHandler) GetApiAuthVerify(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetApiHealth operation middleware
-func (sh *strictHandler) GetApiHealth(w http.ResponseWriter, r *http.Request) {
-	var request GetApiHealthRequestObject
+// PatchApiMePlaylistRules operation middleware
+func (sh *strictHandler) PatchApiMePlaylistRules(w http.ResponseWriter, r *http.Request, params PatchApiMePlaylistRulesParams) {
+	var request PatchApiMePlaylistRulesRequestObject
+
+	request.Params = params
+
+	var body PatchApiMePlaylistRulesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetApiHealth(ctx, request.(GetApiHealthRequestObject))
+		return sh.ssi.PatchApiMePlaylistRules(ctx, request.(PatchApiMePlaylistRulesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetApiHealth")
+		handler = middleware(handler, "PatchApiMePlaylistRules")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetApiHealthResponseObject); ok {
-		if err := validResponse.VisitGetApiHealthResponse(w); err != nil {
+	} else if validResponse, ok := response.(PatchApiMePlaylistRulesResponseObject); ok {
+		if err := validResponse.VisitPatchApiMePlaylistRulesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5183,32 +19367,25 @@ func (sh *strictHandler) GetApiHealth(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// PostApiIntegrationsSpotifyPlaylist operation middleware
-func (sh *strictHandler) PostApiIntegrationsSpotifyPlaylist(w http.ResponseWriter, r *http.Request, params PostApiIntegrationsSpotifyPlaylistParams) {
-	var request PostApiIntegrationsSpotifyPlaylistRequestObject
+// GetApiMePlaylists operation middleware
+func (sh *strictHandler) GetApiMePlaylists(w http.ResponseWriter, r *http.Request, params GetApiMePlaylistsParams) {
+	var request GetApiMePlaylistsRequestObject
 
 	request.Params = params
 
-	var body PostApiIntegrationsSpotifyPlaylistJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostApiIntegrationsSpotifyPlaylist(ctx, request.(PostApiIntegrationsSpotifyPlaylistRequestObject))
+		return sh.ssi.GetApiMePlaylists(ctx, request.(GetApiMePlaylistsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostApiIntegrationsSpotifyPlaylist")
+		handler = middleware(handler, "GetApiMePlaylists")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostApiIntegrationsSpotifyPlaylistResponseObject); ok {
-		if err := validResponse.VisitPostApiIntegrationsSpotifyPlaylistResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetApiMePlaylistsResponseObject); ok {
+		if err := validResponse.VisitGetApiMePlaylistsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5216,26 +19393,25 @@ func (sh *strictHandler) PostApiIntegrationsSpotifyPlaylist(w http.ResponseWrite
 	}
 }
 
-// PostApiIntegrationsSpotifyPlaylistId operation middleware
-func (sh *strictHandler) PostApiIntegrationsSpotifyPlaylistId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostApiIntegrationsSpotifyPlaylistIdParams) {
-	var request PostApiIntegrationsSpotifyPlaylistIdRequestObject
+// GetApiMePreferences operation middleware
+func (sh *strictHandler) GetApiMePreferences(w http.ResponseWriter, r *http.Request, params GetApiMePreferencesParams) {
+	var request GetApiMePreferencesRequestObject
 
-	request.Id = id
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostApiIntegrationsSpotifyPlaylistId(ctx, request.(PostApiIntegrationsSpotifyPlaylistIdRequestObject))
+		return sh.ssi.GetApiMePreferences(ctx, request.(GetApiMePreferencesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostApiIntegrationsSpotifyPlaylistId")
+		handler = middleware(handler, "GetApiMePreferences")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostApiIntegrationsSpotifyPlaylistIdResponseObject); ok {
-		if err := validResponse.VisitPostApiIntegrationsSpotifyPlaylistIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetApiMePreferencesResponseObject); ok {
+		if err := validResponse.VisitGetApiMePreferencesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5243,13 +19419,13 @@ func (sh *strictHandler) PostApiIntegrationsSpotifyPlaylistId(w http.ResponseWri
 	}
 }
 
-// PostApiIntegrationsSpotifyTracksSync operation middleware
-func (sh *strictHandler) PostApiIntegrationsSpotifyTracksSync(w http.ResponseWriter, r *http.Request, params PostApiIntegrationsSpotifyTracksSyncParams) {
-	var request PostApiIntegrationsSpotifyTracksSyncRequestObject
+// PatchApiMePreferences operation middleware
+func (sh *strictHandler) PatchApiMePreferences(w http.ResponseWriter, r *http.Request, params PatchApiMePreferencesParams) {
+	var request PatchApiMePreferencesRequestObject
 
 	request.Params = params
 
-	var body PostApiIntegrationsSpotifyTracksSyncJSONRequestBody
+	var body PatchApiMePreferencesJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5257,18 +19433,18 @@ func (sh *strictHandler) PostApiIntegrationsSpotifyTracksSync(w http.ResponseWri
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostApiIntegrationsSpotifyTracksSync(ctx, request.(PostApiIntegrationsSpotifyTracksSyncRequestObject))
+		return sh.ssi.PatchApiMePreferences(ctx, request.(PatchApiMePreferencesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostApiIntegrationsSpotifyTracksSync")
+		handler = middleware(handler, "PatchApiMePreferences")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostApiIntegrationsSpotifyTracksSyncResponseObject); ok {
-		if err := validResponse.VisitPostApiIntegrationsSpotifyTracksSyncResponse(w); err != nil {
+	} else if validResponse, ok := response.(PatchApiMePreferencesResponseObject); ok {
+		if err := validResponse.VisitPatchApiMePreferencesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5276,30 +19452,52 @@ func (sh *strictHandler) PostApiIntegrationsSpotifyTracksSync(w http.ResponseWri
 	}
 }
 
-// PostApiLogin operation middleware
-func (sh *strictHandler) PostApiLogin(w http.ResponseWriter, r *http.Request) {
-	var request PostApiLoginRequestObject
+// GetApiMeSessions operation middleware
+func (sh *strictHandler) GetApiMeSessions(w http.ResponseWriter, r *http.Request, params GetApiMeSessionsParams) {
+	var request GetApiMeSessionsRequestObject
 
-	var body PostApiLoginJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiMeSessions(ctx, request.(GetApiMeSessionsRequestObject))
 	}
-	request.Body = &body
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiMeSessions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiMeSessionsResponseObject); ok {
+		if err := validResponse.VisitGetApiMeSessionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiMeSessionsId operation middleware
+func (sh *strictHandler) DeleteApiMeSessionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteApiMeSessionsIdParams) {
+	var request DeleteApiMeSessionsIdRequestObject
+
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostApiLogin(ctx, request.(PostApiLoginRequestObject))
+		return sh.ssi.DeleteApiMeSessionsId(ctx, request.(DeleteApiMeSessionsIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostApiLogin")
+		handler = middleware(handler, "DeleteApiMeSessionsId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostApiLoginResponseObject); ok {
-		if err := validResponse.VisitPostApiLoginResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteApiMeSessionsIdResponseObject); ok {
+		if err := validResponse.VisitDeleteApiMeSessionsIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5307,25 +19505,25 @@ func (sh *strictHandler) PostApiLogin(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetApiMePlaylists operation middleware
-func (sh *strictHandler) GetApiMePlaylists(w http.ResponseWriter, r *http.Request, params GetApiMePlaylistsParams) {
-	var request GetApiMePlaylistsRequestObject
+// GetApiMeStats operation middleware
+func (sh *strictHandler) GetApiMeStats(w http.ResponseWriter, r *http.Request, params GetApiMeStatsParams) {
+	var request GetApiMeStatsRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetApiMePlaylists(ctx, request.(GetApiMePlaylistsRequestObject))
+		return sh.ssi.GetApiMeStats(ctx, request.(GetApiMeStatsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetApiMePlaylists")
+		handler = middleware(handler, "GetApiMeStats")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetApiMePlaylistsResponseObject); ok {
-		if err := validResponse.VisitGetApiMePlaylistsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetApiMeStatsResponseObject); ok {
+		if err := validResponse.VisitGetApiMeStatsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5359,6 +19557,33 @@ func (sh *strictHandler) GetApiMeTracksTop(w http.ResponseWriter, r *http.Reques
 	}
 }
 
+// GetApiMeYearInReviewYear operation middleware
+func (sh *strictHandler) GetApiMeYearInReviewYear(w http.ResponseWriter, r *http.Request, year int, params GetApiMeYearInReviewYearParams) {
+	var request GetApiMeYearInReviewYearRequestObject
+
+	request.Year = year
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiMeYearInReviewYear(ctx, request.(GetApiMeYearInReviewYearRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiMeYearInReviewYear")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiMeYearInReviewYearResponseObject); ok {
+		if err := validResponse.VisitGetApiMeYearInReviewYearResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetApiOauthSpotifyConfigJson operation middleware
 func (sh *strictHandler) GetApiOauthSpotifyConfigJson(w http.ResponseWriter, r *http.Request) {
 	var request GetApiOauthSpotifyConfigJsonRequestObject
@@ -5471,6 +19696,68 @@ func (sh *strictHandler) GetApiOpenapiYaml(w http.ResponseWriter, r *http.Reques
 	}
 }
 
+// PostApiPasswordReset operation middleware
+func (sh *strictHandler) PostApiPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var request PostApiPasswordResetRequestObject
+
+	var body PostApiPasswordResetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiPasswordReset(ctx, request.(PostApiPasswordResetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiPasswordReset")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiPasswordResetResponseObject); ok {
+		if err := validResponse.VisitPostApiPasswordResetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiPasswordResetConfirm operation middleware
+func (sh *strictHandler) PostApiPasswordResetConfirm(w http.ResponseWriter, r *http.Request) {
+	var request PostApiPasswordResetConfirmRequestObject
+
+	var body PostApiPasswordResetConfirmJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiPasswordResetConfirm(ctx, request.(PostApiPasswordResetConfirmRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiPasswordResetConfirm")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiPasswordResetConfirmResponseObject); ok {
+		if err := validResponse.VisitPostApiPasswordResetConfirmResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // PostApiPlaylists operation middleware
 func (sh *strictHandler) PostApiPlaylists(w http.ResponseWriter, r *http.Request, params PostApiPlaylistsParams) {
 	var request PostApiPlaylistsRequestObject
@@ -5531,6 +19818,37 @@ func (sh *strictHandler) GetApiPlaylistsId(w http.ResponseWriter, r *http.Reques
 	}
 }
 
+// PostApiRegister operation middleware
+func (sh *strictHandler) PostApiRegister(w http.ResponseWriter, r *http.Request) {
+	var request PostApiRegisterRequestObject
+
+	var body PostApiRegisterJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiRegister(ctx, request.(PostApiRegisterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiRegister")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiRegisterResponseObject); ok {
+		if err := validResponse.VisitPostApiRegisterResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetApiSpotifyStatus operation middleware
 func (sh *strictHandler) GetApiSpotifyStatus(w http.ResponseWriter, r *http.Request) {
 	var request GetApiSpotifyStatusRequestObject
@@ -5555,6 +19873,32 @@ func (sh *strictHandler) GetApiSpotifyStatus(w http.ResponseWriter, r *http.Requ
 	}
 }
 
+// GetApiSpotifySync operation middleware
+func (sh *strictHandler) GetApiSpotifySync(w http.ResponseWriter, r *http.Request, params GetApiSpotifySyncParams) {
+	var request GetApiSpotifySyncRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiSpotifySync(ctx, request.(GetApiSpotifySyncRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiSpotifySync")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiSpotifySyncResponseObject); ok {
+		if err := validResponse.VisitGetApiSpotifySyncResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetApiUsers operation middleware
 func (sh *strictHandler) GetApiUsers(w http.ResponseWriter, r *http.Request, params GetApiUsersParams) {
 	var request GetApiUsersRequestObject
@@ -5580,3 +19924,30 @@ func (sh *strictHandler) GetApiUsers(w http.ResponseWriter, r *http.Request, par
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// PostApiUsersIdSignOut operation middleware
+func (sh *strictHandler) PostApiUsersIdSignOut(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostApiUsersIdSignOutParams) {
+	var request PostApiUsersIdSignOutRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiUsersIdSignOut(ctx, request.(PostApiUsersIdSignOutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiUsersIdSignOut")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiUsersIdSignOutResponseObject); ok {
+		if err := validResponse.VisitPostApiUsersIdSignOutResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
@@ -2,16 +2,19 @@ package openapi
 
 import (
 	"mars/internal/env"
+	"mars/internal/mars"
 )
 
 type Server struct {
-	Env *env.Env
+	Env     *env.Env
+	Service *mars.Service
 }
 
 var _ StrictServerInterface = (*Server)(nil)
 
 func NewServer(env *env.Env) Server {
 	return Server{
-		Env: env,
+		Env:     env,
+		Service: mars.New(env),
 	}
 }
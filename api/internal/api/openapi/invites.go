@@ -0,0 +1,182 @@
+package openapi
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	apierror "mars/internal/api/error"
+	"mars/internal/api/requestid"
+	"mars/internal/argon2id"
+	"mars/internal/database"
+	"mars/internal/tokens"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (s Server) PostApiInvites(ctx context.Context, request PostApiInvitesRequestObject) (
+	PostApiInvitesResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return PostApiInvites500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	expiresIn := tokens.InviteTokenDuration()
+	var email pgtype.Text
+	if request.Body != nil {
+		if request.Body.ExpiresIn != nil {
+			expiresIn = time.Duration(*request.Body.ExpiresIn) * time.Second
+		}
+		if request.Body.Email != nil {
+			email = pgtype.Text{
+				String: strings.TrimSpace(strings.ToLower(string(*request.Body.Email))),
+				Valid:  true,
+			}
+		}
+	}
+
+	// Create invite token
+	s.Env.Logger.DebugContext(ctx, "creating invite token")
+	inviteID := uuid.New()
+	token, err := tokens.CreateInviteToken(inviteID)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to create invite token", slog.Any("error", err))
+		return PostApiInvites500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	tokenHash, err := argon2id.HashAndEncode(token, argon2id.DefaultParams)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to hash invite token", slog.Any("error", err))
+		return PostApiInvites500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Store invite
+	s.Env.Logger.DebugContext(ctx, "storing invite")
+	expiresAt := time.Now().Add(expiresIn)
+	err = s.Env.Database.CreateUserInvite(ctx, database.CreateUserInviteParams{
+		ID:        inviteID,
+		TokenHash: tokenHash,
+		CreatedBy: userid,
+		ExpiresAt: pgtype.Timestamptz{
+			Time:  expiresAt,
+			Valid: true,
+		},
+		Email: email,
+	})
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to store invite", slog.Any("error", err))
+		return PostApiInvites500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	s.Env.Logger.InfoContext(ctx, "created invite", slog.String("invite_id", inviteID.String()))
+
+	res := PostApiInvites201JSONResponse{
+		Id:        inviteID,
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}
+	if email.Valid {
+		e := openapi_types.Email(email.String)
+		res.Email = &e
+	}
+	return res, nil
+}
+
+func (s Server) GetApiInvites(ctx context.Context, request GetApiInvitesRequestObject) (
+	GetApiInvitesResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+
+	// List invites
+	s.Env.Logger.DebugContext(ctx, "listing invites")
+	invites, err := s.Env.Database.ListUserInvites(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to list invites", slog.Any("error", err))
+		return GetApiInvites500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	res := GetApiInvites200JSONResponse{
+		Invites: make([]Invite, len(invites)),
+	}
+	for i, inv := range invites {
+		res.Invites[i] = Invite{
+			Id:        inv.ID,
+			ExpiresAt: inv.ExpiresAt.Time,
+			CreatedAt: inv.CreatedAt.Time,
+		}
+		if inv.Email.Valid {
+			e := openapi_types.Email(inv.Email.String)
+			res.Invites[i].Email = &e
+		}
+		if inv.CreatedBy != uuid.Nil {
+			res.Invites[i].CreatedBy = &inv.CreatedBy
+		}
+		if inv.UsedBy != uuid.Nil {
+			res.Invites[i].UsedBy = &inv.UsedBy
+		}
+		if inv.UsedAt.Valid {
+			res.Invites[i].UsedAt = &inv.UsedAt.Time
+		}
+	}
+	return res, nil
+}
+
+func (s Server) DeleteApiInvitesId(ctx context.Context, request DeleteApiInvitesIdRequestObject) (
+	DeleteApiInvitesIdResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+
+	// Delete invite
+	s.Env.Logger.DebugContext(ctx, "deleting invite", slog.String("invite_id", request.Id.String()))
+	deleted, err := s.Env.Database.DeleteUserInvite(ctx, request.Id)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to delete invite", slog.Any("error", err))
+		return DeleteApiInvitesId500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	if deleted == 0 {
+		s.Env.Logger.ErrorContext(ctx, "invite does not exist or has been used")
+		return DeleteApiInvitesId404JSONResponse{
+			Message: "invite not found",
+			Status:  apierror.InviteNotFound.Status(),
+			Code:    apierror.InviteNotFound.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	return DeleteApiInvitesId204Response{}, nil
+}
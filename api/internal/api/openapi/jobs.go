@@ -0,0 +1,189 @@
+package openapi
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	apierror "mars/internal/api/error"
+	"mars/internal/api/requestid"
+	"mars/internal/database"
+	"mars/internal/scheduler"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func jobFromDatabase(job database.Job) Job {
+	res := Job{
+		Name:      job.Name,
+		Schedule:  job.Schedule,
+		Timezone:  job.Timezone,
+		Paused:    job.Paused,
+		NextRunAt: job.NextRunAt.Time,
+	}
+	if job.LastRunAt.Valid {
+		res.LastRunAt = &job.LastRunAt.Time
+	}
+	if job.LastSuccessAt.Valid {
+		res.LastSuccessAt = &job.LastSuccessAt.Time
+	}
+	if job.LastError.Valid {
+		res.LastError = &job.LastError.String
+	}
+	if job.TriggeredAt.Valid {
+		res.TriggeredAt = &job.TriggeredAt.Time
+	}
+	return res
+}
+
+func (s Server) GetApiJobs(ctx context.Context, request GetApiJobsRequestObject) (GetApiJobsResponseObject, error) {
+	reqid := requestid.FromContext(ctx)
+
+	// List jobs
+	s.Env.Logger.DebugContext(ctx, "listing jobs")
+	jobs, err := s.Env.Database.ListJobs(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to list jobs", slog.Any("error", err))
+		return GetApiJobs500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	res := GetApiJobs200JSONResponse{
+		Jobs: make([]Job, len(jobs)),
+	}
+	for i, job := range jobs {
+		res.Jobs[i] = jobFromDatabase(job)
+	}
+	return res, nil
+}
+
+func (s Server) PatchApiJobsName(ctx context.Context, request PatchApiJobsNameRequestObject) (
+	PatchApiJobsNameResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+	logger := s.Env.Logger.With(slog.String("job", request.Name))
+
+	// Get job
+	logger.DebugContext(ctx, "getting job")
+	job, err := s.Env.Database.GetJob(ctx, request.Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.ErrorContext(ctx, "job does not exist")
+		return PatchApiJobsName404JSONResponse{
+			Message: "job not found",
+			Status:  apierror.JobNotFound.Status(),
+			Code:    apierror.JobNotFound.String(),
+			ErrorId: reqid,
+		}, nil
+	} else if err != nil {
+		logger.ErrorContext(ctx, "failed to get job", slog.Any("error", err))
+		return PatchApiJobsName500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Schedule the next run from now when resuming so that runs missed
+	// while paused are skipped rather than caught up.
+	nextRunAt := job.NextRunAt.Time
+	if job.Paused && !request.Body.Paused {
+		nextRunAt, err = scheduler.NextRun(job, time.Now())
+		if err != nil {
+			logger.ErrorContext(ctx, "failed to compute next run", slog.Any("error", err))
+			return PatchApiJobsName500JSONResponse{
+				Message: "internal server error",
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				ErrorId: reqid,
+			}, nil
+		}
+	}
+
+	// Update job
+	logger.DebugContext(ctx, "updating job", slog.Bool("paused", request.Body.Paused))
+	updated, err := s.Env.Database.SetJobPaused(ctx, database.SetJobPausedParams{
+		Name:   request.Name,
+		Paused: request.Body.Paused,
+		NextRunAt: pgtype.Timestamptz{
+			Time:  nextRunAt,
+			Valid: true,
+		},
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to update job", slog.Any("error", err))
+		return PatchApiJobsName500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	if updated == 0 {
+		logger.ErrorContext(ctx, "job does not exist")
+		return PatchApiJobsName404JSONResponse{
+			Message: "job not found",
+			Status:  apierror.JobNotFound.Status(),
+			Code:    apierror.JobNotFound.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	logger.InfoContext(ctx, "updated job", slog.Bool("paused", request.Body.Paused))
+
+	job.Paused = request.Body.Paused
+	job.NextRunAt.Time = nextRunAt
+	return PatchApiJobsName200JSONResponse(jobFromDatabase(job)), nil
+}
+
+func (s Server) PostApiJobsNameTrigger(ctx context.Context, request PostApiJobsNameTriggerRequestObject) (
+	PostApiJobsNameTriggerResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+	logger := s.Env.Logger.With(slog.String("job", request.Name))
+
+	// Trigger job
+	logger.DebugContext(ctx, "triggering job")
+	triggered, err := s.Env.Database.TriggerJob(ctx, request.Name)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to trigger job", slog.Any("error", err))
+		return PostApiJobsNameTrigger500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	if triggered == 0 {
+		logger.ErrorContext(ctx, "job does not exist")
+		return PostApiJobsNameTrigger404JSONResponse{
+			Message: "job not found",
+			Status:  apierror.JobNotFound.Status(),
+			Code:    apierror.JobNotFound.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Get job
+	logger.DebugContext(ctx, "getting job")
+	job, err := s.Env.Database.GetJob(ctx, request.Name)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to get job", slog.Any("error", err))
+		return PostApiJobsNameTrigger500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	logger.InfoContext(ctx, "triggered job")
+
+	return PostApiJobsNameTrigger202JSONResponse(jobFromDatabase(job)), nil
+}
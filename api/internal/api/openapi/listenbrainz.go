@@ -0,0 +1,289 @@
+package openapi
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	apierror "mars/internal/api/error"
+	"mars/internal/api/requestid"
+	"mars/internal/argon2id"
+	"mars/internal/database"
+	"mars/internal/scrobble"
+	"mars/internal/tokens"
+)
+
+// minListenedAt is the earliest listen timestamp ListenBrainz accepts.
+var minListenedAt = time.Date(2002, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+func (s Server) PostApiMeListenToken(ctx context.Context, request PostApiMeListenTokenRequestObject) (
+	PostApiMeListenTokenResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return PostApiMeListenToken500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Create listen token
+	s.Env.Logger.DebugContext(ctx, "creating listen token")
+	token, err := tokens.CreateListenToken(userid)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to create listen token", slog.Any("error", err))
+		return PostApiMeListenToken500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	tokenHash, err := argon2id.HashAndEncode(token, argon2id.DefaultParams)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to hash listen token", slog.Any("error", err))
+		return PostApiMeListenToken500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Store listen token, replacing any existing token
+	s.Env.Logger.DebugContext(ctx, "storing listen token")
+	createdAt, err := s.Env.Database.UpsertListenToken(ctx, database.UpsertListenTokenParams{
+		UserID:    userid,
+		TokenHash: tokenHash,
+	})
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to store listen token", slog.Any("error", err))
+		return PostApiMeListenToken500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	s.Env.Logger.InfoContext(ctx, "created listen token")
+
+	return PostApiMeListenToken201JSONResponse{
+		Token:     token,
+		CreatedAt: createdAt.Time,
+	}, nil
+}
+
+func (s Server) DeleteApiMeListenToken(ctx context.Context, request DeleteApiMeListenTokenRequestObject) (
+	DeleteApiMeListenTokenResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return DeleteApiMeListenToken500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Delete listen token
+	s.Env.Logger.DebugContext(ctx, "deleting listen token")
+	deleted, err := s.Env.Database.DeleteListenToken(ctx, userid)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to delete listen token", slog.Any("error", err))
+		return DeleteApiMeListenToken500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	if deleted == 0 {
+		s.Env.Logger.ErrorContext(ctx, "user has no listen token")
+		return DeleteApiMeListenToken404JSONResponse{
+			Message: "listen token not found",
+			Status:  apierror.ListenTokenNotFound.Status(),
+			Code:    apierror.ListenTokenNotFound.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	s.Env.Logger.InfoContext(ctx, "deleted listen token")
+
+	return DeleteApiMeListenToken204Response{}, nil
+}
+
+func (s Server) PostApiListenbrainz1SubmitListens(
+	ctx context.Context, request PostApiListenbrainz1SubmitListensRequestObject,
+) (PostApiListenbrainz1SubmitListensResponseObject, error) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return PostApiListenbrainz1SubmitListens500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Validate listens
+	listens, err := submittedListens(request.Body.ListenType, request.Body.Payload)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "invalid listens", slog.Any("error", err))
+		return PostApiListenbrainz1SubmitListens400JSONResponse{
+			Message: err.Error(),
+			Status:  apierror.BadRequest.Status(),
+			Code:    apierror.BadRequest.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	if request.Body.ListenType == PlayingNow {
+		s.Env.Logger.DebugContext(ctx, "ignoring playing now submission")
+		return PostApiListenbrainz1SubmitListens200JSONResponse{
+			Status: "ok",
+		}, nil
+	}
+
+	// Store listens in a transaction so a failed submission can be retried as a whole
+	s.Env.Logger.DebugContext(ctx, "storing listens", slog.Int("listens", len(listens)))
+	tx, err := s.Env.Pool.Begin(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to begin transaction", slog.Any("error", err))
+		return PostApiListenbrainz1SubmitListens500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	result, err := scrobble.Submit(ctx, database.New(tx), userid, listens)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to store listens", slog.Any("error", err))
+		return PostApiListenbrainz1SubmitListens500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	if err := tx.Commit(ctx); err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to commit transaction", slog.Any("error", err))
+		return PostApiListenbrainz1SubmitListens500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	s.Env.Logger.InfoContext(ctx, "stored listens",
+		slog.Int("imported", result.Imported),
+		slog.Int("duplicates", result.Duplicates))
+
+	return PostApiListenbrainz1SubmitListens200JSONResponse{
+		Status: "ok",
+	}, nil
+}
+
+// submittedListens validates a submission following the ListenBrainz rules
+// and converts it into listens to store.
+func submittedListens(listenType ListenType, payload []SubmittedListen) ([]scrobble.Listen, error) {
+	switch listenType {
+	case Single, PlayingNow:
+		if len(payload) != 1 {
+			return nil, fmt.Errorf("%s submissions must contain exactly one listen", listenType)
+		}
+	case Import:
+		if len(payload) == 0 {
+			return nil, fmt.Errorf("%s submissions must contain at least one listen", listenType)
+		}
+	default:
+		return nil, fmt.Errorf("unknown listen type %q", listenType)
+	}
+
+	listens := make([]scrobble.Listen, len(payload))
+	for i, item := range payload {
+		if listenType == PlayingNow {
+			if item.ListenedAt != nil {
+				return nil, fmt.Errorf("listen %d: playing now submissions must not have listened_at", i)
+			}
+		} else {
+			if item.ListenedAt == nil {
+				return nil, fmt.Errorf("listen %d: listened_at is required", i)
+			}
+			listens[i].ListenedAt = time.Unix(*item.ListenedAt, 0)
+			if listens[i].ListenedAt.Before(minListenedAt) {
+				return nil, fmt.Errorf("listen %d: listened_at must be after %s",
+					i, minListenedAt.Format(time.DateOnly))
+			}
+		}
+
+		listens[i].ArtistName = item.TrackMetadata.ArtistName
+		listens[i].TrackName = item.TrackMetadata.TrackName
+		if info := item.TrackMetadata.AdditionalInfo; info != nil {
+			if info.SpotifyId != nil {
+				listens[i].SpotifyID = *info.SpotifyId
+			} else if info.OriginUrl != nil {
+				if _, ok := scrobble.SpotifyTrackID(*info.OriginUrl); ok {
+					listens[i].SpotifyID = *info.OriginUrl
+				}
+			}
+			if info.RecordingMbid != nil {
+				listens[i].RecordingMBID = *info.RecordingMbid
+			}
+			if info.DurationMs != nil {
+				listens[i].DurationMS = *info.DurationMs
+			}
+		}
+	}
+
+	return listens, nil
+}
+
+func (s Server) GetApiListenbrainz1ValidateToken(
+	ctx context.Context, request GetApiListenbrainz1ValidateTokenRequestObject,
+) (GetApiListenbrainz1ValidateTokenResponseObject, error) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return GetApiListenbrainz1ValidateToken500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Get user
+	s.Env.Logger.DebugContext(ctx, "getting listen token owner")
+	token, err := s.Env.Database.GetListenToken(ctx, userid)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get listen token", slog.Any("error", err))
+		return GetApiListenbrainz1ValidateToken500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	return GetApiListenbrainz1ValidateToken200JSONResponse{
+		Code:     200,
+		Message:  "Token valid.",
+		Valid:    true,
+		UserName: token.Email,
+	}, nil
+}
@@ -0,0 +1,410 @@
+package openapi
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	apierror "mars/internal/api/error"
+	"mars/internal/api/requestid"
+	"mars/internal/database"
+	"mars/internal/export"
+	"mars/internal/history"
+	"mars/internal/log"
+	"mars/internal/tokens"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const defaultListensLimit = 50
+
+// likeEscaper escapes the wildcards of a LIKE pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// containsPattern returns a LIKE pattern matching text containing s.
+func containsPattern(s *string) pgtype.Text {
+	if s == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{
+		String: "%" + likeEscaper.Replace(*s) + "%",
+		Valid:  true,
+	}
+}
+
+// encodeListenCursor returns an opaque cursor pointing at a listen.
+func encodeListenCursor(playedAt time.Time, trackID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(playedAt.UTC().Format(time.RFC3339Nano) + "|" + trackID))
+}
+
+// decodeListenCursor returns the listen a cursor points at.
+func decodeListenCursor(cursor string) (time.Time, string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("decoding cursor: %w", err)
+	}
+	playedAtStr, trackID, found := strings.Cut(string(data), "|")
+	if !found {
+		return time.Time{}, "", errors.New("cursor should be in format \"<played-at>|<track-id>\"")
+	}
+	playedAt, err := time.Parse(time.RFC3339Nano, playedAtStr)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("parsing cursor time: %w", err)
+	}
+	return playedAt, trackID, nil
+}
+
+func (s Server) GetApiMeListens(
+	ctx context.Context, request GetApiMeListensRequestObject,
+) (GetApiMeListensResponseObject, error) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return GetApiMeListens500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Build filters
+	limit := defaultListensLimit
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+	var start, end pgtype.Timestamptz
+	if request.Params.Start != nil {
+		start = pgtype.Timestamptz{
+			Time:  time.Unix(*request.Params.Start, 0),
+			Valid: true,
+		}
+	}
+	if request.Params.End != nil {
+		end = pgtype.Timestamptz{
+			Time:  time.Unix(*request.Params.End, 0),
+			Valid: true,
+		}
+	}
+	if start.Valid && end.Valid && end.Time.Before(start.Time) {
+		s.Env.Logger.ErrorContext(ctx, "end time is before start time")
+		return GetApiMeListens400JSONResponse{
+			Message: "end should be after start",
+			Status:  apierror.BadRequest.Status(),
+			Code:    apierror.BadRequest.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	artist := containsPattern(request.Params.Artist)
+	track := containsPattern(request.Params.Track)
+
+	var beforePlayedAt pgtype.Timestamptz
+	var beforeTrackID string
+	if request.Params.Cursor != nil {
+		playedAt, trackID, err := decodeListenCursor(*request.Params.Cursor)
+		if err != nil {
+			s.Env.Logger.ErrorContext(ctx, "invalid cursor", slog.Any("error", err))
+			return GetApiMeListens400JSONResponse{
+				Message: "invalid cursor",
+				Status:  apierror.BadRequest.Status(),
+				Code:    apierror.BadRequest.String(),
+				ErrorId: reqid,
+			}, nil
+		}
+		beforePlayedAt = pgtype.Timestamptz{
+			Time:  playedAt,
+			Valid: true,
+		}
+		beforeTrackID = trackID
+	}
+
+	// Get listens, fetching one extra to know whether there is a next page
+	s.Env.Logger.DebugContext(ctx, "getting listens")
+	listens, err := s.Env.Database.ListUserListens(ctx, database.ListUserListensParams{
+		UserID:         userid,
+		Start:          start,
+		End:            end,
+		Artist:         artist,
+		Track:          track,
+		BeforePlayedAt: beforePlayedAt,
+		BeforeTrackID:  beforeTrackID,
+		PageSize:       int32(limit + 1),
+	})
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get listens", slog.Any("error", err))
+		return GetApiMeListens500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Count listens
+	s.Env.Logger.DebugContext(ctx, "counting listens")
+	total, err := s.Env.Database.CountUserListens(ctx, database.CountUserListensParams{
+		UserID: userid,
+		Start:  start,
+		End:    end,
+		Artist: artist,
+		Track:  track,
+	})
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to count listens", slog.Any("error", err))
+		return GetApiMeListens500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	res := GetApiMeListens200JSONResponse{
+		Total: total,
+	}
+	if len(listens) > limit {
+		listens = listens[:limit]
+		last := listens[len(listens)-1]
+		next := encodeListenCursor(last.PlayedAt.Time, last.TrackID)
+		res.NextCursor = &next
+	}
+	res.Listens = make([]Listen, len(listens))
+	for i, l := range listens {
+		res.Listens[i] = Listen{
+			PlayedAt: l.PlayedAt.Time,
+			Track: Track{
+				Id:      l.TrackID,
+				Name:    l.Name,
+				Artists: l.Artists,
+				Href:    l.Href,
+				Uri:     l.Uri,
+			},
+		}
+		if l.ImageUrl.Valid {
+			res.Listens[i].Track.ImageUrl = &l.ImageUrl.String
+		}
+	}
+	return res, nil
+}
+
+func (s Server) PostApiMeListensImport(
+	ctx context.Context, request PostApiMeListensImportRequestObject,
+) (PostApiMeListensImportResponseObject, error) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return PostApiMeListensImport500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Import each file as it is streamed in
+	var result history.Result
+	var files int
+	for {
+		part, err := request.Body.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			s.Env.Logger.ErrorContext(ctx, "failed to read multipart body", slog.Any("error", err))
+			return PostApiMeListensImport400JSONResponse{
+				Message: "invalid multipart body",
+				Status:  apierror.BadRequest.Status(),
+				Code:    apierror.BadRequest.String(),
+				ErrorId: reqid,
+			}, nil
+		}
+		if part.FormName() != "files" {
+			_ = part.Close()
+			continue
+		}
+		fileCtx := log.AppendCtx(ctx, slog.String("file", part.FileName()))
+
+		// Import the file in a transaction so a bad file is not partially imported
+		s.Env.Logger.DebugContext(fileCtx, "importing streaming history file")
+		tx, err := s.Env.Pool.Begin(ctx)
+		if err != nil {
+			s.Env.Logger.ErrorContext(fileCtx, "failed to begin transaction", slog.Any("error", err))
+			return PostApiMeListensImport500JSONResponse{
+				Message: "internal server error",
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				ErrorId: reqid,
+			}, nil
+		}
+		fileResult, err := history.Import(ctx, database.New(tx), userid, part)
+		_ = part.Close()
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			s.Env.Logger.ErrorContext(fileCtx, "failed to import streaming history file", slog.Any("error", err))
+			if errors.Is(err, history.ErrInvalidFormat) {
+				return PostApiMeListensImport400JSONResponse{
+					Message: part.FileName() + ": " + err.Error(),
+					Status:  apierror.BadRequest.Status(),
+					Code:    apierror.BadRequest.String(),
+					ErrorId: reqid,
+				}, nil
+			}
+			return PostApiMeListensImport500JSONResponse{
+				Message: "internal server error",
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				ErrorId: reqid,
+			}, nil
+		}
+		if err := tx.Commit(ctx); err != nil {
+			s.Env.Logger.ErrorContext(fileCtx, "failed to commit transaction", slog.Any("error", err))
+			return PostApiMeListensImport500JSONResponse{
+				Message: "internal server error",
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				ErrorId: reqid,
+			}, nil
+		}
+
+		s.Env.Logger.InfoContext(fileCtx, "imported streaming history file",
+			slog.Int("imported", fileResult.Imported),
+			slog.Int("skipped", fileResult.Skipped),
+			slog.Int("duplicates", fileResult.Duplicates))
+		result.Add(fileResult)
+		files++
+	}
+
+	return PostApiMeListensImport200JSONResponse{
+		Files:      files,
+		Imported:   result.Imported,
+		Skipped:    result.Skipped,
+		Duplicates: result.Duplicates,
+	}, nil
+}
+
+// exportFilter converts export query parameters into a filter.
+func exportFilter(start, end *time.Time) (export.Filter, error) {
+	var filter export.Filter
+	if start != nil {
+		filter.Start = *start
+	}
+	if end != nil {
+		filter.End = *end
+	}
+	if start != nil && end != nil && !filter.Start.Before(filter.End) {
+		return filter, errors.New("start must be before end")
+	}
+	return filter, nil
+}
+
+// exportFormat returns the requested export format, defaulting to CSV.
+func exportFormat(format *ExportFormat) export.Format {
+	if format == nil {
+		return export.CSV
+	}
+	return export.Format(*format)
+}
+
+// streamExport runs write in the background and returns a reader of its
+// output, so the response is streamed as listens are read from the database.
+func (s Server) streamExport(ctx context.Context, write func(w io.Writer) error) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		err := write(pw)
+		if err != nil {
+			s.Env.Logger.ErrorContext(ctx, "failed to export listens", slog.Any("error", err))
+		}
+		_ = pw.CloseWithError(err)
+	}()
+	return pr
+}
+
+func (s Server) GetApiMeListensExport(
+	ctx context.Context, request GetApiMeListensExportRequestObject,
+) (GetApiMeListensExportResponseObject, error) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return GetApiMeListensExport500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	filter, err := exportFilter(request.Params.Start, request.Params.End)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "invalid date range", slog.Any("error", err))
+		return GetApiMeListensExport400JSONResponse{
+			Message: err.Error(),
+			Status:  apierror.BadRequest.Status(),
+			Code:    apierror.BadRequest.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	format := exportFormat(request.Params.Format)
+
+	// Stream listens
+	s.Env.Logger.DebugContext(ctx, "exporting listens", slog.String("format", string(format)))
+	body := s.streamExport(ctx, func(w io.Writer) error {
+		return export.WriteUserListens(ctx, s.Env.Database, w, format, userid, filter)
+	})
+	headers := GetApiMeListensExport200ResponseHeaders{
+		ContentDisposition: fmt.Sprintf("attachment; filename=%q", export.Filename(format, time.Now())),
+	}
+	if format == export.JSONL {
+		return GetApiMeListensExport200ApplicationxNdjsonResponse{
+			Body:    body,
+			Headers: headers,
+		}, nil
+	}
+	return GetApiMeListensExport200TextcsvResponse{
+		Body:    body,
+		Headers: headers,
+	}, nil
+}
+
+func (s Server) GetApiListensExport(
+	ctx context.Context, request GetApiListensExportRequestObject,
+) (GetApiListensExportResponseObject, error) {
+	reqid := requestid.FromContext(ctx)
+
+	filter, err := exportFilter(request.Params.Start, request.Params.End)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "invalid date range", slog.Any("error", err))
+		return GetApiListensExport400JSONResponse{
+			Message: err.Error(),
+			Status:  apierror.BadRequest.Status(),
+			Code:    apierror.BadRequest.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	format := exportFormat(request.Params.Format)
+
+	// Stream listens of every user
+	s.Env.Logger.InfoContext(ctx, "exporting listens of every user", slog.String("format", string(format)))
+	body := s.streamExport(ctx, func(w io.Writer) error {
+		return export.WriteListens(ctx, s.Env.Database, w, format, filter)
+	})
+	headers := GetApiListensExport200ResponseHeaders{
+		ContentDisposition: fmt.Sprintf("attachment; filename=%q", export.Filename(format, time.Now())),
+	}
+	if format == export.JSONL {
+		return GetApiListensExport200ApplicationxNdjsonResponse{
+			Body:    body,
+			Headers: headers,
+		}, nil
+	}
+	return GetApiListensExport200TextcsvResponse{
+		Body:    body,
+		Headers: headers,
+	}, nil
+}
@@ -0,0 +1,327 @@
+package openapi
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	apierror "mars/internal/api/error"
+	"mars/internal/api/requestid"
+	"mars/internal/mars"
+	"mars/internal/tokens"
+)
+
+func (s Server) GetApiMeMfa(ctx context.Context, request GetApiMeMfaRequestObject) (
+	GetApiMeMfaResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return GetApiMeMfa500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Get status
+	s.Env.Logger.DebugContext(ctx, "getting mfa status")
+	status, err := s.Service.GetMFAStatus(ctx, userid)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get mfa status", slog.Any("error", err))
+		return GetApiMeMfa500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	return GetApiMeMfa200JSONResponse{
+		TotpEnabled:            status.TOTPEnabled,
+		RecoveryCodesRemaining: status.RecoveryCodes,
+	}, nil
+}
+
+func (s Server) PostApiMeMfaTotp(ctx context.Context, request PostApiMeMfaTotpRequestObject) (
+	PostApiMeMfaTotpResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return PostApiMeMfaTotp500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Get email to label the account in authenticator apps
+	s.Env.Logger.DebugContext(ctx, "getting user")
+	user, err := s.Env.Database.GetUser(ctx, userid)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get user", slog.Any("error", err))
+		return PostApiMeMfaTotp500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Create secret
+	s.Env.Logger.DebugContext(ctx, "starting totp enrollment")
+	secret, uri, err := s.Service.StartTOTPEnrollment(ctx, userid, user.Email)
+	if errors.Is(err, mars.ErrTOTPEnabled) {
+		s.Env.Logger.ErrorContext(ctx, "totp already enabled")
+		return PostApiMeMfaTotp409JSONResponse{
+			Message: "two-factor authentication is already enabled",
+			Status:  apierror.MFAAlreadyEnabled.Status(),
+			Code:    apierror.MFAAlreadyEnabled.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to start totp enrollment", slog.Any("error", err))
+		return PostApiMeMfaTotp500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	return PostApiMeMfaTotp200JSONResponse{
+		Secret:          secret,
+		ProvisioningUri: uri,
+	}, nil
+}
+
+func (s Server) PostApiMeMfaTotpVerify(ctx context.Context, request PostApiMeMfaTotpVerifyRequestObject) (
+	PostApiMeMfaTotpVerifyResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return PostApiMeMfaTotpVerify500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	code := strings.TrimSpace(request.Body.Code)
+	if code == "" {
+		s.Env.Logger.ErrorContext(ctx, "missing code")
+		return PostApiMeMfaTotpVerify400JSONResponse{
+			Message: "code is required",
+			Status:  apierror.BadRequest.Status(),
+			Code:    apierror.BadRequest.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Enable totp
+	s.Env.Logger.DebugContext(ctx, "enabling totp")
+	codes, err := s.Service.EnableTOTP(ctx, userid, code)
+	if errors.Is(err, mars.ErrTOTPNotEnrolled) {
+		s.Env.Logger.ErrorContext(ctx, "totp enrollment not started")
+		return PostApiMeMfaTotpVerify404JSONResponse{
+			Message: "two-factor authentication enrollment has not been started",
+			Status:  apierror.MFANotEnrolled.Status(),
+			Code:    apierror.MFANotEnrolled.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	if errors.Is(err, mars.ErrTOTPEnabled) {
+		s.Env.Logger.ErrorContext(ctx, "totp already enabled")
+		return PostApiMeMfaTotpVerify409JSONResponse{
+			Message: "two-factor authentication is already enabled",
+			Status:  apierror.MFAAlreadyEnabled.Status(),
+			Code:    apierror.MFAAlreadyEnabled.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	if errors.Is(err, mars.ErrInvalidMFACode) {
+		s.Env.Logger.ErrorContext(ctx, "invalid totp code")
+		return PostApiMeMfaTotpVerify401JSONResponse{
+			Message: "invalid code",
+			Status:  apierror.InvalidMFACode.Status(),
+			Code:    apierror.InvalidMFACode.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to enable totp", slog.Any("error", err))
+		return PostApiMeMfaTotpVerify500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	s.Env.Logger.InfoContext(ctx, "enabled totp")
+
+	return PostApiMeMfaTotpVerify200JSONResponse{
+		RecoveryCodes: codes,
+	}, nil
+}
+
+func (s Server) DeleteApiMeMfaTotp(ctx context.Context, request DeleteApiMeMfaTotpRequestObject) (
+	DeleteApiMeMfaTotpResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return DeleteApiMeMfaTotp500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	code := strings.TrimSpace(request.Body.Code)
+	if code == "" {
+		s.Env.Logger.ErrorContext(ctx, "missing code")
+		return DeleteApiMeMfaTotp400JSONResponse{
+			Message: "code is required",
+			Status:  apierror.BadRequest.Status(),
+			Code:    apierror.BadRequest.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Disable totp
+	s.Env.Logger.DebugContext(ctx, "disabling totp")
+	err = s.Service.DisableTOTP(ctx, userid, code)
+	if errors.Is(err, mars.ErrTOTPNotEnrolled) {
+		s.Env.Logger.ErrorContext(ctx, "totp not enabled")
+		return DeleteApiMeMfaTotp404JSONResponse{
+			Message: "two-factor authentication is not enabled",
+			Status:  apierror.MFANotEnrolled.Status(),
+			Code:    apierror.MFANotEnrolled.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	if errors.Is(err, mars.ErrInvalidMFACode) {
+		s.Env.Logger.ErrorContext(ctx, "invalid mfa code")
+		return DeleteApiMeMfaTotp401JSONResponse{
+			Message: "invalid code",
+			Status:  apierror.InvalidMFACode.Status(),
+			Code:    apierror.InvalidMFACode.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	if errors.Is(err, mars.ErrMFALocked) {
+		s.Env.Logger.ErrorContext(ctx, "too many invalid mfa codes")
+		return DeleteApiMeMfaTotp429JSONResponse{
+			Message: "too many invalid codes, try again later",
+			Status:  apierror.MFALocked.Status(),
+			Code:    apierror.MFALocked.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to disable totp", slog.Any("error", err))
+		return DeleteApiMeMfaTotp500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	s.Env.Logger.InfoContext(ctx, "disabled totp")
+
+	return DeleteApiMeMfaTotp204Response{}, nil
+}
+
+func (s Server) PostApiLoginMfa(ctx context.Context, request PostApiLoginMfaRequestObject) (
+	PostApiLoginMfaResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+	code := strings.TrimSpace(request.Body.Code)
+	if code == "" {
+		s.Env.Logger.ErrorContext(ctx, "missing code")
+		return PostApiLoginMfa400JSONResponse{
+			Message: "code is required",
+			Status:  apierror.BadRequest.Status(),
+			Code:    apierror.BadRequest.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Check code
+	s.Env.Logger.DebugContext(ctx, "completing mfa challenge")
+	userid, err := s.Service.CompleteMFAChallenge(ctx, request.Body.MfaToken, code)
+	if errors.Is(err, mars.ErrInvalidMFAToken) {
+		s.Env.Logger.ErrorContext(ctx, "invalid mfa token")
+		return PostApiLoginMfa401JSONResponse{
+			Message: "invalid or expired mfa token",
+			Status:  apierror.InvalidMFAToken.Status(),
+			Code:    apierror.InvalidMFAToken.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	if errors.Is(err, mars.ErrInvalidMFACode) {
+		s.Env.Logger.ErrorContext(ctx, "invalid mfa code")
+		return PostApiLoginMfa401JSONResponse{
+			Message: "invalid code",
+			Status:  apierror.InvalidMFACode.Status(),
+			Code:    apierror.InvalidMFACode.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	if errors.Is(err, mars.ErrMFALocked) {
+		s.Env.Logger.ErrorContext(ctx, "too many invalid mfa codes")
+		return PostApiLoginMfa429JSONResponse{
+			Message: "too many invalid codes, try again later",
+			Status:  apierror.MFALocked.Status(),
+			Code:    apierror.MFALocked.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to complete mfa challenge", slog.Any("error", err))
+		return PostApiLoginMfa500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Get role and token version for the access token
+	s.Env.Logger.DebugContext(ctx, "getting user")
+	user, err := s.Env.Database.GetUserAuth(ctx, userid)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get user", slog.Any("error", err))
+		return PostApiLoginMfa500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	s.Env.Logger.DebugContext(ctx, "creating tokens")
+	res, err := s.newLoginSession(ctx, userid, user.Role, user.TokenVersion)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to create session", slog.Any("error", err))
+		return PostApiLoginMfa500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	return res, nil
+}
@@ -0,0 +1,323 @@
+package openapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+
+	apierror "mars/internal/api/error"
+	"mars/internal/api/requestid"
+	"mars/internal/database"
+	"mars/internal/mars"
+	"mars/internal/tokens"
+)
+
+// defaultPasskeyName names passkeys registered without a name.
+const defaultPasskeyName = "Passkey"
+
+// jsonObject converts webauthn options to the free-form object they are
+// returned as.
+func jsonObject(v any) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]any
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (s Server) GetApiMePasskeys(ctx context.Context, request GetApiMePasskeysRequestObject) (
+	GetApiMePasskeysResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return GetApiMePasskeys500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Get passkeys
+	s.Env.Logger.DebugContext(ctx, "getting passkeys")
+	rows, err := s.Env.Database.ListUserWebAuthnCredentials(ctx, userid)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get passkeys", slog.Any("error", err))
+		return GetApiMePasskeys500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	passkeys := make([]Passkey, 0, len(rows))
+	for _, row := range rows {
+		passkey := Passkey{
+			Id:        row.ID,
+			Name:      row.Name,
+			CreatedAt: row.CreatedAt.Time,
+		}
+		if row.LastUsedAt.Valid {
+			passkey.LastUsedAt = &row.LastUsedAt.Time
+		}
+		passkeys = append(passkeys, passkey)
+	}
+
+	return GetApiMePasskeys200JSONResponse{
+		Passkeys: passkeys,
+	}, nil
+}
+
+func (s Server) PostApiMePasskeysRegisterBegin(
+	ctx context.Context, request PostApiMePasskeysRegisterBeginRequestObject,
+) (PostApiMePasskeysRegisterBeginResponseObject, error) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return PostApiMePasskeysRegisterBegin500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Begin registration
+	s.Env.Logger.DebugContext(ctx, "beginning passkey registration")
+	creation, sessionid, err := s.Service.BeginPasskeyRegistration(ctx, userid)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to begin passkey registration", slog.Any("error", err))
+		return PostApiMePasskeysRegisterBegin500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	options, err := jsonObject(creation.Response)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to encode passkey options", slog.Any("error", err))
+		return PostApiMePasskeysRegisterBegin500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	return PostApiMePasskeysRegisterBegin200JSONResponse{
+		SessionId: sessionid,
+		Options:   options,
+	}, nil
+}
+
+func (s Server) PostApiMePasskeysRegisterFinish(
+	ctx context.Context, request PostApiMePasskeysRegisterFinishRequestObject,
+) (PostApiMePasskeysRegisterFinishResponseObject, error) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return PostApiMePasskeysRegisterFinish500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	name := defaultPasskeyName
+	if request.Body.Name != nil && strings.TrimSpace(*request.Body.Name) != "" {
+		name = strings.TrimSpace(*request.Body.Name)
+	}
+	credential, err := json.Marshal(request.Body.Credential)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to encode credential", slog.Any("error", err))
+		return PostApiMePasskeysRegisterFinish400JSONResponse{
+			Message: "invalid credential",
+			Status:  apierror.BadRequest.Status(),
+			Code:    apierror.BadRequest.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Finish registration
+	s.Env.Logger.DebugContext(ctx, "finishing passkey registration")
+	passkey, err := s.Service.FinishPasskeyRegistration(ctx, userid, request.Body.SessionId, name, credential)
+	if errors.Is(err, mars.ErrInvalidPasskey) {
+		s.Env.Logger.ErrorContext(ctx, "invalid passkey registration", slog.Any("error", err))
+		return PostApiMePasskeysRegisterFinish400JSONResponse{
+			Message: "passkey could not be verified, or the registration expired",
+			Status:  apierror.BadRequest.Status(),
+			Code:    apierror.BadRequest.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to finish passkey registration", slog.Any("error", err))
+		return PostApiMePasskeysRegisterFinish500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	s.Env.Logger.InfoContext(ctx, "registered passkey", slog.String("passkey_id", passkey.ID.String()))
+
+	return PostApiMePasskeysRegisterFinish201JSONResponse{
+		Id:        passkey.ID,
+		Name:      passkey.Name,
+		CreatedAt: passkey.CreatedAt.Time,
+	}, nil
+}
+
+func (s Server) DeleteApiMePasskeysId(ctx context.Context, request DeleteApiMePasskeysIdRequestObject) (
+	DeleteApiMePasskeysIdResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return DeleteApiMePasskeysId500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Delete passkey
+	s.Env.Logger.DebugContext(ctx, "deleting passkey")
+	deleted, err := s.Env.Database.DeleteUserWebAuthnCredential(ctx, database.DeleteUserWebAuthnCredentialParams{
+		ID:     request.Id,
+		UserID: userid,
+	})
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to delete passkey", slog.Any("error", err))
+		return DeleteApiMePasskeysId500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	if deleted == 0 {
+		s.Env.Logger.ErrorContext(ctx, "passkey not found")
+		return DeleteApiMePasskeysId404JSONResponse{
+			Message: "passkey not found",
+			Status:  apierror.PasskeyNotFound.Status(),
+			Code:    apierror.PasskeyNotFound.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	return DeleteApiMePasskeysId204Response{}, nil
+}
+
+func (s Server) PostApiLoginPasskeyBegin(ctx context.Context, request PostApiLoginPasskeyBeginRequestObject) (
+	PostApiLoginPasskeyBeginResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+
+	// Begin login
+	s.Env.Logger.DebugContext(ctx, "beginning passkey login")
+	assertion, sessionid, err := s.Service.BeginPasskeyLogin(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to begin passkey login", slog.Any("error", err))
+		return PostApiLoginPasskeyBegin500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	options, err := jsonObject(assertion.Response)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to encode passkey options", slog.Any("error", err))
+		return PostApiLoginPasskeyBegin500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	return PostApiLoginPasskeyBegin200JSONResponse{
+		SessionId: sessionid,
+		Options:   options,
+	}, nil
+}
+
+func (s Server) PostApiLoginPasskeyFinish(ctx context.Context, request PostApiLoginPasskeyFinishRequestObject) (
+	PostApiLoginPasskeyFinishResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+	credential, err := json.Marshal(request.Body.Credential)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to encode credential", slog.Any("error", err))
+		return PostApiLoginPasskeyFinish401JSONResponse{
+			Message: "invalid passkey",
+			Status:  apierror.InvalidPasskey.Status(),
+			Code:    apierror.InvalidPasskey.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Verify passkey
+	s.Env.Logger.DebugContext(ctx, "finishing passkey login")
+	userid, err := s.Service.FinishPasskeyLogin(ctx, request.Body.SessionId, credential)
+	if errors.Is(err, mars.ErrInvalidPasskey) {
+		s.Env.Logger.ErrorContext(ctx, "invalid passkey", slog.Any("error", err))
+		return PostApiLoginPasskeyFinish401JSONResponse{
+			Message: "passkey could not be verified, or the login expired",
+			Status:  apierror.InvalidPasskey.Status(),
+			Code:    apierror.InvalidPasskey.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to finish passkey login", slog.Any("error", err))
+		return PostApiLoginPasskeyFinish500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Get role and token version for the access token
+	s.Env.Logger.DebugContext(ctx, "getting user")
+	user, err := s.Env.Database.GetUserAuth(ctx, userid)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get user", slog.Any("error", err))
+		return PostApiLoginPasskeyFinish500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	s.Env.Logger.DebugContext(ctx, "creating tokens")
+	res, err := s.newLoginSession(ctx, userid, user.Role, user.TokenVersion)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to create session", slog.Any("error", err))
+		return PostApiLoginPasskeyFinish500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	return res, nil
+}
@@ -0,0 +1,152 @@
+package openapi
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"mars/internal/api/clientinfo"
+	apierror "mars/internal/api/error"
+	"mars/internal/api/requestid"
+	"mars/internal/argon2id"
+	"mars/internal/mars"
+	"mars/internal/password"
+	"mars/internal/tokens"
+)
+
+func (s Server) PostApiMePassword(ctx context.Context, request PostApiMePasswordRequestObject) (
+	PostApiMePasswordResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return PostApiMePassword500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	sessionid, _ := tokens.SessionIDFromContext(ctx)
+
+	// Check current password
+	s.Env.Logger.DebugContext(ctx, "checking current password")
+	passwordHash, err := s.Env.Database.GetUserPasswordHash(ctx, userid)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get password hash", slog.Any("error", err))
+		return PostApiMePassword500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	hashParams, hashSalt, groundHash, err := argon2id.DecodeHash(passwordHash)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to decode password hash", slog.Any("error", err))
+		return PostApiMePassword500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	givenHash := argon2id.HashWithSalt(request.Body.CurrentPassword, *hashParams, hashSalt)
+	if subtle.ConstantTimeCompare(givenHash, groundHash) == 0 {
+		s.Env.Logger.ErrorContext(ctx, "current password does not match")
+		return PostApiMePassword401JSONResponse{
+			Message: "current password is incorrect",
+			Status:  apierror.InvalidCredentials.Status(),
+			Code:    apierror.InvalidCredentials.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Validate new password
+	s.Env.Logger.DebugContext(ctx, "validating password")
+	if err := password.ValidatePassword(request.Body.NewPassword); err != nil {
+		s.Env.Logger.ErrorContext(ctx, "password does not meet requirements", slog.Any("error", err))
+		return PostApiMePassword422JSONResponse{
+			Message: err.Error(),
+			Status:  apierror.WeakPassword.Status(),
+			Code:    apierror.WeakPassword.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Change password
+	s.Env.Logger.DebugContext(ctx, "changing password")
+	err = s.Service.ChangePassword(ctx, userid, sessionid, request.Body.NewPassword)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to change password", slog.Any("error", err))
+		return PostApiMePassword500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	s.Env.Logger.InfoContext(ctx, "changed password")
+
+	return PostApiMePassword204Response{}, nil
+}
+
+func (s Server) PostApiPasswordReset(ctx context.Context, request PostApiPasswordResetRequestObject) (
+	PostApiPasswordResetResponseObject, error,
+) {
+	email := strings.TrimSpace(strings.ToLower(string(request.Body.Email)))
+
+	// Send reset email. The response is the same whether or not the email
+	// is in use, or the email could be sent
+	s.Env.Logger.DebugContext(ctx, "sending password reset")
+	s.Service.SendPasswordReset(ctx, email, clientinfo.FromContext(ctx).IPAddress)
+
+	return PostApiPasswordReset202Response{}, nil
+}
+
+func (s Server) PostApiPasswordResetConfirm(ctx context.Context, request PostApiPasswordResetConfirmRequestObject) (
+	PostApiPasswordResetConfirmResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+
+	// Validate password
+	s.Env.Logger.DebugContext(ctx, "validating password")
+	if err := password.ValidatePassword(request.Body.NewPassword); err != nil {
+		s.Env.Logger.ErrorContext(ctx, "password does not meet requirements", slog.Any("error", err))
+		return PostApiPasswordResetConfirm422JSONResponse{
+			Message: err.Error(),
+			Status:  apierror.WeakPassword.Status(),
+			Code:    apierror.WeakPassword.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Reset password
+	s.Env.Logger.DebugContext(ctx, "resetting password")
+	err := s.Service.ResetPassword(ctx, request.Body.Token, request.Body.NewPassword)
+	if errors.Is(err, mars.ErrInvalidResetToken) {
+		s.Env.Logger.ErrorContext(ctx, "invalid password reset token", slog.Any("error", err))
+		return PostApiPasswordResetConfirm403JSONResponse{
+			Message: "reset token is invalid, has expired or has already been used",
+			Status:  apierror.InvalidResetToken.Status(),
+			Code:    apierror.InvalidResetToken.String(),
+			ErrorId: reqid,
+		}, nil
+	} else if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to reset password", slog.Any("error", err))
+		return PostApiPasswordResetConfirm500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	s.Env.Logger.InfoContext(ctx, "reset password")
+
+	return PostApiPasswordResetConfirm204Response{}, nil
+}
@@ -0,0 +1,133 @@
+package openapi
+
+import (
+	"context"
+	"log/slog"
+
+	apierror "mars/internal/api/error"
+	"mars/internal/api/requestid"
+	"mars/internal/database"
+	"mars/internal/playlist"
+	"mars/internal/tokens"
+)
+
+func playlistRulesResponse(rules playlist.Rules) PlaylistRules {
+	return PlaylistRules{
+		MaxTracks:          rules.MaxTracks,
+		MinPlays:           rules.MinPlays,
+		Ordering:           PlaylistOrdering(rules.Ordering),
+		MaxTracksPerArtist: rules.MaxTracksPerArtist,
+		NameTemplate:       rules.NameTemplate,
+	}
+}
+
+func (s Server) GetApiMePlaylistRules(
+	ctx context.Context, request GetApiMePlaylistRulesRequestObject,
+) (GetApiMePlaylistRulesResponseObject, error) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return GetApiMePlaylistRules500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Get rules
+	s.Env.Logger.DebugContext(ctx, "getting playlist rules")
+	rules, err := s.Service.PlaylistRules(ctx, userid)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get playlist rules", slog.Any("error", err))
+		return GetApiMePlaylistRules500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	return GetApiMePlaylistRules200JSONResponse(playlistRulesResponse(rules)), nil
+}
+
+func (s Server) PatchApiMePlaylistRules(
+	ctx context.Context, request PatchApiMePlaylistRulesRequestObject,
+) (PatchApiMePlaylistRulesResponseObject, error) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return PatchApiMePlaylistRules500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Get current rules
+	s.Env.Logger.DebugContext(ctx, "getting playlist rules")
+	rules, err := s.Service.PlaylistRules(ctx, userid)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get playlist rules", slog.Any("error", err))
+		return PatchApiMePlaylistRules500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Apply and validate changes
+	s.Env.Logger.DebugContext(ctx, "validating playlist rules")
+	if request.Body.MaxTracks != nil {
+		rules.MaxTracks = *request.Body.MaxTracks
+	}
+	if request.Body.MinPlays != nil {
+		rules.MinPlays = *request.Body.MinPlays
+	}
+	if request.Body.Ordering != nil {
+		rules.Ordering = playlist.Ordering(*request.Body.Ordering)
+	}
+	if request.Body.MaxTracksPerArtist != nil {
+		rules.MaxTracksPerArtist = *request.Body.MaxTracksPerArtist
+	}
+	if request.Body.NameTemplate != nil {
+		rules.NameTemplate = *request.Body.NameTemplate
+	}
+	if err := rules.Validate(); err != nil {
+		s.Env.Logger.ErrorContext(ctx, "invalid playlist rules", slog.Any("error", err))
+		return PatchApiMePlaylistRules400JSONResponse{
+			Message: err.Error(),
+			Status:  apierror.InvalidPlaylistRules.Status(),
+			Code:    apierror.InvalidPlaylistRules.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Store rules
+	s.Env.Logger.DebugContext(ctx, "storing playlist rules")
+	err = s.Env.Database.UpsertPlaylistRules(ctx, database.UpsertPlaylistRulesParams{
+		UserID:             userid,
+		MaxTracks:          int32(rules.MaxTracks),
+		MinPlays:           int32(rules.MinPlays),
+		Ordering:           string(rules.Ordering),
+		MaxTracksPerArtist: int32(rules.MaxTracksPerArtist),
+		NameTemplate:       rules.NameTemplate,
+	})
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to store playlist rules", slog.Any("error", err))
+		return PatchApiMePlaylistRules500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	s.Env.Logger.InfoContext(ctx, "updated playlist rules")
+
+	return PatchApiMePlaylistRules200JSONResponse(playlistRulesResponse(rules)), nil
+}
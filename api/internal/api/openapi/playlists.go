@@ -3,19 +3,19 @@ package openapi
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log/slog"
-	"strings"
 	"time"
 
 	apierror "mars/internal/api/error"
 	"mars/internal/api/requestid"
+	"mars/internal/calendar"
 	"mars/internal/database"
+	"mars/internal/mars"
+	"mars/internal/provider"
 	"mars/internal/tokens"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgtype"
 )
 
 func (s Server) PostApiPlaylists(
@@ -24,11 +24,33 @@ func (s Server) PostApiPlaylists(
 ) {
 	reqid := requestid.FromContext(ctx)
 
-	// Create start and end date
-	s.Env.Logger.DebugContext(ctx, "compute start and end dates")
-	loc, err := time.LoadLocation("America/New_York")
-	if err != nil {
-		s.Env.Logger.ErrorContext(ctx, "failed to load location", slog.Any("error", err))
+	// Parse request
+	var userid uuid.UUID
+	var playlistType string
+	weeklyOrMonthly, weeklyOrMonthlyErr := request.Body.AsWeeklyOrMonthlyRequest()
+	if weeklyOrMonthlyErr == nil {
+		userid = weeklyOrMonthly.UserId
+		playlistType = string(weeklyOrMonthly.Type)
+	}
+	custom, customErr := request.Body.AsCustomRequest()
+	if customErr == nil && custom.Type == "custom" {
+		userid = custom.UserId
+		playlistType = string(custom.Type)
+	}
+
+	// Load the user's timezone and week start
+	s.Env.Logger.DebugContext(ctx, "getting user calendar")
+	loc, weekStart, err := s.Service.UserCalendar(ctx, userid)
+	if errors.Is(err, pgx.ErrNoRows) {
+		s.Env.Logger.ErrorContext(ctx, "user does not exist", slog.Any("error", err))
+		return PostApiPlaylists404JSONResponse{
+			Message: "user not found",
+			Status:  apierror.UserNotFound.Status(),
+			Code:    apierror.UserNotFound.String(),
+			ErrorId: reqid,
+		}, nil
+	} else if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get user calendar", slog.Any("error", err))
 		return PostApiPlaylists500JSONResponse{
 			Message: "internal server error",
 			Status:  apierror.InternalServerError.Status(),
@@ -37,53 +59,43 @@ func (s Server) PostApiPlaylists(
 		}, nil
 	}
 
+	// Create start and end date in the user's timezone
+	s.Env.Logger.DebugContext(ctx, "compute start and end dates")
 	var startDate time.Time
 	var endDate time.Time
-	var userid uuid.UUID
-	var playlistType string
-	if req, err := request.Body.AsWeeklyOrMonthlyRequest(); err == nil {
-		userid = req.UserId
-		playlistType = string(req.Type)
-		switch req.Type {
-		case "weekly":
-			startDate = time.Date(req.StartDate.Year, time.Month(req.StartDate.Month), req.StartDate.Day, 0, 0, 0, 0, loc)
-			endDate = startDate.AddDate(0, 0, 7)
-		case "monthly":
-			startDate = time.Date(req.StartDate.Year, time.Month(req.StartDate.Month), 1, 0, 0, 0, 0, loc)
-			endDate = startDate.AddDate(0, 1, 0)
+	switch playlistType {
+	case "weekly", "monthly", "yearly":
+		if date := weeklyOrMonthly.StartDate; date != nil {
+			startDate, endDate = calendar.Containing(mars.PlaylistPeriods[playlistType],
+				time.Date(date.Year, time.Month(date.Month), date.Day, 0, 0, 0, 0, loc), weekStart)
+		} else {
+			asOf := time.Now()
+			if weeklyOrMonthly.AsOf != nil {
+				asOf = *weeklyOrMonthly.AsOf
+			}
+			startDate, endDate = mars.LatestPeriod(playlistType, asOf.In(loc), weekStart)
 		}
-	}
-	if req, err := request.Body.AsCustomRequest(); err == nil && req.Type == "custom" {
-		playlistType = string(req.Type)
-		userid = req.UserId
-		startDate = time.Date(req.StartDate.Year, time.Month(req.StartDate.Month), req.StartDate.Day, 0, 0, 0, 0, loc)
-		endDate = time.Date(req.EndDate.Year, time.Month(req.EndDate.Month), req.EndDate.Day, 0, 0, 0, 0, loc)
+	case "rolling_30d":
+		if date := weeklyOrMonthly.StartDate; date != nil {
+			startDate = time.Date(date.Year, time.Month(date.Month), date.Day, 0, 0, 0, 0, loc)
+			endDate = startDate.AddDate(0, 0, mars.RollingPlaylistDays)
+		} else {
+			asOf := time.Now()
+			if weeklyOrMonthly.AsOf != nil {
+				asOf = *weeklyOrMonthly.AsOf
+			}
+			startDate, endDate = mars.LatestPeriod(playlistType, asOf.In(loc), weekStart)
+		}
+	case "custom":
+		startDate = time.Date(custom.StartDate.Year, time.Month(custom.StartDate.Month), custom.StartDate.Day,
+			0, 0, 0, 0, loc)
+		endDate = time.Date(custom.EndDate.Year, time.Month(custom.EndDate.Month), custom.EndDate.Day,
+			0, 0, 0, 0, loc)
 	}
 
-	// Query the data
-	s.Env.Logger.DebugContext(ctx,
-		"querying tracks within range", slog.Group("range", slog.Time("start", startDate), slog.Time("end", endDate)))
-	rows, err := s.Env.Database.TopTrackIDsByUserInRange(ctx, database.TopTrackIDsByUserInRangeParams{
-		UserID: userid,
-		StartDate: pgtype.Timestamptz{
-			Time:  startDate,
-			Valid: true,
-		},
-		EndDate: pgtype.Timestamptz{
-			Time:  endDate,
-			Valid: true,
-		},
-	})
-	if err != nil {
-		s.Env.Logger.ErrorContext(ctx, "failed to query tracks within range", slog.Any("error", err))
-		return PostApiPlaylists500JSONResponse{
-			Message: "internal server error",
-			Status:  apierror.InternalServerError.Status(),
-			Code:    apierror.InternalServerError.String(),
-			ErrorId: reqid,
-		}, nil
-	}
-	if len(rows) == 0 {
+	// Create the playlist
+	playlistID, err := s.Service.CreatePlaylist(ctx, userid, playlistType, startDate, endDate)
+	if errors.Is(err, mars.ErrNoTracks) {
 		s.Env.Logger.ErrorContext(ctx, "no tracks listened to in this range - not creating playlist")
 		return PostApiPlaylists409JSONResponse{
 			Message: "no tracks listened to in this range",
@@ -91,33 +103,15 @@ func (s Server) PostApiPlaylists(
 			Code:    apierror.NoTracksListened.String(),
 			ErrorId: reqid,
 		}, nil
-	}
-
-	// Create playlist and add tracks in a transaction
-	s.Env.Logger.DebugContext(ctx, "create playlist and add tracks")
-	tx, err := s.Env.Pool.Begin(ctx)
-	if err != nil {
-		s.Env.Logger.ErrorContext(ctx, "failed to begin transaction", slog.Any("error", err))
-		return PostApiPlaylists500JSONResponse{
-			Message: "internal server error",
-			Status:  apierror.InternalServerError.Status(),
-			Code:    apierror.InternalServerError.String(),
+	} else if errors.Is(err, mars.ErrPlaylistExists) {
+		s.Env.Logger.ErrorContext(ctx, "playlist already exists for this period - not creating playlist")
+		return PostApiPlaylists409JSONResponse{
+			Message: "playlist already exists for this period",
+			Status:  apierror.PlaylistAlreadyExists.Status(),
+			Code:    apierror.PlaylistAlreadyExists.String(),
 			ErrorId: reqid,
 		}, nil
-	}
-	defer func() { _ = tx.Rollback(ctx) }()
-	qtx := database.New(tx)
-
-	playlistName := fmt.Sprintf("%s %d, %d",
-		strings.ToLower(startDate.Month().String())[:3],
-		startDate.Day(),
-		startDate.Year())
-	playlistID, err := qtx.CreatePlaylist(ctx, database.CreatePlaylistParams{
-		UserID:       userid,
-		Name:         playlistName,
-		PlaylistType: database.PlaylistType(playlistType),
-	})
-	if err != nil {
+	} else if err != nil {
 		s.Env.Logger.ErrorContext(ctx, "failed to create playlist", slog.Any("error", err))
 		return PostApiPlaylists500JSONResponse{
 			Message: "internal server error",
@@ -127,44 +121,6 @@ func (s Server) PostApiPlaylists(
 		}, nil
 	}
 
-	// Add tracks to the playlist
-	for _, row := range rows {
-		err := qtx.AddPlaylistTrack(ctx, database.AddPlaylistTrackParams{
-			PlaylistID: playlistID,
-			TrackID:    row.TrackID,
-			Plays:      int32(row.ListenCount),
-		})
-		if err != nil {
-			s.Env.Logger.ErrorContext(ctx, "failed to add track to playlist",
-				slog.Any("error", err),
-				slog.String("track_id", row.TrackID),
-			)
-			return PostApiPlaylists500JSONResponse{
-				Message: "internal server error",
-				Status:  apierror.InternalServerError.Status(),
-				Code:    apierror.InternalServerError.String(),
-				ErrorId: reqid,
-			}, nil
-		}
-	}
-
-	// Commit the transaction
-	if err := tx.Commit(ctx); err != nil {
-		s.Env.Logger.ErrorContext(ctx, "failed to commit transaction", slog.Any("error", err))
-		return PostApiPlaylists500JSONResponse{
-			Message: "internal server error",
-			Status:  apierror.InternalServerError.Status(),
-			Code:    apierror.InternalServerError.String(),
-			ErrorId: reqid,
-		}, nil
-	}
-
-	s.Env.Logger.InfoContext(ctx, "created monthly playlist",
-		slog.String("playlist_id", playlistID.String()),
-		slog.String("name", playlistName),
-		slog.Int("track_count", len(rows)),
-	)
-
 	return PostApiPlaylists201JSONResponse{
 		Id: playlistID,
 	}, nil
@@ -261,13 +217,34 @@ func (s Server) GetApiPlaylistsId(
 		}, nil
 	}
 
+	export, err := s.Env.Database.GetPlaylistExport(ctx, database.GetPlaylistExportParams{
+		PlaylistID: playlist.ID,
+		Provider:   provider.Spotify,
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		s.Env.Logger.ErrorContext(ctx, "failed to get spotify playlist", slog.Any("error", err))
+		return GetApiPlaylistsId500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
 	// Return response
 	res := GetApiPlaylistsId200JSONResponse{
-		Id:        playlist.ID,
-		Name:      playlist.Name,
-		Type:      string(playlist.PlaylistType),
-		Tracks:    make([]PlaylistTrack, len(tracks)),
-		CreatedAt: playlist.CreatedAt.Time,
+		Id:          playlist.ID,
+		Name:        playlist.Name,
+		Description: playlist.Description,
+		Type:        string(playlist.PlaylistType),
+		Tracks:      make([]PlaylistTrack, len(tracks)),
+		CreatedAt:   playlist.CreatedAt.Time,
+	}
+	if err == nil {
+		res.SpotifyPlaylist = &SpotifyPlaylist{
+			Id:  export.RemoteID,
+			Url: export.Url,
+		}
 	}
 	for i, t := range tracks {
 		res.Tracks[i] = PlaylistTrack{
@@ -277,6 +254,9 @@ func (s Server) GetApiPlaylistsId(
 			Href:    t.Href,
 			Plays:   int(t.Plays),
 		}
+		if t.DurationMs.Valid {
+			res.Tracks[i].MinutesListened = minutesListened(int64(t.Plays) * int64(t.DurationMs.Int32))
+		}
 		if t.ImageUrl.Valid {
 			res.Tracks[i].ImageUrl = &t.ImageUrl.String
 		}
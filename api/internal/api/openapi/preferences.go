@@ -0,0 +1,124 @@
+package openapi
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	apierror "mars/internal/api/error"
+	"mars/internal/api/requestid"
+	"mars/internal/calendar"
+	"mars/internal/database"
+	"mars/internal/tokens"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (s Server) GetApiMePreferences(
+	ctx context.Context, request GetApiMePreferencesRequestObject,
+) (GetApiMePreferencesResponseObject, error) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return GetApiMePreferences500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Get preferences
+	s.Env.Logger.DebugContext(ctx, "getting user preferences")
+	prefs, err := s.Env.Database.GetUserPreferences(ctx, userid)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get user preferences", slog.Any("error", err))
+		return GetApiMePreferences500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	return GetApiMePreferences200JSONResponse{
+		Timezone:  prefs.Timezone,
+		WeekStart: Weekday(calendar.FormatWeekday(time.Weekday(prefs.WeekStart))),
+	}, nil
+}
+
+func (s Server) PatchApiMePreferences(
+	ctx context.Context, request PatchApiMePreferencesRequestObject,
+) (PatchApiMePreferencesResponseObject, error) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return PatchApiMePreferences500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Validate preferences
+	s.Env.Logger.DebugContext(ctx, "validating preferences")
+	params := database.UpdateUserPreferencesParams{
+		ID: userid,
+	}
+	if request.Body.Timezone != nil {
+		if _, err := calendar.LoadLocation(*request.Body.Timezone); err != nil {
+			s.Env.Logger.ErrorContext(ctx, "invalid timezone", slog.Any("error", err))
+			return PatchApiMePreferences400JSONResponse{
+				Message: "invalid timezone",
+				Status:  apierror.InvalidTimezone.Status(),
+				Code:    apierror.InvalidTimezone.String(),
+				ErrorId: reqid,
+			}, nil
+		}
+		params.Timezone = pgtype.Text{
+			String: *request.Body.Timezone,
+			Valid:  true,
+		}
+	}
+	if request.Body.WeekStart != nil {
+		weekStart, err := calendar.ParseWeekday(string(*request.Body.WeekStart))
+		if err != nil {
+			s.Env.Logger.ErrorContext(ctx, "invalid week start", slog.Any("error", err))
+			return PatchApiMePreferences400JSONResponse{
+				Message: "invalid week start",
+				Status:  apierror.BadRequest.Status(),
+				Code:    apierror.BadRequest.String(),
+				ErrorId: reqid,
+			}, nil
+		}
+		params.WeekStart = pgtype.Int2{
+			Int16: int16(weekStart),
+			Valid: true,
+		}
+	}
+
+	// Update preferences
+	s.Env.Logger.DebugContext(ctx, "updating user preferences")
+	prefs, err := s.Env.Database.UpdateUserPreferences(ctx, params)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to update user preferences", slog.Any("error", err))
+		return PatchApiMePreferences500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	s.Env.Logger.InfoContext(ctx, "updated user preferences",
+		slog.String("timezone", prefs.Timezone),
+		slog.Int("week_start", int(prefs.WeekStart)))
+
+	return PatchApiMePreferences200JSONResponse{
+		Timezone:  prefs.Timezone,
+		WeekStart: Weekday(calendar.FormatWeekday(time.Weekday(prefs.WeekStart))),
+	}, nil
+}
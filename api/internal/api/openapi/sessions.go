@@ -0,0 +1,104 @@
+package openapi
+
+import (
+	"context"
+	"log/slog"
+
+	apierror "mars/internal/api/error"
+	"mars/internal/api/requestid"
+	"mars/internal/database"
+	"mars/internal/tokens"
+)
+
+func (s Server) GetApiMeSessions(ctx context.Context, request GetApiMeSessionsRequestObject) (
+	GetApiMeSessionsResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return GetApiMeSessions500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	current, _ := tokens.SessionIDFromContext(ctx)
+
+	// Get sessions
+	s.Env.Logger.DebugContext(ctx, "getting sessions")
+	rows, err := s.Env.Database.ListUserSessions(ctx, userid)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get sessions", slog.Any("error", err))
+		return GetApiMeSessions500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	sessions := make([]Session, 0, len(rows))
+	for _, row := range rows {
+		sessions = append(sessions, Session{
+			Id:         row.ID,
+			UserAgent:  row.UserAgent,
+			IpAddress:  row.IpAddress,
+			CreatedAt:  row.CreatedAt.Time,
+			LastUsedAt: row.LastUsedAt.Time,
+			ExpiresAt:  row.ExpiresAt.Time,
+			Current:    row.ID == current,
+		})
+	}
+
+	return GetApiMeSessions200JSONResponse{
+		Sessions: sessions,
+	}, nil
+}
+
+func (s Server) DeleteApiMeSessionsId(ctx context.Context, request DeleteApiMeSessionsIdRequestObject) (
+	DeleteApiMeSessionsIdResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return DeleteApiMeSessionsId500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Delete session. Access tokens already issued for it stay valid until
+	// they expire, but it can no longer be refreshed.
+	s.Env.Logger.DebugContext(ctx, "deleting session")
+	deleted, err := s.Env.Database.DeleteUserSession(ctx, database.DeleteUserSessionParams{
+		ID:     request.Id,
+		UserID: userid,
+	})
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to delete session", slog.Any("error", err))
+		return DeleteApiMeSessionsId500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	if deleted == 0 {
+		s.Env.Logger.ErrorContext(ctx, "session not found", slog.String("session-id", request.Id.String()))
+		return DeleteApiMeSessionsId404JSONResponse{
+			Message: "session not found",
+			Status:  apierror.SessionNotFound.Status(),
+			Code:    apierror.SessionNotFound.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	s.Env.Logger.InfoContext(ctx, "deleted session", slog.String("session-id", request.Id.String()))
+
+	return DeleteApiMeSessionsId204Response{}, nil
+}
@@ -0,0 +1,246 @@
+package openapi
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	apierror "mars/internal/api/error"
+	"mars/internal/database"
+	"mars/internal/database/databasetest"
+	"mars/internal/provider"
+	"mars/internal/provider/spotify/spotifytest"
+	"mars/internal/tokens"
+
+	"github.com/google/uuid"
+)
+
+const testAccountID = "mars-user"
+
+// spotifyTest is a server whose Spotify provider talks to a fake Spotify,
+// with a user who has linked an account.
+type spotifyTest struct {
+	server  Server
+	spotify *spotifytest.Server
+	db      *databasetest.Querier
+	userID  uuid.UUID
+}
+
+func newSpotifyTest(t *testing.T) spotifyTest {
+	e, fake, db := spotifytest.NewEnv(t)
+	return spotifyTest{
+		server:  NewServer(e),
+		spotify: fake,
+		db:      db,
+		userID:  fake.LinkUser(db, testAccountID),
+	}
+}
+
+func testTrack(i int) provider.Track {
+	id := fmt.Sprintf("track%d", i)
+	artistID := fmt.Sprintf("artist%d", i%3)
+	return provider.Track{
+		ID:   id,
+		Name: "Track " + id,
+		Artists: []provider.Artist{{
+			ID:   artistID,
+			Name: "Artist " + artistID,
+			URI:  "spotify:artist:" + artistID,
+		}},
+		Album: provider.Album{
+			ID:   "album1",
+			Name: "Album",
+		},
+		URI:        "spotify:track:" + id,
+		DurationMS: 180000,
+	}
+}
+
+func TestPostApiIntegrationsSpotifyPlaylist(t *testing.T) {
+	e := newSpotifyTest(t)
+	playlist := databasetest.NewPlaylist("Oct 2026")
+	e.db.Playlists[playlist.ID] = playlist
+	e.db.PlaylistTracks[playlist.ID] = []database.GetPlaylistTracksRow{
+		{ID: "track1", Uri: "spotify:track:track1"},
+		// Listened to on another player, so it can't be exported
+		{ID: "other", Uri: "listenbrainz:other"},
+		{ID: "track2", Uri: "spotify:track:track2"},
+	}
+	request := PostApiIntegrationsSpotifyPlaylistRequestObject{
+		Body: &PostApiIntegrationsSpotifyPlaylistJSONRequestBody{
+			UserId:     e.userID,
+			PlaylistId: playlist.ID,
+		},
+	}
+
+	res, err := e.server.PostApiIntegrationsSpotifyPlaylist(context.Background(), request)
+	if err != nil {
+		t.Fatalf("PostApiIntegrationsSpotifyPlaylist() = %v", err)
+	}
+	created, ok := res.(PostApiIntegrationsSpotifyPlaylist201JSONResponse)
+	if !ok {
+		t.Fatalf("first export responded with %#v, want 201", res)
+	}
+	got, _ := e.spotify.Playlist(created.Id)
+	if want := []string{"spotify:track:track1", "spotify:track:track2"}; !slices.Equal(got.Tracks, want) {
+		t.Errorf("spotify playlist tracks = %v, want %v", got.Tracks, want)
+	}
+
+	res, err = e.server.PostApiIntegrationsSpotifyPlaylist(context.Background(), request)
+	if err != nil {
+		t.Fatalf("PostApiIntegrationsSpotifyPlaylist() = %v", err)
+	}
+	if updated, ok := res.(PostApiIntegrationsSpotifyPlaylist200JSONResponse); !ok || updated.Id != created.Id {
+		t.Errorf("second export responded with %#v, want 200 for playlist %s", res, created.Id)
+	}
+}
+
+func TestPostApiIntegrationsSpotifyPlaylistIdWithoutSpotifyTracks(t *testing.T) {
+	e := newSpotifyTest(t)
+	playlist := databasetest.NewPlaylist("Oct 2026")
+	e.db.Playlists[playlist.ID] = playlist
+	e.db.PlaylistTracks[playlist.ID] = []database.GetPlaylistTracksRow{
+		{ID: "other", Uri: "listenbrainz:other"},
+	}
+	ctx := tokens.UserIDWithContext(context.Background(), e.userID)
+
+	res, err := e.server.PostApiIntegrationsSpotifyPlaylistId(ctx, PostApiIntegrationsSpotifyPlaylistIdRequestObject{
+		Id: playlist.ID,
+	})
+	if err != nil {
+		t.Fatalf("PostApiIntegrationsSpotifyPlaylistId() = %v", err)
+	}
+	if conflict, ok := res.(PostApiIntegrationsSpotifyPlaylistId409JSONResponse); !ok ||
+		conflict.Code != apierror.NoExportableTracks.String() {
+		t.Errorf("export responded with %#v, want 409 %s", res, apierror.NoExportableTracks)
+	}
+	if n := e.spotify.Playlists(); n != 0 {
+		t.Errorf("created %d spotify playlists, want none", n)
+	}
+}
+
+func TestPostApiIntegrationsSpotifyTracksSync(t *testing.T) {
+	e := newSpotifyTest(t)
+	start := time.Date(2026, time.October, 1, 12, 0, 0, 0, time.UTC)
+	// More than a page of plays, so the sync has to page through them
+	const plays = 120
+	for i := range plays {
+		e.spotify.Play(testAccountID, testTrack(i), start.Add(time.Duration(i)*time.Minute))
+	}
+	request := PostApiIntegrationsSpotifyTracksSyncRequestObject{
+		Body: &PostApiIntegrationsSpotifyTracksSyncJSONRequestBody{UserId: e.userID},
+	}
+
+	res, err := e.server.PostApiIntegrationsSpotifyTracksSync(context.Background(), request)
+	if err != nil {
+		t.Fatalf("PostApiIntegrationsSpotifyTracksSync() = %v", err)
+	}
+	if _, ok := res.(PostApiIntegrationsSpotifyTracksSync204Response); !ok {
+		t.Fatalf("sync responded with %#v, want 204", res)
+	}
+
+	if len(e.db.Listens) != plays {
+		t.Errorf("stored %d listens, want %d", len(e.db.Listens), plays)
+	}
+	if len(e.db.Tracks) != plays {
+		t.Errorf("stored %d tracks, want %d", len(e.db.Tracks), plays)
+	}
+	state := e.db.SyncStates[e.userID]
+	if want := start.Add((plays - 1) * time.Minute); !state.PlayedAtCursor.Time.Equal(want) {
+		t.Errorf("sync cursor = %s, want the last play at %s", state.PlayedAtCursor.Time, want)
+	}
+	if state.LastItemsInserted != plays {
+		t.Errorf("sync inserted %d items, want %d", state.LastItemsInserted, plays)
+	}
+	for id := range e.db.Artists {
+		if e.db.ArtistImages[id] != e.spotify.ImageURL(id+".jpg") {
+			t.Errorf("artist %s has image %q, want the image from spotify", id, e.db.ArtistImages[id])
+		}
+	}
+
+	// Syncing again picks up only the new play
+	e.spotify.Play(testAccountID, testTrack(plays), start.Add(plays*time.Minute))
+	if _, err := e.server.PostApiIntegrationsSpotifyTracksSync(context.Background(), request); err != nil {
+		t.Fatalf("second PostApiIntegrationsSpotifyTracksSync() = %v", err)
+	}
+	if state := e.db.SyncStates[e.userID]; state.LastItemsFetched != 1 || state.LastItemsInserted != 1 {
+		t.Errorf("second sync fetched %d and inserted %d items, want 1 and 1",
+			state.LastItemsFetched, state.LastItemsInserted)
+	}
+}
+
+func TestPostApiIntegrationsSpotifyTracksSyncExpiredToken(t *testing.T) {
+	e := newSpotifyTest(t)
+	e.spotify.Play(testAccountID, testTrack(1), time.Now())
+	e.spotify.ExpireTokens()
+
+	res, err := e.server.PostApiIntegrationsSpotifyTracksSync(context.Background(),
+		PostApiIntegrationsSpotifyTracksSyncRequestObject{
+			Body: &PostApiIntegrationsSpotifyTracksSyncJSONRequestBody{UserId: e.userID},
+		})
+	if err != nil {
+		t.Fatalf("PostApiIntegrationsSpotifyTracksSync() = %v", err)
+	}
+	if _, ok := res.(PostApiIntegrationsSpotifyTracksSync500JSONResponse); !ok {
+		t.Fatalf("sync with an expired token responded with %#v, want 500", res)
+	}
+	if state := e.db.SyncStates[e.userID]; !state.LastError.Valid {
+		t.Error("sync failure was not recorded")
+	}
+}
+
+func TestPostApiOauthSpotifyTokenRefresh(t *testing.T) {
+	e := newSpotifyTest(t)
+	before := e.db.Tokens[e.userID]
+	e.spotify.ExpireTokens()
+
+	res, err := e.server.PostApiOauthSpotifyTokenRefresh(context.Background(),
+		PostApiOauthSpotifyTokenRefreshRequestObject{
+			Body: &PostApiOauthSpotifyTokenRefreshJSONRequestBody{UserId: e.userID},
+		})
+	if err != nil {
+		t.Fatalf("PostApiOauthSpotifyTokenRefresh() = %v", err)
+	}
+	if _, ok := res.(PostApiOauthSpotifyTokenRefresh204Response); !ok {
+		t.Fatalf("refresh responded with %#v, want 204", res)
+	}
+
+	after := e.db.Tokens[e.userID]
+	if after.AccessToken == before.AccessToken {
+		t.Error("access token was not refreshed")
+	}
+	// Spotify didn't rotate the refresh token, so the old one is kept
+	if after.RefreshToken != before.RefreshToken {
+		t.Errorf("refresh token = %q, want the old %q", after.RefreshToken, before.RefreshToken)
+	}
+
+	// The new access token works against the API
+	e.spotify.Play(testAccountID, testTrack(1), time.Now())
+	res2, err := e.server.PostApiIntegrationsSpotifyTracksSync(context.Background(),
+		PostApiIntegrationsSpotifyTracksSyncRequestObject{
+			Body: &PostApiIntegrationsSpotifyTracksSyncJSONRequestBody{UserId: e.userID},
+		})
+	if err != nil {
+		t.Fatalf("PostApiIntegrationsSpotifyTracksSync() = %v", err)
+	}
+	if _, ok := res2.(PostApiIntegrationsSpotifyTracksSync204Response); !ok {
+		t.Errorf("sync after refresh responded with %#v, want 204", res2)
+	}
+}
+
+func TestPostApiOauthSpotifyTokenRefreshNotLinked(t *testing.T) {
+	e := newSpotifyTest(t)
+
+	res, err := e.server.PostApiOauthSpotifyTokenRefresh(context.Background(),
+		PostApiOauthSpotifyTokenRefreshRequestObject{
+			Body: &PostApiOauthSpotifyTokenRefreshJSONRequestBody{UserId: uuid.New()},
+		})
+	if err != nil {
+		t.Fatalf("PostApiOauthSpotifyTokenRefresh() = %v", err)
+	}
+	if _, ok := res.(PostApiOauthSpotifyTokenRefresh404JSONResponse); !ok {
+		t.Errorf("refresh of an unlinked user responded with %#v, want 404", res)
+	}
+}
@@ -0,0 +1,423 @@
+package openapi
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	apierror "mars/internal/api/error"
+	"mars/internal/api/requestid"
+	"mars/internal/calendar"
+	"mars/internal/database"
+	"mars/internal/log"
+	"mars/internal/tokens"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	daysPerWeek   = 7
+	hoursPerDay   = 24
+	monthsPerYear = 12
+)
+
+// streak converts a run of listening days into its API representation.
+func streak(row database.UserListenStreaksRow) *Streak {
+	return &Streak{
+		Start: openapi_types.Date{Time: row.StartDay.Time},
+		End:   openapi_types.Date{Time: row.EndDay.Time},
+		Days:  int(row.Days),
+	}
+}
+
+// streaks returns the current and longest of the runs of listening days, which
+// are ordered by start day. The current streak is the run that reaches the last
+// day of the range, or the day before it so that a streak isn't broken before
+// the user has had a chance to listen that day.
+func streaks(rows []database.UserListenStreaksRow, lastDay time.Time) (current, longest *Streak) {
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	longestRow := rows[0]
+	for _, row := range rows[1:] {
+		if row.Days > longestRow.Days {
+			longestRow = row
+		}
+	}
+
+	last := rows[len(rows)-1]
+	if !last.EndDay.Time.Before(lastDay.AddDate(0, 0, -1)) {
+		current = streak(last)
+	}
+	return current, streak(longestRow)
+}
+
+func (s Server) GetApiMeStats(
+	ctx context.Context, request GetApiMeStatsRequestObject,
+) (GetApiMeStatsResponseObject, error) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return GetApiMeStats500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Get user timezone
+	s.Env.Logger.DebugContext(ctx, "getting user calendar")
+	loc, _, err := s.Service.UserCalendar(ctx, userid)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get user calendar", slog.Any("error", err))
+		return GetApiMeStats500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Compute start and end time
+	s.Env.Logger.DebugContext(ctx, "computing start and end times")
+	startTime, endTime, err := s.topRange(ctx, userid, request.Params.Start, request.Params.End, request.Params.Period)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get user calendar", slog.Any("error", err))
+		return GetApiMeStats500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	ctx = log.AppendCtx(ctx, slog.Time("start", startTime.Time))
+	ctx = log.AppendCtx(ctx, slog.Time("end", endTime.Time))
+	s.Env.Logger.DebugContext(ctx, "computed")
+
+	if endTime.Time.Before(startTime.Time) {
+		s.Env.Logger.ErrorContext(ctx, "end time is before start time")
+		return GetApiMeStats400JSONResponse{
+			Message: "end should be after start",
+			Status:  apierror.BadRequest.Status(),
+			Code:    apierror.BadRequest.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Get totals
+	s.Env.Logger.DebugContext(ctx, "getting listen totals")
+	totals, err := s.Env.Database.GetUserListenStats(ctx, database.GetUserListenStatsParams{
+		UserID:    userid,
+		StartDate: startTime,
+		EndDate:   endTime,
+	})
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get listen totals", slog.Any("error", err))
+		return GetApiMeStats500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Get heatmap
+	s.Env.Logger.DebugContext(ctx, "getting listen heatmap")
+	cells, err := s.Env.Database.UserListenHeatmap(ctx, database.UserListenHeatmapParams{
+		UserID:    userid,
+		Timezone:  loc.String(),
+		StartDate: startTime,
+		EndDate:   endTime,
+	})
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get listen heatmap", slog.Any("error", err))
+		return GetApiMeStats500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Get streaks
+	s.Env.Logger.DebugContext(ctx, "getting listen streaks")
+	runs, err := s.Env.Database.UserListenStreaks(ctx, database.UserListenStreaksParams{
+		UserID:    userid,
+		Timezone:  loc.String(),
+		StartDate: startTime,
+		EndDate:   endTime,
+	})
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get listen streaks", slog.Any("error", err))
+		return GetApiMeStats500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	resp := GetApiMeStats200JSONResponse{
+		Start:         startTime.Time,
+		End:           endTime.Time,
+		Timezone:      loc.String(),
+		TotalPlays:    totals.TotalPlays,
+		UniqueTracks:  totals.UniqueTracks,
+		UniqueArtists: totals.UniqueArtists,
+		NewTracks:     totals.NewTracks,
+		NewArtists:    totals.NewArtists,
+		Heatmap:       make([][]int64, daysPerWeek),
+	}
+	for day := range resp.Heatmap {
+		resp.Heatmap[day] = make([]int64, hoursPerDay)
+	}
+	for _, cell := range cells {
+		resp.Heatmap[cell.DayOfWeek][cell.Hour] = cell.Plays
+	}
+
+	// The range end is exclusive, so its last day is the day of the instant before it
+	last := endTime.Time.Add(-time.Nanosecond).In(loc)
+	lastDay := time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, time.UTC)
+	resp.CurrentStreak, resp.LongestStreak = streaks(runs, lastDay)
+
+	return resp, nil
+}
+
+const (
+	// yearInReviewTracks is the number of top tracks in a year in review.
+	yearInReviewTracks = 100
+	// yearInReviewTopN is the number of top artists and albums in a year in review.
+	yearInReviewTopN = 10
+)
+
+func (s Server) GetApiMeYearInReviewYear(
+	ctx context.Context, request GetApiMeYearInReviewYearRequestObject,
+) (GetApiMeYearInReviewYearResponseObject, error) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return GetApiMeYearInReviewYear500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	ctx = log.AppendCtx(ctx, slog.Int("year", request.Year))
+
+	// Get user timezone
+	s.Env.Logger.DebugContext(ctx, "getting user calendar")
+	loc, _, err := s.Service.UserCalendar(ctx, userid)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get user calendar", slog.Any("error", err))
+		return GetApiMeYearInReviewYear500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	start, end := calendar.Containing(calendar.Year, time.Date(request.Year, time.January, 1, 0, 0, 0, 0, loc), 0)
+	startTime := pgtype.Timestamptz{Time: start, Valid: true}
+	endTime := pgtype.Timestamptz{Time: end, Valid: true}
+
+	// Get totals
+	s.Env.Logger.DebugContext(ctx, "getting listen totals")
+	totals, err := s.Env.Database.GetUserListenStats(ctx, database.GetUserListenStatsParams{
+		UserID:    userid,
+		StartDate: startTime,
+		EndDate:   endTime,
+	})
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get listen totals", slog.Any("error", err))
+		return GetApiMeYearInReviewYear500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Get months
+	s.Env.Logger.DebugContext(ctx, "getting listens by month")
+	months, err := s.Env.Database.UserListenMonths(ctx, database.UserListenMonthsParams{
+		UserID:    userid,
+		Timezone:  loc.String(),
+		StartDate: startTime,
+		EndDate:   endTime,
+	})
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get listens by month", slog.Any("error", err))
+		return GetApiMeYearInReviewYear500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Get streaks
+	s.Env.Logger.DebugContext(ctx, "getting listen streaks")
+	runs, err := s.Env.Database.UserListenStreaks(ctx, database.UserListenStreaksParams{
+		UserID:    userid,
+		Timezone:  loc.String(),
+		StartDate: startTime,
+		EndDate:   endTime,
+	})
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get listen streaks", slog.Any("error", err))
+		return GetApiMeYearInReviewYear500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Get top tracks, artists and albums
+	s.Env.Logger.DebugContext(ctx, "getting top tracks")
+	tracks, err := s.Env.Database.TopTracksByUserInRange(ctx, database.TopTracksByUserInRangeParams{
+		UserID:    userid,
+		StartDate: startTime,
+		EndDate:   endTime,
+		RankBy:    string(RankByPlays),
+		MaxRows:   yearInReviewTracks,
+	})
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get top tracks", slog.Any("error", err))
+		return GetApiMeYearInReviewYear500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	s.Env.Logger.DebugContext(ctx, "getting top artists")
+	artists, err := s.Env.Database.TopArtistsByUserInRange(ctx, database.TopArtistsByUserInRangeParams{
+		UserID:    userid,
+		StartDate: startTime,
+		EndDate:   endTime,
+	})
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get top artists", slog.Any("error", err))
+		return GetApiMeYearInReviewYear500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	s.Env.Logger.DebugContext(ctx, "getting top albums")
+	albums, err := s.Env.Database.TopAlbumsByUserInRange(ctx, database.TopAlbumsByUserInRangeParams{
+		UserID:    userid,
+		StartDate: startTime,
+		EndDate:   endTime,
+	})
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get top albums", slog.Any("error", err))
+		return GetApiMeYearInReviewYear500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Get yearly playlist
+	s.Env.Logger.DebugContext(ctx, "getting yearly playlist")
+	playlistID, err := s.Env.Database.GetUserPlaylistByPeriod(ctx, database.GetUserPlaylistByPeriodParams{
+		UserID:       userid,
+		PlaylistType: database.PlaylistTypeYearly,
+		PeriodStart:  startTime,
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		s.Env.Logger.ErrorContext(ctx, "failed to get yearly playlist", slog.Any("error", err))
+		return GetApiMeYearInReviewYear500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	resp := GetApiMeYearInReviewYear200JSONResponse{
+		Year:          request.Year,
+		Timezone:      loc.String(),
+		TotalPlays:    totals.TotalPlays,
+		UniqueTracks:  totals.UniqueTracks,
+		UniqueArtists: totals.UniqueArtists,
+		NewTracks:     totals.NewTracks,
+		NewArtists:    totals.NewArtists,
+		Months:        make([]MonthInReview, monthsPerYear),
+		TopTracks:     make([]PlaylistTrack, len(tracks)),
+		TopArtists:    make([]TopArtist, min(len(artists), yearInReviewTopN)),
+		TopAlbums:     make([]TopAlbum, min(len(albums), yearInReviewTopN)),
+	}
+	if err == nil {
+		resp.PlaylistId = &playlistID
+	}
+	_, resp.LongestStreak = streaks(runs, end)
+
+	var listenedMs int64
+	for i := range resp.Months {
+		resp.Months[i].Month = i + 1
+	}
+	for _, m := range months {
+		resp.Months[m.Month-1].Plays = m.Plays
+		if minutes := minutesListened(m.ListenedMs); minutes != nil {
+			resp.Months[m.Month-1].MinutesListened = *minutes
+		}
+		listenedMs += m.ListenedMs
+	}
+	if minutes := minutesListened(listenedMs); minutes != nil {
+		resp.MinutesListened = *minutes
+	}
+
+	for i, t := range tracks {
+		resp.TopTracks[i] = PlaylistTrack{
+			Id:              t.TrackID,
+			Name:            t.Name,
+			Artists:         t.Artists,
+			Href:            t.Href,
+			Plays:           int(t.ListenCount),
+			MinutesListened: minutesListened(t.ListenedMs),
+		}
+		if t.ImageUrl.Valid {
+			resp.TopTracks[i].ImageUrl = &t.ImageUrl.String
+		}
+	}
+	for i := range resp.TopArtists {
+		a := artists[i]
+		resp.TopArtists[i] = TopArtist{
+			Id:    a.ID,
+			Name:  a.Name,
+			Href:  a.Href,
+			Plays: int(a.ListenCount),
+		}
+		if a.ImageUrl.Valid {
+			resp.TopArtists[i].ImageUrl = &a.ImageUrl.String
+		}
+	}
+	for i := range resp.TopAlbums {
+		a := albums[i]
+		resp.TopAlbums[i] = TopAlbum{
+			Id:      a.ID,
+			Name:    a.Name,
+			Artists: a.Artists,
+			Href:    a.Href,
+			Plays:   int(a.ListenCount),
+		}
+		if a.ImageUrl.Valid {
+			resp.TopAlbums[i].ImageUrl = &a.ImageUrl.String
+		}
+	}
+
+	return resp, nil
+}
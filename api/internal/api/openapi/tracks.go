@@ -3,17 +3,61 @@ package openapi
 import (
 	"context"
 	"log/slog"
+	"math"
 	"time"
 
 	apierror "mars/internal/api/error"
 	"mars/internal/api/requestid"
+	"mars/internal/calendar"
 	"mars/internal/database"
 	"mars/internal/log"
 	"mars/internal/tokens"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// topTracksLimit is the most tracks returned by top tracks requests.
+const topTracksLimit = 50
+
+// minutesListened converts a listening time in milliseconds to minutes rounded
+// to a tenth. Unknown listening times are nil.
+func minutesListened(ms int64) *float64 {
+	if ms <= 0 {
+		return nil
+	}
+	minutes := math.Round(float64(ms)/float64(time.Minute/time.Millisecond)*10) / 10
+	return &minutes
+}
+
+// topRange computes the time range of a top tracks, artists or albums request.
+// The range defaults to the last 24 hours, or the start of the current period
+// in the user's timezone if a period is given.
+func (s Server) topRange(
+	ctx context.Context, userid uuid.UUID, start *RangeStart, end *RangeEnd, period *RangePeriod,
+) (pgtype.Timestamptz, pgtype.Timestamptz, error) {
+	now := time.Now()
+	startTime := now.Add(-24 * time.Hour)
+	endTime := now
+
+	if end != nil {
+		endTime = time.Unix(*end, 0)
+	}
+
+	if start != nil {
+		startTime = time.Unix(*start, 0)
+	} else if period != nil {
+		// Start at the beginning of the current period in the user's timezone
+		loc, weekStart, err := s.Service.UserCalendar(ctx, userid)
+		if err != nil {
+			return pgtype.Timestamptz{}, pgtype.Timestamptz{}, err
+		}
+		startTime = calendar.Start(calendar.Period(*period), now.In(loc), weekStart)
+	}
+
+	return pgtype.Timestamptz{Time: startTime, Valid: true}, pgtype.Timestamptz{Time: endTime, Valid: true}, nil
+}
+
 func (s Server) GetApiMeTracksTop(
 	ctx context.Context, request GetApiMeTracksTopRequestObject,
 ) (GetApiMeTracksTopResponseObject, error) {
@@ -31,27 +75,21 @@ func (s Server) GetApiMeTracksTop(
 
 	// Compute start and end time
 	s.Env.Logger.DebugContext(ctx, "computing start and end times")
-	now := time.Now()
-	var startTime int64
-	var endTime int64
-
-	if request.Params.End != nil {
-		endTime = *request.Params.End
-	} else {
-		endTime = now.Unix()
-	}
-
-	if request.Params.Start != nil {
-		startTime = *request.Params.Start
-	} else {
-		startTime = now.Add(-24 * time.Hour).Unix()
+	startTime, endTime, err := s.topRange(ctx, userid, request.Params.Start, request.Params.End, request.Params.Period)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get user calendar", slog.Any("error", err))
+		return GetApiMeTracksTop500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
 	}
-
-	ctx = log.AppendCtx(ctx, slog.Time("start", time.Unix(startTime, 0)))
-	ctx = log.AppendCtx(ctx, slog.Time("end", time.Unix(startTime, 0)))
+	ctx = log.AppendCtx(ctx, slog.Time("start", startTime.Time))
+	ctx = log.AppendCtx(ctx, slog.Time("end", endTime.Time))
 	s.Env.Logger.DebugContext(ctx, "computed")
 
-	if endTime < startTime {
+	if endTime.Time.Before(startTime.Time) {
 		s.Env.Logger.ErrorContext(ctx, "end time is before start time")
 		return GetApiMeTracksTop400JSONResponse{
 			Message: "end should be after start",
@@ -61,18 +99,19 @@ func (s Server) GetApiMeTracksTop(
 		}, nil
 	}
 
+	rankBy := RankByPlays
+	if request.Params.RankBy != nil {
+		rankBy = *request.Params.RankBy
+	}
+
 	// Get tracks
-	s.Env.Logger.DebugContext(ctx, "getting tracks")
+	s.Env.Logger.DebugContext(ctx, "getting tracks", slog.String("rank_by", string(rankBy)))
 	tracks, err := s.Env.Database.TopTracksByUserInRange(ctx, database.TopTracksByUserInRangeParams{
-		UserID: userid,
-		StartDate: pgtype.Timestamptz{
-			Time:  time.Unix(startTime, 0),
-			Valid: true,
-		},
-		EndDate: pgtype.Timestamptz{
-			Time:  time.Unix(endTime, 0),
-			Valid: true,
-		},
+		UserID:    userid,
+		StartDate: startTime,
+		EndDate:   endTime,
+		RankBy:    string(rankBy),
+		MaxRows:   topTracksLimit,
 	})
 	if err != nil {
 		s.Env.Logger.ErrorContext(ctx, "failed to get tracks", slog.Any("error", err))
@@ -88,11 +127,12 @@ func (s Server) GetApiMeTracksTop(
 	}
 	for i, t := range tracks {
 		resp.Tracks[i] = PlaylistTrack{
-			Id:      t.TrackID,
-			Name:    t.Name,
-			Artists: t.Artists,
-			Href:    t.Href,
-			Plays:   int(t.ListenCount),
+			Id:              t.TrackID,
+			Name:            t.Name,
+			Artists:         t.Artists,
+			Href:            t.Href,
+			Plays:           int(t.ListenCount),
+			MinutesListened: minutesListened(t.ListenedMs),
 		}
 		if t.ImageUrl.Valid {
 			resp.Tracks[i].ImageUrl = &t.ImageUrl.String
@@ -100,3 +140,152 @@ func (s Server) GetApiMeTracksTop(
 	}
 	return resp, nil
 }
+
+func (s Server) GetApiMeArtistsTop(
+	ctx context.Context, request GetApiMeArtistsTopRequestObject,
+) (GetApiMeArtistsTopResponseObject, error) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return GetApiMeArtistsTop500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Compute start and end time
+	s.Env.Logger.DebugContext(ctx, "computing start and end times")
+	startTime, endTime, err := s.topRange(ctx, userid, request.Params.Start, request.Params.End, request.Params.Period)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get user calendar", slog.Any("error", err))
+		return GetApiMeArtistsTop500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	ctx = log.AppendCtx(ctx, slog.Time("start", startTime.Time))
+	ctx = log.AppendCtx(ctx, slog.Time("end", endTime.Time))
+	s.Env.Logger.DebugContext(ctx, "computed")
+
+	if endTime.Time.Before(startTime.Time) {
+		s.Env.Logger.ErrorContext(ctx, "end time is before start time")
+		return GetApiMeArtistsTop400JSONResponse{
+			Message: "end should be after start",
+			Status:  apierror.BadRequest.Status(),
+			Code:    apierror.BadRequest.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Get artists
+	s.Env.Logger.DebugContext(ctx, "getting artists")
+	artists, err := s.Env.Database.TopArtistsByUserInRange(ctx, database.TopArtistsByUserInRangeParams{
+		UserID:    userid,
+		StartDate: startTime,
+		EndDate:   endTime,
+	})
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get artists", slog.Any("error", err))
+		return GetApiMeArtistsTop500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	resp := GetApiMeArtistsTop200JSONResponse{
+		Artists: make([]TopArtist, len(artists)),
+	}
+	for i, a := range artists {
+		resp.Artists[i] = TopArtist{
+			Id:    a.ID,
+			Name:  a.Name,
+			Href:  a.Href,
+			Plays: int(a.ListenCount),
+		}
+		if a.ImageUrl.Valid {
+			resp.Artists[i].ImageUrl = &a.ImageUrl.String
+		}
+	}
+	return resp, nil
+}
+
+func (s Server) GetApiMeAlbumsTop(
+	ctx context.Context, request GetApiMeAlbumsTopRequestObject,
+) (GetApiMeAlbumsTopResponseObject, error) {
+	reqid := requestid.FromContext(ctx)
+	userid, err := tokens.UserIDFromContext(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get userid", slog.Any("error", err))
+		return GetApiMeAlbumsTop500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Compute start and end time
+	s.Env.Logger.DebugContext(ctx, "computing start and end times")
+	startTime, endTime, err := s.topRange(ctx, userid, request.Params.Start, request.Params.End, request.Params.Period)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get user calendar", slog.Any("error", err))
+		return GetApiMeAlbumsTop500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	ctx = log.AppendCtx(ctx, slog.Time("start", startTime.Time))
+	ctx = log.AppendCtx(ctx, slog.Time("end", endTime.Time))
+	s.Env.Logger.DebugContext(ctx, "computed")
+
+	if endTime.Time.Before(startTime.Time) {
+		s.Env.Logger.ErrorContext(ctx, "end time is before start time")
+		return GetApiMeAlbumsTop400JSONResponse{
+			Message: "end should be after start",
+			Status:  apierror.BadRequest.Status(),
+			Code:    apierror.BadRequest.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Get albums
+	s.Env.Logger.DebugContext(ctx, "getting albums")
+	albums, err := s.Env.Database.TopAlbumsByUserInRange(ctx, database.TopAlbumsByUserInRangeParams{
+		UserID:    userid,
+		StartDate: startTime,
+		EndDate:   endTime,
+	})
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to get albums", slog.Any("error", err))
+		return GetApiMeAlbumsTop500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	resp := GetApiMeAlbumsTop200JSONResponse{
+		Albums: make([]TopAlbum, len(albums)),
+	}
+	for i, a := range albums {
+		resp.Albums[i] = TopAlbum{
+			Id:      a.ID,
+			Name:    a.Name,
+			Artists: a.Artists,
+			Href:    a.Href,
+			Plays:   int(a.ListenCount),
+		}
+		if a.ImageUrl.Valid {
+			resp.Albums[i].ImageUrl = &a.ImageUrl.String
+		}
+	}
+	return resp, nil
+}
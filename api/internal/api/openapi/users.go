@@ -2,10 +2,24 @@ package openapi
 
 import (
 	"context"
+	"crypto/subtle"
+	"errors"
 	"log/slog"
+	"strings"
+	"time"
 
 	apierror "mars/internal/api/error"
 	"mars/internal/api/requestid"
+	"mars/internal/argon2id"
+	"mars/internal/database"
+	"mars/internal/mars"
+	"mars/internal/password"
+	"mars/internal/role"
+	"mars/internal/tokens"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 func (s Server) GetApiUsers(ctx context.Context, request GetApiUsersRequestObject) (GetApiUsersResponseObject, error) {
@@ -32,3 +46,233 @@ func (s Server) GetApiUsers(ctx context.Context, request GetApiUsersRequestObjec
 		Ids: &users,
 	}, nil
 }
+
+func (s Server) PostApiRegister(ctx context.Context, request PostApiRegisterRequestObject) (
+	PostApiRegisterResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+	email := strings.TrimSpace(strings.ToLower(string(request.Body.Email)))
+
+	// Validate password
+	s.Env.Logger.DebugContext(ctx, "validating password")
+	if err := password.ValidatePassword(request.Body.Password); err != nil {
+		s.Env.Logger.ErrorContext(ctx, "password does not meet requirements", slog.Any("error", err))
+		return PostApiRegister422JSONResponse{
+			Message: err.Error(),
+			Status:  apierror.WeakPassword.Status(),
+			Code:    apierror.WeakPassword.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Validate invite
+	var inviteToken string
+	if request.Body.InviteToken != nil {
+		inviteToken = *request.Body.InviteToken
+	}
+	if inviteToken == "" && !s.Env.OpenRegistration() {
+		s.Env.Logger.ErrorContext(ctx, "invite token not provided")
+		return PostApiRegister403JSONResponse{
+			Message: "invite token required",
+			Status:  apierror.InvalidInviteToken.Status(),
+			Code:    apierror.InvalidInviteToken.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	var inviteID uuid.UUID
+	if inviteToken != "" {
+		s.Env.Logger.DebugContext(ctx, "validating invite token")
+		var err error
+		inviteID, err = tokens.ParseInviteToken(inviteToken)
+		if err != nil {
+			s.Env.Logger.ErrorContext(ctx, "failed to parse invite token", slog.Any("error", err))
+			return PostApiRegister403JSONResponse{
+				Message: "invalid invite token",
+				Status:  apierror.InvalidInviteToken.Status(),
+				Code:    apierror.InvalidInviteToken.String(),
+				ErrorId: reqid,
+			}, nil
+		}
+		invite, err := s.Env.Database.GetUserInvite(ctx, inviteID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			s.Env.Logger.ErrorContext(ctx, "invite does not exist", slog.Any("error", err))
+			return PostApiRegister403JSONResponse{
+				Message: "invalid invite token",
+				Status:  apierror.InvalidInviteToken.Status(),
+				Code:    apierror.InvalidInviteToken.String(),
+				ErrorId: reqid,
+			}, nil
+		} else if err != nil {
+			s.Env.Logger.ErrorContext(ctx, "failed to get invite", slog.Any("error", err))
+			return PostApiRegister500JSONResponse{
+				Message: "internal server error",
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				ErrorId: reqid,
+			}, nil
+		}
+		argonParams, salt, groundHash, err := argon2id.DecodeHash(invite.TokenHash)
+		if err != nil {
+			s.Env.Logger.ErrorContext(ctx, "failed to decode invite token hash", slog.Any("error", err))
+			return PostApiRegister500JSONResponse{
+				Message: "internal server error",
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				ErrorId: reqid,
+			}, nil
+		}
+		givenHash := argon2id.HashWithSalt(inviteToken, *argonParams, salt)
+		if subtle.ConstantTimeCompare(givenHash, groundHash) == 0 {
+			s.Env.Logger.ErrorContext(ctx, "invite tokens do not match")
+			return PostApiRegister403JSONResponse{
+				Message: "invalid invite token",
+				Status:  apierror.InvalidInviteToken.Status(),
+				Code:    apierror.InvalidInviteToken.String(),
+				ErrorId: reqid,
+			}, nil
+		}
+		if invite.UsedAt.Valid || invite.ExpiresAt.Time.Before(time.Now()) {
+			s.Env.Logger.ErrorContext(ctx, "invite has been used or has expired")
+			return PostApiRegister403JSONResponse{
+				Message: "invite has been used or has expired",
+				Status:  apierror.InvalidInviteToken.Status(),
+				Code:    apierror.InvalidInviteToken.String(),
+				ErrorId: reqid,
+			}, nil
+		}
+		if invite.Email.Valid && invite.Email.String != email {
+			s.Env.Logger.ErrorContext(ctx, "invite was issued for a different email")
+			return PostApiRegister403JSONResponse{
+				Message: "invite was issued for a different email",
+				Status:  apierror.InvalidInviteToken.Status(),
+				Code:    apierror.InvalidInviteToken.String(),
+				ErrorId: reqid,
+			}, nil
+		}
+	}
+
+	// Hash password
+	s.Env.Logger.DebugContext(ctx, "hashing password")
+	passwordHash, err := argon2id.HashAndEncode(request.Body.Password, argon2id.DefaultParams)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to hash password", slog.Any("error", err))
+		return PostApiRegister500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	// Create user and redeem invite in a transaction
+	s.Env.Logger.DebugContext(ctx, "creating user")
+	tx, err := s.Env.Pool.Begin(ctx)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to begin transaction", slog.Any("error", err))
+		return PostApiRegister500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := database.New(tx)
+
+	userid, err := qtx.CreateUser(ctx, database.CreateUserParams{
+		Email:        email,
+		PasswordHash: passwordHash,
+	})
+	if database.IsUniqueViolation(err, "users_unique_email") {
+		s.Env.Logger.ErrorContext(ctx, "email already in use", slog.Any("error", err))
+		return PostApiRegister409JSONResponse{
+			Message: "email already in use",
+			Status:  apierror.EmailAlreadyExists.Status(),
+			Code:    apierror.EmailAlreadyExists.String(),
+			ErrorId: reqid,
+		}, nil
+	} else if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to create user", slog.Any("error", err))
+		return PostApiRegister500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	if inviteToken != "" {
+		s.Env.Logger.DebugContext(ctx, "redeeming invite")
+		redeemed, err := qtx.RedeemUserInvite(ctx, database.RedeemUserInviteParams{
+			ID:     inviteID,
+			UsedBy: userid,
+		})
+		if err != nil {
+			s.Env.Logger.ErrorContext(ctx, "failed to redeem invite", slog.Any("error", err))
+			return PostApiRegister500JSONResponse{
+				Message: "internal server error",
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				ErrorId: reqid,
+			}, nil
+		}
+		if redeemed == 0 {
+			s.Env.Logger.ErrorContext(ctx, "invite was redeemed concurrently or has expired")
+			return PostApiRegister403JSONResponse{
+				Message: "invite has been used or has expired",
+				Status:  apierror.InvalidInviteToken.Status(),
+				Code:    apierror.InvalidInviteToken.String(),
+				ErrorId: reqid,
+			}, nil
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to commit transaction", slog.Any("error", err))
+		return PostApiRegister500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	s.Env.Logger.InfoContext(ctx, "registered user", slog.String("user_id", userid.String()))
+
+	return PostApiRegister201JSONResponse{
+		Id:    userid,
+		Email: openapi_types.Email(email),
+		Role:  Role(role.RoleUser.String()),
+	}, nil
+}
+
+func (s Server) PostApiUsersIdSignOut(ctx context.Context, request PostApiUsersIdSignOutRequestObject) (
+	PostApiUsersIdSignOutResponseObject, error,
+) {
+	reqid := requestid.FromContext(ctx)
+
+	// Sign user out
+	s.Env.Logger.DebugContext(ctx, "signing user out everywhere", slog.String("target-user-id", request.Id.String()))
+	err := s.Service.SignOutEverywhere(ctx, request.Id)
+	if errors.Is(err, mars.ErrUserNotFound) {
+		s.Env.Logger.ErrorContext(ctx, "user does not exist", slog.Any("error", err))
+		return PostApiUsersIdSignOut404JSONResponse{
+			Message: "user not found",
+			Status:  apierror.UserNotFound.Status(),
+			Code:    apierror.UserNotFound.String(),
+			ErrorId: reqid,
+		}, nil
+	} else if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to sign user out", slog.Any("error", err))
+		return PostApiUsersIdSignOut500JSONResponse{
+			Message: "internal server error",
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			ErrorId: reqid,
+		}, nil
+	}
+
+	s.Env.Logger.InfoContext(ctx, "signed user out everywhere", slog.String("target-user-id", request.Id.String()))
+
+	return PostApiUsersIdSignOut204Response{}, nil
+}
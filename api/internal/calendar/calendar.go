@@ -0,0 +1,121 @@
+// Package calendar computes calendar periods in a user's local time.
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultTimezone is the time zone of users who haven't set one.
+	DefaultTimezone = "America/New_York"
+	// DefaultWeekStart is the first day of the week of users who haven't set one.
+	DefaultWeekStart = time.Monday
+)
+
+var ErrInvalidTimezone = errors.New("invalid timezone")
+
+// Period is a recurring calendar window.
+type Period string
+
+const (
+	Day   Period = "day"
+	Week  Period = "week"
+	Month Period = "month"
+	Year  Period = "year"
+)
+
+// LoadLocation loads an IANA time zone. Unlike time.LoadLocation, the empty
+// name and "Local" are rejected since they depend on the server's zone.
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTimezone, name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTimezone, err)
+	}
+	return loc, nil
+}
+
+// ParseWeekday parses an English day name such as "monday".
+func ParseWeekday(s string) (time.Weekday, error) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), s) {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid weekday %q", s)
+}
+
+// FormatWeekday formats a day as a lowercase English name such as "monday".
+func FormatWeekday(d time.Weekday) string {
+	return strings.ToLower(d.String())
+}
+
+// Start returns the start of the period containing t, in t's location.
+// Weeks begin on weekStart.
+func Start(p Period, t time.Time, weekStart time.Weekday) time.Time {
+	y, m, d := t.Date()
+	switch p {
+	case Week:
+		offset := (int(t.Weekday()) - int(weekStart) + 7) % 7
+		return time.Date(y, m, d-offset, 0, 0, 0, 0, t.Location())
+	case Month:
+		return time.Date(y, m, 1, 0, 0, 0, 0, t.Location())
+	case Year:
+		return time.Date(y, time.January, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	}
+}
+
+// Next returns the start of the period following the one starting at start.
+func Next(p Period, start time.Time) time.Time {
+	switch p {
+	case Week:
+		return start.AddDate(0, 0, 7)
+	case Month:
+		return start.AddDate(0, 1, 0)
+	case Year:
+		return start.AddDate(1, 0, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// Previous returns the start of the period preceding the one starting at start.
+func Previous(p Period, start time.Time) time.Time {
+	switch p {
+	case Week:
+		return start.AddDate(0, 0, -7)
+	case Month:
+		return start.AddDate(0, -1, 0)
+	case Year:
+		return start.AddDate(-1, 0, 0)
+	default:
+		return start.AddDate(0, 0, -1)
+	}
+}
+
+// Containing returns the bounds [start, end) of the period containing t.
+func Containing(p Period, t time.Time, weekStart time.Weekday) (start, end time.Time) {
+	start = Start(p, t, weekStart)
+	return start, Next(p, start)
+}
+
+// LastCompleted returns the bounds [start, end) of the most recent period
+// that ended at or before t.
+func LastCompleted(p Period, t time.Time, weekStart time.Weekday) (start, end time.Time) {
+	end = Start(p, t, weekStart)
+	return Previous(p, end), end
+}
+
+// Trailing returns the bounds [start, end) of the given number of whole days
+// ending at the start of the day containing t.
+func Trailing(days int, t time.Time) (start, end time.Time) {
+	end = Start(Day, t, time.Sunday)
+	return end.AddDate(0, 0, -days), end
+}
@@ -0,0 +1,151 @@
+// Package cover renders playlist cover images from the album art of their
+// tracks.
+package cover
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png" // Album art may be a PNG
+	"io"
+	"net/http"
+
+	marshttp "mars/internal/http"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	// Size is the width and height of rendered covers in pixels.
+	Size = 640
+	// MaxEncodedSize is the largest cover Spotify accepts, after base64 encoding.
+	MaxEncodedSize = 256 * 1024
+
+	// mosaicImages is the number of images in a mosaic cover.
+	mosaicImages = 4
+	// maxImageBytes is the largest album art that is downloaded.
+	maxImageBytes = 4 << 20
+)
+
+var (
+	ErrNoImages = errors.New("no images to render a cover from")
+	ErrTooLarge = errors.New("cover too large")
+)
+
+// Render downloads album art and renders it into a JPEG cover. Images that
+// fail to download or decode are skipped, so ErrNoImages is returned only if
+// none of them could be used.
+func Render(ctx context.Context, client *marshttp.Client, imageURLs []string) ([]byte, error) {
+	images := make([]image.Image, 0, mosaicImages)
+	var errs []error
+	for _, u := range imageURLs {
+		if len(images) == mosaicImages {
+			break
+		}
+		img, err := fetch(ctx, client, u)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		images = append(images, img)
+	}
+	if len(images) == 0 {
+		return nil, errors.Join(append([]error{ErrNoImages}, errs...)...)
+	}
+	return Encode(Mosaic(images))
+}
+
+func fetch(ctx context.Context, client *marshttp.Client, imageURL string) (image.Image, error) {
+	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodGet, imageURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("image %s returned status %d", imageURL, res.StatusCode)
+	}
+	img, _, err := image.Decode(io.LimitReader(res.Body, maxImageBytes))
+	if err != nil {
+		return nil, fmt.Errorf("decode image %s: %w", imageURL, err)
+	}
+	return img, nil
+}
+
+// Mosaic lays out images into a square cover. With four or more images, the
+// first four fill a 2x2 grid like Spotify's own covers; otherwise the first
+// image fills the whole cover. Images that aren't square are cropped to their
+// center. images must not be empty.
+func Mosaic(images []image.Image) image.Image {
+	dst := image.NewRGBA(image.Rect(0, 0, Size, Size))
+	if len(images) < mosaicImages {
+		scale(dst, dst.Bounds(), images[0])
+		return dst
+	}
+
+	const half = Size / 2
+	for i, img := range images[:mosaicImages] {
+		x, y := i%2*half, i/2*half
+		scale(dst, image.Rect(x, y, x+half, y+half), img)
+	}
+	return dst
+}
+
+// scale draws the centered square of src into r of dst, averaging the source
+// pixels that fall within each destination pixel.
+func scale(dst *image.RGBA, r image.Rectangle, src image.Image) {
+	sb := src.Bounds()
+	side := min(sb.Dx(), sb.Dy())
+	sq := image.Rect(0, 0, side, side).Add(sb.Min).Add(image.Pt((sb.Dx()-side)/2, (sb.Dy()-side)/2))
+
+	for y := range r.Dy() {
+		sy0 := sq.Min.Y + y*side/r.Dy()
+		sy1 := max(sq.Min.Y+(y+1)*side/r.Dy(), sy0+1)
+		for x := range r.Dx() {
+			sx0 := sq.Min.X + x*side/r.Dx()
+			sx1 := max(sq.Min.X+(x+1)*side/r.Dx(), sx0+1)
+
+			var red, green, blue, n uint32
+			for sy := sy0; sy < sy1; sy++ {
+				for sx := sx0; sx < sx1; sx++ {
+					cr, cg, cb, _ := src.At(sx, sy).RGBA()
+					red += cr >> 8
+					green += cg >> 8
+					blue += cb >> 8
+					n++
+				}
+			}
+			dst.SetRGBA(r.Min.X+x, r.Min.Y+y, color.RGBA{
+				R: uint8(red / n),
+				G: uint8(green / n),
+				B: uint8(blue / n),
+				A: 0xff,
+			})
+		}
+	}
+}
+
+// Encode encodes a cover as a JPEG, lowering the quality until it fits within
+// MaxEncodedSize.
+func Encode(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	for quality := 90; quality >= 50; quality -= 10 {
+		buf.Reset()
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality}); err != nil {
+			return nil, fmt.Errorf("encode jpeg: %w", err)
+		}
+		if base64.StdEncoding.EncodedLen(buf.Len()) <= MaxEncodedSize {
+			return buf.Bytes(), nil
+		}
+	}
+	return nil, ErrTooLarge
+}
@@ -0,0 +1,911 @@
+// Package databasetest provides an in-memory fake of the database queries,
+// for testing code that reads and writes the database without Postgres.
+package databasetest
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"mars/internal/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Querier keeps the rows used by syncs, exports, jobs and logins in memory. Other
+// queries panic, as the embedded database.Querier is nil.
+// The exported fields hold the rows. Set them up before the code under test
+// runs, and read them once it has returned.
+type Querier struct {
+	database.Querier
+	mu sync.Mutex
+
+	// Users are the ids of every user, in any order.
+	Users          []uuid.UUID
+	Emails         map[uuid.UUID]string
+	Preferences    map[uuid.UUID]database.GetUserPreferencesRow
+	UserAuth       map[uuid.UUID]database.GetUserAuthRow
+	Sessions       map[uuid.UUID]database.GetSessionRow
+	PasswordResets map[uuid.UUID]database.CreatePasswordResetParams
+	Tokens         map[uuid.UUID]database.GetProviderTokensRow
+	SyncStates     map[uuid.UUID]database.GetSpotifySyncStateRow
+	Tracks         map[string]database.UpsertTrackParams
+	Artists        map[string]database.UpsertArtistParams
+	ArtistImages   map[string]string
+	Listens        map[database.UpsertTrackListenParams]bool
+	Playlists      map[uuid.UUID]database.GetUserPlaylistRow
+	PlaylistTracks map[uuid.UUID][]database.GetPlaylistTracksRow
+	Exports        map[uuid.UUID]database.GetPlaylistExportRow
+	// ScheduledPlaylists are the periods of playlists, by playlist id.
+	ScheduledPlaylists map[uuid.UUID]database.GetUserPlaylistByPeriodParams
+	Jobs               map[string]database.Job
+	TOTP               map[uuid.UUID]database.UserTotp
+	MFAChallenges      map[uuid.UUID]database.MfaChallenge
+	// Passkeys are the passkeys of every user, by id.
+	Passkeys         map[uuid.UUID]database.WebauthnCredential
+	WebAuthnSessions map[uuid.UUID]database.WebauthnSession
+}
+
+// NewQuerier returns a Querier without any rows.
+func NewQuerier() *Querier {
+	return &Querier{
+		Emails:             make(map[uuid.UUID]string),
+		Preferences:        make(map[uuid.UUID]database.GetUserPreferencesRow),
+		UserAuth:           make(map[uuid.UUID]database.GetUserAuthRow),
+		Sessions:           make(map[uuid.UUID]database.GetSessionRow),
+		PasswordResets:     make(map[uuid.UUID]database.CreatePasswordResetParams),
+		Tokens:             make(map[uuid.UUID]database.GetProviderTokensRow),
+		SyncStates:         make(map[uuid.UUID]database.GetSpotifySyncStateRow),
+		Tracks:             make(map[string]database.UpsertTrackParams),
+		Artists:            make(map[string]database.UpsertArtistParams),
+		ArtistImages:       make(map[string]string),
+		Listens:            make(map[database.UpsertTrackListenParams]bool),
+		Playlists:          make(map[uuid.UUID]database.GetUserPlaylistRow),
+		PlaylistTracks:     make(map[uuid.UUID][]database.GetPlaylistTracksRow),
+		Exports:            make(map[uuid.UUID]database.GetPlaylistExportRow),
+		ScheduledPlaylists: make(map[uuid.UUID]database.GetUserPlaylistByPeriodParams),
+		Jobs:               make(map[string]database.Job),
+		TOTP:               make(map[uuid.UUID]database.UserTotp),
+		MFAChallenges:      make(map[uuid.UUID]database.MfaChallenge),
+		Passkeys:           make(map[uuid.UUID]database.WebauthnCredential),
+		WebAuthnSessions:   make(map[uuid.UUID]database.WebauthnSession),
+	}
+}
+
+// NewPlaylist returns a playlist with a new ID, to be added to Playlists.
+func NewPlaylist(name string) database.GetUserPlaylistRow {
+	return database.GetUserPlaylistRow{
+		ID:          uuid.New(),
+		Name:        name,
+		Description: "Your top tracks",
+	}
+}
+
+func (q *Querier) GetUserPreferences(_ context.Context, userID uuid.UUID) (database.GetUserPreferencesRow, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	prefs, ok := q.Preferences[userID]
+	if !ok {
+		return database.GetUserPreferencesRow{}, pgx.ErrNoRows
+	}
+	return prefs, nil
+}
+
+func (q *Querier) GetProviderTokens(
+	_ context.Context, arg database.GetProviderTokensParams,
+) (database.GetProviderTokensRow, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	tokens, ok := q.Tokens[arg.UserID]
+	if !ok {
+		return database.GetProviderTokensRow{}, pgx.ErrNoRows
+	}
+	return tokens, nil
+}
+
+func (q *Querier) UpsertProviderTokens(_ context.Context, arg database.UpsertProviderTokensParams) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	tokens := q.Tokens[arg.UserID]
+	tokens.AccessToken = arg.AccessToken
+	tokens.TokenType = arg.TokenType
+	tokens.Scope = arg.Scope
+	tokens.RefreshToken = arg.RefreshToken
+	tokens.ExpiresAt = arg.ExpiresAt
+	q.Tokens[arg.UserID] = tokens
+	return nil
+}
+
+func (q *Querier) GetSpotifySyncState(
+	_ context.Context, userID uuid.UUID,
+) (database.GetSpotifySyncStateRow, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	state, ok := q.SyncStates[userID]
+	if !ok {
+		return database.GetSpotifySyncStateRow{}, pgx.ErrNoRows
+	}
+	return state, nil
+}
+
+func (q *Querier) RecordSpotifySyncSuccess(_ context.Context, arg database.RecordSpotifySyncSuccessParams) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.SyncStates[arg.UserID] = database.GetSpotifySyncStateRow{
+		PlayedAtCursor:    arg.PlayedAtCursor,
+		LastSuccessAt:     pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		LastPages:         arg.LastPages,
+		LastItemsFetched:  arg.LastItemsFetched,
+		LastItemsInserted: arg.LastItemsInserted,
+	}
+	return nil
+}
+
+func (q *Querier) RecordSpotifySyncFailure(_ context.Context, arg database.RecordSpotifySyncFailureParams) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	state := q.SyncStates[arg.UserID]
+	state.LastError = arg.LastError
+	q.SyncStates[arg.UserID] = state
+	return nil
+}
+
+func (q *Querier) UpsertAlbum(context.Context, database.UpsertAlbumParams) error {
+	return nil
+}
+
+func (q *Querier) UpsertTrack(_ context.Context, arg database.UpsertTrackParams) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.Tracks[arg.ID] = arg
+	return nil
+}
+
+func (q *Querier) CreateTrackIfNotExists(_ context.Context, arg database.CreateTrackIfNotExistsParams) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, ok := q.Tracks[arg.ID]; ok {
+		return nil
+	}
+	q.Tracks[arg.ID] = database.UpsertTrackParams{
+		ID:         arg.ID,
+		Name:       arg.Name,
+		Artists:    arg.Artists,
+		Href:       arg.Href,
+		Uri:        arg.Uri,
+		DurationMs: arg.DurationMs,
+	}
+	return nil
+}
+
+func (q *Querier) TrackExists(_ context.Context, id string) (bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	_, ok := q.Tracks[id]
+	return ok, nil
+}
+
+// FindTrackByNameAndArtist prefers Spotify tracks, then the lowest ID, as the
+// fake doesn't know when tracks were created.
+func (q *Querier) FindTrackByNameAndArtist(
+	_ context.Context, arg database.FindTrackByNameAndArtistParams,
+) (string, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var matches []database.UpsertTrackParams
+	for _, track := range q.Tracks {
+		if !strings.EqualFold(track.Name, arg.Name) || len(track.Artists) == 0 {
+			continue
+		}
+		if strings.EqualFold(track.Artists[0], arg.Artist) ||
+			strings.EqualFold(strings.Join(track.Artists, ", "), arg.Artist) {
+			matches = append(matches, track)
+		}
+	}
+	if len(matches) == 0 {
+		return "", pgx.ErrNoRows
+	}
+	slices.SortFunc(matches, func(a, b database.UpsertTrackParams) int {
+		aSpotify := strings.HasPrefix(a.Uri, "spotify:track:")
+		bSpotify := strings.HasPrefix(b.Uri, "spotify:track:")
+		if aSpotify != bSpotify {
+			if aSpotify {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return matches[0].ID, nil
+}
+
+func (q *Querier) UpsertArtist(_ context.Context, arg database.UpsertArtistParams) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.Artists[arg.ID] = arg
+	return nil
+}
+
+func (q *Querier) UpsertTrackArtist(context.Context, database.UpsertTrackArtistParams) error {
+	return nil
+}
+
+func (q *Querier) UpsertTrackListen(_ context.Context, arg database.UpsertTrackListenParams) (int64, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.Listens[arg] {
+		return 0, nil
+	}
+	q.Listens[arg] = true
+	return 1, nil
+}
+
+// compareListens orders listens by user, time played and track, as exports do.
+func compareListens(a, b database.UpsertTrackListenParams) int {
+	if c := bytes.Compare(a.UserID[:], b.UserID[:]); c != 0 {
+		return c
+	}
+	if c := a.PlayedAt.Time.Compare(b.PlayedAt.Time); c != 0 {
+		return c
+	}
+	return strings.Compare(a.TrackID, b.TrackID)
+}
+
+// exportPage returns the next page of listens within the bounds after the
+// given listen. The caller must hold q.mu.
+func (q *Querier) exportPage(
+	start, end pgtype.Timestamptz, after database.UpsertTrackListenParams, pageSize int32,
+	include func(database.UpsertTrackListenParams) bool,
+) []database.UpsertTrackListenParams {
+	var page []database.UpsertTrackListenParams
+	for listen := range q.Listens {
+		if !include(listen) || compareListens(listen, after) <= 0 ||
+			(start.Valid && listen.PlayedAt.Time.Before(start.Time)) ||
+			(end.Valid && !listen.PlayedAt.Time.Before(end.Time)) {
+			continue
+		}
+		page = append(page, listen)
+	}
+	slices.SortFunc(page, compareListens)
+	return page[:min(len(page), int(pageSize))]
+}
+
+func (q *Querier) ExportUserListens(
+	_ context.Context, arg database.ExportUserListensParams,
+) ([]database.ExportUserListensRow, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	after := database.UpsertTrackListenParams{
+		UserID:   arg.UserID,
+		TrackID:  arg.AfterTrackID,
+		PlayedAt: arg.AfterPlayedAt,
+	}
+	page := q.exportPage(arg.Start, arg.End, after, arg.PageSize, func(l database.UpsertTrackListenParams) bool {
+		return l.UserID == arg.UserID
+	})
+	rows := make([]database.ExportUserListensRow, len(page))
+	for i, listen := range page {
+		track := q.Tracks[listen.TrackID]
+		rows[i] = database.ExportUserListensRow{
+			PlayedAt:  listen.PlayedAt,
+			TrackID:   track.ID,
+			TrackName: track.Name,
+			Artists:   track.Artists,
+			Href:      track.Href,
+			Uri:       track.Uri,
+		}
+	}
+	return rows, nil
+}
+
+func (q *Querier) ExportListens(
+	_ context.Context, arg database.ExportListensParams,
+) ([]database.ExportListensRow, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	after := database.UpsertTrackListenParams{
+		UserID:   arg.AfterUserID,
+		TrackID:  arg.AfterTrackID,
+		PlayedAt: arg.AfterPlayedAt,
+	}
+	page := q.exportPage(arg.Start, arg.End, after, arg.PageSize, func(database.UpsertTrackListenParams) bool {
+		return true
+	})
+	rows := make([]database.ExportListensRow, len(page))
+	for i, listen := range page {
+		track := q.Tracks[listen.TrackID]
+		rows[i] = database.ExportListensRow{
+			UserID:    listen.UserID,
+			Email:     q.Emails[listen.UserID],
+			PlayedAt:  listen.PlayedAt,
+			TrackID:   track.ID,
+			TrackName: track.Name,
+			Artists:   track.Artists,
+			Href:      track.Href,
+			Uri:       track.Uri,
+		}
+	}
+	return rows, nil
+}
+
+func (q *Querier) ListArtistsWithoutImages(_ context.Context, ids []string) ([]string, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var missing []string
+	for _, id := range ids {
+		if q.ArtistImages[id] == "" {
+			missing = append(missing, id)
+		}
+	}
+	return missing, nil
+}
+
+func (q *Querier) UpdateArtistImage(_ context.Context, arg database.UpdateArtistImageParams) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.ArtistImages[arg.ID] = arg.ImageUrl.String
+	return nil
+}
+
+func (q *Querier) GetUserPlaylist(
+	_ context.Context, arg database.GetUserPlaylistParams,
+) (database.GetUserPlaylistRow, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	playlist, ok := q.Playlists[arg.ID]
+	if !ok {
+		return database.GetUserPlaylistRow{}, pgx.ErrNoRows
+	}
+	return playlist, nil
+}
+
+func (q *Querier) GetUserPlaylistByPeriod(
+	_ context.Context, arg database.GetUserPlaylistByPeriodParams,
+) (uuid.UUID, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for id, period := range q.ScheduledPlaylists {
+		if period.UserID == arg.UserID && period.PlaylistType == arg.PlaylistType &&
+			period.PeriodStart.Time.Equal(arg.PeriodStart.Time) {
+			return id, nil
+		}
+	}
+	return uuid.Nil, pgx.ErrNoRows
+}
+
+// GetLatestUserPlaylistByType returns any matching playlist, as the fake
+// doesn't know when playlists were created. Users only have one rolling
+// playlist, the only type this is used for.
+func (q *Querier) GetLatestUserPlaylistByType(
+	_ context.Context, arg database.GetLatestUserPlaylistByTypeParams,
+) (database.GetLatestUserPlaylistByTypeRow, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for id, period := range q.ScheduledPlaylists {
+		if period.UserID == arg.UserID && period.PlaylistType == arg.PlaylistType {
+			return database.GetLatestUserPlaylistByTypeRow{ID: id, PeriodStart: period.PeriodStart}, nil
+		}
+	}
+	return database.GetLatestUserPlaylistByTypeRow{}, pgx.ErrNoRows
+}
+
+func (q *Querier) GetPlaylistTracks(
+	_ context.Context, playlistID uuid.UUID,
+) ([]database.GetPlaylistTracksRow, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.PlaylistTracks[playlistID], nil
+}
+
+func (q *Querier) GetPlaylistExport(
+	_ context.Context, arg database.GetPlaylistExportParams,
+) (database.GetPlaylistExportRow, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	export, ok := q.Exports[arg.PlaylistID]
+	if !ok {
+		return database.GetPlaylistExportRow{}, pgx.ErrNoRows
+	}
+	return export, nil
+}
+
+func (q *Querier) UpsertPlaylistExport(_ context.Context, arg database.UpsertPlaylistExportParams) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.Exports[arg.PlaylistID] = database.GetPlaylistExportRow{
+		RemoteID: arg.RemoteID,
+		Url:      arg.Url,
+	}
+	return nil
+}
+
+func (q *Querier) ListUserIDsAfter(
+	_ context.Context, arg database.ListUserIDsAfterParams,
+) ([]uuid.UUID, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	users := slices.SortedFunc(slices.Values(q.Users), func(a, b uuid.UUID) int {
+		return bytes.Compare(a[:], b[:])
+	})
+	var page []uuid.UUID
+	for _, id := range users {
+		if bytes.Compare(id[:], arg.ID[:]) > 0 && len(page) < int(arg.Limit) {
+			page = append(page, id)
+		}
+	}
+	return page, nil
+}
+
+func (q *Querier) UpsertJob(_ context.Context, arg database.UpsertJobParams) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	job, ok := q.Jobs[arg.Name]
+	if !ok || job.Schedule != arg.Schedule || job.Timezone != arg.Timezone {
+		job.NextRunAt = arg.NextRunAt
+	}
+	job.Name = arg.Name
+	job.Schedule = arg.Schedule
+	job.Timezone = arg.Timezone
+	q.Jobs[arg.Name] = job
+	return nil
+}
+
+func (q *Querier) GetJob(_ context.Context, name string) (database.Job, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	job, ok := q.Jobs[name]
+	if !ok {
+		return database.Job{}, pgx.ErrNoRows
+	}
+	return job, nil
+}
+
+func (q *Querier) ListRunnableJobs(context.Context) ([]database.Job, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+	var jobs []database.Job
+	for _, job := range q.Jobs {
+		if (!job.Paused && !job.NextRunAt.Time.After(now)) || job.TriggeredAt.Valid {
+			jobs = append(jobs, job)
+		}
+	}
+	slices.SortFunc(jobs, func(a, b database.Job) int {
+		return a.NextRunAt.Time.Compare(b.NextRunAt.Time)
+	})
+	return jobs, nil
+}
+
+func (q *Querier) SetJobPaused(_ context.Context, arg database.SetJobPausedParams) (int64, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	job, ok := q.Jobs[arg.Name]
+	if !ok {
+		return 0, nil
+	}
+	job.Paused = arg.Paused
+	job.NextRunAt = arg.NextRunAt
+	q.Jobs[arg.Name] = job
+	return 1, nil
+}
+
+func (q *Querier) TriggerJob(_ context.Context, name string) (int64, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	job, ok := q.Jobs[name]
+	if !ok {
+		return 0, nil
+	}
+	job.TriggeredAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	q.Jobs[name] = job
+	return 1, nil
+}
+
+func (q *Querier) ClearJobTrigger(_ context.Context, name string) (int64, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	job, ok := q.Jobs[name]
+	if !ok || !job.TriggeredAt.Valid {
+		return 0, nil
+	}
+	job.TriggeredAt = pgtype.Timestamptz{}
+	q.Jobs[name] = job
+	return 1, nil
+}
+
+func (q *Querier) RecordJobSuccess(_ context.Context, arg database.RecordJobSuccessParams) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	job, ok := q.Jobs[arg.Name]
+	if !ok {
+		return nil
+	}
+	job.LastRunAt = arg.LastRunAt
+	job.LastSuccessAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	job.LastError = pgtype.Text{}
+	job.NextRunAt = arg.NextRunAt
+	q.Jobs[arg.Name] = job
+	return nil
+}
+
+func (q *Querier) RecordJobFailure(_ context.Context, arg database.RecordJobFailureParams) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	job, ok := q.Jobs[arg.Name]
+	if !ok {
+		return nil
+	}
+	job.LastRunAt = arg.LastRunAt
+	job.LastError = arg.LastError
+	job.NextRunAt = arg.NextRunAt
+	q.Jobs[arg.Name] = job
+	return nil
+}
+
+func (q *Querier) GetUserTOTP(_ context.Context, userID uuid.UUID) (database.GetUserTOTPRow, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	row, ok := q.TOTP[userID]
+	if !ok {
+		return database.GetUserTOTPRow{}, pgx.ErrNoRows
+	}
+	return database.GetUserTOTPRow{
+		Secret:       row.Secret,
+		EnabledAt:    row.EnabledAt,
+		LastUsedStep: row.LastUsedStep,
+	}, nil
+}
+
+func (q *Querier) UseUserTOTPStep(_ context.Context, arg database.UseUserTOTPStepParams) (int64, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	row, ok := q.TOTP[arg.UserID]
+	if !ok || !row.EnabledAt.Valid || row.LastUsedStep >= arg.LastUsedStep {
+		return 0, nil
+	}
+	row.LastUsedStep = arg.LastUsedStep
+	q.TOTP[arg.UserID] = row
+	return 1, nil
+}
+
+func (q *Querier) CountUserMFAAttempt(
+	_ context.Context, arg database.CountUserMFAAttemptParams,
+) (database.CountUserMFAAttemptRow, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	row, ok := q.TOTP[arg.UserID]
+	if !ok {
+		return database.CountUserMFAAttemptRow{}, pgx.ErrNoRows
+	}
+	now := time.Now()
+	switch {
+	case row.LockedUntil.Valid && !row.LockedUntil.Time.After(now):
+		row.FailedAttempts = 1
+		row.LockedUntil = pgtype.Timestamptz{}
+	case row.LockedUntil.Valid:
+		row.FailedAttempts++
+	default:
+		row.FailedAttempts++
+		if row.FailedAttempts > arg.MaxAttempts {
+			row.LockedUntil = pgtype.Timestamptz{
+				Time:  now.Add(time.Duration(arg.LockoutSeconds) * time.Second),
+				Valid: true,
+			}
+		}
+	}
+	q.TOTP[arg.UserID] = row
+	return database.CountUserMFAAttemptRow{
+		FailedAttempts: row.FailedAttempts,
+		LockedUntil:    row.LockedUntil,
+	}, nil
+}
+
+func (q *Querier) ResetUserMFAAttempts(_ context.Context, userID uuid.UUID) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	row, ok := q.TOTP[userID]
+	if !ok {
+		return nil
+	}
+	row.FailedAttempts = 0
+	row.LockedUntil = pgtype.Timestamptz{}
+	q.TOTP[userID] = row
+	return nil
+}
+
+func (q *Querier) CreateMFAChallenge(_ context.Context, arg database.CreateMFAChallengeParams) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.MFAChallenges[arg.ID] = database.MfaChallenge{
+		ID:        arg.ID,
+		UserID:    arg.UserID,
+		TokenHash: arg.TokenHash,
+		ExpiresAt: arg.ExpiresAt,
+	}
+	return nil
+}
+
+func (q *Querier) GetMFAChallenge(_ context.Context, id uuid.UUID) (database.GetMFAChallengeRow, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	challenge, ok := q.MFAChallenges[id]
+	if !ok {
+		return database.GetMFAChallengeRow{}, pgx.ErrNoRows
+	}
+	return database.GetMFAChallengeRow{
+		UserID:    challenge.UserID,
+		TokenHash: challenge.TokenHash,
+		Attempts:  challenge.Attempts,
+		ExpiresAt: challenge.ExpiresAt,
+	}, nil
+}
+
+func (q *Querier) IncrementMFAChallengeAttempts(_ context.Context, id uuid.UUID) (int32, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	challenge, ok := q.MFAChallenges[id]
+	if !ok {
+		return 0, pgx.ErrNoRows
+	}
+	challenge.Attempts++
+	q.MFAChallenges[id] = challenge
+	return challenge.Attempts, nil
+}
+
+func (q *Querier) DeleteMFAChallenge(_ context.Context, id uuid.UUID) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	delete(q.MFAChallenges, id)
+	return nil
+}
+
+func (q *Querier) DeleteExpiredUserMFAChallenges(_ context.Context, userID uuid.UUID) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+	for id, challenge := range q.MFAChallenges {
+		if challenge.UserID == userID && !challenge.ExpiresAt.Time.After(now) {
+			delete(q.MFAChallenges, id)
+		}
+	}
+	return nil
+}
+
+func (q *Querier) GetUserAuth(_ context.Context, id uuid.UUID) (database.GetUserAuthRow, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	row, ok := q.UserAuth[id]
+	if !ok {
+		return database.GetUserAuthRow{}, pgx.ErrNoRows
+	}
+	return row, nil
+}
+
+func (q *Querier) GetSession(_ context.Context, id uuid.UUID) (database.GetSessionRow, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	row, ok := q.Sessions[id]
+	if !ok {
+		return database.GetSessionRow{}, pgx.ErrNoRows
+	}
+	return row, nil
+}
+
+func (q *Querier) RotateSession(_ context.Context, arg database.RotateSessionParams) (int64, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	row, ok := q.Sessions[arg.ID]
+	if !ok || row.RefreshTokenHash != arg.RefreshTokenHash {
+		return 0, nil
+	}
+	row.RefreshTokenHash = arg.NewRefreshTokenHash
+	row.ExpiresAt = arg.ExpiresAt
+	q.Sessions[arg.ID] = row
+	return 1, nil
+}
+
+func (q *Querier) DeleteSession(_ context.Context, id uuid.UUID) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	delete(q.Sessions, id)
+	return nil
+}
+
+func (q *Querier) GetUserByEmail(_ context.Context, email string) (database.GetUserByEmailRow, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for id, userEmail := range q.Emails {
+		if userEmail == email {
+			return database.GetUserByEmailRow{
+				ID:           id,
+				Email:        email,
+				Role:         q.UserAuth[id].Role,
+				TokenVersion: q.UserAuth[id].TokenVersion,
+			}, nil
+		}
+	}
+	return database.GetUserByEmailRow{}, pgx.ErrNoRows
+}
+
+func (q *Querier) CreatePasswordReset(_ context.Context, arg database.CreatePasswordResetParams) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.PasswordResets[arg.ID] = arg
+	return nil
+}
+
+func (q *Querier) GetUser(_ context.Context, id uuid.UUID) (database.GetUserRow, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	email, ok := q.Emails[id]
+	if !ok {
+		return database.GetUserRow{}, pgx.ErrNoRows
+	}
+	return database.GetUserRow{Email: email, Role: q.UserAuth[id].Role}, nil
+}
+
+func (q *Querier) ListUserWebAuthnCredentials(
+	_ context.Context, userID uuid.UUID,
+) ([]database.ListUserWebAuthnCredentialsRow, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var rows []database.ListUserWebAuthnCredentialsRow
+	for _, passkey := range q.Passkeys {
+		if passkey.UserID == userID {
+			rows = append(rows, database.ListUserWebAuthnCredentialsRow{
+				ID:         passkey.ID,
+				Credential: passkey.Credential,
+				Name:       passkey.Name,
+				CreatedAt:  passkey.CreatedAt,
+				LastUsedAt: passkey.LastUsedAt,
+			})
+		}
+	}
+	return rows, nil
+}
+
+func (q *Querier) CreateWebAuthnCredential(_ context.Context, arg database.CreateWebAuthnCredentialParams) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.Passkeys[arg.ID] = database.WebauthnCredential{
+		ID:           arg.ID,
+		UserID:       arg.UserID,
+		CredentialID: arg.CredentialID,
+		Credential:   arg.Credential,
+		Name:         arg.Name,
+		CreatedAt:    pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+	return nil
+}
+
+func (q *Querier) GetWebAuthnCredential(
+	_ context.Context, credentialID []byte,
+) (database.GetWebAuthnCredentialRow, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, passkey := range q.Passkeys {
+		if bytes.Equal(passkey.CredentialID, credentialID) {
+			return database.GetWebAuthnCredentialRow{
+				ID:         passkey.ID,
+				UserID:     passkey.UserID,
+				Credential: passkey.Credential,
+			}, nil
+		}
+	}
+	return database.GetWebAuthnCredentialRow{}, pgx.ErrNoRows
+}
+
+func (q *Querier) UpdateWebAuthnCredentialUse(
+	_ context.Context, arg database.UpdateWebAuthnCredentialUseParams,
+) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	passkey, ok := q.Passkeys[arg.ID]
+	if !ok {
+		return nil
+	}
+	passkey.Credential = arg.Credential
+	passkey.LastUsedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	q.Passkeys[arg.ID] = passkey
+	return nil
+}
+
+func (q *Querier) CreateWebAuthnSession(_ context.Context, arg database.CreateWebAuthnSessionParams) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.WebAuthnSessions[arg.ID] = database.WebauthnSession{
+		ID:          arg.ID,
+		Ceremony:    arg.Ceremony,
+		SessionData: arg.SessionData,
+		ExpiresAt:   arg.ExpiresAt,
+		CreatedAt:   pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+	return nil
+}
+
+func (q *Querier) TakeWebAuthnSession(
+	_ context.Context, arg database.TakeWebAuthnSessionParams,
+) (database.TakeWebAuthnSessionRow, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	session, ok := q.WebAuthnSessions[arg.ID]
+	if !ok || session.Ceremony != arg.Ceremony {
+		return database.TakeWebAuthnSessionRow{}, pgx.ErrNoRows
+	}
+	delete(q.WebAuthnSessions, arg.ID)
+	return database.TakeWebAuthnSessionRow{
+		SessionData: session.SessionData,
+		ExpiresAt:   session.ExpiresAt,
+	}, nil
+}
+
+func (q *Querier) DeleteExpiredWebAuthnSessions(context.Context) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+	for id, session := range q.WebAuthnSessions {
+		if !session.ExpiresAt.Time.After(now) {
+			delete(q.WebAuthnSessions, id)
+		}
+	}
+	return nil
+}
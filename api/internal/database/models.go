@@ -15,9 +15,11 @@ import (
 type PlaylistType string
 
 const (
-	PlaylistTypeWeekly  PlaylistType = "weekly"
-	PlaylistTypeMonthly PlaylistType = "monthly"
-	PlaylistTypeCustom  PlaylistType = "custom"
+	PlaylistTypeWeekly     PlaylistType = "weekly"
+	PlaylistTypeMonthly    PlaylistType = "monthly"
+	PlaylistTypeCustom     PlaylistType = "custom"
+	PlaylistTypeYearly     PlaylistType = "yearly"
+	PlaylistTypeRolling30d PlaylistType = "rolling_30d"
 )
 
 func (e *PlaylistType) Scan(src interface{}) error {
@@ -98,38 +100,168 @@ func (ns NullRole) Value() (driver.Value, error) {
 	return string(ns.Role), nil
 }
 
+type Album struct {
+	ID        string
+	Name      string
+	Artists   []string
+	Href      string
+	Uri       string
+	ImageUrl  pgtype.Text
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+type Artist struct {
+	ID              string
+	Name            string
+	Href            string
+	Uri             string
+	ImageUrl        pgtype.Text
+	ImagesUpdatedAt pgtype.Timestamptz
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+}
+
+type Job struct {
+	Name          string
+	Schedule      string
+	Timezone      string
+	Paused        bool
+	NextRunAt     pgtype.Timestamptz
+	LastRunAt     pgtype.Timestamptz
+	LastSuccessAt pgtype.Timestamptz
+	LastError     pgtype.Text
+	TriggeredAt   pgtype.Timestamptz
+	CreatedAt     pgtype.Timestamptz
+	UpdatedAt     pgtype.Timestamptz
+}
+
+type ListenToken struct {
+	UserID    uuid.UUID
+	TokenHash string
+	CreatedAt pgtype.Timestamptz
+}
+
+type MfaChallenge struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	TokenHash string
+	Attempts  int32
+	ExpiresAt pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
+}
+
+type MfaRecoveryCode struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	CodeHash  string
+	UsedAt    pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
+}
+
+type PasswordReset struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	TokenHash string
+	ExpiresAt pgtype.Timestamptz
+	UsedAt    pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
+}
+
 type Playlist struct {
 	ID           uuid.UUID
 	UserID       uuid.UUID
 	PlaylistType PlaylistType
 	Name         string
 	CreatedAt    pgtype.Timestamptz
+	PeriodStart  pgtype.Timestamptz
+	Description  string
+}
+
+type PlaylistExport struct {
+	PlaylistID uuid.UUID
+	Provider   string
+	RemoteID   string
+	Url        string
+	ExportedAt pgtype.Timestamptz
+}
+
+type PlaylistRule struct {
+	UserID             uuid.UUID
+	MaxTracks          int32
+	MinPlays           int32
+	Ordering           string
+	MaxTracksPerArtist int32
+	NameTemplate       string
+	UpdatedAt          pgtype.Timestamptz
 }
 
 type PlaylistTrack struct {
 	PlaylistID uuid.UUID
 	TrackID    string
 	Plays      int32
+	Position   pgtype.Int4
+}
+
+type ProviderAccount struct {
+	UserID    uuid.UUID
+	Provider  string
+	AccountID string
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+type ProviderToken struct {
+	UserID       uuid.UUID
+	Provider     string
+	AccessToken  string
+	TokenType    string
+	Scope        string
+	RefreshToken string
+	ExpiresAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
 }
 
-type SpotifyToken struct {
-	SpotifyUserID string
-	AccessToken   string
-	TokenType     string
-	Scope         string
-	RefreshToken  string
-	ExpiresAt     pgtype.Timestamptz
+type Session struct {
+	ID               uuid.UUID
+	UserID           uuid.UUID
+	RefreshTokenHash string
+	UserAgent        string
+	IpAddress        string
+	ExpiresAt        pgtype.Timestamptz
+	CreatedAt        pgtype.Timestamptz
+	LastUsedAt       pgtype.Timestamptz
+}
+
+type SpotifySyncState struct {
+	UserID            uuid.UUID
+	PlayedAtCursor    pgtype.Timestamptz
+	LastSuccessAt     pgtype.Timestamptz
+	LastError         pgtype.Text
+	LastErrorAt       pgtype.Timestamptz
+	LastPages         int32
+	LastItemsFetched  int32
+	LastItemsInserted int32
+	UpdatedAt         pgtype.Timestamptz
 }
 
 type Track struct {
-	ID        string
-	Name      string
-	Artists   []string
-	Href      string
-	Uri       string
-	ImageUrl  pgtype.Text
-	CreatedAt pgtype.Timestamptz
-	UpdatedAt pgtype.Timestamptz
+	ID         string
+	Name       string
+	Artists    []string
+	Href       string
+	Uri        string
+	ImageUrl   pgtype.Text
+	CreatedAt  pgtype.Timestamptz
+	UpdatedAt  pgtype.Timestamptz
+	AlbumID    pgtype.Text
+	DurationMs pgtype.Int4
+}
+
+type TrackArtist struct {
+	TrackID  string
+	ArtistID string
+	Position int16
 }
 
 type TrackListen struct {
@@ -139,13 +271,52 @@ type TrackListen struct {
 }
 
 type User struct {
-	ID                    uuid.UUID
-	Email                 string
-	Role                  Role
-	PasswordHash          string
-	SpotifyID             pgtype.Text
-	RefreshTokenHash      pgtype.Text
-	RefreshTokenExpiresAt pgtype.Timestamptz
-	CreatedAt             pgtype.Timestamptz
-	UpdatedAt             pgtype.Timestamptz
+	ID           uuid.UUID
+	Email        string
+	Role         Role
+	PasswordHash string
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+	Timezone     string
+	WeekStart    int16
+	TokenVersion int32
+}
+
+type UserInvite struct {
+	ID        uuid.UUID
+	TokenHash string
+	Email     pgtype.Text
+	CreatedBy uuid.UUID
+	UsedBy    uuid.UUID
+	ExpiresAt pgtype.Timestamptz
+	UsedAt    pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
+}
+
+type UserTotp struct {
+	UserID         uuid.UUID
+	Secret         string
+	EnabledAt      pgtype.Timestamptz
+	LastUsedStep   int64
+	CreatedAt      pgtype.Timestamptz
+	FailedAttempts int32
+	LockedUntil    pgtype.Timestamptz
+}
+
+type WebauthnCredential struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	CredentialID []byte
+	Credential   []byte
+	Name         string
+	CreatedAt    pgtype.Timestamptz
+	LastUsedAt   pgtype.Timestamptz
+}
+
+type WebauthnSession struct {
+	ID          uuid.UUID
+	Ceremony    string
+	SessionData []byte
+	ExpiresAt   pgtype.Timestamptz
+	CreatedAt   pgtype.Timestamptz
 }
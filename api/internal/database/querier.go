@@ -14,31 +14,118 @@ import (
 type Querier interface {
 	AddPlaylistTrack(ctx context.Context, arg AddPlaylistTrackParams) error
 	AdminExists(ctx context.Context) (bool, error)
+	ClearJobTrigger(ctx context.Context, name string) (int64, error)
+	CountUnusedRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error)
+	CountUserListens(ctx context.Context, arg CountUserListensParams) (int64, error)
+	CountUserMFAAttempt(ctx context.Context, arg CountUserMFAAttemptParams) (CountUserMFAAttemptRow, error)
 	CreateAdminUser(ctx context.Context, arg CreateAdminUserParams) (uuid.UUID, error)
+	CreateMFAChallenge(ctx context.Context, arg CreateMFAChallengeParams) error
+	CreatePasswordReset(ctx context.Context, arg CreatePasswordResetParams) error
 	CreatePlaylist(ctx context.Context, arg CreatePlaylistParams) (uuid.UUID, error)
+	CreateRecoveryCode(ctx context.Context, arg CreateRecoveryCodeParams) error
 	CreateServiceAccount(ctx context.Context, arg CreateServiceAccountParams) (uuid.UUID, error)
+	CreateSession(ctx context.Context, arg CreateSessionParams) error
+	CreateTrackIfNotExists(ctx context.Context, arg CreateTrackIfNotExistsParams) error
+	CreateUser(ctx context.Context, arg CreateUserParams) (uuid.UUID, error)
+	CreateUserInvite(ctx context.Context, arg CreateUserInviteParams) error
+	CreateWebAuthnCredential(ctx context.Context, arg CreateWebAuthnCredentialParams) error
+	CreateWebAuthnSession(ctx context.Context, arg CreateWebAuthnSessionParams) error
+	DeleteExpiredUserMFAChallenges(ctx context.Context, userID uuid.UUID) error
+	DeleteExpiredUserSessions(ctx context.Context, userID uuid.UUID) error
+	DeleteExpiredWebAuthnSessions(ctx context.Context) error
+	DeleteListenToken(ctx context.Context, userID uuid.UUID) (int64, error)
+	DeleteMFAChallenge(ctx context.Context, id uuid.UUID) error
+	DeleteOtherUserSessions(ctx context.Context, arg DeleteOtherUserSessionsParams) error
+	DeletePlaylistExport(ctx context.Context, arg DeletePlaylistExportParams) (int64, error)
+	DeletePlaylistTracks(ctx context.Context, playlistID uuid.UUID) error
+	DeleteSession(ctx context.Context, id uuid.UUID) error
+	DeleteUserInvite(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteUserPasswordResets(ctx context.Context, userID uuid.UUID) error
+	DeleteUserRecoveryCodes(ctx context.Context, userID uuid.UUID) error
+	DeleteUserSession(ctx context.Context, arg DeleteUserSessionParams) (int64, error)
+	DeleteUserSessions(ctx context.Context, userID uuid.UUID) error
+	DeleteUserTOTP(ctx context.Context, userID uuid.UUID) (int64, error)
+	DeleteUserWebAuthnCredential(ctx context.Context, arg DeleteUserWebAuthnCredentialParams) (int64, error)
+	EnableUserTOTP(ctx context.Context, arg EnableUserTOTPParams) (int64, error)
+	ExportListens(ctx context.Context, arg ExportListensParams) ([]ExportListensRow, error)
+	ExportUserListens(ctx context.Context, arg ExportUserListensParams) ([]ExportUserListensRow, error)
+	FindTrackByNameAndArtist(ctx context.Context, arg FindTrackByNameAndArtistParams) (string, error)
+	GetJob(ctx context.Context, name string) (Job, error)
+	GetLatestUserPlaylistByType(ctx context.Context, arg GetLatestUserPlaylistByTypeParams) (GetLatestUserPlaylistByTypeRow, error)
+	GetListenToken(ctx context.Context, userID uuid.UUID) (GetListenTokenRow, error)
+	GetMFAChallenge(ctx context.Context, id uuid.UUID) (GetMFAChallengeRow, error)
+	GetPasswordReset(ctx context.Context, id uuid.UUID) (GetPasswordResetRow, error)
+	GetPlaylistExport(ctx context.Context, arg GetPlaylistExportParams) (GetPlaylistExportRow, error)
+	GetPlaylistRules(ctx context.Context, userID uuid.UUID) (GetPlaylistRulesRow, error)
 	GetPlaylistTracks(ctx context.Context, playlistID uuid.UUID) ([]GetPlaylistTracksRow, error)
+	GetProviderTokens(ctx context.Context, arg GetProviderTokensParams) (GetProviderTokensRow, error)
+	GetSession(ctx context.Context, id uuid.UUID) (GetSessionRow, error)
+	GetSpotifySyncState(ctx context.Context, userID uuid.UUID) (GetSpotifySyncStateRow, error)
 	GetUser(ctx context.Context, id uuid.UUID) (GetUserRow, error)
+	GetUserAuth(ctx context.Context, id uuid.UUID) (GetUserAuthRow, error)
 	GetUserByEmail(ctx context.Context, email string) (GetUserByEmailRow, error)
 	GetUserIDs(ctx context.Context, limit int32) ([]uuid.UUID, error)
+	GetUserInvite(ctx context.Context, id uuid.UUID) (GetUserInviteRow, error)
+	GetUserListenStats(ctx context.Context, arg GetUserListenStatsParams) (GetUserListenStatsRow, error)
+	GetUserPasswordHash(ctx context.Context, id uuid.UUID) (string, error)
 	GetUserPlaylist(ctx context.Context, arg GetUserPlaylistParams) (GetUserPlaylistRow, error)
+	GetUserPlaylistByPeriod(ctx context.Context, arg GetUserPlaylistByPeriodParams) (uuid.UUID, error)
 	GetUserPlaylists(ctx context.Context, userID uuid.UUID) ([]GetUserPlaylistsRow, error)
-	GetUserRefreshToken(ctx context.Context, id uuid.UUID) (GetUserRefreshTokenRow, error)
-	GetUserRole(ctx context.Context, id uuid.UUID) (Role, error)
-	GetUserSpotifyAccessToken(ctx context.Context, id uuid.UUID) (string, error)
-	GetUserSpotifyId(ctx context.Context, id uuid.UUID) (pgtype.Text, error)
-	GetUserSpotifyRefreshToken(ctx context.Context, id uuid.UUID) (string, error)
-	GetUserSpotifyTokenExpiration(ctx context.Context, id uuid.UUID) (pgtype.Timestamptz, error)
+	GetUserPreferences(ctx context.Context, id uuid.UUID) (GetUserPreferencesRow, error)
+	GetUserTOTP(ctx context.Context, userID uuid.UUID) (GetUserTOTPRow, error)
+	GetUserTokenVersion(ctx context.Context, id uuid.UUID) (int32, error)
+	GetWebAuthnCredential(ctx context.Context, credentialID []byte) (GetWebAuthnCredentialRow, error)
+	IncrementMFAChallengeAttempts(ctx context.Context, id uuid.UUID) (int32, error)
+	IncrementUserTokenVersion(ctx context.Context, id uuid.UUID) (int64, error)
+	ListArtistsWithoutImages(ctx context.Context, ids []string) ([]string, error)
+	ListJobs(ctx context.Context) ([]Job, error)
+	ListPlaylistCandidates(ctx context.Context, arg ListPlaylistCandidatesParams) ([]ListPlaylistCandidatesRow, error)
+	ListRunnableJobs(ctx context.Context) ([]Job, error)
+	ListUnusedRecoveryCodes(ctx context.Context, userID uuid.UUID) ([]ListUnusedRecoveryCodesRow, error)
+	ListUserIDsAfter(ctx context.Context, arg ListUserIDsAfterParams) ([]uuid.UUID, error)
+	ListUserInvites(ctx context.Context) ([]ListUserInvitesRow, error)
+	ListUserListens(ctx context.Context, arg ListUserListensParams) ([]ListUserListensRow, error)
+	ListUserSessions(ctx context.Context, userID uuid.UUID) ([]ListUserSessionsRow, error)
+	ListUserWebAuthnCredentials(ctx context.Context, userID uuid.UUID) ([]ListUserWebAuthnCredentialsRow, error)
 	Ping(ctx context.Context) error
+	RecordJobFailure(ctx context.Context, arg RecordJobFailureParams) error
+	RecordJobSuccess(ctx context.Context, arg RecordJobSuccessParams) error
+	RecordSpotifySyncFailure(ctx context.Context, arg RecordSpotifySyncFailureParams) error
+	RecordSpotifySyncSuccess(ctx context.Context, arg RecordSpotifySyncSuccessParams) error
+	RedeemPasswordReset(ctx context.Context, id uuid.UUID) (int64, error)
+	RedeemUserInvite(ctx context.Context, arg RedeemUserInviteParams) (int64, error)
+	ResetUserMFAAttempts(ctx context.Context, userID uuid.UUID) error
+	RotateSession(ctx context.Context, arg RotateSessionParams) (int64, error)
 	ServiceAccountExists(ctx context.Context) (bool, error)
-	TopTrackIDsByUserInRange(ctx context.Context, arg TopTrackIDsByUserInRangeParams) ([]TopTrackIDsByUserInRangeRow, error)
+	SetJobPaused(ctx context.Context, arg SetJobPausedParams) (int64, error)
+	TakeWebAuthnSession(ctx context.Context, arg TakeWebAuthnSessionParams) (TakeWebAuthnSessionRow, error)
+	TopAlbumsByUserInRange(ctx context.Context, arg TopAlbumsByUserInRangeParams) ([]TopAlbumsByUserInRangeRow, error)
+	TopArtistsByUserInRange(ctx context.Context, arg TopArtistsByUserInRangeParams) ([]TopArtistsByUserInRangeRow, error)
 	TopTracksByUserInRange(ctx context.Context, arg TopTracksByUserInRangeParams) ([]TopTracksByUserInRangeRow, error)
-	UpdateUserRefreshToken(ctx context.Context, arg UpdateUserRefreshTokenParams) error
-	UpdateUserSpotifyID(ctx context.Context, arg UpdateUserSpotifyIDParams) error
-	UpdateUserSpotifyTokens(ctx context.Context, arg UpdateUserSpotifyTokensParams) error
+	TrackExists(ctx context.Context, id string) (bool, error)
+	TriggerJob(ctx context.Context, name string) (int64, error)
+	UpdateArtistImage(ctx context.Context, arg UpdateArtistImageParams) error
+	UpdatePlaylistPeriod(ctx context.Context, arg UpdatePlaylistPeriodParams) error
+	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error
+	UpdateUserPreferences(ctx context.Context, arg UpdateUserPreferencesParams) (UpdateUserPreferencesRow, error)
+	UpdateWebAuthnCredentialUse(ctx context.Context, arg UpdateWebAuthnCredentialUseParams) error
+	UpsertAlbum(ctx context.Context, arg UpsertAlbumParams) error
+	UpsertArtist(ctx context.Context, arg UpsertArtistParams) error
+	UpsertJob(ctx context.Context, arg UpsertJobParams) error
+	UpsertListenToken(ctx context.Context, arg UpsertListenTokenParams) (pgtype.Timestamptz, error)
+	UpsertPendingUserTOTP(ctx context.Context, arg UpsertPendingUserTOTPParams) (int64, error)
+	UpsertPlaylistExport(ctx context.Context, arg UpsertPlaylistExportParams) error
+	UpsertPlaylistRules(ctx context.Context, arg UpsertPlaylistRulesParams) error
+	UpsertProviderAccount(ctx context.Context, arg UpsertProviderAccountParams) error
+	UpsertProviderTokens(ctx context.Context, arg UpsertProviderTokensParams) error
 	UpsertTrack(ctx context.Context, arg UpsertTrackParams) error
-	UpsertTrackListen(ctx context.Context, arg UpsertTrackListenParams) error
-	UpsertUserSpotifyTokens(ctx context.Context, arg UpsertUserSpotifyTokensParams) error
+	UpsertTrackArtist(ctx context.Context, arg UpsertTrackArtistParams) error
+	UpsertTrackListen(ctx context.Context, arg UpsertTrackListenParams) (int64, error)
+	UseRecoveryCode(ctx context.Context, id uuid.UUID) (int64, error)
+	UseUserTOTPStep(ctx context.Context, arg UseUserTOTPStepParams) (int64, error)
+	UserListenHeatmap(ctx context.Context, arg UserListenHeatmapParams) ([]UserListenHeatmapRow, error)
+	UserListenMonths(ctx context.Context, arg UserListenMonthsParams) ([]UserListenMonthsRow, error)
+	UserListenStreaks(ctx context.Context, arg UserListenStreaksParams) ([]UserListenStreaksRow, error)
 }
 
 var _ Querier = (*Queries)(nil)
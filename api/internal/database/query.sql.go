@@ -13,18 +13,24 @@ import (
 )
 
 const addPlaylistTrack = `-- name: AddPlaylistTrack :exec
-INSERT INTO playlist_tracks (playlist_id, track_id, plays)
-  VALUES ($1, $2, $3)
+INSERT INTO playlist_tracks (playlist_id, track_id, plays, position)
+  VALUES ($1, $2, $3, $4)
 `
 
 type AddPlaylistTrackParams struct {
 	PlaylistID uuid.UUID
 	TrackID    string
 	Plays      int32
+	Position   pgtype.Int4
 }
 
 func (q *Queries) AddPlaylistTrack(ctx context.Context, arg AddPlaylistTrackParams) error {
-	_, err := q.db.Exec(ctx, addPlaylistTrack, arg.PlaylistID, arg.TrackID, arg.Plays)
+	_, err := q.db.Exec(ctx, addPlaylistTrack,
+		arg.PlaylistID,
+		arg.TrackID,
+		arg.Plays,
+		arg.Position,
+	)
 	return err
 }
 
@@ -46,6 +52,130 @@ func (q *Queries) AdminExists(ctx context.Context) (bool, error) {
 	return exists, err
 }
 
+const clearJobTrigger = `-- name: ClearJobTrigger :execrows
+UPDATE
+  jobs
+SET
+  triggered_at = NULL,
+  updated_at = now()
+WHERE
+  name = $1
+  AND triggered_at IS NOT NULL
+`
+
+func (q *Queries) ClearJobTrigger(ctx context.Context, name string) (int64, error) {
+	result, err := q.db.Exec(ctx, clearJobTrigger, name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const countUnusedRecoveryCodes = `-- name: CountUnusedRecoveryCodes :one
+SELECT
+  count(*)
+FROM
+  mfa_recovery_codes
+WHERE
+  user_id = $1
+  AND used_at IS NULL
+`
+
+func (q *Queries) CountUnusedRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countUnusedRecoveryCodes, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUserListens = `-- name: CountUserListens :one
+SELECT
+  COUNT(*)::bigint AS total
+FROM
+  track_listens tl
+  JOIN tracks t ON t.id = tl.track_id
+WHERE
+  tl.user_id = $1
+  AND ($2::timestamptz IS NULL
+    OR tl.played_at >= $2)
+  AND ($3::timestamptz IS NULL
+    OR tl.played_at < $3)
+  AND ($4::text IS NULL
+    OR EXISTS (
+      SELECT
+        1
+      FROM
+        unnest(t.artists) AS artist
+      WHERE
+        artist ILIKE $4))
+  AND ($5::text IS NULL
+    OR t.name ILIKE $5)
+`
+
+type CountUserListensParams struct {
+	UserID uuid.UUID
+	Start  pgtype.Timestamptz
+	End    pgtype.Timestamptz
+	Artist pgtype.Text
+	Track  pgtype.Text
+}
+
+func (q *Queries) CountUserListens(ctx context.Context, arg CountUserListensParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countUserListens,
+		arg.UserID,
+		arg.Start,
+		arg.End,
+		arg.Artist,
+		arg.Track,
+	)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
+const countUserMFAAttempt = `-- name: CountUserMFAAttempt :one
+UPDATE
+  user_totp
+SET
+  failed_attempts = CASE WHEN locked_until <= now() THEN
+    1
+  ELSE
+    failed_attempts + 1
+  END,
+  locked_until = CASE WHEN locked_until <= now() THEN
+    NULL
+  WHEN locked_until IS NOT NULL THEN
+    locked_until
+  WHEN failed_attempts + 1 > $2::integer THEN
+    now() + make_interval(secs => $3::integer)
+  ELSE
+    NULL
+  END
+WHERE
+  user_id = $1
+RETURNING
+  failed_attempts,
+  locked_until
+`
+
+type CountUserMFAAttemptParams struct {
+	UserID         uuid.UUID
+	MaxAttempts    int32
+	LockoutSeconds int32
+}
+
+type CountUserMFAAttemptRow struct {
+	FailedAttempts int32
+	LockedUntil    pgtype.Timestamptz
+}
+
+func (q *Queries) CountUserMFAAttempt(ctx context.Context, arg CountUserMFAAttemptParams) (CountUserMFAAttemptRow, error) {
+	row := q.db.QueryRow(ctx, countUserMFAAttempt, arg.UserID, arg.MaxAttempts, arg.LockoutSeconds)
+	var i CountUserMFAAttemptRow
+	err := row.Scan(&i.FailedAttempts, &i.LockedUntil)
+	return i, err
+}
+
 const createAdminUser = `-- name: CreateAdminUser :one
 INSERT INTO users (email, role, password_hash)
   VALUES (trim(lower($2::text)), 'admin', $1)
@@ -65,9 +195,53 @@ func (q *Queries) CreateAdminUser(ctx context.Context, arg CreateAdminUserParams
 	return id, err
 }
 
+const createMFAChallenge = `-- name: CreateMFAChallenge :exec
+INSERT INTO mfa_challenges (id, user_id, token_hash, expires_at)
+  VALUES ($1, $2, $3, $4)
+`
+
+type CreateMFAChallengeParams struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	TokenHash string
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) CreateMFAChallenge(ctx context.Context, arg CreateMFAChallengeParams) error {
+	_, err := q.db.Exec(ctx, createMFAChallenge,
+		arg.ID,
+		arg.UserID,
+		arg.TokenHash,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const createPasswordReset = `-- name: CreatePasswordReset :exec
+INSERT INTO password_resets (id, user_id, token_hash, expires_at)
+  VALUES ($1, $2, $3, $4)
+`
+
+type CreatePasswordResetParams struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	TokenHash string
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) CreatePasswordReset(ctx context.Context, arg CreatePasswordResetParams) error {
+	_, err := q.db.Exec(ctx, createPasswordReset,
+		arg.ID,
+		arg.UserID,
+		arg.TokenHash,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
 const createPlaylist = `-- name: CreatePlaylist :one
-INSERT INTO playlists (user_id, playlist_type, name)
-  VALUES ($1, $2, $3)
+INSERT INTO playlists (user_id, playlist_type, name, period_start, description)
+  VALUES ($1, $2, $3, $4, $5)
 RETURNING
   id
 `
@@ -76,15 +250,39 @@ type CreatePlaylistParams struct {
 	UserID       uuid.UUID
 	PlaylistType PlaylistType
 	Name         string
+	PeriodStart  pgtype.Timestamptz
+	Description  string
 }
 
 func (q *Queries) CreatePlaylist(ctx context.Context, arg CreatePlaylistParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, createPlaylist, arg.UserID, arg.PlaylistType, arg.Name)
+	row := q.db.QueryRow(ctx, createPlaylist,
+		arg.UserID,
+		arg.PlaylistType,
+		arg.Name,
+		arg.PeriodStart,
+		arg.Description,
+	)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
 }
 
+const createRecoveryCode = `-- name: CreateRecoveryCode :exec
+INSERT INTO mfa_recovery_codes (id, user_id, code_hash)
+  VALUES ($1, $2, $3)
+`
+
+type CreateRecoveryCodeParams struct {
+	ID       uuid.UUID
+	UserID   uuid.UUID
+	CodeHash string
+}
+
+func (q *Queries) CreateRecoveryCode(ctx context.Context, arg CreateRecoveryCodeParams) error {
+	_, err := q.db.Exec(ctx, createRecoveryCode, arg.ID, arg.UserID, arg.CodeHash)
+	return err
+}
+
 const createServiceAccount = `-- name: CreateServiceAccount :one
 INSERT INTO users (email, role, password_hash)
   VALUES (trim(lower($2::text)), 'service', $1)
@@ -104,406 +302,456 @@ func (q *Queries) CreateServiceAccount(ctx context.Context, arg CreateServiceAcc
 	return id, err
 }
 
-const getPlaylistTracks = `-- name: GetPlaylistTracks :many
-SELECT
-  t.id,
-  t.name,
-  t.artists,
-  t.href,
-  t.image_url,
-  t.uri,
-  pt.plays
-FROM
-  playlist_tracks pt
-  JOIN tracks t ON pt.track_id = t.id
-WHERE
-  pt.playlist_id = $1
-ORDER BY
-  pt.plays DESC,
-  pt.track_id ASC
+const createSession = `-- name: CreateSession :exec
+INSERT INTO sessions (id, user_id, refresh_token_hash, user_agent, ip_address, expires_at)
+  VALUES ($1, $2, $3, $4, $5, $6)
 `
 
-type GetPlaylistTracksRow struct {
-	ID       string
-	Name     string
-	Artists  []string
-	Href     string
-	ImageUrl pgtype.Text
-	Uri      string
-	Plays    int32
+type CreateSessionParams struct {
+	ID               uuid.UUID
+	UserID           uuid.UUID
+	RefreshTokenHash string
+	UserAgent        string
+	IpAddress        string
+	ExpiresAt        pgtype.Timestamptz
 }
 
-func (q *Queries) GetPlaylistTracks(ctx context.Context, playlistID uuid.UUID) ([]GetPlaylistTracksRow, error) {
-	rows, err := q.db.Query(ctx, getPlaylistTracks, playlistID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []GetPlaylistTracksRow
-	for rows.Next() {
-		var i GetPlaylistTracksRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.Name,
-			&i.Artists,
-			&i.Href,
-			&i.ImageUrl,
-			&i.Uri,
-			&i.Plays,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) error {
+	_, err := q.db.Exec(ctx, createSession,
+		arg.ID,
+		arg.UserID,
+		arg.RefreshTokenHash,
+		arg.UserAgent,
+		arg.IpAddress,
+		arg.ExpiresAt,
+	)
+	return err
 }
 
-const getUser = `-- name: GetUser :one
-SELECT
-  email,
-  ROLE
-FROM
-  users
-WHERE
-  id = $1
+const createTrackIfNotExists = `-- name: CreateTrackIfNotExists :exec
+INSERT INTO tracks (id, name, artists, href, uri, duration_ms)
+  VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (id)
+  DO UPDATE SET
+    duration_ms = EXCLUDED.duration_ms
+  WHERE
+    tracks.duration_ms IS NULL
+    AND EXCLUDED.duration_ms IS NOT NULL
 `
 
-type GetUserRow struct {
-	Email string
-	Role  Role
+type CreateTrackIfNotExistsParams struct {
+	ID         string
+	Name       string
+	Artists    []string
+	Href       string
+	Uri        string
+	DurationMs pgtype.Int4
 }
 
-func (q *Queries) GetUser(ctx context.Context, id uuid.UUID) (GetUserRow, error) {
-	row := q.db.QueryRow(ctx, getUser, id)
-	var i GetUserRow
-	err := row.Scan(&i.Email, &i.Role)
-	return i, err
+func (q *Queries) CreateTrackIfNotExists(ctx context.Context, arg CreateTrackIfNotExistsParams) error {
+	_, err := q.db.Exec(ctx, createTrackIfNotExists,
+		arg.ID,
+		arg.Name,
+		arg.Artists,
+		arg.Href,
+		arg.Uri,
+		arg.DurationMs,
+	)
+	return err
 }
 
-const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT
-  id,
-  email,
-  ROLE,
-  password_hash
-FROM
-  users
-WHERE
-  email = trim(lower($1::text))
+const createUser = `-- name: CreateUser :one
+INSERT INTO users (email, role, password_hash)
+  VALUES (trim(lower($2::text)), 'user', $1)
+RETURNING
+  id
 `
 
-type GetUserByEmailRow struct {
-	ID           uuid.UUID
-	Email        string
-	Role         Role
+type CreateUserParams struct {
 	PasswordHash string
+	Email        string
 }
 
-func (q *Queries) GetUserByEmail(ctx context.Context, email string) (GetUserByEmailRow, error) {
-	row := q.db.QueryRow(ctx, getUserByEmail, email)
-	var i GetUserByEmailRow
-	err := row.Scan(
-		&i.ID,
-		&i.Email,
-		&i.Role,
-		&i.PasswordHash,
-	)
-	return i, err
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createUser, arg.PasswordHash, arg.Email)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
 }
 
-const getUserIDs = `-- name: GetUserIDs :many
-SELECT
-  id
-FROM
-  users
-ORDER BY
-  created_at ASC
-LIMIT $1
+const createUserInvite = `-- name: CreateUserInvite :exec
+INSERT INTO user_invites (id, token_hash, email, created_by, expires_at)
+  VALUES ($1, $2, trim(lower($5::text)), $3, $4)
 `
 
-func (q *Queries) GetUserIDs(ctx context.Context, limit int32) ([]uuid.UUID, error) {
-	rows, err := q.db.Query(ctx, getUserIDs, limit)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []uuid.UUID
-	for rows.Next() {
-		var id uuid.UUID
-		if err := rows.Scan(&id); err != nil {
-			return nil, err
-		}
-		items = append(items, id)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+type CreateUserInviteParams struct {
+	ID        uuid.UUID
+	TokenHash string
+	CreatedBy uuid.UUID
+	ExpiresAt pgtype.Timestamptz
+	Email     pgtype.Text
 }
 
-const getUserPlaylist = `-- name: GetUserPlaylist :one
-SELECT
-  id,
-  playlist_type,
-  name,
-  created_at
-FROM
-  playlists
-WHERE
-  user_id = $1
-  AND id = $2
-`
-
-type GetUserPlaylistParams struct {
-	UserID uuid.UUID
-	ID     uuid.UUID
+func (q *Queries) CreateUserInvite(ctx context.Context, arg CreateUserInviteParams) error {
+	_, err := q.db.Exec(ctx, createUserInvite,
+		arg.ID,
+		arg.TokenHash,
+		arg.CreatedBy,
+		arg.ExpiresAt,
+		arg.Email,
+	)
+	return err
 }
 
-type GetUserPlaylistRow struct {
+const createWebAuthnCredential = `-- name: CreateWebAuthnCredential :exec
+INSERT INTO webauthn_credentials (id, user_id, credential_id, credential, name)
+  VALUES ($1, $2, $3, $4, $5)
+`
+
+type CreateWebAuthnCredentialParams struct {
 	ID           uuid.UUID
-	PlaylistType PlaylistType
+	UserID       uuid.UUID
+	CredentialID []byte
+	Credential   []byte
 	Name         string
-	CreatedAt    pgtype.Timestamptz
 }
 
-func (q *Queries) GetUserPlaylist(ctx context.Context, arg GetUserPlaylistParams) (GetUserPlaylistRow, error) {
-	row := q.db.QueryRow(ctx, getUserPlaylist, arg.UserID, arg.ID)
-	var i GetUserPlaylistRow
-	err := row.Scan(
-		&i.ID,
-		&i.PlaylistType,
-		&i.Name,
-		&i.CreatedAt,
+func (q *Queries) CreateWebAuthnCredential(ctx context.Context, arg CreateWebAuthnCredentialParams) error {
+	_, err := q.db.Exec(ctx, createWebAuthnCredential,
+		arg.ID,
+		arg.UserID,
+		arg.CredentialID,
+		arg.Credential,
+		arg.Name,
 	)
-	return i, err
+	return err
 }
 
-const getUserPlaylists = `-- name: GetUserPlaylists :many
-SELECT
-  id,
-  playlist_type,
-  name,
-  created_at
-FROM
-  playlists
-WHERE
-  user_id = $1
-ORDER BY
-  created_at DESC
+const createWebAuthnSession = `-- name: CreateWebAuthnSession :exec
+INSERT INTO webauthn_sessions (id, ceremony, session_data, expires_at)
+  VALUES ($1, $2, $3, $4)
 `
 
-type GetUserPlaylistsRow struct {
-	ID           uuid.UUID
-	PlaylistType PlaylistType
-	Name         string
-	CreatedAt    pgtype.Timestamptz
+type CreateWebAuthnSessionParams struct {
+	ID          uuid.UUID
+	Ceremony    string
+	SessionData []byte
+	ExpiresAt   pgtype.Timestamptz
 }
 
-func (q *Queries) GetUserPlaylists(ctx context.Context, userID uuid.UUID) ([]GetUserPlaylistsRow, error) {
-	rows, err := q.db.Query(ctx, getUserPlaylists, userID)
+func (q *Queries) CreateWebAuthnSession(ctx context.Context, arg CreateWebAuthnSessionParams) error {
+	_, err := q.db.Exec(ctx, createWebAuthnSession,
+		arg.ID,
+		arg.Ceremony,
+		arg.SessionData,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const deleteExpiredUserMFAChallenges = `-- name: DeleteExpiredUserMFAChallenges :exec
+DELETE FROM mfa_challenges
+WHERE user_id = $1
+  AND expires_at <= now()
+`
+
+func (q *Queries) DeleteExpiredUserMFAChallenges(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteExpiredUserMFAChallenges, userID)
+	return err
+}
+
+const deleteExpiredUserSessions = `-- name: DeleteExpiredUserSessions :exec
+DELETE FROM sessions
+WHERE user_id = $1
+  AND expires_at <= now()
+`
+
+func (q *Queries) DeleteExpiredUserSessions(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteExpiredUserSessions, userID)
+	return err
+}
+
+const deleteExpiredWebAuthnSessions = `-- name: DeleteExpiredWebAuthnSessions :exec
+DELETE FROM webauthn_sessions
+WHERE expires_at <= now()
+`
+
+func (q *Queries) DeleteExpiredWebAuthnSessions(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deleteExpiredWebAuthnSessions)
+	return err
+}
+
+const deleteListenToken = `-- name: DeleteListenToken :execrows
+DELETE FROM listen_tokens
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteListenToken(ctx context.Context, userID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteListenToken, userID)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []GetUserPlaylistsRow
-	for rows.Next() {
-		var i GetUserPlaylistsRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.PlaylistType,
-			&i.Name,
-			&i.CreatedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const getUserRefreshToken = `-- name: GetUserRefreshToken :one
-SELECT
-  refresh_token_hash,
-  refresh_token_expires_at
-FROM
-  users
-WHERE
-  id = $1
+const deleteMFAChallenge = `-- name: DeleteMFAChallenge :exec
+DELETE FROM mfa_challenges
+WHERE id = $1
 `
 
-type GetUserRefreshTokenRow struct {
-	RefreshTokenHash      pgtype.Text
-	RefreshTokenExpiresAt pgtype.Timestamptz
+func (q *Queries) DeleteMFAChallenge(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteMFAChallenge, id)
+	return err
 }
 
-func (q *Queries) GetUserRefreshToken(ctx context.Context, id uuid.UUID) (GetUserRefreshTokenRow, error) {
-	row := q.db.QueryRow(ctx, getUserRefreshToken, id)
-	var i GetUserRefreshTokenRow
-	err := row.Scan(&i.RefreshTokenHash, &i.RefreshTokenExpiresAt)
-	return i, err
+const deleteOtherUserSessions = `-- name: DeleteOtherUserSessions :exec
+DELETE FROM sessions
+WHERE user_id = $1
+  AND id <> $2
+`
+
+type DeleteOtherUserSessionsParams struct {
+	UserID uuid.UUID
+	ID     uuid.UUID
 }
 
-const getUserRole = `-- name: GetUserRole :one
-SELECT
-  ROLE
-FROM
-  users
-WHERE
-  id = $1
+func (q *Queries) DeleteOtherUserSessions(ctx context.Context, arg DeleteOtherUserSessionsParams) error {
+	_, err := q.db.Exec(ctx, deleteOtherUserSessions, arg.UserID, arg.ID)
+	return err
+}
+
+const deletePlaylistExport = `-- name: DeletePlaylistExport :execrows
+DELETE FROM playlist_exports
+WHERE playlist_id = $1
+  AND provider = $2
 `
 
-func (q *Queries) GetUserRole(ctx context.Context, id uuid.UUID) (Role, error) {
-	row := q.db.QueryRow(ctx, getUserRole, id)
-	var role Role
-	err := row.Scan(&role)
-	return role, err
+type DeletePlaylistExportParams struct {
+	PlaylistID uuid.UUID
+	Provider   string
 }
 
-const getUserSpotifyAccessToken = `-- name: GetUserSpotifyAccessToken :one
-SELECT
-  st.access_token
-FROM
-  users u
-  JOIN spotify_tokens st ON st.spotify_user_id = u.spotify_id
-WHERE
-  u.spotify_id IS NOT NULL
-  AND u.id = $1
+func (q *Queries) DeletePlaylistExport(ctx context.Context, arg DeletePlaylistExportParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePlaylistExport, arg.PlaylistID, arg.Provider)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deletePlaylistTracks = `-- name: DeletePlaylistTracks :exec
+DELETE FROM playlist_tracks
+WHERE playlist_id = $1
 `
 
-func (q *Queries) GetUserSpotifyAccessToken(ctx context.Context, id uuid.UUID) (string, error) {
-	row := q.db.QueryRow(ctx, getUserSpotifyAccessToken, id)
-	var access_token string
-	err := row.Scan(&access_token)
-	return access_token, err
+func (q *Queries) DeletePlaylistTracks(ctx context.Context, playlistID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deletePlaylistTracks, playlistID)
+	return err
 }
 
-const getUserSpotifyId = `-- name: GetUserSpotifyId :one
-SELECT
-  u.spotify_id
-FROM
-  users u
-  JOIN spotify_tokens st ON st.spotify_user_id = u.spotify_id
-WHERE
-  u.spotify_id IS NOT NULL
-  AND u.id = $1
+const deleteSession = `-- name: DeleteSession :exec
+DELETE FROM sessions
+WHERE id = $1
 `
 
-func (q *Queries) GetUserSpotifyId(ctx context.Context, id uuid.UUID) (pgtype.Text, error) {
-	row := q.db.QueryRow(ctx, getUserSpotifyId, id)
-	var spotify_id pgtype.Text
-	err := row.Scan(&spotify_id)
-	return spotify_id, err
+func (q *Queries) DeleteSession(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteSession, id)
+	return err
 }
 
-const getUserSpotifyRefreshToken = `-- name: GetUserSpotifyRefreshToken :one
-SELECT
-  st.refresh_token
-FROM
-  users u
-  JOIN spotify_tokens st ON st.spotify_user_id = u.spotify_id
-WHERE
-  u.spotify_id IS NOT NULL
-  AND u.id = $1
+const deleteUserInvite = `-- name: DeleteUserInvite :execrows
+DELETE FROM user_invites
+WHERE id = $1
+  AND used_at IS NULL
 `
 
-func (q *Queries) GetUserSpotifyRefreshToken(ctx context.Context, id uuid.UUID) (string, error) {
-	row := q.db.QueryRow(ctx, getUserSpotifyRefreshToken, id)
-	var refresh_token string
-	err := row.Scan(&refresh_token)
-	return refresh_token, err
+func (q *Queries) DeleteUserInvite(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUserInvite, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-const getUserSpotifyTokenExpiration = `-- name: GetUserSpotifyTokenExpiration :one
-SELECT
-  st.expires_at
-FROM
-  users u
-  JOIN spotify_tokens st ON st.spotify_user_id = u.spotify_id
-WHERE
-  u.spotify_id IS NOT NULL
-  AND u.id = $1
+const deleteUserPasswordResets = `-- name: DeleteUserPasswordResets :exec
+DELETE FROM password_resets
+WHERE user_id = $1
+  AND used_at IS NULL
 `
 
-func (q *Queries) GetUserSpotifyTokenExpiration(ctx context.Context, id uuid.UUID) (pgtype.Timestamptz, error) {
-	row := q.db.QueryRow(ctx, getUserSpotifyTokenExpiration, id)
-	var expires_at pgtype.Timestamptz
-	err := row.Scan(&expires_at)
-	return expires_at, err
+func (q *Queries) DeleteUserPasswordResets(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteUserPasswordResets, userID)
+	return err
 }
 
-const ping = `-- name: Ping :exec
-SELECT
-  1
+const deleteUserRecoveryCodes = `-- name: DeleteUserRecoveryCodes :exec
+DELETE FROM mfa_recovery_codes
+WHERE user_id = $1
 `
 
-func (q *Queries) Ping(ctx context.Context) error {
-	_, err := q.db.Exec(ctx, ping)
+func (q *Queries) DeleteUserRecoveryCodes(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteUserRecoveryCodes, userID)
 	return err
 }
 
-const serviceAccountExists = `-- name: ServiceAccountExists :one
-SELECT
-  EXISTS (
-    SELECT
-      1
-    FROM
-      users
-    WHERE
-      ROLE = 'service')
+const deleteUserSession = `-- name: DeleteUserSession :execrows
+DELETE FROM sessions
+WHERE id = $1
+  AND user_id = $2
 `
 
-func (q *Queries) ServiceAccountExists(ctx context.Context) (bool, error) {
-	row := q.db.QueryRow(ctx, serviceAccountExists)
-	var exists bool
-	err := row.Scan(&exists)
-	return exists, err
+type DeleteUserSessionParams struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
 }
 
-const topTrackIDsByUserInRange = `-- name: TopTrackIDsByUserInRange :many
-SELECT
-  track_id,
-  COUNT(*)::bigint AS listen_count
-FROM
-  track_listens
+func (q *Queries) DeleteUserSession(ctx context.Context, arg DeleteUserSessionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUserSession, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUserSessions = `-- name: DeleteUserSessions :exec
+DELETE FROM sessions
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteUserSessions(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteUserSessions, userID)
+	return err
+}
+
+const deleteUserTOTP = `-- name: DeleteUserTOTP :execrows
+DELETE FROM user_totp
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteUserTOTP(ctx context.Context, userID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUserTOTP, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUserWebAuthnCredential = `-- name: DeleteUserWebAuthnCredential :execrows
+DELETE FROM webauthn_credentials
+WHERE id = $1
+  AND user_id = $2
+`
+
+type DeleteUserWebAuthnCredentialParams struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+func (q *Queries) DeleteUserWebAuthnCredential(ctx context.Context, arg DeleteUserWebAuthnCredentialParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUserWebAuthnCredential, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const enableUserTOTP = `-- name: EnableUserTOTP :execrows
+UPDATE
+  user_totp
+SET
+  enabled_at = now(),
+  last_used_step = $2
 WHERE
   user_id = $1
-  AND played_at >= $2::timestamptz
-  AND played_at < $3::timestamptz
-GROUP BY
-  track_id
+  AND enabled_at IS NULL
+`
+
+type EnableUserTOTPParams struct {
+	UserID       uuid.UUID
+	LastUsedStep int64
+}
+
+func (q *Queries) EnableUserTOTP(ctx context.Context, arg EnableUserTOTPParams) (int64, error) {
+	result, err := q.db.Exec(ctx, enableUserTOTP, arg.UserID, arg.LastUsedStep)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const exportListens = `-- name: ExportListens :many
+SELECT
+  tl.user_id,
+  u.email,
+  tl.played_at,
+  t.id AS track_id,
+  t.name AS track_name,
+  t.artists,
+  t.href,
+  t.uri
+FROM
+  track_listens tl
+  JOIN tracks t ON t.id = tl.track_id
+  JOIN users u ON u.id = tl.user_id
+WHERE ($1::timestamptz IS NULL
+  OR tl.played_at >= $1)
+AND ($2::timestamptz IS NULL
+  OR tl.played_at < $2)
+AND (tl.user_id, tl.played_at, tl.track_id) > ($3::uuid, $4::timestamptz,
+  $5::text)
 ORDER BY
-  listen_count DESC,
-  track_id ASC
-LIMIT 50
+  tl.user_id,
+  tl.played_at,
+  tl.track_id
+LIMIT $6
 `
 
-type TopTrackIDsByUserInRangeParams struct {
-	UserID    uuid.UUID
-	StartDate pgtype.Timestamptz
-	EndDate   pgtype.Timestamptz
+type ExportListensParams struct {
+	Start         pgtype.Timestamptz
+	End           pgtype.Timestamptz
+	AfterUserID   uuid.UUID
+	AfterPlayedAt pgtype.Timestamptz
+	AfterTrackID  string
+	PageSize      int32
 }
 
-type TopTrackIDsByUserInRangeRow struct {
-	TrackID     string
-	ListenCount int64
+type ExportListensRow struct {
+	UserID    uuid.UUID
+	Email     string
+	PlayedAt  pgtype.Timestamptz
+	TrackID   string
+	TrackName string
+	Artists   []string
+	Href      string
+	Uri       string
 }
 
-func (q *Queries) TopTrackIDsByUserInRange(ctx context.Context, arg TopTrackIDsByUserInRangeParams) ([]TopTrackIDsByUserInRangeRow, error) {
-	rows, err := q.db.Query(ctx, topTrackIDsByUserInRange, arg.UserID, arg.StartDate, arg.EndDate)
+func (q *Queries) ExportListens(ctx context.Context, arg ExportListensParams) ([]ExportListensRow, error) {
+	rows, err := q.db.Query(ctx, exportListens,
+		arg.Start,
+		arg.End,
+		arg.AfterUserID,
+		arg.AfterPlayedAt,
+		arg.AfterTrackID,
+		arg.PageSize,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []TopTrackIDsByUserInRangeRow
+	var items []ExportListensRow
 	for rows.Next() {
-		var i TopTrackIDsByUserInRangeRow
-		if err := rows.Scan(&i.TrackID, &i.ListenCount); err != nil {
+		var i ExportListensRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Email,
+			&i.PlayedAt,
+			&i.TrackID,
+			&i.TrackName,
+			&i.Artists,
+			&i.Href,
+			&i.Uri,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -514,68 +762,71 @@ func (q *Queries) TopTrackIDsByUserInRange(ctx context.Context, arg TopTrackIDsB
 	return items, nil
 }
 
-const topTracksByUserInRange = `-- name: TopTracksByUserInRange :many
+const exportUserListens = `-- name: ExportUserListens :many
 SELECT
-  tl.track_id,
-  t.name,
+  tl.played_at,
+  t.id AS track_id,
+  t.name AS track_name,
   t.artists,
   t.href,
-  t.uri,
-  t.image_url,
-  COUNT(*)::bigint AS listen_count
+  t.uri
 FROM
   track_listens tl
   JOIN tracks t ON t.id = tl.track_id
 WHERE
   tl.user_id = $1
-  AND tl.played_at >= $2::timestamptz
-  AND tl.played_at < $3::timestamptz
-GROUP BY
-  tl.track_id,
-  t.name,
-  t.artists,
-  t.href,
-  t.uri,
-  t.image_url
+  AND ($2::timestamptz IS NULL
+    OR tl.played_at >= $2)
+  AND ($3::timestamptz IS NULL
+    OR tl.played_at < $3)
+  AND (tl.played_at, tl.track_id) > ($4::timestamptz, $5::text)
 ORDER BY
-  listen_count DESC,
-  tl.track_id ASC
-LIMIT 50
+  tl.played_at,
+  tl.track_id
+LIMIT $6
 `
 
-type TopTracksByUserInRangeParams struct {
-	UserID    uuid.UUID
-	StartDate pgtype.Timestamptz
-	EndDate   pgtype.Timestamptz
+type ExportUserListensParams struct {
+	UserID        uuid.UUID
+	Start         pgtype.Timestamptz
+	End           pgtype.Timestamptz
+	AfterPlayedAt pgtype.Timestamptz
+	AfterTrackID  string
+	PageSize      int32
 }
 
-type TopTracksByUserInRangeRow struct {
-	TrackID     string
-	Name        string
-	Artists     []string
-	Href        string
-	Uri         string
-	ImageUrl    pgtype.Text
-	ListenCount int64
+type ExportUserListensRow struct {
+	PlayedAt  pgtype.Timestamptz
+	TrackID   string
+	TrackName string
+	Artists   []string
+	Href      string
+	Uri       string
 }
 
-func (q *Queries) TopTracksByUserInRange(ctx context.Context, arg TopTracksByUserInRangeParams) ([]TopTracksByUserInRangeRow, error) {
-	rows, err := q.db.Query(ctx, topTracksByUserInRange, arg.UserID, arg.StartDate, arg.EndDate)
+func (q *Queries) ExportUserListens(ctx context.Context, arg ExportUserListensParams) ([]ExportUserListensRow, error) {
+	rows, err := q.db.Query(ctx, exportUserListens,
+		arg.UserID,
+		arg.Start,
+		arg.End,
+		arg.AfterPlayedAt,
+		arg.AfterTrackID,
+		arg.PageSize,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []TopTracksByUserInRangeRow
+	var items []ExportUserListensRow
 	for rows.Next() {
-		var i TopTracksByUserInRangeRow
+		var i ExportUserListensRow
 		if err := rows.Scan(
+			&i.PlayedAt,
 			&i.TrackID,
-			&i.Name,
+			&i.TrackName,
 			&i.Artists,
 			&i.Href,
 			&i.Uri,
-			&i.ImageUrl,
-			&i.ListenCount,
 		); err != nil {
 			return nil, err
 		}
@@ -587,93 +838,2121 @@ func (q *Queries) TopTracksByUserInRange(ctx context.Context, arg TopTracksByUse
 	return items, nil
 }
 
-const updateUserRefreshToken = `-- name: UpdateUserRefreshToken :exec
-UPDATE
-  users
-SET
-  refresh_token_hash = $1,
-  refresh_token_expires_at = $2
+const findTrackByNameAndArtist = `-- name: FindTrackByNameAndArtist :one
+SELECT
+  id
+FROM
+  tracks
 WHERE
-  id = $3
+  lower(name) = lower($1::text)
+  AND (lower(artists[1]) = lower($2::text)
+    OR lower(array_to_string(artists, ', ')) = lower($2::text))
+ORDER BY
+  uri LIKE 'spotify:track:%' DESC,
+  created_at ASC
+LIMIT 1
 `
 
-type UpdateUserRefreshTokenParams struct {
-	RefreshTokenHash      pgtype.Text
-	RefreshTokenExpiresAt pgtype.Timestamptz
-	ID                    uuid.UUID
+type FindTrackByNameAndArtistParams struct {
+	Name   string
+	Artist string
 }
 
-func (q *Queries) UpdateUserRefreshToken(ctx context.Context, arg UpdateUserRefreshTokenParams) error {
-	_, err := q.db.Exec(ctx, updateUserRefreshToken, arg.RefreshTokenHash, arg.RefreshTokenExpiresAt, arg.ID)
-	return err
+func (q *Queries) FindTrackByNameAndArtist(ctx context.Context, arg FindTrackByNameAndArtistParams) (string, error) {
+	row := q.db.QueryRow(ctx, findTrackByNameAndArtist, arg.Name, arg.Artist)
+	var id string
+	err := row.Scan(&id)
+	return id, err
 }
 
-const updateUserSpotifyID = `-- name: UpdateUserSpotifyID :exec
-UPDATE
-  users
-SET
-  spotify_id = $1
+const getJob = `-- name: GetJob :one
+SELECT
+  name,
+  schedule,
+  timezone,
+  paused,
+  next_run_at,
+  last_run_at,
+  last_success_at,
+  last_error,
+  triggered_at,
+  created_at,
+  updated_at
+FROM
+  jobs
 WHERE
-  id = $2
+  name = $1
 `
 
-type UpdateUserSpotifyIDParams struct {
-	SpotifyID pgtype.Text
-	ID        uuid.UUID
-}
-
-func (q *Queries) UpdateUserSpotifyID(ctx context.Context, arg UpdateUserSpotifyIDParams) error {
-	_, err := q.db.Exec(ctx, updateUserSpotifyID, arg.SpotifyID, arg.ID)
-	return err
+func (q *Queries) GetJob(ctx context.Context, name string) (Job, error) {
+	row := q.db.QueryRow(ctx, getJob, name)
+	var i Job
+	err := row.Scan(
+		&i.Name,
+		&i.Schedule,
+		&i.Timezone,
+		&i.Paused,
+		&i.NextRunAt,
+		&i.LastRunAt,
+		&i.LastSuccessAt,
+		&i.LastError,
+		&i.TriggeredAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
 }
 
-const updateUserSpotifyTokens = `-- name: UpdateUserSpotifyTokens :exec
-UPDATE
-  spotify_tokens
-SET
-  access_token = $1,
-  refresh_token = $2,
-  token_type = $3,
-  scope = $4,
-  expires_at = $5
+const getLatestUserPlaylistByType = `-- name: GetLatestUserPlaylistByType :one
+SELECT
+  id,
+  period_start
+FROM
+  playlists
+WHERE
+  user_id = $1
+  AND playlist_type = $2
+ORDER BY
+  created_at DESC
+LIMIT 1
+`
+
+type GetLatestUserPlaylistByTypeParams struct {
+	UserID       uuid.UUID
+	PlaylistType PlaylistType
+}
+
+type GetLatestUserPlaylistByTypeRow struct {
+	ID          uuid.UUID
+	PeriodStart pgtype.Timestamptz
+}
+
+func (q *Queries) GetLatestUserPlaylistByType(ctx context.Context, arg GetLatestUserPlaylistByTypeParams) (GetLatestUserPlaylistByTypeRow, error) {
+	row := q.db.QueryRow(ctx, getLatestUserPlaylistByType, arg.UserID, arg.PlaylistType)
+	var i GetLatestUserPlaylistByTypeRow
+	err := row.Scan(&i.ID, &i.PeriodStart)
+	return i, err
+}
+
+const getListenToken = `-- name: GetListenToken :one
+SELECT
+  lt.token_hash,
+  u.email
+FROM
+  listen_tokens lt
+  JOIN users u ON u.id = lt.user_id
+WHERE
+  lt.user_id = $1
+`
+
+type GetListenTokenRow struct {
+	TokenHash string
+	Email     string
+}
+
+func (q *Queries) GetListenToken(ctx context.Context, userID uuid.UUID) (GetListenTokenRow, error) {
+	row := q.db.QueryRow(ctx, getListenToken, userID)
+	var i GetListenTokenRow
+	err := row.Scan(&i.TokenHash, &i.Email)
+	return i, err
+}
+
+const getMFAChallenge = `-- name: GetMFAChallenge :one
+SELECT
+  user_id,
+  token_hash,
+  attempts,
+  expires_at
+FROM
+  mfa_challenges
+WHERE
+  id = $1
+`
+
+type GetMFAChallengeRow struct {
+	UserID    uuid.UUID
+	TokenHash string
+	Attempts  int32
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) GetMFAChallenge(ctx context.Context, id uuid.UUID) (GetMFAChallengeRow, error) {
+	row := q.db.QueryRow(ctx, getMFAChallenge, id)
+	var i GetMFAChallengeRow
+	err := row.Scan(
+		&i.UserID,
+		&i.TokenHash,
+		&i.Attempts,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getPasswordReset = `-- name: GetPasswordReset :one
+SELECT
+  user_id,
+  token_hash,
+  expires_at,
+  used_at
+FROM
+  password_resets
+WHERE
+  id = $1
+`
+
+type GetPasswordResetRow struct {
+	UserID    uuid.UUID
+	TokenHash string
+	ExpiresAt pgtype.Timestamptz
+	UsedAt    pgtype.Timestamptz
+}
+
+func (q *Queries) GetPasswordReset(ctx context.Context, id uuid.UUID) (GetPasswordResetRow, error) {
+	row := q.db.QueryRow(ctx, getPasswordReset, id)
+	var i GetPasswordResetRow
+	err := row.Scan(
+		&i.UserID,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.UsedAt,
+	)
+	return i, err
+}
+
+const getPlaylistExport = `-- name: GetPlaylistExport :one
+SELECT
+  remote_id,
+  url
+FROM
+  playlist_exports
+WHERE
+  playlist_id = $1
+  AND provider = $2
+`
+
+type GetPlaylistExportParams struct {
+	PlaylistID uuid.UUID
+	Provider   string
+}
+
+type GetPlaylistExportRow struct {
+	RemoteID string
+	Url      string
+}
+
+func (q *Queries) GetPlaylistExport(ctx context.Context, arg GetPlaylistExportParams) (GetPlaylistExportRow, error) {
+	row := q.db.QueryRow(ctx, getPlaylistExport, arg.PlaylistID, arg.Provider)
+	var i GetPlaylistExportRow
+	err := row.Scan(&i.RemoteID, &i.Url)
+	return i, err
+}
+
+const getPlaylistRules = `-- name: GetPlaylistRules :one
+SELECT
+  max_tracks,
+  min_plays,
+  ordering,
+  max_tracks_per_artist,
+  name_template
+FROM
+  playlist_rules
+WHERE
+  user_id = $1
+`
+
+type GetPlaylistRulesRow struct {
+	MaxTracks          int32
+	MinPlays           int32
+	Ordering           string
+	MaxTracksPerArtist int32
+	NameTemplate       string
+}
+
+func (q *Queries) GetPlaylistRules(ctx context.Context, userID uuid.UUID) (GetPlaylistRulesRow, error) {
+	row := q.db.QueryRow(ctx, getPlaylistRules, userID)
+	var i GetPlaylistRulesRow
+	err := row.Scan(
+		&i.MaxTracks,
+		&i.MinPlays,
+		&i.Ordering,
+		&i.MaxTracksPerArtist,
+		&i.NameTemplate,
+	)
+	return i, err
+}
+
+const getPlaylistTracks = `-- name: GetPlaylistTracks :many
+SELECT
+  t.id,
+  t.name,
+  t.artists,
+  t.href,
+  t.image_url,
+  t.uri,
+  t.duration_ms,
+  pt.plays
+FROM
+  playlist_tracks pt
+  JOIN tracks t ON pt.track_id = t.id
+WHERE
+  pt.playlist_id = $1
+ORDER BY
+  pt.position ASC NULLS LAST,
+  pt.plays DESC,
+  pt.track_id ASC
+`
+
+type GetPlaylistTracksRow struct {
+	ID         string
+	Name       string
+	Artists    []string
+	Href       string
+	ImageUrl   pgtype.Text
+	Uri        string
+	DurationMs pgtype.Int4
+	Plays      int32
+}
+
+func (q *Queries) GetPlaylistTracks(ctx context.Context, playlistID uuid.UUID) ([]GetPlaylistTracksRow, error) {
+	rows, err := q.db.Query(ctx, getPlaylistTracks, playlistID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPlaylistTracksRow
+	for rows.Next() {
+		var i GetPlaylistTracksRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Artists,
+			&i.Href,
+			&i.ImageUrl,
+			&i.Uri,
+			&i.DurationMs,
+			&i.Plays,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProviderTokens = `-- name: GetProviderTokens :one
+SELECT
+  pa.account_id,
+  pt.access_token,
+  pt.token_type,
+  pt.scope,
+  pt.refresh_token,
+  pt.expires_at
+FROM
+  provider_accounts pa
+  JOIN provider_tokens pt ON pt.user_id = pa.user_id
+    AND pt.provider = pa.provider
+WHERE
+  pa.user_id = $1
+  AND pa.provider = $2
+`
+
+type GetProviderTokensParams struct {
+	UserID   uuid.UUID
+	Provider string
+}
+
+type GetProviderTokensRow struct {
+	AccountID    string
+	AccessToken  string
+	TokenType    string
+	Scope        string
+	RefreshToken string
+	ExpiresAt    pgtype.Timestamptz
+}
+
+func (q *Queries) GetProviderTokens(ctx context.Context, arg GetProviderTokensParams) (GetProviderTokensRow, error) {
+	row := q.db.QueryRow(ctx, getProviderTokens, arg.UserID, arg.Provider)
+	var i GetProviderTokensRow
+	err := row.Scan(
+		&i.AccountID,
+		&i.AccessToken,
+		&i.TokenType,
+		&i.Scope,
+		&i.RefreshToken,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getSession = `-- name: GetSession :one
+SELECT
+  user_id,
+  refresh_token_hash,
+  expires_at
+FROM
+  sessions
+WHERE
+  id = $1
+`
+
+type GetSessionRow struct {
+	UserID           uuid.UUID
+	RefreshTokenHash string
+	ExpiresAt        pgtype.Timestamptz
+}
+
+func (q *Queries) GetSession(ctx context.Context, id uuid.UUID) (GetSessionRow, error) {
+	row := q.db.QueryRow(ctx, getSession, id)
+	var i GetSessionRow
+	err := row.Scan(&i.UserID, &i.RefreshTokenHash, &i.ExpiresAt)
+	return i, err
+}
+
+const getSpotifySyncState = `-- name: GetSpotifySyncState :one
+SELECT
+  played_at_cursor,
+  last_success_at,
+  last_error,
+  last_error_at,
+  last_pages,
+  last_items_fetched,
+  last_items_inserted
+FROM
+  spotify_sync_state
+WHERE
+  user_id = $1
+`
+
+type GetSpotifySyncStateRow struct {
+	PlayedAtCursor    pgtype.Timestamptz
+	LastSuccessAt     pgtype.Timestamptz
+	LastError         pgtype.Text
+	LastErrorAt       pgtype.Timestamptz
+	LastPages         int32
+	LastItemsFetched  int32
+	LastItemsInserted int32
+}
+
+func (q *Queries) GetSpotifySyncState(ctx context.Context, userID uuid.UUID) (GetSpotifySyncStateRow, error) {
+	row := q.db.QueryRow(ctx, getSpotifySyncState, userID)
+	var i GetSpotifySyncStateRow
+	err := row.Scan(
+		&i.PlayedAtCursor,
+		&i.LastSuccessAt,
+		&i.LastError,
+		&i.LastErrorAt,
+		&i.LastPages,
+		&i.LastItemsFetched,
+		&i.LastItemsInserted,
+	)
+	return i, err
+}
+
+const getUser = `-- name: GetUser :one
+SELECT
+  email,
+  ROLE
+FROM
+  users
+WHERE
+  id = $1
+`
+
+type GetUserRow struct {
+	Email string
+	Role  Role
+}
+
+func (q *Queries) GetUser(ctx context.Context, id uuid.UUID) (GetUserRow, error) {
+	row := q.db.QueryRow(ctx, getUser, id)
+	var i GetUserRow
+	err := row.Scan(&i.Email, &i.Role)
+	return i, err
+}
+
+const getUserAuth = `-- name: GetUserAuth :one
+SELECT
+  ROLE,
+  token_version
+FROM
+  users
+WHERE
+  id = $1
+`
+
+type GetUserAuthRow struct {
+	Role         Role
+	TokenVersion int32
+}
+
+func (q *Queries) GetUserAuth(ctx context.Context, id uuid.UUID) (GetUserAuthRow, error) {
+	row := q.db.QueryRow(ctx, getUserAuth, id)
+	var i GetUserAuthRow
+	err := row.Scan(&i.Role, &i.TokenVersion)
+	return i, err
+}
+
+const getUserByEmail = `-- name: GetUserByEmail :one
+SELECT
+  id,
+  email,
+  ROLE,
+  password_hash,
+  token_version
+FROM
+  users
+WHERE
+  email = trim(lower($1::text))
+`
+
+type GetUserByEmailRow struct {
+	ID           uuid.UUID
+	Email        string
+	Role         Role
+	PasswordHash string
+	TokenVersion int32
+}
+
+func (q *Queries) GetUserByEmail(ctx context.Context, email string) (GetUserByEmailRow, error) {
+	row := q.db.QueryRow(ctx, getUserByEmail, email)
+	var i GetUserByEmailRow
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Role,
+		&i.PasswordHash,
+		&i.TokenVersion,
+	)
+	return i, err
+}
+
+const getUserIDs = `-- name: GetUserIDs :many
+SELECT
+  id
+FROM
+  users
+ORDER BY
+  created_at ASC
+LIMIT $1
+`
+
+func (q *Queries) GetUserIDs(ctx context.Context, limit int32) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getUserIDs, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserInvite = `-- name: GetUserInvite :one
+SELECT
+  id,
+  token_hash,
+  email,
+  expires_at,
+  used_at
+FROM
+  user_invites
+WHERE
+  id = $1
+`
+
+type GetUserInviteRow struct {
+	ID        uuid.UUID
+	TokenHash string
+	Email     pgtype.Text
+	ExpiresAt pgtype.Timestamptz
+	UsedAt    pgtype.Timestamptz
+}
+
+func (q *Queries) GetUserInvite(ctx context.Context, id uuid.UUID) (GetUserInviteRow, error) {
+	row := q.db.QueryRow(ctx, getUserInvite, id)
+	var i GetUserInviteRow
+	err := row.Scan(
+		&i.ID,
+		&i.TokenHash,
+		&i.Email,
+		&i.ExpiresAt,
+		&i.UsedAt,
+	)
+	return i, err
+}
+
+const getUserListenStats = `-- name: GetUserListenStats :one
+WITH range_listens AS (
+  SELECT
+    tl.track_id,
+    tl.played_at
+  FROM
+    track_listens tl
+  WHERE
+    tl.user_id = $1
+    AND tl.played_at >= $2::timestamptz
+    AND tl.played_at < $3::timestamptz
+),
+first_track_listens AS (
+  SELECT
+    tl.track_id,
+    MIN(tl.played_at) AS first_played_at
+  FROM
+    track_listens tl
+  WHERE
+    tl.user_id = $1
+  GROUP BY
+    tl.track_id
+),
+first_artist_listens AS (
+  SELECT
+    lower(artist.name) AS artist,
+    MIN(tl.played_at) AS first_played_at
+  FROM
+    track_listens tl
+    JOIN tracks t ON t.id = tl.track_id
+    CROSS JOIN LATERAL unnest(t.artists) AS artist (name)
+  WHERE
+    tl.user_id = $1
+  GROUP BY
+    lower(artist.name)
+)
+SELECT
+  (
+    SELECT
+      COUNT(*)
+    FROM
+      range_listens)::bigint AS total_plays,
+  (
+    SELECT
+      COUNT(DISTINCT rl.track_id)
+    FROM
+      range_listens rl)::bigint AS unique_tracks,
+  (
+    SELECT
+      COUNT(DISTINCT lower(artist.name))
+    FROM
+      range_listens rl
+      JOIN tracks t ON t.id = rl.track_id
+      CROSS JOIN LATERAL unnest(t.artists) AS artist (name))::bigint AS unique_artists,
+  (
+    SELECT
+      COUNT(*)
+    FROM
+      first_track_listens ftl
+    WHERE
+      ftl.first_played_at >= $2::timestamptz
+      AND ftl.first_played_at < $3::timestamptz)::bigint AS new_tracks,
+  (
+    SELECT
+      COUNT(*)
+    FROM
+      first_artist_listens fal
+    WHERE
+      fal.first_played_at >= $2::timestamptz
+      AND fal.first_played_at < $3::timestamptz)::bigint AS new_artists
+`
+
+type GetUserListenStatsParams struct {
+	UserID    uuid.UUID
+	StartDate pgtype.Timestamptz
+	EndDate   pgtype.Timestamptz
+}
+
+type GetUserListenStatsRow struct {
+	TotalPlays    int64
+	UniqueTracks  int64
+	UniqueArtists int64
+	NewTracks     int64
+	NewArtists    int64
+}
+
+func (q *Queries) GetUserListenStats(ctx context.Context, arg GetUserListenStatsParams) (GetUserListenStatsRow, error) {
+	row := q.db.QueryRow(ctx, getUserListenStats, arg.UserID, arg.StartDate, arg.EndDate)
+	var i GetUserListenStatsRow
+	err := row.Scan(
+		&i.TotalPlays,
+		&i.UniqueTracks,
+		&i.UniqueArtists,
+		&i.NewTracks,
+		&i.NewArtists,
+	)
+	return i, err
+}
+
+const getUserPasswordHash = `-- name: GetUserPasswordHash :one
+SELECT
+  password_hash
+FROM
+  users
+WHERE
+  id = $1
+`
+
+func (q *Queries) GetUserPasswordHash(ctx context.Context, id uuid.UUID) (string, error) {
+	row := q.db.QueryRow(ctx, getUserPasswordHash, id)
+	var password_hash string
+	err := row.Scan(&password_hash)
+	return password_hash, err
+}
+
+const getUserPlaylist = `-- name: GetUserPlaylist :one
+SELECT
+  id,
+  playlist_type,
+  name,
+  description,
+  created_at
+FROM
+  playlists
+WHERE
+  user_id = $1
+  AND id = $2
+`
+
+type GetUserPlaylistParams struct {
+	UserID uuid.UUID
+	ID     uuid.UUID
+}
+
+type GetUserPlaylistRow struct {
+	ID           uuid.UUID
+	PlaylistType PlaylistType
+	Name         string
+	Description  string
+	CreatedAt    pgtype.Timestamptz
+}
+
+func (q *Queries) GetUserPlaylist(ctx context.Context, arg GetUserPlaylistParams) (GetUserPlaylistRow, error) {
+	row := q.db.QueryRow(ctx, getUserPlaylist, arg.UserID, arg.ID)
+	var i GetUserPlaylistRow
+	err := row.Scan(
+		&i.ID,
+		&i.PlaylistType,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getUserPlaylistByPeriod = `-- name: GetUserPlaylistByPeriod :one
+SELECT
+  id
+FROM
+  playlists
+WHERE
+  user_id = $1
+  AND playlist_type = $2
+  AND period_start = $3
+`
+
+type GetUserPlaylistByPeriodParams struct {
+	UserID       uuid.UUID
+	PlaylistType PlaylistType
+	PeriodStart  pgtype.Timestamptz
+}
+
+func (q *Queries) GetUserPlaylistByPeriod(ctx context.Context, arg GetUserPlaylistByPeriodParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, getUserPlaylistByPeriod, arg.UserID, arg.PlaylistType, arg.PeriodStart)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const getUserPlaylists = `-- name: GetUserPlaylists :many
+SELECT
+  id,
+  playlist_type,
+  name,
+  created_at
+FROM
+  playlists
+WHERE
+  user_id = $1
+ORDER BY
+  created_at DESC
+`
+
+type GetUserPlaylistsRow struct {
+	ID           uuid.UUID
+	PlaylistType PlaylistType
+	Name         string
+	CreatedAt    pgtype.Timestamptz
+}
+
+func (q *Queries) GetUserPlaylists(ctx context.Context, userID uuid.UUID) ([]GetUserPlaylistsRow, error) {
+	rows, err := q.db.Query(ctx, getUserPlaylists, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserPlaylistsRow
+	for rows.Next() {
+		var i GetUserPlaylistsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PlaylistType,
+			&i.Name,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserPreferences = `-- name: GetUserPreferences :one
+SELECT
+  timezone,
+  week_start
+FROM
+  users
+WHERE
+  id = $1
+`
+
+type GetUserPreferencesRow struct {
+	Timezone  string
+	WeekStart int16
+}
+
+func (q *Queries) GetUserPreferences(ctx context.Context, id uuid.UUID) (GetUserPreferencesRow, error) {
+	row := q.db.QueryRow(ctx, getUserPreferences, id)
+	var i GetUserPreferencesRow
+	err := row.Scan(&i.Timezone, &i.WeekStart)
+	return i, err
+}
+
+const getUserTOTP = `-- name: GetUserTOTP :one
+SELECT
+  secret,
+  enabled_at,
+  last_used_step
+FROM
+  user_totp
+WHERE
+  user_id = $1
+`
+
+type GetUserTOTPRow struct {
+	Secret       string
+	EnabledAt    pgtype.Timestamptz
+	LastUsedStep int64
+}
+
+func (q *Queries) GetUserTOTP(ctx context.Context, userID uuid.UUID) (GetUserTOTPRow, error) {
+	row := q.db.QueryRow(ctx, getUserTOTP, userID)
+	var i GetUserTOTPRow
+	err := row.Scan(&i.Secret, &i.EnabledAt, &i.LastUsedStep)
+	return i, err
+}
+
+const getUserTokenVersion = `-- name: GetUserTokenVersion :one
+SELECT
+  token_version
+FROM
+  users
+WHERE
+  id = $1
+`
+
+func (q *Queries) GetUserTokenVersion(ctx context.Context, id uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, getUserTokenVersion, id)
+	var token_version int32
+	err := row.Scan(&token_version)
+	return token_version, err
+}
+
+const getWebAuthnCredential = `-- name: GetWebAuthnCredential :one
+SELECT
+  id,
+  user_id,
+  credential
+FROM
+  webauthn_credentials
+WHERE
+  credential_id = $1
+`
+
+type GetWebAuthnCredentialRow struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Credential []byte
+}
+
+func (q *Queries) GetWebAuthnCredential(ctx context.Context, credentialID []byte) (GetWebAuthnCredentialRow, error) {
+	row := q.db.QueryRow(ctx, getWebAuthnCredential, credentialID)
+	var i GetWebAuthnCredentialRow
+	err := row.Scan(&i.ID, &i.UserID, &i.Credential)
+	return i, err
+}
+
+const incrementMFAChallengeAttempts = `-- name: IncrementMFAChallengeAttempts :one
+UPDATE
+  mfa_challenges
+SET
+  attempts = attempts + 1
+WHERE
+  id = $1
+RETURNING
+  attempts
+`
+
+func (q *Queries) IncrementMFAChallengeAttempts(ctx context.Context, id uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, incrementMFAChallengeAttempts, id)
+	var attempts int32
+	err := row.Scan(&attempts)
+	return attempts, err
+}
+
+const incrementUserTokenVersion = `-- name: IncrementUserTokenVersion :execrows
+UPDATE
+  users
+SET
+  token_version = token_version + 1,
+  updated_at = now()
+WHERE
+  id = $1
+`
+
+func (q *Queries) IncrementUserTokenVersion(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, incrementUserTokenVersion, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listArtistsWithoutImages = `-- name: ListArtistsWithoutImages :many
+SELECT
+  id
+FROM
+  artists
+WHERE
+  id = ANY ($1::text[])
+  AND images_updated_at IS NULL
+`
+
+func (q *Queries) ListArtistsWithoutImages(ctx context.Context, ids []string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listArtistsWithoutImages, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listJobs = `-- name: ListJobs :many
+SELECT
+  name,
+  schedule,
+  timezone,
+  paused,
+  next_run_at,
+  last_run_at,
+  last_success_at,
+  last_error,
+  triggered_at,
+  created_at,
+  updated_at
+FROM
+  jobs
+ORDER BY
+  name
+`
+
+func (q *Queries) ListJobs(ctx context.Context) ([]Job, error) {
+	rows, err := q.db.Query(ctx, listJobs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Job
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.Name,
+			&i.Schedule,
+			&i.Timezone,
+			&i.Paused,
+			&i.NextRunAt,
+			&i.LastRunAt,
+			&i.LastSuccessAt,
+			&i.LastError,
+			&i.TriggeredAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPlaylistCandidates = `-- name: ListPlaylistCandidates :many
+WITH range_listens AS (
+  SELECT
+    tl.track_id,
+    COUNT(*)::bigint AS listen_count,
+    MIN(tl.played_at) AS first_played_at
+  FROM
+    track_listens tl
+  WHERE
+    tl.user_id = $1
+    AND tl.played_at >= $2::timestamptz
+    AND tl.played_at < $3::timestamptz
+  GROUP BY
+    tl.track_id
+)
+SELECT
+  rl.track_id,
+  t.artists,
+  rl.listen_count,
+  rl.first_played_at::timestamptz AS first_played_at,
+  (
+    SELECT
+      MIN(tl.played_at)
+    FROM
+      track_listens tl
+    WHERE
+      tl.user_id = $1
+      AND tl.track_id = rl.track_id)::timestamptz AS first_heard_at
+FROM
+  range_listens rl
+  JOIN tracks t ON t.id = rl.track_id
+WHERE
+  rl.listen_count >= $4::bigint
+ORDER BY
+  rl.listen_count DESC,
+  rl.track_id ASC
+`
+
+type ListPlaylistCandidatesParams struct {
+	UserID    uuid.UUID
+	StartDate pgtype.Timestamptz
+	EndDate   pgtype.Timestamptz
+	MinPlays  int64
+}
+
+type ListPlaylistCandidatesRow struct {
+	TrackID       string
+	Artists       []string
+	ListenCount   int64
+	FirstPlayedAt pgtype.Timestamptz
+	FirstHeardAt  pgtype.Timestamptz
+}
+
+func (q *Queries) ListPlaylistCandidates(ctx context.Context, arg ListPlaylistCandidatesParams) ([]ListPlaylistCandidatesRow, error) {
+	rows, err := q.db.Query(ctx, listPlaylistCandidates,
+		arg.UserID,
+		arg.StartDate,
+		arg.EndDate,
+		arg.MinPlays,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPlaylistCandidatesRow
+	for rows.Next() {
+		var i ListPlaylistCandidatesRow
+		if err := rows.Scan(
+			&i.TrackID,
+			&i.Artists,
+			&i.ListenCount,
+			&i.FirstPlayedAt,
+			&i.FirstHeardAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRunnableJobs = `-- name: ListRunnableJobs :many
+SELECT
+  name,
+  schedule,
+  timezone,
+  paused,
+  next_run_at,
+  last_run_at,
+  last_success_at,
+  last_error,
+  triggered_at,
+  created_at,
+  updated_at
+FROM
+  jobs
+WHERE (NOT paused
+  AND next_run_at <= now())
+  OR triggered_at IS NOT NULL
+ORDER BY
+  next_run_at
+`
+
+func (q *Queries) ListRunnableJobs(ctx context.Context) ([]Job, error) {
+	rows, err := q.db.Query(ctx, listRunnableJobs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Job
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.Name,
+			&i.Schedule,
+			&i.Timezone,
+			&i.Paused,
+			&i.NextRunAt,
+			&i.LastRunAt,
+			&i.LastSuccessAt,
+			&i.LastError,
+			&i.TriggeredAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnusedRecoveryCodes = `-- name: ListUnusedRecoveryCodes :many
+SELECT
+  id,
+  code_hash
+FROM
+  mfa_recovery_codes
+WHERE
+  user_id = $1
+  AND used_at IS NULL
+`
+
+type ListUnusedRecoveryCodesRow struct {
+	ID       uuid.UUID
+	CodeHash string
+}
+
+func (q *Queries) ListUnusedRecoveryCodes(ctx context.Context, userID uuid.UUID) ([]ListUnusedRecoveryCodesRow, error) {
+	rows, err := q.db.Query(ctx, listUnusedRecoveryCodes, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUnusedRecoveryCodesRow
+	for rows.Next() {
+		var i ListUnusedRecoveryCodesRow
+		if err := rows.Scan(&i.ID, &i.CodeHash); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserIDsAfter = `-- name: ListUserIDsAfter :many
+SELECT
+  id
+FROM
+  users
+WHERE
+  id > $1
+ORDER BY
+  id ASC
+LIMIT $2
+`
+
+type ListUserIDsAfterParams struct {
+	ID    uuid.UUID
+	Limit int32
+}
+
+func (q *Queries) ListUserIDsAfter(ctx context.Context, arg ListUserIDsAfterParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listUserIDsAfter, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserInvites = `-- name: ListUserInvites :many
+SELECT
+  id,
+  email,
+  created_by,
+  used_by,
+  expires_at,
+  used_at,
+  created_at
+FROM
+  user_invites
+ORDER BY
+  created_at DESC
+`
+
+type ListUserInvitesRow struct {
+	ID        uuid.UUID
+	Email     pgtype.Text
+	CreatedBy uuid.UUID
+	UsedBy    uuid.UUID
+	ExpiresAt pgtype.Timestamptz
+	UsedAt    pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) ListUserInvites(ctx context.Context) ([]ListUserInvitesRow, error) {
+	rows, err := q.db.Query(ctx, listUserInvites)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserInvitesRow
+	for rows.Next() {
+		var i ListUserInvitesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.CreatedBy,
+			&i.UsedBy,
+			&i.ExpiresAt,
+			&i.UsedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserListens = `-- name: ListUserListens :many
+SELECT
+  tl.played_at,
+  t.id AS track_id,
+  t.name,
+  t.artists,
+  t.href,
+  t.uri,
+  t.image_url
+FROM
+  track_listens tl
+  JOIN tracks t ON t.id = tl.track_id
+WHERE
+  tl.user_id = $1
+  AND ($2::timestamptz IS NULL
+    OR tl.played_at >= $2)
+  AND ($3::timestamptz IS NULL
+    OR tl.played_at < $3)
+  AND ($4::text IS NULL
+    OR EXISTS (
+      SELECT
+        1
+      FROM
+        unnest(t.artists) AS artist
+      WHERE
+        artist ILIKE $4))
+  AND ($5::text IS NULL
+    OR t.name ILIKE $5)
+  AND ($6::timestamptz IS NULL
+    OR (tl.played_at, tl.track_id) < ($6, $7::text))
+ORDER BY
+  tl.played_at DESC,
+  tl.track_id DESC
+LIMIT $8
+`
+
+type ListUserListensParams struct {
+	UserID         uuid.UUID
+	Start          pgtype.Timestamptz
+	End            pgtype.Timestamptz
+	Artist         pgtype.Text
+	Track          pgtype.Text
+	BeforePlayedAt pgtype.Timestamptz
+	BeforeTrackID  string
+	PageSize       int32
+}
+
+type ListUserListensRow struct {
+	PlayedAt pgtype.Timestamptz
+	TrackID  string
+	Name     string
+	Artists  []string
+	Href     string
+	Uri      string
+	ImageUrl pgtype.Text
+}
+
+func (q *Queries) ListUserListens(ctx context.Context, arg ListUserListensParams) ([]ListUserListensRow, error) {
+	rows, err := q.db.Query(ctx, listUserListens,
+		arg.UserID,
+		arg.Start,
+		arg.End,
+		arg.Artist,
+		arg.Track,
+		arg.BeforePlayedAt,
+		arg.BeforeTrackID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserListensRow
+	for rows.Next() {
+		var i ListUserListensRow
+		if err := rows.Scan(
+			&i.PlayedAt,
+			&i.TrackID,
+			&i.Name,
+			&i.Artists,
+			&i.Href,
+			&i.Uri,
+			&i.ImageUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserSessions = `-- name: ListUserSessions :many
+SELECT
+  id,
+  user_agent,
+  ip_address,
+  expires_at,
+  created_at,
+  last_used_at
+FROM
+  sessions
+WHERE
+  user_id = $1
+  AND expires_at > now()
+ORDER BY
+  last_used_at DESC
+`
+
+type ListUserSessionsRow struct {
+	ID         uuid.UUID
+	UserAgent  string
+	IpAddress  string
+	ExpiresAt  pgtype.Timestamptz
+	CreatedAt  pgtype.Timestamptz
+	LastUsedAt pgtype.Timestamptz
+}
+
+func (q *Queries) ListUserSessions(ctx context.Context, userID uuid.UUID) ([]ListUserSessionsRow, error) {
+	rows, err := q.db.Query(ctx, listUserSessions, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserSessionsRow
+	for rows.Next() {
+		var i ListUserSessionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserAgent,
+			&i.IpAddress,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.LastUsedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserWebAuthnCredentials = `-- name: ListUserWebAuthnCredentials :many
+SELECT
+  id,
+  credential,
+  name,
+  created_at,
+  last_used_at
+FROM
+  webauthn_credentials
+WHERE
+  user_id = $1
+ORDER BY
+  created_at ASC
+`
+
+type ListUserWebAuthnCredentialsRow struct {
+	ID         uuid.UUID
+	Credential []byte
+	Name       string
+	CreatedAt  pgtype.Timestamptz
+	LastUsedAt pgtype.Timestamptz
+}
+
+func (q *Queries) ListUserWebAuthnCredentials(ctx context.Context, userID uuid.UUID) ([]ListUserWebAuthnCredentialsRow, error) {
+	rows, err := q.db.Query(ctx, listUserWebAuthnCredentials, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserWebAuthnCredentialsRow
+	for rows.Next() {
+		var i ListUserWebAuthnCredentialsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Credential,
+			&i.Name,
+			&i.CreatedAt,
+			&i.LastUsedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ping = `-- name: Ping :exec
+SELECT
+  1
+`
+
+func (q *Queries) Ping(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, ping)
+	return err
+}
+
+const recordJobFailure = `-- name: RecordJobFailure :exec
+UPDATE
+  jobs
+SET
+  last_run_at = $2,
+  last_error = $3,
+  next_run_at = $4,
+  updated_at = now()
+WHERE
+  name = $1
+`
+
+type RecordJobFailureParams struct {
+	Name      string
+	LastRunAt pgtype.Timestamptz
+	LastError pgtype.Text
+	NextRunAt pgtype.Timestamptz
+}
+
+func (q *Queries) RecordJobFailure(ctx context.Context, arg RecordJobFailureParams) error {
+	_, err := q.db.Exec(ctx, recordJobFailure,
+		arg.Name,
+		arg.LastRunAt,
+		arg.LastError,
+		arg.NextRunAt,
+	)
+	return err
+}
+
+const recordJobSuccess = `-- name: RecordJobSuccess :exec
+UPDATE
+  jobs
+SET
+  last_run_at = $2,
+  last_success_at = now(),
+  last_error = NULL,
+  next_run_at = $3,
+  updated_at = now()
+WHERE
+  name = $1
+`
+
+type RecordJobSuccessParams struct {
+	Name      string
+	LastRunAt pgtype.Timestamptz
+	NextRunAt pgtype.Timestamptz
+}
+
+func (q *Queries) RecordJobSuccess(ctx context.Context, arg RecordJobSuccessParams) error {
+	_, err := q.db.Exec(ctx, recordJobSuccess, arg.Name, arg.LastRunAt, arg.NextRunAt)
+	return err
+}
+
+const recordSpotifySyncFailure = `-- name: RecordSpotifySyncFailure :exec
+INSERT INTO spotify_sync_state (user_id, last_error, last_error_at)
+  VALUES ($1, $2, now())
+ON CONFLICT (user_id)
+  DO UPDATE SET
+    last_error = EXCLUDED.last_error,
+    last_error_at = EXCLUDED.last_error_at,
+    updated_at = now()
+`
+
+type RecordSpotifySyncFailureParams struct {
+	UserID    uuid.UUID
+	LastError pgtype.Text
+}
+
+func (q *Queries) RecordSpotifySyncFailure(ctx context.Context, arg RecordSpotifySyncFailureParams) error {
+	_, err := q.db.Exec(ctx, recordSpotifySyncFailure, arg.UserID, arg.LastError)
+	return err
+}
+
+const recordSpotifySyncSuccess = `-- name: RecordSpotifySyncSuccess :exec
+INSERT INTO spotify_sync_state (user_id, played_at_cursor, last_success_at, last_pages, last_items_fetched, last_items_inserted)
+  VALUES ($1, $2, now(), $3, $4, $5)
+ON CONFLICT (user_id)
+  DO UPDATE SET
+    played_at_cursor = EXCLUDED.played_at_cursor,
+    last_success_at = EXCLUDED.last_success_at,
+    last_error = NULL,
+    last_error_at = NULL,
+    last_pages = EXCLUDED.last_pages,
+    last_items_fetched = EXCLUDED.last_items_fetched,
+    last_items_inserted = EXCLUDED.last_items_inserted,
+    updated_at = now()
+`
+
+type RecordSpotifySyncSuccessParams struct {
+	UserID            uuid.UUID
+	PlayedAtCursor    pgtype.Timestamptz
+	LastPages         int32
+	LastItemsFetched  int32
+	LastItemsInserted int32
+}
+
+func (q *Queries) RecordSpotifySyncSuccess(ctx context.Context, arg RecordSpotifySyncSuccessParams) error {
+	_, err := q.db.Exec(ctx, recordSpotifySyncSuccess,
+		arg.UserID,
+		arg.PlayedAtCursor,
+		arg.LastPages,
+		arg.LastItemsFetched,
+		arg.LastItemsInserted,
+	)
+	return err
+}
+
+const redeemPasswordReset = `-- name: RedeemPasswordReset :execrows
+UPDATE
+  password_resets
+SET
+  used_at = now()
+WHERE
+  id = $1
+  AND used_at IS NULL
+  AND expires_at > now()
+`
+
+func (q *Queries) RedeemPasswordReset(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, redeemPasswordReset, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const redeemUserInvite = `-- name: RedeemUserInvite :execrows
+UPDATE
+  user_invites
+SET
+  used_at = now(),
+  used_by = $2
+WHERE
+  id = $1
+  AND used_at IS NULL
+  AND expires_at > now()
+`
+
+type RedeemUserInviteParams struct {
+	ID     uuid.UUID
+	UsedBy uuid.UUID
+}
+
+func (q *Queries) RedeemUserInvite(ctx context.Context, arg RedeemUserInviteParams) (int64, error) {
+	result, err := q.db.Exec(ctx, redeemUserInvite, arg.ID, arg.UsedBy)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const resetUserMFAAttempts = `-- name: ResetUserMFAAttempts :exec
+UPDATE
+  user_totp
+SET
+  failed_attempts = 0,
+  locked_until = NULL
+WHERE
+  user_id = $1
+`
+
+func (q *Queries) ResetUserMFAAttempts(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, resetUserMFAAttempts, userID)
+	return err
+}
+
+const rotateSession = `-- name: RotateSession :execrows
+UPDATE
+  sessions
+SET
+  refresh_token_hash = $1,
+  user_agent = $2,
+  ip_address = $3,
+  expires_at = $4,
+  last_used_at = now()
+WHERE
+  id = $5
+  AND refresh_token_hash = $6
+`
+
+type RotateSessionParams struct {
+	NewRefreshTokenHash string
+	UserAgent           string
+	IpAddress           string
+	ExpiresAt           pgtype.Timestamptz
+	ID                  uuid.UUID
+	RefreshTokenHash    string
+}
+
+func (q *Queries) RotateSession(ctx context.Context, arg RotateSessionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, rotateSession,
+		arg.NewRefreshTokenHash,
+		arg.UserAgent,
+		arg.IpAddress,
+		arg.ExpiresAt,
+		arg.ID,
+		arg.RefreshTokenHash,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const serviceAccountExists = `-- name: ServiceAccountExists :one
+SELECT
+  EXISTS (
+    SELECT
+      1
+    FROM
+      users
+    WHERE
+      ROLE = 'service')
+`
+
+func (q *Queries) ServiceAccountExists(ctx context.Context) (bool, error) {
+	row := q.db.QueryRow(ctx, serviceAccountExists)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const setJobPaused = `-- name: SetJobPaused :execrows
+UPDATE
+  jobs
+SET
+  paused = $2,
+  next_run_at = $3,
+  updated_at = now()
+WHERE
+  name = $1
+`
+
+type SetJobPausedParams struct {
+	Name      string
+	Paused    bool
+	NextRunAt pgtype.Timestamptz
+}
+
+func (q *Queries) SetJobPaused(ctx context.Context, arg SetJobPausedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setJobPaused, arg.Name, arg.Paused, arg.NextRunAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const takeWebAuthnSession = `-- name: TakeWebAuthnSession :one
+DELETE FROM webauthn_sessions
+WHERE id = $1
+  AND ceremony = $2
+RETURNING
+  session_data,
+  expires_at
+`
+
+type TakeWebAuthnSessionParams struct {
+	ID       uuid.UUID
+	Ceremony string
+}
+
+type TakeWebAuthnSessionRow struct {
+	SessionData []byte
+	ExpiresAt   pgtype.Timestamptz
+}
+
+func (q *Queries) TakeWebAuthnSession(ctx context.Context, arg TakeWebAuthnSessionParams) (TakeWebAuthnSessionRow, error) {
+	row := q.db.QueryRow(ctx, takeWebAuthnSession, arg.ID, arg.Ceremony)
+	var i TakeWebAuthnSessionRow
+	err := row.Scan(&i.SessionData, &i.ExpiresAt)
+	return i, err
+}
+
+const topAlbumsByUserInRange = `-- name: TopAlbumsByUserInRange :many
+SELECT
+  al.id,
+  al.name,
+  al.artists,
+  al.href,
+  al.image_url,
+  COUNT(*)::bigint AS listen_count
+FROM
+  track_listens tl
+  JOIN tracks t ON t.id = tl.track_id
+  JOIN albums al ON al.id = t.album_id
+WHERE
+  tl.user_id = $1
+  AND tl.played_at >= $2::timestamptz
+  AND tl.played_at < $3::timestamptz
+GROUP BY
+  al.id
+ORDER BY
+  listen_count DESC,
+  al.id ASC
+LIMIT 50
+`
+
+type TopAlbumsByUserInRangeParams struct {
+	UserID    uuid.UUID
+	StartDate pgtype.Timestamptz
+	EndDate   pgtype.Timestamptz
+}
+
+type TopAlbumsByUserInRangeRow struct {
+	ID          string
+	Name        string
+	Artists     []string
+	Href        string
+	ImageUrl    pgtype.Text
+	ListenCount int64
+}
+
+func (q *Queries) TopAlbumsByUserInRange(ctx context.Context, arg TopAlbumsByUserInRangeParams) ([]TopAlbumsByUserInRangeRow, error) {
+	rows, err := q.db.Query(ctx, topAlbumsByUserInRange, arg.UserID, arg.StartDate, arg.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TopAlbumsByUserInRangeRow
+	for rows.Next() {
+		var i TopAlbumsByUserInRangeRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Artists,
+			&i.Href,
+			&i.ImageUrl,
+			&i.ListenCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const topArtistsByUserInRange = `-- name: TopArtistsByUserInRange :many
+SELECT
+  ar.id,
+  ar.name,
+  ar.href,
+  ar.image_url,
+  COUNT(*)::bigint AS listen_count
+FROM
+  track_listens tl
+  JOIN track_artists ta ON ta.track_id = tl.track_id
+  JOIN artists ar ON ar.id = ta.artist_id
+WHERE
+  tl.user_id = $1
+  AND tl.played_at >= $2::timestamptz
+  AND tl.played_at < $3::timestamptz
+GROUP BY
+  ar.id
+ORDER BY
+  listen_count DESC,
+  ar.id ASC
+LIMIT 50
+`
+
+type TopArtistsByUserInRangeParams struct {
+	UserID    uuid.UUID
+	StartDate pgtype.Timestamptz
+	EndDate   pgtype.Timestamptz
+}
+
+type TopArtistsByUserInRangeRow struct {
+	ID          string
+	Name        string
+	Href        string
+	ImageUrl    pgtype.Text
+	ListenCount int64
+}
+
+func (q *Queries) TopArtistsByUserInRange(ctx context.Context, arg TopArtistsByUserInRangeParams) ([]TopArtistsByUserInRangeRow, error) {
+	rows, err := q.db.Query(ctx, topArtistsByUserInRange, arg.UserID, arg.StartDate, arg.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TopArtistsByUserInRangeRow
+	for rows.Next() {
+		var i TopArtistsByUserInRangeRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Href,
+			&i.ImageUrl,
+			&i.ListenCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const topTracksByUserInRange = `-- name: TopTracksByUserInRange :many
+SELECT
+  tl.track_id,
+  t.name,
+  t.artists,
+  t.href,
+  t.uri,
+  t.image_url,
+  COUNT(*)::bigint AS listen_count,
+  COALESCE(SUM(t.duration_ms), 0)::bigint AS listened_ms
+FROM
+  track_listens tl
+  JOIN tracks t ON t.id = tl.track_id
+WHERE
+  tl.user_id = $1
+  AND tl.played_at >= $2::timestamptz
+  AND tl.played_at < $3::timestamptz
+GROUP BY
+  tl.track_id,
+  t.name,
+  t.artists,
+  t.href,
+  t.uri,
+  t.image_url
+ORDER BY
+  CASE WHEN $4::text = 'time' THEN
+    COALESCE(SUM(t.duration_ms), 0)
+  ELSE
+    COUNT(*)
+  END DESC,
+  listen_count DESC,
+  tl.track_id ASC
+LIMIT $5
+`
+
+type TopTracksByUserInRangeParams struct {
+	UserID    uuid.UUID
+	StartDate pgtype.Timestamptz
+	EndDate   pgtype.Timestamptz
+	RankBy    string
+	MaxRows   int32
+}
+
+type TopTracksByUserInRangeRow struct {
+	TrackID     string
+	Name        string
+	Artists     []string
+	Href        string
+	Uri         string
+	ImageUrl    pgtype.Text
+	ListenCount int64
+	ListenedMs  int64
+}
+
+func (q *Queries) TopTracksByUserInRange(ctx context.Context, arg TopTracksByUserInRangeParams) ([]TopTracksByUserInRangeRow, error) {
+	rows, err := q.db.Query(ctx, topTracksByUserInRange,
+		arg.UserID,
+		arg.StartDate,
+		arg.EndDate,
+		arg.RankBy,
+		arg.MaxRows,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TopTracksByUserInRangeRow
+	for rows.Next() {
+		var i TopTracksByUserInRangeRow
+		if err := rows.Scan(
+			&i.TrackID,
+			&i.Name,
+			&i.Artists,
+			&i.Href,
+			&i.Uri,
+			&i.ImageUrl,
+			&i.ListenCount,
+			&i.ListenedMs,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const trackExists = `-- name: TrackExists :one
+SELECT
+  EXISTS (
+    SELECT
+      1
+    FROM
+      tracks
+    WHERE
+      id = $1)
+`
+
+func (q *Queries) TrackExists(ctx context.Context, id string) (bool, error) {
+	row := q.db.QueryRow(ctx, trackExists, id)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const triggerJob = `-- name: TriggerJob :execrows
+UPDATE
+  jobs
+SET
+  triggered_at = now(),
+  updated_at = now()
+WHERE
+  name = $1
+`
+
+func (q *Queries) TriggerJob(ctx context.Context, name string) (int64, error) {
+	result, err := q.db.Exec(ctx, triggerJob, name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateArtistImage = `-- name: UpdateArtistImage :exec
+UPDATE
+  artists
+SET
+  image_url = $2,
+  images_updated_at = now(),
+  updated_at = now()
+WHERE
+  id = $1
+`
+
+type UpdateArtistImageParams struct {
+	ID       string
+	ImageUrl pgtype.Text
+}
+
+func (q *Queries) UpdateArtistImage(ctx context.Context, arg UpdateArtistImageParams) error {
+	_, err := q.db.Exec(ctx, updateArtistImage, arg.ID, arg.ImageUrl)
+	return err
+}
+
+const updatePlaylistPeriod = `-- name: UpdatePlaylistPeriod :exec
+UPDATE
+  playlists
+SET
+  name = $2,
+  period_start = $3,
+  description = $4
+WHERE
+  id = $1
+`
+
+type UpdatePlaylistPeriodParams struct {
+	ID          uuid.UUID
+	Name        string
+	PeriodStart pgtype.Timestamptz
+	Description string
+}
+
+func (q *Queries) UpdatePlaylistPeriod(ctx context.Context, arg UpdatePlaylistPeriodParams) error {
+	_, err := q.db.Exec(ctx, updatePlaylistPeriod,
+		arg.ID,
+		arg.Name,
+		arg.PeriodStart,
+		arg.Description,
+	)
+	return err
+}
+
+const updateUserPassword = `-- name: UpdateUserPassword :exec
+UPDATE
+  users
+SET
+  password_hash = $2,
+  updated_at = now()
+WHERE
+  id = $1
+`
+
+type UpdateUserPasswordParams struct {
+	ID           uuid.UUID
+	PasswordHash string
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error {
+	_, err := q.db.Exec(ctx, updateUserPassword, arg.ID, arg.PasswordHash)
+	return err
+}
+
+const updateUserPreferences = `-- name: UpdateUserPreferences :one
+UPDATE
+  users
+SET
+  timezone = coalesce(sqlc.narg ('timezone')::text, timezone),
+  week_start = coalesce(sqlc.narg ('week_start')::smallint, week_start),
+  updated_at = now()
+WHERE
+  id = $1
+RETURNING
+  timezone,
+  week_start
+`
+
+type UpdateUserPreferencesParams struct {
+	ID        uuid.UUID
+	Timezone  pgtype.Text
+	WeekStart pgtype.Int2
+}
+
+type UpdateUserPreferencesRow struct {
+	Timezone  string
+	WeekStart int16
+}
+
+func (q *Queries) UpdateUserPreferences(ctx context.Context, arg UpdateUserPreferencesParams) (UpdateUserPreferencesRow, error) {
+	row := q.db.QueryRow(ctx, updateUserPreferences, arg.ID, arg.Timezone, arg.WeekStart)
+	var i UpdateUserPreferencesRow
+	err := row.Scan(&i.Timezone, &i.WeekStart)
+	return i, err
+}
+
+const updateWebAuthnCredentialUse = `-- name: UpdateWebAuthnCredentialUse :exec
+UPDATE
+  webauthn_credentials
+SET
+  credential = $2,
+  last_used_at = now()
 WHERE
-  spotify_user_id = $6
+  id = $1
 `
 
-type UpdateUserSpotifyTokensParams struct {
-	AccessToken   string
-	RefreshToken  string
-	TokenType     string
-	Scope         string
-	ExpiresAt     pgtype.Timestamptz
-	SpotifyUserID string
+type UpdateWebAuthnCredentialUseParams struct {
+	ID         uuid.UUID
+	Credential []byte
 }
 
-func (q *Queries) UpdateUserSpotifyTokens(ctx context.Context, arg UpdateUserSpotifyTokensParams) error {
-	_, err := q.db.Exec(ctx, updateUserSpotifyTokens,
-		arg.AccessToken,
-		arg.RefreshToken,
-		arg.TokenType,
-		arg.Scope,
-		arg.ExpiresAt,
-		arg.SpotifyUserID,
-	)
+func (q *Queries) UpdateWebAuthnCredentialUse(ctx context.Context, arg UpdateWebAuthnCredentialUseParams) error {
+	_, err := q.db.Exec(ctx, updateWebAuthnCredentialUse, arg.ID, arg.Credential)
 	return err
 }
 
-const upsertTrack = `-- name: UpsertTrack :exec
-INSERT INTO tracks (image_url, id, name, artists, href, uri)
+const upsertAlbum = `-- name: UpsertAlbum :exec
+INSERT INTO albums (image_url, id, name, artists, href, uri)
   VALUES ($6, $1, $2, $3, $4, $5)
 ON CONFLICT (id)
   DO UPDATE SET
-    updated_at = NOW(),
+    updated_at = now(),
     image_url = EXCLUDED.image_url,
     name = EXCLUDED.name,
     artists = EXCLUDED.artists,
-    href = EXCLUDED.href
+    href = EXCLUDED.href,
+    uri = EXCLUDED.uri
 `
 
-type UpsertTrackParams struct {
+type UpsertAlbumParams struct {
 	ID       string
 	Name     string
 	Artists  []string
@@ -682,8 +2961,8 @@ type UpsertTrackParams struct {
 	ImageUrl pgtype.Text
 }
 
-func (q *Queries) UpsertTrack(ctx context.Context, arg UpsertTrackParams) error {
-	_, err := q.db.Exec(ctx, upsertTrack,
+func (q *Queries) UpsertAlbum(ctx context.Context, arg UpsertAlbumParams) error {
+	_, err := q.db.Exec(ctx, upsertAlbum,
 		arg.ID,
 		arg.Name,
 		arg.Artists,
@@ -694,48 +2973,223 @@ func (q *Queries) UpsertTrack(ctx context.Context, arg UpsertTrackParams) error
 	return err
 }
 
-const upsertTrackListen = `-- name: UpsertTrackListen :exec
-INSERT INTO track_listens (user_id, track_id, played_at)
-  VALUES ($1, $2, $3)
-ON CONFLICT (user_id, track_id, played_at)
-  DO NOTHING
+const upsertArtist = `-- name: UpsertArtist :exec
+INSERT INTO artists (id, name, href, uri)
+  VALUES ($1, $2, $3, $4)
+ON CONFLICT (id)
+  DO UPDATE SET
+    updated_at = now(),
+    name = EXCLUDED.name,
+    href = EXCLUDED.href,
+    uri = EXCLUDED.uri
 `
 
-type UpsertTrackListenParams struct {
-	UserID   uuid.UUID
-	TrackID  string
-	PlayedAt pgtype.Timestamptz
+type UpsertArtistParams struct {
+	ID   string
+	Name string
+	Href string
+	Uri  string
+}
+
+func (q *Queries) UpsertArtist(ctx context.Context, arg UpsertArtistParams) error {
+	_, err := q.db.Exec(ctx, upsertArtist,
+		arg.ID,
+		arg.Name,
+		arg.Href,
+		arg.Uri,
+	)
+	return err
+}
+
+const upsertJob = `-- name: UpsertJob :exec
+INSERT INTO jobs (name, schedule, timezone, next_run_at)
+  VALUES ($1, $2, $3, $4)
+ON CONFLICT (name)
+  DO UPDATE SET
+    schedule = EXCLUDED.schedule,
+    timezone = EXCLUDED.timezone,
+    next_run_at = CASE WHEN jobs.schedule <> EXCLUDED.schedule
+      OR jobs.timezone <> EXCLUDED.timezone THEN
+      EXCLUDED.next_run_at
+    ELSE
+      jobs.next_run_at
+    END,
+    updated_at = now()
+`
+
+type UpsertJobParams struct {
+	Name      string
+	Schedule  string
+	Timezone  string
+	NextRunAt pgtype.Timestamptz
+}
+
+func (q *Queries) UpsertJob(ctx context.Context, arg UpsertJobParams) error {
+	_, err := q.db.Exec(ctx, upsertJob,
+		arg.Name,
+		arg.Schedule,
+		arg.Timezone,
+		arg.NextRunAt,
+	)
+	return err
+}
+
+const upsertListenToken = `-- name: UpsertListenToken :one
+INSERT INTO listen_tokens (user_id, token_hash)
+  VALUES ($1, $2)
+ON CONFLICT (user_id)
+  DO UPDATE SET
+    token_hash = EXCLUDED.token_hash,
+    created_at = now()
+  RETURNING
+    created_at
+`
+
+type UpsertListenTokenParams struct {
+	UserID    uuid.UUID
+	TokenHash string
+}
+
+func (q *Queries) UpsertListenToken(ctx context.Context, arg UpsertListenTokenParams) (pgtype.Timestamptz, error) {
+	row := q.db.QueryRow(ctx, upsertListenToken, arg.UserID, arg.TokenHash)
+	var created_at pgtype.Timestamptz
+	err := row.Scan(&created_at)
+	return created_at, err
+}
+
+const upsertPendingUserTOTP = `-- name: UpsertPendingUserTOTP :execrows
+INSERT INTO user_totp (user_id, secret)
+  VALUES ($1, $2)
+ON CONFLICT (user_id)
+  DO UPDATE SET
+    secret = excluded.secret,
+    last_used_step = 0,
+    created_at = now()
+  WHERE
+    user_totp.enabled_at IS NULL
+`
+
+type UpsertPendingUserTOTPParams struct {
+	UserID uuid.UUID
+	Secret string
+}
+
+func (q *Queries) UpsertPendingUserTOTP(ctx context.Context, arg UpsertPendingUserTOTPParams) (int64, error) {
+	result, err := q.db.Exec(ctx, upsertPendingUserTOTP, arg.UserID, arg.Secret)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const upsertPlaylistExport = `-- name: UpsertPlaylistExport :exec
+INSERT INTO playlist_exports (playlist_id, provider, remote_id, url)
+  VALUES ($1, $2, $3, $4)
+ON CONFLICT (playlist_id, provider)
+  DO UPDATE SET
+    remote_id = EXCLUDED.remote_id,
+    url = EXCLUDED.url,
+    exported_at = now()
+`
+
+type UpsertPlaylistExportParams struct {
+	PlaylistID uuid.UUID
+	Provider   string
+	RemoteID   string
+	Url        string
 }
 
-func (q *Queries) UpsertTrackListen(ctx context.Context, arg UpsertTrackListenParams) error {
-	_, err := q.db.Exec(ctx, upsertTrackListen, arg.UserID, arg.TrackID, arg.PlayedAt)
+func (q *Queries) UpsertPlaylistExport(ctx context.Context, arg UpsertPlaylistExportParams) error {
+	_, err := q.db.Exec(ctx, upsertPlaylistExport,
+		arg.PlaylistID,
+		arg.Provider,
+		arg.RemoteID,
+		arg.Url,
+	)
 	return err
 }
 
-const upsertUserSpotifyTokens = `-- name: UpsertUserSpotifyTokens :exec
-INSERT INTO spotify_tokens (spotify_user_id, access_token, token_type, scope, refresh_token, expires_at)
+const upsertPlaylistRules = `-- name: UpsertPlaylistRules :exec
+INSERT INTO playlist_rules (user_id, max_tracks, min_plays, ordering, max_tracks_per_artist, name_template)
   VALUES ($1, $2, $3, $4, $5, $6)
-ON CONFLICT (spotify_user_id)
+ON CONFLICT (user_id)
+  DO UPDATE SET
+    max_tracks = EXCLUDED.max_tracks,
+    min_plays = EXCLUDED.min_plays,
+    ordering = EXCLUDED.ordering,
+    max_tracks_per_artist = EXCLUDED.max_tracks_per_artist,
+    name_template = EXCLUDED.name_template,
+    updated_at = now()
+`
+
+type UpsertPlaylistRulesParams struct {
+	UserID             uuid.UUID
+	MaxTracks          int32
+	MinPlays           int32
+	Ordering           string
+	MaxTracksPerArtist int32
+	NameTemplate       string
+}
+
+func (q *Queries) UpsertPlaylistRules(ctx context.Context, arg UpsertPlaylistRulesParams) error {
+	_, err := q.db.Exec(ctx, upsertPlaylistRules,
+		arg.UserID,
+		arg.MaxTracks,
+		arg.MinPlays,
+		arg.Ordering,
+		arg.MaxTracksPerArtist,
+		arg.NameTemplate,
+	)
+	return err
+}
+
+const upsertProviderAccount = `-- name: UpsertProviderAccount :exec
+INSERT INTO provider_accounts (user_id, provider, account_id)
+  VALUES ($1, $2, $3)
+ON CONFLICT (user_id, provider)
+  DO UPDATE SET
+    account_id = EXCLUDED.account_id,
+    updated_at = now()
+`
+
+type UpsertProviderAccountParams struct {
+	UserID    uuid.UUID
+	Provider  string
+	AccountID string
+}
+
+func (q *Queries) UpsertProviderAccount(ctx context.Context, arg UpsertProviderAccountParams) error {
+	_, err := q.db.Exec(ctx, upsertProviderAccount, arg.UserID, arg.Provider, arg.AccountID)
+	return err
+}
+
+const upsertProviderTokens = `-- name: UpsertProviderTokens :exec
+INSERT INTO provider_tokens (user_id, provider, access_token, token_type, scope, refresh_token, expires_at)
+  VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (user_id, provider)
   DO UPDATE SET
     access_token = EXCLUDED.access_token,
     token_type = EXCLUDED.token_type,
     scope = EXCLUDED.scope,
     refresh_token = EXCLUDED.refresh_token,
-    expires_at = EXCLUDED.expires_at
+    expires_at = EXCLUDED.expires_at,
+    updated_at = now()
 `
 
-type UpsertUserSpotifyTokensParams struct {
-	SpotifyUserID string
-	AccessToken   string
-	TokenType     string
-	Scope         string
-	RefreshToken  string
-	ExpiresAt     pgtype.Timestamptz
+type UpsertProviderTokensParams struct {
+	UserID       uuid.UUID
+	Provider     string
+	AccessToken  string
+	TokenType    string
+	Scope        string
+	RefreshToken string
+	ExpiresAt    pgtype.Timestamptz
 }
 
-func (q *Queries) UpsertUserSpotifyTokens(ctx context.Context, arg UpsertUserSpotifyTokensParams) error {
-	_, err := q.db.Exec(ctx, upsertUserSpotifyTokens,
-		arg.SpotifyUserID,
+func (q *Queries) UpsertProviderTokens(ctx context.Context, arg UpsertProviderTokensParams) error {
+	_, err := q.db.Exec(ctx, upsertProviderTokens,
+		arg.UserID,
+		arg.Provider,
 		arg.AccessToken,
 		arg.TokenType,
 		arg.Scope,
@@ -744,3 +3198,306 @@ func (q *Queries) UpsertUserSpotifyTokens(ctx context.Context, arg UpsertUserSpo
 	)
 	return err
 }
+
+const upsertTrack = `-- name: UpsertTrack :exec
+INSERT INTO tracks (image_url, id, name, artists, href, uri, album_id, duration_ms)
+  VALUES ($6, $1, $2, $3, $4, $5, $7, $8)
+ON CONFLICT (id)
+  DO UPDATE SET
+    updated_at = NOW(),
+    image_url = EXCLUDED.image_url,
+    name = EXCLUDED.name,
+    artists = EXCLUDED.artists,
+    href = EXCLUDED.href,
+    album_id = coalesce(EXCLUDED.album_id, tracks.album_id),
+    duration_ms = coalesce(EXCLUDED.duration_ms, tracks.duration_ms)
+`
+
+type UpsertTrackParams struct {
+	ID         string
+	Name       string
+	Artists    []string
+	Href       string
+	Uri        string
+	ImageUrl   pgtype.Text
+	AlbumID    pgtype.Text
+	DurationMs pgtype.Int4
+}
+
+func (q *Queries) UpsertTrack(ctx context.Context, arg UpsertTrackParams) error {
+	_, err := q.db.Exec(ctx, upsertTrack,
+		arg.ID,
+		arg.Name,
+		arg.Artists,
+		arg.Href,
+		arg.Uri,
+		arg.ImageUrl,
+		arg.AlbumID,
+		arg.DurationMs,
+	)
+	return err
+}
+
+const upsertTrackArtist = `-- name: UpsertTrackArtist :exec
+INSERT INTO track_artists (track_id, artist_id, position)
+  VALUES ($1, $2, $3)
+ON CONFLICT (track_id, artist_id)
+  DO UPDATE SET
+    position = EXCLUDED.position
+`
+
+type UpsertTrackArtistParams struct {
+	TrackID  string
+	ArtistID string
+	Position int16
+}
+
+func (q *Queries) UpsertTrackArtist(ctx context.Context, arg UpsertTrackArtistParams) error {
+	_, err := q.db.Exec(ctx, upsertTrackArtist, arg.TrackID, arg.ArtistID, arg.Position)
+	return err
+}
+
+const upsertTrackListen = `-- name: UpsertTrackListen :execrows
+INSERT INTO track_listens (user_id, track_id, played_at)
+  VALUES ($1, $2, $3)
+ON CONFLICT (user_id, track_id, played_at)
+  DO NOTHING
+`
+
+type UpsertTrackListenParams struct {
+	UserID   uuid.UUID
+	TrackID  string
+	PlayedAt pgtype.Timestamptz
+}
+
+func (q *Queries) UpsertTrackListen(ctx context.Context, arg UpsertTrackListenParams) (int64, error) {
+	result, err := q.db.Exec(ctx, upsertTrackListen, arg.UserID, arg.TrackID, arg.PlayedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const useRecoveryCode = `-- name: UseRecoveryCode :execrows
+UPDATE
+  mfa_recovery_codes
+SET
+  used_at = now()
+WHERE
+  id = $1
+  AND used_at IS NULL
+`
+
+func (q *Queries) UseRecoveryCode(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, useRecoveryCode, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const useUserTOTPStep = `-- name: UseUserTOTPStep :execrows
+UPDATE
+  user_totp
+SET
+  last_used_step = $2
+WHERE
+  user_id = $1
+  AND enabled_at IS NOT NULL
+  AND last_used_step < $2
+`
+
+type UseUserTOTPStepParams struct {
+	UserID       uuid.UUID
+	LastUsedStep int64
+}
+
+func (q *Queries) UseUserTOTPStep(ctx context.Context, arg UseUserTOTPStepParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useUserTOTPStep, arg.UserID, arg.LastUsedStep)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const userListenHeatmap = `-- name: UserListenHeatmap :many
+SELECT
+  EXTRACT(DOW FROM tl.played_at AT TIME ZONE $2::text)::smallint AS day_of_week,
+  EXTRACT(HOUR FROM tl.played_at AT TIME ZONE $2::text)::smallint AS hour,
+  COUNT(*)::bigint AS plays
+FROM
+  track_listens tl
+WHERE
+  tl.user_id = $1
+  AND tl.played_at >= $3::timestamptz
+  AND tl.played_at < $4::timestamptz
+GROUP BY
+  day_of_week,
+  hour
+ORDER BY
+  day_of_week,
+  hour
+`
+
+type UserListenHeatmapParams struct {
+	UserID    uuid.UUID
+	Timezone  string
+	StartDate pgtype.Timestamptz
+	EndDate   pgtype.Timestamptz
+}
+
+type UserListenHeatmapRow struct {
+	DayOfWeek int16
+	Hour      int16
+	Plays     int64
+}
+
+func (q *Queries) UserListenHeatmap(ctx context.Context, arg UserListenHeatmapParams) ([]UserListenHeatmapRow, error) {
+	rows, err := q.db.Query(ctx, userListenHeatmap,
+		arg.UserID,
+		arg.Timezone,
+		arg.StartDate,
+		arg.EndDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserListenHeatmapRow
+	for rows.Next() {
+		var i UserListenHeatmapRow
+		if err := rows.Scan(&i.DayOfWeek, &i.Hour, &i.Plays); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const userListenMonths = `-- name: UserListenMonths :many
+SELECT
+  EXTRACT(MONTH FROM tl.played_at AT TIME ZONE $2::text)::smallint AS month,
+  COUNT(*)::bigint AS plays,
+  COALESCE(SUM(t.duration_ms), 0)::bigint AS listened_ms
+FROM
+  track_listens tl
+  JOIN tracks t ON t.id = tl.track_id
+WHERE
+  tl.user_id = $1
+  AND tl.played_at >= $3::timestamptz
+  AND tl.played_at < $4::timestamptz
+GROUP BY
+  month
+ORDER BY
+  month
+`
+
+type UserListenMonthsParams struct {
+	UserID    uuid.UUID
+	Timezone  string
+	StartDate pgtype.Timestamptz
+	EndDate   pgtype.Timestamptz
+}
+
+type UserListenMonthsRow struct {
+	Month      int16
+	Plays      int64
+	ListenedMs int64
+}
+
+func (q *Queries) UserListenMonths(ctx context.Context, arg UserListenMonthsParams) ([]UserListenMonthsRow, error) {
+	rows, err := q.db.Query(ctx, userListenMonths,
+		arg.UserID,
+		arg.Timezone,
+		arg.StartDate,
+		arg.EndDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserListenMonthsRow
+	for rows.Next() {
+		var i UserListenMonthsRow
+		if err := rows.Scan(&i.Month, &i.Plays, &i.ListenedMs); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const userListenStreaks = `-- name: UserListenStreaks :many
+WITH listen_days AS (
+  SELECT DISTINCT
+    (tl.played_at AT TIME ZONE $2::text)::date AS day
+  FROM
+    track_listens tl
+  WHERE
+    tl.user_id = $1
+    AND tl.played_at >= $3::timestamptz
+    AND tl.played_at < $4::timestamptz
+),
+islands AS (
+  -- Consecutive days share the same day minus row number
+  SELECT
+    day,
+    day - (ROW_NUMBER() OVER (ORDER BY day))::int AS island
+  FROM
+    listen_days
+)
+SELECT
+  MIN(day)::date AS start_day,
+  MAX(day)::date AS end_day,
+  COUNT(*)::bigint AS days
+FROM
+  islands
+GROUP BY
+  island
+ORDER BY
+  start_day
+`
+
+type UserListenStreaksParams struct {
+	UserID    uuid.UUID
+	Timezone  string
+	StartDate pgtype.Timestamptz
+	EndDate   pgtype.Timestamptz
+}
+
+type UserListenStreaksRow struct {
+	StartDay pgtype.Date
+	EndDay   pgtype.Date
+	Days     int64
+}
+
+func (q *Queries) UserListenStreaks(ctx context.Context, arg UserListenStreaksParams) ([]UserListenStreaksRow, error) {
+	rows, err := q.db.Query(ctx, userListenStreaks,
+		arg.UserID,
+		arg.Timezone,
+		arg.StartDate,
+		arg.EndDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserListenStreaksRow
+	for rows.Next() {
+		var i UserListenStreaksRow
+		if err := rows.Scan(&i.StartDay, &i.EndDay, &i.Days); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -39,117 +39,83 @@ SELECT
   id,
   email,
   ROLE,
-  password_hash
+  password_hash,
+  token_version
 FROM
   users
 WHERE
   email = trim(lower(@email::text));
 
--- name: GetUserRole :one
+-- name: GetUserAuth :one
 SELECT
-  ROLE
+  ROLE,
+  token_version
 FROM
   users
 WHERE
   id = $1;
 
--- name: GetUser :one
+-- name: GetUserTokenVersion :one
 SELECT
-  email,
-  ROLE
+  token_version
 FROM
   users
 WHERE
   id = $1;
 
--- name: UpdateUserRefreshToken :exec
+-- name: IncrementUserTokenVersion :execrows
 UPDATE
   users
 SET
-  refresh_token_hash = $1,
-  refresh_token_expires_at = $2
+  token_version = token_version + 1,
+  updated_at = now()
 WHERE
-  id = $3;
+  id = $1;
 
--- name: GetUserRefreshToken :one
+-- name: GetUser :one
 SELECT
-  refresh_token_hash,
-  refresh_token_expires_at
+  email,
+  ROLE
 FROM
   users
 WHERE
   id = $1;
 
--- name: UpdateUserSpotifyID :exec
-UPDATE
-  users
-SET
-  spotify_id = $1
-WHERE
-  id = $2;
+-- name: UpsertProviderAccount :exec
+INSERT INTO provider_accounts (user_id, provider, account_id)
+  VALUES ($1, $2, $3)
+ON CONFLICT (user_id, provider)
+  DO UPDATE SET
+    account_id = EXCLUDED.account_id,
+    updated_at = now();
 
--- name: UpsertUserSpotifyTokens :exec
-INSERT INTO spotify_tokens (spotify_user_id, access_token, token_type, scope, refresh_token, expires_at)
-  VALUES ($1, $2, $3, $4, $5, $6)
-ON CONFLICT (spotify_user_id)
+-- name: UpsertProviderTokens :exec
+INSERT INTO provider_tokens (user_id, provider, access_token, token_type, scope, refresh_token, expires_at)
+  VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (user_id, provider)
   DO UPDATE SET
     access_token = EXCLUDED.access_token,
     token_type = EXCLUDED.token_type,
     scope = EXCLUDED.scope,
     refresh_token = EXCLUDED.refresh_token,
-    expires_at = EXCLUDED.expires_at;
-
--- name: GetUserSpotifyTokenExpiration :one
-SELECT
-  st.expires_at
-FROM
-  users u
-  JOIN spotify_tokens st ON st.spotify_user_id = u.spotify_id
-WHERE
-  u.spotify_id IS NOT NULL
-  AND u.id = $1;
-
--- name: GetUserSpotifyRefreshToken :one
-SELECT
-  st.refresh_token
-FROM
-  users u
-  JOIN spotify_tokens st ON st.spotify_user_id = u.spotify_id
-WHERE
-  u.spotify_id IS NOT NULL
-  AND u.id = $1;
-
--- name: GetUserSpotifyAccessToken :one
-SELECT
-  st.access_token
-FROM
-  users u
-  JOIN spotify_tokens st ON st.spotify_user_id = u.spotify_id
-WHERE
-  u.spotify_id IS NOT NULL
-  AND u.id = $1;
+    expires_at = EXCLUDED.expires_at,
+    updated_at = now();
 
--- name: GetUserSpotifyId :one
+-- name: GetProviderTokens :one
 SELECT
-  u.spotify_id
+  pa.account_id,
+  pt.access_token,
+  pt.token_type,
+  pt.scope,
+  pt.refresh_token,
+  pt.expires_at
 FROM
-  users u
-  JOIN spotify_tokens st ON st.spotify_user_id = u.spotify_id
+  provider_accounts pa
+  JOIN provider_tokens pt ON pt.user_id = pa.user_id
+    AND pt.provider = pa.provider
 WHERE
-  u.spotify_id IS NOT NULL
-  AND u.id = $1;
-
--- name: UpdateUserSpotifyTokens :exec
-UPDATE
-  spotify_tokens
-SET
-  access_token = $1,
-  refresh_token = $2,
-  token_type = $3,
-  scope = $4,
-  expires_at = $5
-WHERE
-  spotify_user_id = $6;
+  pa.user_id = $1
+  AND pa.provider = $2;
 
 -- name: GetUserIDs :many
 SELECT
@@ -160,40 +126,36 @@ ORDER BY
   created_at ASC
 LIMIT $1;
 
+-- name: ListUserIDsAfter :many
+SELECT
+  id
+FROM
+  users
+WHERE
+  id > $1
+ORDER BY
+  id ASC
+LIMIT $2;
+
 -- name: UpsertTrack :exec
-INSERT INTO tracks (image_url, id, name, artists, href, uri)
-  VALUES (sqlc.narg ('image_url'), $1, $2, $3, $4, $5)
+INSERT INTO tracks (image_url, id, name, artists, href, uri, album_id, duration_ms)
+  VALUES (sqlc.narg ('image_url'), $1, $2, $3, $4, $5, sqlc.narg ('album_id'), sqlc.narg ('duration_ms'))
 ON CONFLICT (id)
   DO UPDATE SET
     updated_at = NOW(),
     image_url = EXCLUDED.image_url,
     name = EXCLUDED.name,
     artists = EXCLUDED.artists,
-    href = EXCLUDED.href;
+    href = EXCLUDED.href,
+    album_id = coalesce(EXCLUDED.album_id, tracks.album_id),
+    duration_ms = coalesce(EXCLUDED.duration_ms, tracks.duration_ms);
 
--- name: UpsertTrackListen :exec
+-- name: UpsertTrackListen :execrows
 INSERT INTO track_listens (user_id, track_id, played_at)
   VALUES ($1, $2, $3)
 ON CONFLICT (user_id, track_id, played_at)
   DO NOTHING;
 
--- name: TopTrackIDsByUserInRange :many
-SELECT
-  track_id,
-  COUNT(*)::bigint AS listen_count
-FROM
-  track_listens
-WHERE
-  user_id = $1
-  AND played_at >= @start_date::timestamptz
-  AND played_at < @end_date::timestamptz
-GROUP BY
-  track_id
-ORDER BY
-  listen_count DESC,
-  track_id ASC
-LIMIT 50;
-
 -- name: TopTracksByUserInRange :many
 SELECT
   tl.track_id,
@@ -202,7 +164,8 @@ SELECT
   t.href,
   t.uri,
   t.image_url,
-  COUNT(*)::bigint AS listen_count
+  COUNT(*)::bigint AS listen_count,
+  COALESCE(SUM(t.duration_ms), 0)::bigint AS listened_ms
 FROM
   track_listens tl
   JOIN tracks t ON t.id = tl.track_id
@@ -218,19 +181,24 @@ GROUP BY
   t.uri,
   t.image_url
 ORDER BY
+  CASE WHEN @rank_by::text = 'time' THEN
+    COALESCE(SUM(t.duration_ms), 0)
+  ELSE
+    COUNT(*)
+  END DESC,
   listen_count DESC,
   tl.track_id ASC
-LIMIT 50;
+LIMIT sqlc.arg ('max_rows');
 
 -- name: CreatePlaylist :one
-INSERT INTO playlists (user_id, playlist_type, name)
-  VALUES ($1, $2, $3)
+INSERT INTO playlists (user_id, playlist_type, name, period_start, description)
+  VALUES ($1, $2, $3, $4, $5)
 RETURNING
   id;
 
 -- name: AddPlaylistTrack :exec
-INSERT INTO playlist_tracks (playlist_id, track_id, plays)
-  VALUES ($1, $2, $3);
+INSERT INTO playlist_tracks (playlist_id, track_id, plays, position)
+  VALUES ($1, $2, $3, $4);
 
 -- name: GetUserPlaylists :many
 SELECT
@@ -250,6 +218,7 @@ SELECT
   id,
   playlist_type,
   name,
+  description,
   created_at
 FROM
   playlists
@@ -265,6 +234,7 @@ SELECT
   t.href,
   t.image_url,
   t.uri,
+  t.duration_ms,
   pt.plays
 FROM
   playlist_tracks pt
@@ -272,5 +242,1076 @@ FROM
 WHERE
   pt.playlist_id = $1
 ORDER BY
+  pt.position ASC NULLS LAST,
   pt.plays DESC,
   pt.track_id ASC;
+
+-- name: CreateUser :one
+INSERT INTO users (email, role, password_hash)
+  VALUES (trim(lower(@email::text)), 'user', $1)
+RETURNING
+  id;
+
+-- name: CreateUserInvite :exec
+INSERT INTO user_invites (id, token_hash, email, created_by, expires_at)
+  VALUES ($1, $2, trim(lower(sqlc.narg ('email')::text)), $3, $4);
+
+-- name: GetUserInvite :one
+SELECT
+  id,
+  token_hash,
+  email,
+  expires_at,
+  used_at
+FROM
+  user_invites
+WHERE
+  id = $1;
+
+-- name: ListUserInvites :many
+SELECT
+  id,
+  email,
+  created_by,
+  used_by,
+  expires_at,
+  used_at,
+  created_at
+FROM
+  user_invites
+ORDER BY
+  created_at DESC;
+
+-- name: RedeemUserInvite :execrows
+UPDATE
+  user_invites
+SET
+  used_at = now(),
+  used_by = $2
+WHERE
+  id = $1
+  AND used_at IS NULL
+  AND expires_at > now();
+
+-- name: DeleteUserInvite :execrows
+DELETE FROM user_invites
+WHERE id = $1
+  AND used_at IS NULL;
+
+-- name: GetSpotifySyncState :one
+SELECT
+  played_at_cursor,
+  last_success_at,
+  last_error,
+  last_error_at,
+  last_pages,
+  last_items_fetched,
+  last_items_inserted
+FROM
+  spotify_sync_state
+WHERE
+  user_id = $1;
+
+-- name: RecordSpotifySyncSuccess :exec
+INSERT INTO spotify_sync_state (user_id, played_at_cursor, last_success_at, last_pages, last_items_fetched, last_items_inserted)
+  VALUES ($1, $2, now(), $3, $4, $5)
+ON CONFLICT (user_id)
+  DO UPDATE SET
+    played_at_cursor = EXCLUDED.played_at_cursor,
+    last_success_at = EXCLUDED.last_success_at,
+    last_error = NULL,
+    last_error_at = NULL,
+    last_pages = EXCLUDED.last_pages,
+    last_items_fetched = EXCLUDED.last_items_fetched,
+    last_items_inserted = EXCLUDED.last_items_inserted,
+    updated_at = now();
+
+-- name: RecordSpotifySyncFailure :exec
+INSERT INTO spotify_sync_state (user_id, last_error, last_error_at)
+  VALUES ($1, $2, now())
+ON CONFLICT (user_id)
+  DO UPDATE SET
+    last_error = EXCLUDED.last_error,
+    last_error_at = EXCLUDED.last_error_at,
+    updated_at = now();
+
+-- name: CreateTrackIfNotExists :exec
+INSERT INTO tracks (id, name, artists, href, uri, duration_ms)
+  VALUES ($1, $2, $3, $4, $5, sqlc.narg ('duration_ms'))
+ON CONFLICT (id)
+  DO UPDATE SET
+    duration_ms = EXCLUDED.duration_ms
+  WHERE
+    tracks.duration_ms IS NULL
+    AND EXCLUDED.duration_ms IS NOT NULL;
+
+-- name: UpsertJob :exec
+INSERT INTO jobs (name, schedule, timezone, next_run_at)
+  VALUES ($1, $2, $3, $4)
+ON CONFLICT (name)
+  DO UPDATE SET
+    schedule = EXCLUDED.schedule,
+    timezone = EXCLUDED.timezone,
+    next_run_at = CASE WHEN jobs.schedule <> EXCLUDED.schedule
+      OR jobs.timezone <> EXCLUDED.timezone THEN
+      EXCLUDED.next_run_at
+    ELSE
+      jobs.next_run_at
+    END,
+    updated_at = now();
+
+-- name: GetJob :one
+SELECT
+  name,
+  schedule,
+  timezone,
+  paused,
+  next_run_at,
+  last_run_at,
+  last_success_at,
+  last_error,
+  triggered_at,
+  created_at,
+  updated_at
+FROM
+  jobs
+WHERE
+  name = $1;
+
+-- name: ListJobs :many
+SELECT
+  name,
+  schedule,
+  timezone,
+  paused,
+  next_run_at,
+  last_run_at,
+  last_success_at,
+  last_error,
+  triggered_at,
+  created_at,
+  updated_at
+FROM
+  jobs
+ORDER BY
+  name;
+
+-- name: ListRunnableJobs :many
+SELECT
+  name,
+  schedule,
+  timezone,
+  paused,
+  next_run_at,
+  last_run_at,
+  last_success_at,
+  last_error,
+  triggered_at,
+  created_at,
+  updated_at
+FROM
+  jobs
+WHERE (NOT paused
+  AND next_run_at <= now())
+  OR triggered_at IS NOT NULL
+ORDER BY
+  next_run_at;
+
+-- name: SetJobPaused :execrows
+UPDATE
+  jobs
+SET
+  paused = $2,
+  next_run_at = $3,
+  updated_at = now()
+WHERE
+  name = $1;
+
+-- name: TriggerJob :execrows
+UPDATE
+  jobs
+SET
+  triggered_at = now(),
+  updated_at = now()
+WHERE
+  name = $1;
+
+-- name: ClearJobTrigger :execrows
+UPDATE
+  jobs
+SET
+  triggered_at = NULL,
+  updated_at = now()
+WHERE
+  name = $1
+  AND triggered_at IS NOT NULL;
+
+-- name: RecordJobSuccess :exec
+UPDATE
+  jobs
+SET
+  last_run_at = $2,
+  last_success_at = now(),
+  last_error = NULL,
+  next_run_at = $3,
+  updated_at = now()
+WHERE
+  name = $1;
+
+-- name: RecordJobFailure :exec
+UPDATE
+  jobs
+SET
+  last_run_at = $2,
+  last_error = $3,
+  next_run_at = $4,
+  updated_at = now()
+WHERE
+  name = $1;
+
+-- name: GetUserPreferences :one
+SELECT
+  timezone,
+  week_start
+FROM
+  users
+WHERE
+  id = $1;
+
+-- name: UpdateUserPreferences :one
+UPDATE
+  users
+SET
+  timezone = coalesce(sqlc.narg ('timezone')::text, timezone),
+  week_start = coalesce(sqlc.narg ('week_start')::smallint, week_start),
+  updated_at = now()
+WHERE
+  id = $1
+RETURNING
+  timezone,
+  week_start;
+
+-- name: UpsertListenToken :one
+INSERT INTO listen_tokens (user_id, token_hash)
+  VALUES ($1, $2)
+ON CONFLICT (user_id)
+  DO UPDATE SET
+    token_hash = EXCLUDED.token_hash,
+    created_at = now()
+  RETURNING
+    created_at;
+
+-- name: GetListenToken :one
+SELECT
+  lt.token_hash,
+  u.email
+FROM
+  listen_tokens lt
+  JOIN users u ON u.id = lt.user_id
+WHERE
+  lt.user_id = $1;
+
+-- name: DeleteListenToken :execrows
+DELETE FROM listen_tokens
+WHERE user_id = $1;
+
+-- name: TrackExists :one
+SELECT
+  EXISTS (
+    SELECT
+      1
+    FROM
+      tracks
+    WHERE
+      id = $1);
+
+-- name: FindTrackByNameAndArtist :one
+SELECT
+  id
+FROM
+  tracks
+WHERE
+  lower(name) = lower(sqlc.arg ('name')::text)
+  AND (lower(artists[1]) = lower(sqlc.arg ('artist')::text)
+    OR lower(array_to_string(artists, ', ')) = lower(sqlc.arg ('artist')::text))
+ORDER BY
+  uri LIKE 'spotify:track:%' DESC,
+  created_at ASC
+LIMIT 1;
+
+-- name: ExportUserListens :many
+SELECT
+  tl.played_at,
+  t.id AS track_id,
+  t.name AS track_name,
+  t.artists,
+  t.href,
+  t.uri
+FROM
+  track_listens tl
+  JOIN tracks t ON t.id = tl.track_id
+WHERE
+  tl.user_id = $1
+  AND (sqlc.narg ('start')::timestamptz IS NULL
+    OR tl.played_at >= sqlc.narg ('start'))
+  AND (sqlc.narg ('end')::timestamptz IS NULL
+    OR tl.played_at < sqlc.narg ('end'))
+  AND (tl.played_at, tl.track_id) > (sqlc.arg ('after_played_at')::timestamptz, sqlc.arg ('after_track_id')::text)
+ORDER BY
+  tl.played_at,
+  tl.track_id
+LIMIT sqlc.arg ('page_size');
+
+-- name: ExportListens :many
+SELECT
+  tl.user_id,
+  u.email,
+  tl.played_at,
+  t.id AS track_id,
+  t.name AS track_name,
+  t.artists,
+  t.href,
+  t.uri
+FROM
+  track_listens tl
+  JOIN tracks t ON t.id = tl.track_id
+  JOIN users u ON u.id = tl.user_id
+WHERE (sqlc.narg ('start')::timestamptz IS NULL
+  OR tl.played_at >= sqlc.narg ('start'))
+AND (sqlc.narg ('end')::timestamptz IS NULL
+  OR tl.played_at < sqlc.narg ('end'))
+AND (tl.user_id, tl.played_at, tl.track_id) > (sqlc.arg ('after_user_id')::uuid, sqlc.arg ('after_played_at')::timestamptz,
+  sqlc.arg ('after_track_id')::text)
+ORDER BY
+  tl.user_id,
+  tl.played_at,
+  tl.track_id
+LIMIT sqlc.arg ('page_size');
+
+-- name: ListUserListens :many
+SELECT
+  tl.played_at,
+  t.id AS track_id,
+  t.name,
+  t.artists,
+  t.href,
+  t.uri,
+  t.image_url
+FROM
+  track_listens tl
+  JOIN tracks t ON t.id = tl.track_id
+WHERE
+  tl.user_id = $1
+  AND (sqlc.narg ('start')::timestamptz IS NULL
+    OR tl.played_at >= sqlc.narg ('start'))
+  AND (sqlc.narg ('end')::timestamptz IS NULL
+    OR tl.played_at < sqlc.narg ('end'))
+  AND (sqlc.narg ('artist')::text IS NULL
+    OR EXISTS (
+      SELECT
+        1
+      FROM
+        unnest(t.artists) AS artist
+      WHERE
+        artist ILIKE sqlc.narg ('artist')))
+  AND (sqlc.narg ('track')::text IS NULL
+    OR t.name ILIKE sqlc.narg ('track'))
+  AND (sqlc.narg ('before_played_at')::timestamptz IS NULL
+    OR (tl.played_at, tl.track_id) < (sqlc.narg ('before_played_at'), sqlc.arg ('before_track_id')::text))
+ORDER BY
+  tl.played_at DESC,
+  tl.track_id DESC
+LIMIT sqlc.arg ('page_size');
+
+-- name: CountUserListens :one
+SELECT
+  COUNT(*)::bigint AS total
+FROM
+  track_listens tl
+  JOIN tracks t ON t.id = tl.track_id
+WHERE
+  tl.user_id = $1
+  AND (sqlc.narg ('start')::timestamptz IS NULL
+    OR tl.played_at >= sqlc.narg ('start'))
+  AND (sqlc.narg ('end')::timestamptz IS NULL
+    OR tl.played_at < sqlc.narg ('end'))
+  AND (sqlc.narg ('artist')::text IS NULL
+    OR EXISTS (
+      SELECT
+        1
+      FROM
+        unnest(t.artists) AS artist
+      WHERE
+        artist ILIKE sqlc.narg ('artist')))
+  AND (sqlc.narg ('track')::text IS NULL
+    OR t.name ILIKE sqlc.narg ('track'));
+
+-- name: UpsertAlbum :exec
+INSERT INTO albums (image_url, id, name, artists, href, uri)
+  VALUES (sqlc.narg ('image_url'), $1, $2, $3, $4, $5)
+ON CONFLICT (id)
+  DO UPDATE SET
+    updated_at = now(),
+    image_url = EXCLUDED.image_url,
+    name = EXCLUDED.name,
+    artists = EXCLUDED.artists,
+    href = EXCLUDED.href,
+    uri = EXCLUDED.uri;
+
+-- name: UpsertArtist :exec
+INSERT INTO artists (id, name, href, uri)
+  VALUES ($1, $2, $3, $4)
+ON CONFLICT (id)
+  DO UPDATE SET
+    updated_at = now(),
+    name = EXCLUDED.name,
+    href = EXCLUDED.href,
+    uri = EXCLUDED.uri;
+
+-- name: UpsertTrackArtist :exec
+INSERT INTO track_artists (track_id, artist_id, position)
+  VALUES ($1, $2, $3)
+ON CONFLICT (track_id, artist_id)
+  DO UPDATE SET
+    position = EXCLUDED.position;
+
+-- name: ListArtistsWithoutImages :many
+SELECT
+  id
+FROM
+  artists
+WHERE
+  id = ANY (sqlc.arg ('ids')::text[])
+  AND images_updated_at IS NULL;
+
+-- name: UpdateArtistImage :exec
+UPDATE
+  artists
+SET
+  image_url = sqlc.narg ('image_url'),
+  images_updated_at = now(),
+  updated_at = now()
+WHERE
+  id = $1;
+
+-- name: TopArtistsByUserInRange :many
+SELECT
+  ar.id,
+  ar.name,
+  ar.href,
+  ar.image_url,
+  COUNT(*)::bigint AS listen_count
+FROM
+  track_listens tl
+  JOIN track_artists ta ON ta.track_id = tl.track_id
+  JOIN artists ar ON ar.id = ta.artist_id
+WHERE
+  tl.user_id = $1
+  AND tl.played_at >= @start_date::timestamptz
+  AND tl.played_at < @end_date::timestamptz
+GROUP BY
+  ar.id
+ORDER BY
+  listen_count DESC,
+  ar.id ASC
+LIMIT 50;
+
+-- name: TopAlbumsByUserInRange :many
+SELECT
+  al.id,
+  al.name,
+  al.artists,
+  al.href,
+  al.image_url,
+  COUNT(*)::bigint AS listen_count
+FROM
+  track_listens tl
+  JOIN tracks t ON t.id = tl.track_id
+  JOIN albums al ON al.id = t.album_id
+WHERE
+  tl.user_id = $1
+  AND tl.played_at >= @start_date::timestamptz
+  AND tl.played_at < @end_date::timestamptz
+GROUP BY
+  al.id
+ORDER BY
+  listen_count DESC,
+  al.id ASC
+LIMIT 50;
+
+-- name: GetUserListenStats :one
+WITH range_listens AS (
+  SELECT
+    tl.track_id,
+    tl.played_at
+  FROM
+    track_listens tl
+  WHERE
+    tl.user_id = $1
+    AND tl.played_at >= @start_date::timestamptz
+    AND tl.played_at < @end_date::timestamptz
+),
+first_track_listens AS (
+  SELECT
+    tl.track_id,
+    MIN(tl.played_at) AS first_played_at
+  FROM
+    track_listens tl
+  WHERE
+    tl.user_id = $1
+  GROUP BY
+    tl.track_id
+),
+first_artist_listens AS (
+  SELECT
+    lower(artist.name) AS artist,
+    MIN(tl.played_at) AS first_played_at
+  FROM
+    track_listens tl
+    JOIN tracks t ON t.id = tl.track_id
+    CROSS JOIN LATERAL unnest(t.artists) AS artist (name)
+  WHERE
+    tl.user_id = $1
+  GROUP BY
+    lower(artist.name)
+)
+SELECT
+  (
+    SELECT
+      COUNT(*)
+    FROM
+      range_listens)::bigint AS total_plays,
+  (
+    SELECT
+      COUNT(DISTINCT rl.track_id)
+    FROM
+      range_listens rl)::bigint AS unique_tracks,
+  (
+    SELECT
+      COUNT(DISTINCT lower(artist.name))
+    FROM
+      range_listens rl
+      JOIN tracks t ON t.id = rl.track_id
+      CROSS JOIN LATERAL unnest(t.artists) AS artist (name))::bigint AS unique_artists,
+  (
+    SELECT
+      COUNT(*)
+    FROM
+      first_track_listens ftl
+    WHERE
+      ftl.first_played_at >= @start_date::timestamptz
+      AND ftl.first_played_at < @end_date::timestamptz)::bigint AS new_tracks,
+  (
+    SELECT
+      COUNT(*)
+    FROM
+      first_artist_listens fal
+    WHERE
+      fal.first_played_at >= @start_date::timestamptz
+      AND fal.first_played_at < @end_date::timestamptz)::bigint AS new_artists;
+
+-- name: UserListenHeatmap :many
+SELECT
+  EXTRACT(DOW FROM tl.played_at AT TIME ZONE @timezone::text)::smallint AS day_of_week,
+  EXTRACT(HOUR FROM tl.played_at AT TIME ZONE @timezone::text)::smallint AS hour,
+  COUNT(*)::bigint AS plays
+FROM
+  track_listens tl
+WHERE
+  tl.user_id = $1
+  AND tl.played_at >= @start_date::timestamptz
+  AND tl.played_at < @end_date::timestamptz
+GROUP BY
+  day_of_week,
+  hour
+ORDER BY
+  day_of_week,
+  hour;
+
+-- name: UserListenStreaks :many
+WITH listen_days AS (
+  SELECT DISTINCT
+    (tl.played_at AT TIME ZONE @timezone::text)::date AS day
+  FROM
+    track_listens tl
+  WHERE
+    tl.user_id = $1
+    AND tl.played_at >= @start_date::timestamptz
+    AND tl.played_at < @end_date::timestamptz
+),
+islands AS (
+  -- Consecutive days share the same day minus row number
+  SELECT
+    day,
+    day - (ROW_NUMBER() OVER (ORDER BY day))::int AS island
+  FROM
+    listen_days
+)
+SELECT
+  MIN(day)::date AS start_day,
+  MAX(day)::date AS end_day,
+  COUNT(*)::bigint AS days
+FROM
+  islands
+GROUP BY
+  island
+ORDER BY
+  start_day;
+
+-- name: ListPlaylistCandidates :many
+WITH range_listens AS (
+  SELECT
+    tl.track_id,
+    COUNT(*)::bigint AS listen_count,
+    MIN(tl.played_at) AS first_played_at
+  FROM
+    track_listens tl
+  WHERE
+    tl.user_id = $1
+    AND tl.played_at >= @start_date::timestamptz
+    AND tl.played_at < @end_date::timestamptz
+  GROUP BY
+    tl.track_id
+)
+SELECT
+  rl.track_id,
+  t.artists,
+  rl.listen_count,
+  rl.first_played_at::timestamptz AS first_played_at,
+  (
+    SELECT
+      MIN(tl.played_at)
+    FROM
+      track_listens tl
+    WHERE
+      tl.user_id = $1
+      AND tl.track_id = rl.track_id)::timestamptz AS first_heard_at
+FROM
+  range_listens rl
+  JOIN tracks t ON t.id = rl.track_id
+WHERE
+  rl.listen_count >= @min_plays::bigint
+ORDER BY
+  rl.listen_count DESC,
+  rl.track_id ASC;
+
+-- name: GetPlaylistRules :one
+SELECT
+  max_tracks,
+  min_plays,
+  ordering,
+  max_tracks_per_artist,
+  name_template
+FROM
+  playlist_rules
+WHERE
+  user_id = $1;
+
+-- name: UpsertPlaylistRules :exec
+INSERT INTO playlist_rules (user_id, max_tracks, min_plays, ordering, max_tracks_per_artist, name_template)
+  VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (user_id)
+  DO UPDATE SET
+    max_tracks = EXCLUDED.max_tracks,
+    min_plays = EXCLUDED.min_plays,
+    ordering = EXCLUDED.ordering,
+    max_tracks_per_artist = EXCLUDED.max_tracks_per_artist,
+    name_template = EXCLUDED.name_template,
+    updated_at = now();
+
+-- name: GetLatestUserPlaylistByType :one
+SELECT
+  id,
+  period_start
+FROM
+  playlists
+WHERE
+  user_id = $1
+  AND playlist_type = $2
+ORDER BY
+  created_at DESC
+LIMIT 1;
+
+-- name: GetUserPlaylistByPeriod :one
+SELECT
+  id
+FROM
+  playlists
+WHERE
+  user_id = $1
+  AND playlist_type = $2
+  AND period_start = $3;
+
+-- name: UpdatePlaylistPeriod :exec
+UPDATE
+  playlists
+SET
+  name = $2,
+  period_start = $3,
+  description = $4
+WHERE
+  id = $1;
+
+-- name: DeletePlaylistTracks :exec
+DELETE FROM playlist_tracks
+WHERE playlist_id = $1;
+
+-- name: UserListenMonths :many
+SELECT
+  EXTRACT(MONTH FROM tl.played_at AT TIME ZONE @timezone::text)::smallint AS month,
+  COUNT(*)::bigint AS plays,
+  COALESCE(SUM(t.duration_ms), 0)::bigint AS listened_ms
+FROM
+  track_listens tl
+  JOIN tracks t ON t.id = tl.track_id
+WHERE
+  tl.user_id = $1
+  AND tl.played_at >= @start_date::timestamptz
+  AND tl.played_at < @end_date::timestamptz
+GROUP BY
+  month
+ORDER BY
+  month;
+
+-- name: GetPlaylistExport :one
+SELECT
+  remote_id,
+  url
+FROM
+  playlist_exports
+WHERE
+  playlist_id = $1
+  AND provider = $2;
+
+-- name: UpsertPlaylistExport :exec
+INSERT INTO playlist_exports (playlist_id, provider, remote_id, url)
+  VALUES ($1, $2, $3, $4)
+ON CONFLICT (playlist_id, provider)
+  DO UPDATE SET
+    remote_id = EXCLUDED.remote_id,
+    url = EXCLUDED.url,
+    exported_at = now();
+
+-- name: DeletePlaylistExport :execrows
+DELETE FROM playlist_exports
+WHERE playlist_id = $1
+  AND provider = $2;
+
+-- name: CreateSession :exec
+INSERT INTO sessions (id, user_id, refresh_token_hash, user_agent, ip_address, expires_at)
+  VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: GetSession :one
+SELECT
+  user_id,
+  refresh_token_hash,
+  expires_at
+FROM
+  sessions
+WHERE
+  id = $1;
+
+-- name: RotateSession :execrows
+UPDATE
+  sessions
+SET
+  refresh_token_hash = @new_refresh_token_hash,
+  user_agent = @user_agent,
+  ip_address = @ip_address,
+  expires_at = @expires_at,
+  last_used_at = now()
+WHERE
+  id = @id
+  AND refresh_token_hash = @refresh_token_hash;
+
+-- name: ListUserSessions :many
+SELECT
+  id,
+  user_agent,
+  ip_address,
+  expires_at,
+  created_at,
+  last_used_at
+FROM
+  sessions
+WHERE
+  user_id = $1
+  AND expires_at > now()
+ORDER BY
+  last_used_at DESC;
+
+-- name: DeleteSession :exec
+DELETE FROM sessions
+WHERE id = $1;
+
+-- name: DeleteUserSession :execrows
+DELETE FROM sessions
+WHERE id = $1
+  AND user_id = $2;
+
+-- name: DeleteExpiredUserSessions :exec
+DELETE FROM sessions
+WHERE user_id = $1
+  AND expires_at <= now();
+
+-- name: DeleteUserSessions :exec
+DELETE FROM sessions
+WHERE user_id = $1;
+
+-- name: GetUserPasswordHash :one
+SELECT
+  password_hash
+FROM
+  users
+WHERE
+  id = $1;
+
+-- name: UpdateUserPassword :exec
+UPDATE
+  users
+SET
+  password_hash = $2,
+  updated_at = now()
+WHERE
+  id = $1;
+
+-- name: DeleteOtherUserSessions :exec
+DELETE FROM sessions
+WHERE user_id = $1
+  AND id <> $2;
+
+-- name: CreatePasswordReset :exec
+INSERT INTO password_resets (id, user_id, token_hash, expires_at)
+  VALUES ($1, $2, $3, $4);
+
+-- name: GetPasswordReset :one
+SELECT
+  user_id,
+  token_hash,
+  expires_at,
+  used_at
+FROM
+  password_resets
+WHERE
+  id = $1;
+
+-- name: RedeemPasswordReset :execrows
+UPDATE
+  password_resets
+SET
+  used_at = now()
+WHERE
+  id = $1
+  AND used_at IS NULL
+  AND expires_at > now();
+
+-- name: DeleteUserPasswordResets :exec
+DELETE FROM password_resets
+WHERE user_id = $1
+  AND used_at IS NULL;
+
+-- name: UpsertPendingUserTOTP :execrows
+INSERT INTO user_totp (user_id, secret)
+  VALUES ($1, $2)
+ON CONFLICT (user_id)
+  DO UPDATE SET
+    secret = excluded.secret,
+    last_used_step = 0,
+    created_at = now()
+  WHERE
+    user_totp.enabled_at IS NULL;
+
+-- name: GetUserTOTP :one
+SELECT
+  secret,
+  enabled_at,
+  last_used_step
+FROM
+  user_totp
+WHERE
+  user_id = $1;
+
+-- name: EnableUserTOTP :execrows
+UPDATE
+  user_totp
+SET
+  enabled_at = now(),
+  last_used_step = $2
+WHERE
+  user_id = $1
+  AND enabled_at IS NULL;
+
+-- name: UseUserTOTPStep :execrows
+UPDATE
+  user_totp
+SET
+  last_used_step = $2
+WHERE
+  user_id = $1
+  AND enabled_at IS NOT NULL
+  AND last_used_step < $2;
+
+-- name: CountUserMFAAttempt :one
+UPDATE
+  user_totp
+SET
+  failed_attempts = CASE WHEN locked_until <= now() THEN
+    1
+  ELSE
+    failed_attempts + 1
+  END,
+  locked_until = CASE WHEN locked_until <= now() THEN
+    NULL
+  WHEN locked_until IS NOT NULL THEN
+    locked_until
+  WHEN failed_attempts + 1 > sqlc.arg (max_attempts)::integer THEN
+    now() + make_interval(secs => sqlc.arg (lockout_seconds)::integer)
+  ELSE
+    NULL
+  END
+WHERE
+  user_id = $1
+RETURNING
+  failed_attempts,
+  locked_until;
+
+-- name: ResetUserMFAAttempts :exec
+UPDATE
+  user_totp
+SET
+  failed_attempts = 0,
+  locked_until = NULL
+WHERE
+  user_id = $1;
+
+-- name: DeleteUserTOTP :execrows
+DELETE FROM user_totp
+WHERE user_id = $1;
+
+-- name: CreateRecoveryCode :exec
+INSERT INTO mfa_recovery_codes (id, user_id, code_hash)
+  VALUES ($1, $2, $3);
+
+-- name: ListUnusedRecoveryCodes :many
+SELECT
+  id,
+  code_hash
+FROM
+  mfa_recovery_codes
+WHERE
+  user_id = $1
+  AND used_at IS NULL;
+
+-- name: CountUnusedRecoveryCodes :one
+SELECT
+  count(*)
+FROM
+  mfa_recovery_codes
+WHERE
+  user_id = $1
+  AND used_at IS NULL;
+
+-- name: UseRecoveryCode :execrows
+UPDATE
+  mfa_recovery_codes
+SET
+  used_at = now()
+WHERE
+  id = $1
+  AND used_at IS NULL;
+
+-- name: DeleteUserRecoveryCodes :exec
+DELETE FROM mfa_recovery_codes
+WHERE user_id = $1;
+
+-- name: CreateMFAChallenge :exec
+INSERT INTO mfa_challenges (id, user_id, token_hash, expires_at)
+  VALUES ($1, $2, $3, $4);
+
+-- name: GetMFAChallenge :one
+SELECT
+  user_id,
+  token_hash,
+  attempts,
+  expires_at
+FROM
+  mfa_challenges
+WHERE
+  id = $1;
+
+-- name: IncrementMFAChallengeAttempts :one
+UPDATE
+  mfa_challenges
+SET
+  attempts = attempts + 1
+WHERE
+  id = $1
+RETURNING
+  attempts;
+
+-- name: DeleteMFAChallenge :exec
+DELETE FROM mfa_challenges
+WHERE id = $1;
+
+-- name: DeleteExpiredUserMFAChallenges :exec
+DELETE FROM mfa_challenges
+WHERE user_id = $1
+  AND expires_at <= now();
+
+-- name: CreateWebAuthnCredential :exec
+INSERT INTO webauthn_credentials (id, user_id, credential_id, credential, name)
+  VALUES ($1, $2, $3, $4, $5);
+
+-- name: ListUserWebAuthnCredentials :many
+SELECT
+  id,
+  credential,
+  name,
+  created_at,
+  last_used_at
+FROM
+  webauthn_credentials
+WHERE
+  user_id = $1
+ORDER BY
+  created_at ASC;
+
+-- name: GetWebAuthnCredential :one
+SELECT
+  id,
+  user_id,
+  credential
+FROM
+  webauthn_credentials
+WHERE
+  credential_id = $1;
+
+-- name: UpdateWebAuthnCredentialUse :exec
+UPDATE
+  webauthn_credentials
+SET
+  credential = $2,
+  last_used_at = now()
+WHERE
+  id = $1;
+
+-- name: DeleteUserWebAuthnCredential :execrows
+DELETE FROM webauthn_credentials
+WHERE id = $1
+  AND user_id = $2;
+
+-- name: CreateWebAuthnSession :exec
+INSERT INTO webauthn_sessions (id, ceremony, session_data, expires_at)
+  VALUES ($1, $2, $3, $4);
+
+-- name: TakeWebAuthnSession :one
+DELETE FROM webauthn_sessions
+WHERE id = $1
+  AND ceremony = $2
+RETURNING
+  session_data,
+  expires_at;
+
+-- name: DeleteExpiredWebAuthnSessions :exec
+DELETE FROM webauthn_sessions
+WHERE expires_at <= now();
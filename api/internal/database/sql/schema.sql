@@ -16,19 +16,20 @@ CREATE TABLE IF NOT EXISTS users (
   email text NOT NULL,
   ROLE ROLE NOT NULL DEFAULT 'user',
   password_hash text NOT NULL,
-  spotify_id text UNIQUE,
-  refresh_token_hash text,
-  refresh_token_expires_at timestamptz,
   created_at timestamptz NOT NULL DEFAULT now(),
-  updated_at timestamptz NOT NULL DEFAULT now(),
-  CHECK ((refresh_token_hash IS NULL AND refresh_token_expires_at IS NULL) OR (refresh_token_hash IS NOT NULL AND
-    refresh_token_expires_at IS NOT NULL))
+  updated_at timestamptz NOT NULL DEFAULT now()
 );
 
 CREATE UNIQUE INDEX IF NOT EXISTS users_unique_email ON users (trim(lower(email)))
 WHERE
   email IS NOT NULL;
 
+ALTER TABLE users
+  ADD COLUMN IF NOT EXISTS timezone text NOT NULL DEFAULT 'America/New_York';
+
+ALTER TABLE users
+  ADD COLUMN IF NOT EXISTS week_start smallint NOT NULL DEFAULT 1 CHECK (week_start BETWEEN 0 AND 6);
+
 CREATE TABLE IF NOT EXISTS tracks (
   id text PRIMARY KEY,
   name text NOT NULL,
@@ -63,6 +64,13 @@ CREATE TABLE IF NOT EXISTS playlists (
   FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
 );
 
+ALTER TABLE playlists
+  ADD COLUMN IF NOT EXISTS period_start timestamptz;
+
+CREATE UNIQUE INDEX IF NOT EXISTS playlists_unique_period ON playlists (user_id, playlist_type, period_start)
+WHERE
+  playlist_type IN ('weekly', 'monthly');
+
 CREATE TABLE IF NOT EXISTS playlist_tracks (
   playlist_id uuid NOT NULL,
   track_id text NOT NULL,
@@ -84,12 +92,306 @@ CREATE TABLE IF NOT EXISTS track_listens (
 
 CREATE INDEX IF NOT EXISTS idx_track_listens_user_played_at ON track_listens (user_id, played_at);
 
-CREATE TABLE IF NOT EXISTS spotify_tokens (
-  spotify_user_id text PRIMARY KEY,
+CREATE TABLE IF NOT EXISTS provider_accounts (
+  user_id uuid NOT NULL,
+  provider text NOT NULL,
+  account_id text NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (user_id, provider),
+  UNIQUE (provider, account_id),
+  FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS provider_tokens (
+  user_id uuid NOT NULL,
+  provider text NOT NULL,
   access_token text NOT NULL,
   token_type text NOT NULL,
   scope text NOT NULL,
   refresh_token text NOT NULL,
   expires_at timestamptz NOT NULL,
-  FOREIGN KEY (spotify_user_id) REFERENCES users (spotify_id) ON DELETE CASCADE
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (user_id, provider),
+  FOREIGN KEY (user_id, provider) REFERENCES provider_accounts (user_id, provider) ON DELETE CASCADE
+);
+
+-- Move Spotify accounts linked before provider_accounts existed
+DO $$
+BEGIN
+  IF EXISTS (
+    SELECT
+      1
+    FROM
+      information_schema.columns
+    WHERE
+      table_schema = current_schema()
+      AND table_name = 'users'
+      AND column_name = 'spotify_id') THEN
+  INSERT INTO provider_accounts (user_id, provider, account_id)
+  SELECT
+    id,
+    'spotify',
+    spotify_id
+  FROM
+    users
+  WHERE
+    spotify_id IS NOT NULL
+  ON CONFLICT
+    DO NOTHING;
+  INSERT INTO provider_tokens (user_id, provider, access_token, token_type, scope, refresh_token, expires_at)
+  SELECT
+    u.id,
+    'spotify',
+    st.access_token,
+    st.token_type,
+    st.scope,
+    st.refresh_token,
+    st.expires_at
+  FROM
+    spotify_tokens st
+    JOIN users u ON u.spotify_id = st.spotify_user_id
+  ON CONFLICT
+    DO NOTHING;
+  DROP TABLE spotify_tokens;
+  ALTER TABLE users
+    DROP COLUMN spotify_id;
+END IF;
+END
+$$;
+
+CREATE TABLE IF NOT EXISTS user_invites (
+  id uuid PRIMARY KEY,
+  token_hash text NOT NULL,
+  email text,
+  created_by uuid,
+  used_by uuid,
+  expires_at timestamptz NOT NULL,
+  used_at timestamptz,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  FOREIGN KEY (created_by) REFERENCES users (id) ON DELETE SET NULL,
+  FOREIGN KEY (used_by) REFERENCES users (id) ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS spotify_sync_state (
+  user_id uuid PRIMARY KEY,
+  played_at_cursor timestamptz,
+  last_success_at timestamptz,
+  last_error text,
+  last_error_at timestamptz,
+  last_pages integer NOT NULL DEFAULT 0,
+  last_items_fetched integer NOT NULL DEFAULT 0,
+  last_items_inserted integer NOT NULL DEFAULT 0,
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS jobs (
+  name text PRIMARY KEY,
+  schedule text NOT NULL,
+  timezone text NOT NULL DEFAULT 'UTC',
+  paused boolean NOT NULL DEFAULT FALSE,
+  next_run_at timestamptz NOT NULL,
+  last_run_at timestamptz,
+  last_success_at timestamptz,
+  last_error text,
+  triggered_at timestamptz,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS listen_tokens (
+  user_id uuid PRIMARY KEY,
+  token_hash text NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_tracks_lower_name ON tracks (lower(name));
+
+CREATE TABLE IF NOT EXISTS artists (
+  id text PRIMARY KEY,
+  name text NOT NULL,
+  href text NOT NULL,
+  uri text NOT NULL,
+  image_url text,
+  images_updated_at timestamptz,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS albums (
+  id text PRIMARY KEY,
+  name text NOT NULL,
+  artists text[] NOT NULL,
+  href text NOT NULL,
+  uri text NOT NULL,
+  image_url text,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+ALTER TABLE tracks
+  ADD COLUMN IF NOT EXISTS album_id text REFERENCES albums (id) ON DELETE SET NULL;
+
+CREATE TABLE IF NOT EXISTS track_artists (
+  track_id text NOT NULL,
+  artist_id text NOT NULL,
+  position smallint NOT NULL,
+  PRIMARY KEY (track_id, artist_id),
+  FOREIGN KEY (track_id) REFERENCES tracks (id) ON DELETE CASCADE,
+  FOREIGN KEY (artist_id) REFERENCES artists (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_track_artists_artist_id ON track_artists (artist_id);
+
+ALTER TABLE tracks
+  ADD COLUMN IF NOT EXISTS duration_ms integer;
+
+CREATE TABLE IF NOT EXISTS playlist_rules (
+  user_id uuid PRIMARY KEY,
+  max_tracks integer NOT NULL,
+  min_plays integer NOT NULL,
+  ordering text NOT NULL,
+  max_tracks_per_artist integer NOT NULL,
+  name_template text NOT NULL,
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  CONSTRAINT positive_max_tracks CHECK (max_tracks > 0),
+  CONSTRAINT positive_min_plays CHECK (min_plays > 0),
+  CONSTRAINT valid_ordering CHECK (ordering IN ('plays', 'first_heard', 'chronological')),
+  CONSTRAINT non_negative_max_tracks_per_artist CHECK (max_tracks_per_artist >= 0),
+  FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+ALTER TABLE playlist_tracks
+  ADD COLUMN IF NOT EXISTS position integer;
+
+ALTER TYPE playlist_type
+  ADD VALUE IF NOT EXISTS 'yearly';
+
+ALTER TYPE playlist_type
+  ADD VALUE IF NOT EXISTS 'rolling_30d';
+
+-- New enum values can't be used until the schema is committed, so the index
+-- excludes custom playlists rather than listing the scheduled types
+DROP INDEX IF EXISTS playlists_unique_period;
+
+CREATE UNIQUE INDEX IF NOT EXISTS playlists_unique_scheduled_period ON playlists (user_id, playlist_type, period_start)
+WHERE
+  playlist_type <> 'custom';
+
+CREATE TABLE IF NOT EXISTS playlist_exports (
+  playlist_id uuid NOT NULL,
+  provider text NOT NULL,
+  remote_id text NOT NULL,
+  url text NOT NULL,
+  exported_at timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (playlist_id, provider),
+  FOREIGN KEY (playlist_id) REFERENCES playlists (id) ON DELETE CASCADE
+);
+
+ALTER TABLE playlists
+  ADD COLUMN IF NOT EXISTS description text NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS sessions (
+  id uuid PRIMARY KEY,
+  user_id uuid NOT NULL,
+  refresh_token_hash text NOT NULL,
+  user_agent text NOT NULL DEFAULT '',
+  ip_address text NOT NULL DEFAULT '',
+  expires_at timestamptz NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  last_used_at timestamptz NOT NULL DEFAULT now(),
+  FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions (user_id);
+
+-- Refresh tokens are stored per session, so users logged in before sessions
+-- existed have to log in again
+ALTER TABLE users
+  DROP COLUMN IF EXISTS refresh_token_hash,
+  DROP COLUMN IF EXISTS refresh_token_expires_at;
+
+-- Access tokens carry the version they were issued at, and are rejected once
+-- it is bumped, e.g. when a user is signed out everywhere
+ALTER TABLE users
+  ADD COLUMN IF NOT EXISTS token_version integer NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS password_resets (
+  id uuid PRIMARY KEY,
+  user_id uuid NOT NULL,
+  token_hash text NOT NULL,
+  expires_at timestamptz NOT NULL,
+  used_at timestamptz,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_password_resets_user_id ON password_resets (user_id);
+
+CREATE TABLE IF NOT EXISTS user_totp (
+  user_id uuid PRIMARY KEY,
+  secret text NOT NULL,
+  -- NULL until the user proves their authenticator works
+  enabled_at timestamptz,
+  -- The last time step a code was accepted for, so codes can't be replayed
+  last_used_step bigint NOT NULL DEFAULT 0,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+-- Wrong codes are counted per user across login challenges, and codes are
+-- refused until locked_until once there have been too many
+ALTER TABLE user_totp
+  ADD COLUMN IF NOT EXISTS failed_attempts integer NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS locked_until timestamptz;
+
+CREATE TABLE IF NOT EXISTS mfa_recovery_codes (
+  id uuid PRIMARY KEY,
+  user_id uuid NOT NULL,
+  code_hash text NOT NULL,
+  used_at timestamptz,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_mfa_recovery_codes_user_id ON mfa_recovery_codes (user_id);
+
+CREATE TABLE IF NOT EXISTS mfa_challenges (
+  id uuid PRIMARY KEY,
+  user_id uuid NOT NULL,
+  token_hash text NOT NULL,
+  attempts integer NOT NULL DEFAULT 0,
+  expires_at timestamptz NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_mfa_challenges_user_id ON mfa_challenges (user_id);
+
+CREATE TABLE IF NOT EXISTS webauthn_credentials (
+  id uuid PRIMARY KEY,
+  user_id uuid NOT NULL,
+  -- The ID the authenticator gave the credential
+  credential_id bytea NOT NULL,
+  -- The credential record as JSON, with its public key, sign count and flags
+  credential jsonb NOT NULL,
+  name text NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  last_used_at timestamptz,
+  FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+  CONSTRAINT webauthn_credentials_unique_credential_id UNIQUE (credential_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_webauthn_credentials_user_id ON webauthn_credentials (user_id);
+
+-- Registrations and logins that have been started but not finished
+CREATE TABLE IF NOT EXISTS webauthn_sessions (
+  id uuid PRIMARY KEY,
+  ceremony text NOT NULL,
+  -- The session data the ceremony is checked against, as JSON
+  session_data jsonb NOT NULL,
+  expires_at timestamptz NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT now()
 );
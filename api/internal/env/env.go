@@ -10,7 +10,11 @@ import (
 	"mars/internal/database"
 	marshttp "mars/internal/http"
 	"mars/internal/log"
+	"mars/internal/mail"
+	"mars/internal/provider"
+	"mars/internal/ratelimit"
 
+	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -20,10 +24,24 @@ var envKey envKeyType
 
 // Env holds the dependencies for the environment.
 type Env struct {
-	Logger   *slog.Logger
-	Database database.Querier
-	Pool     *pgxpool.Pool
-	HTTP     *marshttp.Client
+	Logger    *slog.Logger
+	Database  database.Querier
+	Pool      *pgxpool.Pool
+	HTTP      *marshttp.Client
+	Providers provider.Registry
+	// RateLimiter limits requests to providers per user. A nil limiter
+	// doesn't limit requests.
+	RateLimiter *ratelimit.Limiter
+	// SpotifyAccountsURL and SpotifyAPIURL are the base URLs of the Spotify
+	// accounts service and Web API. Empty URLs use Spotify's own.
+	SpotifyAccountsURL string
+	SpotifyAPIURL      string
+	// Mailer sends emails to users.
+	Mailer mail.Mailer
+	// AppURL is the base URL of the frontend, used for links in emails.
+	AppURL string
+	// WebAuthn verifies passkeys for the origin of AppURL.
+	WebAuthn *webauthn.WebAuthn
 	vars     map[string]string
 }
 
@@ -45,6 +63,11 @@ func (e *Env) IsProd() bool {
 	return strings.ToLower(os.Getenv("ENV")) == "production"
 }
 
+// OpenRegistration reports whether users may register without an invite.
+func (e *Env) OpenRegistration() bool {
+	return strings.ToLower(os.Getenv("OPEN_REGISTRATION")) == "true"
+}
+
 func New() *Env {
 	return &Env{
 		vars: make(map[string]string),
@@ -0,0 +1,225 @@
+// Package export streams listening history out of Mars as CSV or JSON Lines.
+package export
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"mars/internal/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// PageSize is the number of listens read from the database at a time, so
+// exports never hold a user's whole history in memory.
+const PageSize = 1000
+
+// artistSeparator joins a track's artists in a CSV cell.
+const artistSeparator = "; "
+
+type Format string
+
+const (
+	CSV   Format = "csv"
+	JSONL Format = "jsonl"
+)
+
+var ErrUnknownFormat = errors.New("unknown export format")
+
+// Filter limits the listens that are exported. Zero times are unbounded.
+type Filter struct {
+	// Start is the inclusive lower bound of the time played.
+	Start time.Time
+	// End is the exclusive upper bound of the time played.
+	End time.Time
+}
+
+// Listen is a single exported listen. UserID and Email are only set in
+// exports of every user.
+type Listen struct {
+	UserID    *uuid.UUID `json:"user_id,omitempty"`
+	Email     string     `json:"email,omitempty"`
+	PlayedAt  time.Time  `json:"played_at"`
+	TrackID   string     `json:"track_id"`
+	TrackName string     `json:"track_name"`
+	Artists   []string   `json:"artists"`
+	Href      string     `json:"href"`
+	URI       string     `json:"uri"`
+}
+
+// encoder writes listens in an export format.
+type encoder interface {
+	encode(Listen) error
+	flush() error
+}
+
+func newEncoder(w io.Writer, format Format, withUser bool) (encoder, error) {
+	switch format {
+	case CSV:
+		header := []string{"played_at", "track_id", "track_name", "artists", "href", "uri"}
+		if withUser {
+			header = append([]string{"user_id", "email"}, header...)
+		}
+		e := &csvEncoder{w: csv.NewWriter(w), withUser: withUser}
+		if err := e.w.Write(header); err != nil {
+			return nil, fmt.Errorf("writing csv header: %w", err)
+		}
+		return e, nil
+	case JSONL:
+		return &jsonlEncoder{enc: json.NewEncoder(w)}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+}
+
+type csvEncoder struct {
+	w        *csv.Writer
+	withUser bool
+}
+
+func (e *csvEncoder) encode(l Listen) error {
+	record := []string{
+		l.PlayedAt.UTC().Format(time.RFC3339),
+		l.TrackID,
+		l.TrackName,
+		strings.Join(l.Artists, artistSeparator),
+		l.Href,
+		l.URI,
+	}
+	if e.withUser {
+		record = append([]string{l.UserID.String(), l.Email}, record...)
+	}
+	return e.w.Write(record)
+}
+
+func (e *csvEncoder) flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type jsonlEncoder struct {
+	enc *json.Encoder
+}
+
+func (e *jsonlEncoder) encode(l Listen) error {
+	l.PlayedAt = l.PlayedAt.UTC()
+	return e.enc.Encode(l)
+}
+
+func (e *jsonlEncoder) flush() error {
+	return nil
+}
+
+func bound(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{
+		Time:  t,
+		Valid: !t.IsZero(),
+	}
+}
+
+// WriteUserListens writes a user's listens ordered by time played.
+func WriteUserListens(
+	ctx context.Context, db database.Querier, w io.Writer, format Format, userID uuid.UUID, filter Filter,
+) error {
+	enc, err := newEncoder(w, format, false)
+	if err != nil {
+		return err
+	}
+
+	params := database.ExportUserListensParams{
+		UserID:        userID,
+		Start:         bound(filter.Start),
+		End:           bound(filter.End),
+		AfterPlayedAt: pgtype.Timestamptz{Valid: true},
+		PageSize:      PageSize,
+	}
+	for {
+		rows, err := db.ExportUserListens(ctx, params)
+		if err != nil {
+			return fmt.Errorf("getting listens: %w", err)
+		}
+		for _, row := range rows {
+			err = enc.encode(Listen{
+				PlayedAt:  row.PlayedAt.Time,
+				TrackID:   row.TrackID,
+				TrackName: row.TrackName,
+				Artists:   row.Artists,
+				Href:      row.Href,
+				URI:       row.Uri,
+			})
+			if err != nil {
+				return fmt.Errorf("writing listen: %w", err)
+			}
+		}
+		if err := enc.flush(); err != nil {
+			return fmt.Errorf("flushing listens: %w", err)
+		}
+
+		if len(rows) < PageSize {
+			return nil
+		}
+		last := rows[len(rows)-1]
+		params.AfterPlayedAt = last.PlayedAt
+		params.AfterTrackID = last.TrackID
+	}
+}
+
+// WriteListens writes the listens of every user ordered by user, then time
+// played.
+func WriteListens(ctx context.Context, db database.Querier, w io.Writer, format Format, filter Filter) error {
+	enc, err := newEncoder(w, format, true)
+	if err != nil {
+		return err
+	}
+
+	params := database.ExportListensParams{
+		Start:         bound(filter.Start),
+		End:           bound(filter.End),
+		AfterPlayedAt: pgtype.Timestamptz{Valid: true},
+		PageSize:      PageSize,
+	}
+	for {
+		rows, err := db.ExportListens(ctx, params)
+		if err != nil {
+			return fmt.Errorf("getting listens: %w", err)
+		}
+		for _, row := range rows {
+			err = enc.encode(Listen{
+				UserID:    &row.UserID,
+				Email:     row.Email,
+				PlayedAt:  row.PlayedAt.Time,
+				TrackID:   row.TrackID,
+				TrackName: row.TrackName,
+				Artists:   row.Artists,
+				Href:      row.Href,
+				URI:       row.Uri,
+			})
+			if err != nil {
+				return fmt.Errorf("writing listen: %w", err)
+			}
+		}
+		if err := enc.flush(); err != nil {
+			return fmt.Errorf("flushing listens: %w", err)
+		}
+
+		if len(rows) < PageSize {
+			return nil
+		}
+		last := rows[len(rows)-1]
+		params.AfterUserID = last.UserID
+		params.AfterPlayedAt = last.PlayedAt
+		params.AfterTrackID = last.TrackID
+	}
+}
+
+// Filename returns the name exports are downloaded as.
+func Filename(format Format, now time.Time) string {
+	return fmt.Sprintf("mars-listens-%s.%s", now.Format("2006-01-02"), format)
+}
@@ -0,0 +1,294 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"mars/internal/database"
+	"mars/internal/database/databasetest"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var testStart = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// pageCounter counts the pages of listens read from the database.
+type pageCounter struct {
+	*databasetest.Querier
+	pages int
+}
+
+func (q *pageCounter) ExportUserListens(
+	ctx context.Context, arg database.ExportUserListensParams,
+) ([]database.ExportUserListensRow, error) {
+	q.pages++
+	return q.Querier.ExportUserListens(ctx, arg)
+}
+
+func (q *pageCounter) ExportListens(
+	ctx context.Context, arg database.ExportListensParams,
+) ([]database.ExportListensRow, error) {
+	q.pages++
+	return q.Querier.ExportListens(ctx, arg)
+}
+
+func addTrack(db *databasetest.Querier, id, name string, artists ...string) {
+	db.Tracks[id] = database.UpsertTrackParams{
+		ID:      id,
+		Name:    name,
+		Artists: artists,
+		Href:    "https://open.spotify.com/track/" + id,
+		Uri:     "spotify:track:" + id,
+	}
+}
+
+func addListen(db *databasetest.Querier, userID uuid.UUID, trackID string, playedAt time.Time) {
+	db.Listens[database.UpsertTrackListenParams{
+		UserID:   userID,
+		TrackID:  trackID,
+		PlayedAt: pgtype.Timestamptz{Time: playedAt, Valid: true},
+	}] = true
+}
+
+// readJSONL decodes every listen written in the JSON Lines format.
+func readJSONL(t *testing.T, b []byte) []Listen {
+	t.Helper()
+
+	var listens []Listen
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		var l Listen
+		if err := json.Unmarshal(scanner.Bytes(), &l); err != nil {
+			t.Fatalf("decoding listen %q: %v", scanner.Text(), err)
+		}
+		listens = append(listens, l)
+	}
+	return listens
+}
+
+func TestWriteUserListensPages(t *testing.T) {
+	tests := []struct {
+		listens   int
+		wantPages int
+	}{
+		{listens: 0, wantPages: 1},
+		{listens: 1, wantPages: 1},
+		{listens: PageSize - 1, wantPages: 1},
+		{listens: PageSize, wantPages: 2},
+		{listens: PageSize + 1, wantPages: 2},
+		{listens: 2 * PageSize, wantPages: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.listens), func(t *testing.T) {
+			db := &pageCounter{Querier: databasetest.NewQuerier()}
+			userID := uuid.New()
+			addTrack(db.Querier, "a", "A", "Artist")
+			addTrack(db.Querier, "b", "B", "Artist")
+			// Listens share their time played in pairs, so that pages also
+			// end between listens played at the same time
+			for i := range tt.listens {
+				trackID := "a"
+				if i%2 == 1 {
+					trackID = "b"
+				}
+				addListen(db.Querier, userID, trackID, testStart.Add(time.Duration(i/2)*time.Minute))
+			}
+			addListen(db.Querier, uuid.New(), "a", testStart)
+
+			var buf bytes.Buffer
+			err := WriteUserListens(context.Background(), db, &buf, JSONL, userID, Filter{})
+			if err != nil {
+				t.Fatalf("WriteUserListens() = %v", err)
+			}
+
+			listens := readJSONL(t, buf.Bytes())
+			if len(listens) != tt.listens {
+				t.Fatalf("wrote %d listens, want %d", len(listens), tt.listens)
+			}
+			for i := 1; i < len(listens); i++ {
+				prev, cur := listens[i-1], listens[i]
+				if !prev.PlayedAt.Before(cur.PlayedAt) &&
+					!(prev.PlayedAt.Equal(cur.PlayedAt) && prev.TrackID < cur.TrackID) {
+					t.Fatalf("listen %d (%v, %s) is not after (%v, %s)",
+						i, cur.PlayedAt, cur.TrackID, prev.PlayedAt, prev.TrackID)
+				}
+			}
+			if db.pages != tt.wantPages {
+				t.Errorf("read %d pages, want %d", db.pages, tt.wantPages)
+			}
+		})
+	}
+}
+
+func TestWriteListensPages(t *testing.T) {
+	db := &pageCounter{Querier: databasetest.NewQuerier()}
+	addTrack(db.Querier, "a", "A", "Artist")
+	// The first page ends partway through the second user's listens
+	users := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	for i, userID := range users {
+		db.Emails[userID] = fmt.Sprintf("user%d@example.com", i)
+		for j := range PageSize / 2 {
+			addListen(db.Querier, userID, "a", testStart.Add(time.Duration(j)*time.Minute))
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := WriteListens(context.Background(), db, &buf, JSONL, Filter{}); err != nil {
+		t.Fatalf("WriteListens() = %v", err)
+	}
+
+	listens := readJSONL(t, buf.Bytes())
+	if want := len(users) * (PageSize / 2); len(listens) != want {
+		t.Fatalf("wrote %d listens, want %d", len(listens), want)
+	}
+	counts := make(map[uuid.UUID]int)
+	for _, l := range listens {
+		if l.UserID == nil {
+			t.Fatalf("listen without a user")
+		}
+		if l.Email != db.Emails[*l.UserID] {
+			t.Errorf("listen email = %q, want %q", l.Email, db.Emails[*l.UserID])
+		}
+		counts[*l.UserID]++
+	}
+	for _, userID := range users {
+		if counts[userID] != PageSize/2 {
+			t.Errorf("wrote %d listens for user, want %d", counts[userID], PageSize/2)
+		}
+	}
+	if db.pages != 2 {
+		t.Errorf("read %d pages, want 2", db.pages)
+	}
+}
+
+func TestWriteUserListensFilter(t *testing.T) {
+	db := databasetest.NewQuerier()
+	userID := uuid.New()
+	addTrack(db, "a", "A", "Artist")
+	for day := range 5 {
+		addListen(db, userID, "a", testStart.AddDate(0, 0, day))
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   []time.Time
+	}{
+		{
+			name:   "unbounded",
+			filter: Filter{},
+			want: []time.Time{
+				testStart, testStart.AddDate(0, 0, 1), testStart.AddDate(0, 0, 2),
+				testStart.AddDate(0, 0, 3), testStart.AddDate(0, 0, 4),
+			},
+		},
+		{
+			name:   "start is inclusive",
+			filter: Filter{Start: testStart.AddDate(0, 0, 3)},
+			want:   []time.Time{testStart.AddDate(0, 0, 3), testStart.AddDate(0, 0, 4)},
+		},
+		{
+			name:   "end is exclusive",
+			filter: Filter{End: testStart.AddDate(0, 0, 2)},
+			want:   []time.Time{testStart, testStart.AddDate(0, 0, 1)},
+		},
+		{
+			name:   "start and end",
+			filter: Filter{Start: testStart.AddDate(0, 0, 1), End: testStart.AddDate(0, 0, 3)},
+			want:   []time.Time{testStart.AddDate(0, 0, 1), testStart.AddDate(0, 0, 2)},
+		},
+		{
+			name:   "empty range",
+			filter: Filter{Start: testStart.AddDate(0, 0, 10)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteUserListens(context.Background(), db, &buf, JSONL, userID, tt.filter); err != nil {
+				t.Fatalf("WriteUserListens() = %v", err)
+			}
+
+			listens := readJSONL(t, buf.Bytes())
+			if len(listens) != len(tt.want) {
+				t.Fatalf("wrote %d listens, want %d", len(listens), len(tt.want))
+			}
+			for i, want := range tt.want {
+				if !listens[i].PlayedAt.Equal(want) {
+					t.Errorf("listen %d played at %v, want %v", i, listens[i].PlayedAt, want)
+				}
+			}
+		})
+	}
+}
+
+func TestWriteUserListensCSV(t *testing.T) {
+	db := databasetest.NewQuerier()
+	userID := uuid.New()
+	addTrack(db, "a", `Song "With" Quotes, And Commas`, "Artist, The", `"Quoted" Artist`)
+	addTrack(db, "b", "Line\nBreak", "Plain")
+	playedAt := time.Date(2024, time.March, 1, 12, 30, 0, 0, time.FixedZone("CET", 3600))
+	addListen(db, userID, "a", playedAt)
+	addListen(db, userID, "b", playedAt.Add(time.Hour))
+
+	var buf bytes.Buffer
+	if err := WriteUserListens(context.Background(), db, &buf, CSV, userID, Filter{}); err != nil {
+		t.Fatalf("WriteUserListens() = %v", err)
+	}
+
+	if !strings.Contains(buf.String(), `"Song ""With"" Quotes, And Commas"`) {
+		t.Errorf("track name is not escaped in:\n%s", buf.String())
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("reading csv: %v", err)
+	}
+	want := [][]string{
+		{"played_at", "track_id", "track_name", "artists", "href", "uri"},
+		{
+			"2024-03-01T11:30:00Z", "a", `Song "With" Quotes, And Commas`, `Artist, The; "Quoted" Artist`,
+			"https://open.spotify.com/track/a", "spotify:track:a",
+		},
+		{
+			"2024-03-01T12:30:00Z", "b", "Line\nBreak", "Plain",
+			"https://open.spotify.com/track/b", "spotify:track:b",
+		},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("read %d records, want %d", len(records), len(want))
+	}
+	for i := range want {
+		if strings.Join(records[i], "|") != strings.Join(want[i], "|") {
+			t.Errorf("record %d = %q, want %q", i, records[i], want[i])
+		}
+	}
+}
+
+func TestWriteListensCSVHeader(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteListens(context.Background(), databasetest.NewQuerier(), &buf, CSV, Filter{}); err != nil {
+		t.Fatalf("WriteListens() = %v", err)
+	}
+	if want := "user_id,email,played_at,track_id,track_name,artists,href,uri\n"; buf.String() != want {
+		t.Errorf("WriteListens() = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestUnknownFormat(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteUserListens(context.Background(), databasetest.NewQuerier(), &buf, "xml", uuid.New(), Filter{})
+	if !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("WriteUserListens() error = %v, want %v", err, ErrUnknownFormat)
+	}
+}
@@ -0,0 +1,140 @@
+// Package history imports listening history from Spotify extended streaming history exports.
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"mars/internal/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// MinimumPlayDuration is the minimum time a track must be played for it to
+// count as a listen. This matches the threshold Spotify uses to count streams.
+const MinimumPlayDuration = 30 * time.Second
+
+const spotifyTrackURIPrefix = "spotify:track:"
+
+var ErrInvalidFormat = errors.New("invalid streaming history file")
+
+// Result summarizes an import.
+type Result struct {
+	// Imported is the number of new listens stored.
+	Imported int
+	// Skipped is the number of entries that are not track listens,
+	// such as podcast episodes or plays shorter than MinimumPlayDuration.
+	Skipped int
+	// Duplicates is the number of listens that were already stored.
+	Duplicates int
+}
+
+// Add accumulates the counts of another result.
+func (r *Result) Add(other Result) {
+	r.Imported += other.Imported
+	r.Skipped += other.Skipped
+	r.Duplicates += other.Duplicates
+}
+
+// entry is a single item of a Streaming_History_Audio_*.json file.
+type entry struct {
+	Timestamp       time.Time `json:"ts"`
+	MsPlayed        int64     `json:"ms_played"`
+	TrackName       *string   `json:"master_metadata_track_name"`
+	ArtistName      *string   `json:"master_metadata_album_artist_name"`
+	SpotifyTrackURI *string   `json:"spotify_track_uri"`
+}
+
+// Import reads a Spotify extended streaming history file and stores each
+// track listen for the user. The file is decoded one entry at a time so
+// large exports are never held in memory. Imports are idempotent: listens
+// that already exist are counted as duplicates.
+func Import(ctx context.Context, db database.Querier, userID uuid.UUID, r io.Reader) (Result, error) {
+	var result Result
+	decoder := json.NewDecoder(r)
+
+	tok, err := decoder.Token()
+	if err != nil {
+		return result, fmt.Errorf("%w: reading opening token: %w", ErrInvalidFormat, err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return result, fmt.Errorf("%w: expected a JSON array of entries", ErrInvalidFormat)
+	}
+
+	seenTracks := make(map[string]struct{})
+	for decoder.More() {
+		var e entry
+		if err := decoder.Decode(&e); err != nil {
+			n := result.Imported + result.Skipped + result.Duplicates
+			return result, fmt.Errorf("%w: decoding entry %d: %w", ErrInvalidFormat, n, err)
+		}
+
+		trackID, ok := e.trackID()
+		if !ok || time.Duration(e.MsPlayed)*time.Millisecond < MinimumPlayDuration {
+			result.Skipped++
+			continue
+		}
+
+		// Store the track if we haven't seen it, keeping any richer
+		// metadata that was already stored by a sync.
+		if _, seen := seenTracks[trackID]; !seen {
+			artists := []string{}
+			if e.ArtistName != nil && *e.ArtistName != "" {
+				artists = []string{*e.ArtistName}
+			}
+			err = db.CreateTrackIfNotExists(ctx, database.CreateTrackIfNotExistsParams{
+				ID:      trackID,
+				Name:    *e.TrackName,
+				Artists: artists,
+				Href:    "https://open.spotify.com/track/" + trackID,
+				Uri:     *e.SpotifyTrackURI,
+			})
+			if err != nil {
+				return result, fmt.Errorf("creating track (%s): %w", trackID, err)
+			}
+			seenTracks[trackID] = struct{}{}
+		}
+
+		inserted, err := db.UpsertTrackListen(ctx, database.UpsertTrackListenParams{
+			UserID:  userID,
+			TrackID: trackID,
+			PlayedAt: pgtype.Timestamptz{
+				Time:  e.Timestamp,
+				Valid: true,
+			},
+		})
+		if err != nil {
+			return result, fmt.Errorf("creating listen (%s): %w", trackID, err)
+		}
+		if inserted == 0 {
+			result.Duplicates++
+		} else {
+			result.Imported++
+		}
+	}
+
+	if _, err := decoder.Token(); err != nil {
+		return result, fmt.Errorf("%w: reading closing token: %w", ErrInvalidFormat, err)
+	}
+
+	return result, nil
+}
+
+// trackID extracts the Spotify track ID from the entry. Entries without a
+// track URI (podcast episodes, audiobooks) or a track name are not tracks.
+func (e entry) trackID() (string, bool) {
+	if e.SpotifyTrackURI == nil || e.TrackName == nil {
+		return "", false
+	}
+	id, found := strings.CutPrefix(*e.SpotifyTrackURI, spotifyTrackURIPrefix)
+	if !found || id == "" {
+		return "", false
+	}
+	return id, true
+}
@@ -0,0 +1,174 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"mars/internal/database"
+	"mars/internal/database/databasetest"
+
+	"github.com/google/uuid"
+)
+
+func TestImport(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		want   Result
+		tracks []string
+	}{
+		{
+			name: "track listens",
+			input: `[
+				{"ts": "2024-01-01T10:00:00Z", "ms_played": 180000, "master_metadata_track_name": "One",
+				 "master_metadata_album_artist_name": "Artist", "spotify_track_uri": "spotify:track:aaa"},
+				{"ts": "2024-01-01T10:05:00Z", "ms_played": 200000, "master_metadata_track_name": "Two",
+				 "master_metadata_album_artist_name": "Artist", "spotify_track_uri": "spotify:track:bbb"}
+			]`,
+			want:   Result{Imported: 2},
+			tracks: []string{"aaa", "bbb"},
+		},
+		{
+			name: "plays shorter than 30 seconds are skipped",
+			input: `[
+				{"ts": "2024-01-01T10:00:00Z", "ms_played": 29999, "master_metadata_track_name": "One",
+				 "spotify_track_uri": "spotify:track:aaa"},
+				{"ts": "2024-01-01T10:01:00Z", "ms_played": 30000, "master_metadata_track_name": "Two",
+				 "spotify_track_uri": "spotify:track:bbb"}
+			]`,
+			want:   Result{Imported: 1, Skipped: 1},
+			tracks: []string{"bbb"},
+		},
+		{
+			name: "entries that are not tracks are skipped",
+			input: `[
+				{"ts": "2024-01-01T10:00:00Z", "ms_played": 600000, "master_metadata_track_name": null,
+				 "spotify_track_uri": null, "episode_name": "A podcast"},
+				{"ts": "2024-01-01T10:10:00Z", "ms_played": 600000, "master_metadata_track_name": "Chapter 1",
+				 "spotify_track_uri": "spotify:episode:ccc"},
+				{"ts": "2024-01-01T10:20:00Z", "ms_played": 600000, "master_metadata_track_name": "Empty",
+				 "spotify_track_uri": "spotify:track:"}
+			]`,
+			want: Result{Skipped: 3},
+		},
+		{
+			name: "repeated listens are duplicates",
+			input: `[
+				{"ts": "2024-01-01T10:00:00Z", "ms_played": 180000, "master_metadata_track_name": "One",
+				 "spotify_track_uri": "spotify:track:aaa"},
+				{"ts": "2024-01-01T10:00:00Z", "ms_played": 180000, "master_metadata_track_name": "One",
+				 "spotify_track_uri": "spotify:track:aaa"},
+				{"ts": "2024-01-01T11:00:00Z", "ms_played": 180000, "master_metadata_track_name": "One",
+				 "spotify_track_uri": "spotify:track:aaa"}
+			]`,
+			want:   Result{Imported: 2, Duplicates: 1},
+			tracks: []string{"aaa"},
+		},
+		{
+			name:  "empty file",
+			input: `[]`,
+			want:  Result{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := databasetest.NewQuerier()
+			userID := uuid.New()
+
+			got, err := Import(context.Background(), db, userID, strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Import() = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Import() = %+v, want %+v", got, tt.want)
+			}
+			if len(db.Tracks) != len(tt.tracks) {
+				t.Errorf("stored %d tracks, want %d", len(db.Tracks), len(tt.tracks))
+			}
+			for _, id := range tt.tracks {
+				track, ok := db.Tracks[id]
+				if !ok {
+					t.Errorf("track %s was not stored", id)
+					continue
+				}
+				if track.Uri != "spotify:track:"+id {
+					t.Errorf("track %s uri = %q, want %q", id, track.Uri, "spotify:track:"+id)
+				}
+			}
+			if len(db.Listens) != got.Imported {
+				t.Errorf("stored %d listens, want %d", len(db.Listens), got.Imported)
+			}
+		})
+	}
+}
+
+func TestImportKeepsExistingTracks(t *testing.T) {
+	db := databasetest.NewQuerier()
+	db.Tracks["aaa"] = database.UpsertTrackParams{ID: "aaa", Name: "Synced name", Uri: "spotify:track:aaa"}
+
+	input := `[{"ts": "2024-01-01T10:00:00Z", "ms_played": 180000, "master_metadata_track_name": "Export name",
+		"spotify_track_uri": "spotify:track:aaa"}]`
+	if _, err := Import(context.Background(), db, uuid.New(), strings.NewReader(input)); err != nil {
+		t.Fatalf("Import() = %v", err)
+	}
+	if name := db.Tracks["aaa"].Name; name != "Synced name" {
+		t.Errorf("track name = %q, want the synced name to be kept", name)
+	}
+}
+
+func TestImportInvalidFormat(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Result
+	}{
+		{
+			name:  "not an array",
+			input: `{"ts": "2024-01-01T10:00:00Z"}`,
+		},
+		{
+			name:  "empty input",
+			input: ``,
+		},
+		{
+			name: "malformed entry partway through",
+			input: `[
+				{"ts": "2024-01-01T10:00:00Z", "ms_played": 180000, "master_metadata_track_name": "One",
+				 "spotify_track_uri": "spotify:track:aaa"},
+				{"ts": "2024-01-01T10:05:00Z", "ms_played": "lots"},
+				{"ts": "2024-01-01T10:10:00Z", "ms_played": 180000, "master_metadata_track_name": "Three",
+				 "spotify_track_uri": "spotify:track:ccc"}
+			]`,
+			want: Result{Imported: 1},
+		},
+		{
+			name: "truncated file",
+			input: `[
+				{"ts": "2024-01-01T10:00:00Z", "ms_played": 180000, "master_metadata_track_name": "One",
+				 "spotify_track_uri": "spotify:track:aaa"},
+				{"ts": "2024-01-01T10:05:00Z", "ms_pla`,
+			want: Result{Imported: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := databasetest.NewQuerier()
+
+			got, err := Import(context.Background(), db, uuid.New(), strings.NewReader(tt.input))
+			if !errors.Is(err, ErrInvalidFormat) {
+				t.Fatalf("Import() error = %v, want %v", err, ErrInvalidFormat)
+			}
+			// Entries before the malformed one are kept, and reported
+			if got != tt.want {
+				t.Errorf("Import() = %+v, want %+v", got, tt.want)
+			}
+			if len(db.Listens) != tt.want.Imported {
+				t.Errorf("stored %d listens, want %d", len(db.Listens), tt.want.Imported)
+			}
+		})
+	}
+}
@@ -11,8 +11,12 @@ import (
 )
 
 type JWTParams struct {
-	Role   role.Role
-	UserID string
+	Role      role.Role
+	UserID    string
+	SessionID string
+	// TokenVersion is the user's token version when the token was issued.
+	// Tokens from an older version are rejected.
+	TokenVersion int32
 }
 
 const (
@@ -26,6 +30,10 @@ func GenerateJWT(params JWTParams, secret []byte, version string, duration time.
 		"role": params.Role.String(),
 		"iat":  time.Now().Unix(),
 		"exp":  time.Now().Add(duration).Unix(),
+		"ver":  params.TokenVersion,
+	}
+	if params.SessionID != "" {
+		claims["sid"] = params.SessionID
 	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	token.Header["kid"] = version
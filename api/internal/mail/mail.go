@@ -0,0 +1,33 @@
+// Package mail sends emails to users, such as password reset links.
+package mail
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Message is a plain text email to a single recipient.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer sends emails.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// LogMailer writes emails to a logger instead of sending them. It is used when
+// no mail server is configured, so emails can still be read from the logs.
+type LogMailer struct {
+	Logger *slog.Logger
+}
+
+func (m LogMailer) Send(ctx context.Context, msg Message) error {
+	m.Logger.InfoContext(ctx, "no mail server configured, logging email",
+		slog.String("to", msg.To),
+		slog.String("subject", msg.Subject),
+		slog.String("body", msg.Body))
+	return nil
+}
@@ -0,0 +1,227 @@
+// Package mailtest provides an in-process SMTP server that keeps the emails
+// it receives, so code that sends emails can be tested without a mail server.
+package mailtest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	netmail "net/mail"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Message is an email received by the server.
+type Message struct {
+	// From and To are the envelope sender and recipients.
+	From string
+	To   []string
+	// Header holds the headers of the email.
+	Header netmail.Header
+	// Subject is the decoded subject header.
+	Subject string
+	// Body is the decoded body of the email.
+	Body string
+}
+
+// Server is an SMTP sink. It accepts every email, and PLAIN authentication
+// with any credentials. It doesn't support STARTTLS.
+type Server struct {
+	// Host and Port are the address the server listens on.
+	Host string
+	Port int
+
+	t        *testing.T
+	listener net.Listener
+	wg       sync.WaitGroup
+
+	mu       sync.Mutex
+	messages []Message
+	auths    []string
+}
+
+// New starts a server that is stopped when the test ends.
+func New(t *testing.T) *Server {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listening: %v", err)
+	}
+	addr := listener.Addr().(*net.TCPAddr)
+	s := &Server{
+		Host:     addr.IP.String(),
+		Port:     addr.Port,
+		t:        t,
+		listener: listener,
+	}
+	s.wg.Go(s.serve)
+	t.Cleanup(func() {
+		_ = listener.Close()
+		s.wg.Wait()
+	})
+	return s
+}
+
+// Messages returns the emails received so far.
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.messages...)
+}
+
+// Auths returns the usernames clients authenticated with.
+func (s *Server) Auths() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.auths...)
+}
+
+func (s *Server) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Go(func() {
+			defer func() { _ = conn.Close() }()
+			s.handle(conn)
+		})
+	}
+}
+
+// handle speaks just enough SMTP for net/smtp clients.
+func (s *Server) handle(conn net.Conn) {
+	r := bufio.NewReader(conn)
+	reply := func(line string) bool {
+		_, err := io.WriteString(conn, line+"\r\n")
+		return err == nil
+	}
+	if !reply("220 mailtest ESMTP") {
+		return
+	}
+
+	var from string
+	var to []string
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			reply("250-mailtest")
+			reply("250-8BITMIME")
+			reply("250 AUTH PLAIN")
+		case "HELO":
+			reply("250 mailtest")
+		case "AUTH":
+			mechanism, initial, _ := strings.Cut(arg, " ")
+			if !strings.EqualFold(mechanism, "PLAIN") {
+				reply("504 unsupported mechanism")
+				continue
+			}
+			creds, err := base64.StdEncoding.DecodeString(initial)
+			parts := strings.Split(string(creds), "\x00")
+			if err != nil || len(parts) != 3 {
+				reply("501 invalid credentials")
+				continue
+			}
+			s.mu.Lock()
+			s.auths = append(s.auths, parts[1])
+			s.mu.Unlock()
+			reply("235 authenticated")
+		case "MAIL":
+			from = trimPath(arg, "FROM:")
+			to = nil
+			reply("250 ok")
+		case "RCPT":
+			to = append(to, trimPath(arg, "TO:"))
+			reply("250 ok")
+		case "DATA":
+			reply("354 end data with <CR><LF>.<CR><LF>")
+			data, err := readData(r)
+			if err != nil {
+				return
+			}
+			msg, err := parse(from, to, data)
+			if err != nil {
+				s.t.Errorf("mailtest: parsing message: %v", err)
+				reply("554 invalid message")
+				continue
+			}
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+			reply("250 ok")
+		case "RSET":
+			from, to = "", nil
+			reply("250 ok")
+		case "NOOP":
+			reply("250 ok")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 command not implemented")
+		}
+	}
+}
+
+// trimPath returns the address of a MAIL FROM or RCPT TO argument.
+func trimPath(arg, prefix string) string {
+	arg = strings.TrimSpace(arg)
+	if len(arg) >= len(prefix) && strings.EqualFold(arg[:len(prefix)], prefix) {
+		arg = arg[len(prefix):]
+	}
+	path, _, _ := strings.Cut(arg, " ")
+	return strings.Trim(path, "<>")
+}
+
+// readData reads a message up to the terminating "." line, undoing dot
+// stuffing.
+func readData(r *bufio.Reader) ([]byte, error) {
+	var buf bytes.Buffer
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		if line == ".\r\n" {
+			return buf.Bytes(), nil
+		}
+		buf.WriteString(strings.TrimPrefix(line, "."))
+	}
+}
+
+func parse(from string, to []string, data []byte) (Message, error) {
+	msg, err := netmail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		return Message{}, err
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		return Message{}, err
+	}
+	body := msg.Body
+	if strings.EqualFold(msg.Header.Get("Content-Transfer-Encoding"), "quoted-printable") {
+		body = quotedprintable.NewReader(body)
+	}
+	decoded, err := io.ReadAll(body)
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{
+		From:    from,
+		To:      to,
+		Header:  msg.Header,
+		Subject: subject,
+		Body:    strings.ReplaceAll(string(decoded), "\r\n", "\n"),
+	}, nil
+}
@@ -0,0 +1,159 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultSMTPPort is the submission port, which mail servers expect
+	// clients to upgrade to TLS with STARTTLS.
+	DefaultSMTPPort = 587
+	// smtpTimeout bounds sending an email when the context has no deadline.
+	smtpTimeout = 30 * time.Second
+)
+
+// SMTPConfig configures the mail server emails are sent through.
+type SMTPConfig struct {
+	Host string
+	Port int
+	// Username and Password authenticate with the server. No authentication
+	// is attempted if Username is empty.
+	Username string
+	Password string
+	// From is the address emails are sent from.
+	From string
+}
+
+// SMTP sends emails through a mail server. The connection is upgraded to TLS
+// whenever the server supports STARTTLS.
+type SMTP struct {
+	config SMTPConfig
+}
+
+// NewSMTP returns a mailer that sends emails through the configured server.
+func NewSMTP(config SMTPConfig) (*SMTP, error) {
+	if config.Host == "" {
+		return nil, errors.New("smtp host not set")
+	}
+	if _, err := netmail.ParseAddress(config.From); err != nil {
+		return nil, fmt.Errorf("parsing from address: %w", err)
+	}
+	if config.Port == 0 {
+		config.Port = DefaultSMTPPort
+	}
+	return &SMTP{config: config}, nil
+}
+
+func (m *SMTP) Send(ctx context.Context, msg Message) error {
+	from, err := netmail.ParseAddress(m.config.From)
+	if err != nil {
+		return fmt.Errorf("parsing from address: %w", err)
+	}
+	to, err := netmail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("parsing to address: %w", err)
+	}
+	data, err := m.format(from, to, msg)
+	if err != nil {
+		return fmt.Errorf("formatting message: %w", err)
+	}
+
+	// Connect
+	addr := net.JoinHostPort(m.config.Host, strconv.Itoa(m.config.Port))
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("connecting to %s: %w", addr, err)
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(smtpTimeout)
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("setting deadline: %w", err)
+	}
+	client, err := smtp.NewClient(conn, m.config.Host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("greeting server: %w", err)
+	}
+	defer func() { _ = client.Close() }()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: m.config.Host}); err != nil {
+			return fmt.Errorf("starting tls: %w", err)
+		}
+	}
+	if m.config.Username != "" {
+		auth := smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("authenticating: %w", err)
+		}
+	}
+
+	// Send message
+	if err := client.Mail(from.Address); err != nil {
+		return fmt.Errorf("setting sender: %w", err)
+	}
+	if err := client.Rcpt(to.Address); err != nil {
+		return fmt.Errorf("setting recipient: %w", err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("starting message: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("writing message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("sending message: %w", err)
+	}
+	if err := client.Quit(); err != nil {
+		return fmt.Errorf("closing connection: %w", err)
+	}
+	return nil
+}
+
+// format builds the headers and quoted-printable body of a message.
+func (m *SMTP) format(from, to *netmail.Address, msg Message) ([]byte, error) {
+	var buf bytes.Buffer
+	headers := [][2]string{
+		{"From", from.String()},
+		{"To", to.String()},
+		{"Subject", mime.QEncoding.Encode("utf-8", msg.Subject)},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "text/plain; charset=utf-8"},
+		{"Content-Transfer-Encoding", "quoted-printable"},
+	}
+	for _, h := range headers {
+		fmt.Fprintf(&buf, "%s: %s\r\n", h[0], h[1])
+	}
+	buf.WriteString("\r\n")
+
+	qp := quotedprintable.NewWriter(&buf)
+	body := strings.ReplaceAll(msg.Body, "\r\n", "\n")
+	if !strings.HasSuffix(body, "\n") {
+		body += "\n"
+	}
+	if _, err := qp.Write([]byte(strings.ReplaceAll(body, "\n", "\r\n"))); err != nil {
+		return nil, err
+	}
+	if err := qp.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,101 @@
+package mail
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"mars/internal/mail/mailtest"
+)
+
+func newTestSMTP(t *testing.T, sink *mailtest.Server, username string) *SMTP {
+	t.Helper()
+	m, err := NewSMTP(SMTPConfig{
+		Host:     sink.Host,
+		Port:     sink.Port,
+		Username: username,
+		Password: "secret",
+		From:     "Mars <mars@example.com>",
+	})
+	if err != nil {
+		t.Fatalf("NewSMTP() = %v", err)
+	}
+	return m
+}
+
+func TestSMTPSend(t *testing.T) {
+	sink := mailtest.New(t)
+	m := newTestSMTP(t, sink, "mars")
+	// Long enough to be wrapped by quoted-printable, with a line that has to
+	// be dot stuffed
+	body := "Reset your password:\n" + strings.Repeat("x", 100) + "\n.\nThanks"
+
+	err := m.Send(context.Background(), Message{
+		To:      "user@example.com",
+		Subject: "Réinitialiser le mot de passe",
+		Body:    body,
+	})
+	if err != nil {
+		t.Fatalf("Send() = %v", err)
+	}
+
+	messages := sink.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("sink received %d messages, want 1", len(messages))
+	}
+	got := messages[0]
+	if got.From != "mars@example.com" {
+		t.Errorf("envelope sender = %q, want %q", got.From, "mars@example.com")
+	}
+	if want := []string{"user@example.com"}; !slices.Equal(got.To, want) {
+		t.Errorf("envelope recipients = %v, want %v", got.To, want)
+	}
+	if got.Subject != "Réinitialiser le mot de passe" {
+		t.Errorf("subject = %q, want the original subject", got.Subject)
+	}
+	// Messages always end with a line break
+	if got.Body != body+"\n" {
+		t.Errorf("body = %q, want %q", got.Body, body+"\n")
+	}
+	if auths := sink.Auths(); !slices.Equal(auths, []string{"mars"}) {
+		t.Errorf("authenticated as %v, want [mars]", auths)
+	}
+}
+
+func TestSMTPSendWithoutAuth(t *testing.T) {
+	sink := mailtest.New(t)
+	m := newTestSMTP(t, sink, "")
+
+	if err := m.Send(context.Background(), Message{To: "user@example.com", Subject: "Hi", Body: "Hi"}); err != nil {
+		t.Fatalf("Send() = %v", err)
+	}
+	if auths := sink.Auths(); len(auths) != 0 {
+		t.Errorf("authenticated as %v, want no authentication", auths)
+	}
+}
+
+func TestSMTPSendRejectsHeaderInjection(t *testing.T) {
+	sink := mailtest.New(t)
+	m := newTestSMTP(t, sink, "")
+
+	err := m.Send(context.Background(), Message{
+		To:      "user@example.com\r\nBcc: other@example.com",
+		Subject: "Hi",
+		Body:    "Hi",
+	})
+	if err == nil {
+		t.Error("Send() = nil, want an error for an invalid recipient")
+	}
+	if err := m.Send(context.Background(), Message{
+		To:      "user@example.com",
+		Subject: "Hi\r\nBcc: other@example.com",
+		Body:    "Hi",
+	}); err != nil {
+		t.Fatalf("Send() = %v", err)
+	}
+	messages := sink.Messages()
+	if len(messages) != 1 || messages[0].Header.Get("Bcc") != "" {
+		t.Errorf("sink received %+v, want a single message without a Bcc header", messages)
+	}
+}
@@ -0,0 +1,155 @@
+package mars
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"mars/internal/cover"
+	"mars/internal/database"
+	"mars/internal/provider"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// getPlaylistTracks retrieves the URIs a provider identifies a playlist's
+// tracks by, along with the distinct album art of its tracks in playlist
+// order. Tracks the provider doesn't have, such as tracks only ever listened to
+// on other players, are left out.
+func (s *Service) getPlaylistTracks(
+	ctx context.Context, p provider.Provider, playlistID uuid.UUID,
+) (trackURIs, imageURLs []string, err error) {
+	tracks, err := s.Env.Database.GetPlaylistTracks(ctx, playlistID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	trackURIs = make([]string, 0, len(tracks))
+	for _, t := range tracks {
+		if uri, ok := p.TrackURI(provider.Track{ID: t.ID, URI: t.Uri}); ok {
+			trackURIs = append(trackURIs, uri)
+		}
+		if t.ImageUrl.Valid && !slices.Contains(imageURLs, t.ImageUrl.String) {
+			imageURLs = append(imageURLs, t.ImageUrl.String)
+		}
+	}
+	return trackURIs, imageURLs, nil
+}
+
+// setPlaylistCover renders a cover from album art and uploads it to a
+// provider's playlist. Covers are only cosmetic, so failures are logged rather
+// than failing the export.
+func (s *Service) setPlaylistCover(
+	ctx context.Context, p provider.Provider, accessToken, remoteID string, imageURLs []string,
+) {
+	if len(imageURLs) == 0 {
+		return
+	}
+
+	s.Env.Logger.DebugContext(ctx, "rendering playlist cover")
+	jpeg, err := cover.Render(ctx, s.Env.HTTP, imageURLs)
+	if err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to render playlist cover", slog.Any("error", err))
+		return
+	}
+	if err := p.SetPlaylistCover(ctx, accessToken, remoteID, jpeg); err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to upload playlist cover", slog.Any("error", err))
+	}
+}
+
+// exportPlaylist copies a playlist, along with a cover rendered from its album
+// art, to a provider. A playlist that was exported before is updated and has
+// its tracks replaced in place, so exporting again never creates duplicates.
+// If the exported playlist was deleted on the provider, a new one is created.
+// created reports whether a new playlist was created on the provider.
+func (s *Service) exportPlaylist(
+	ctx context.Context, p provider.Provider, linked database.GetProviderTokensRow,
+	playlist database.GetUserPlaylistRow, trackURIs, imageURLs []string,
+) (remote provider.Playlist, created bool, err error) {
+	export, err := s.Env.Database.GetPlaylistExport(ctx, database.GetPlaylistExportParams{
+		PlaylistID: playlist.ID,
+		Provider:   p.Name(),
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return provider.Playlist{}, false, fmt.Errorf("getting playlist export: %w", err)
+	}
+	if err == nil {
+		remote = provider.Playlist{ID: export.RemoteID, URL: export.Url}
+		err = p.UpdatePlaylist(ctx, linked.AccessToken, remote.ID, playlist.Name, playlist.Description)
+		if err == nil {
+			err = p.ReplaceTracks(ctx, linked.AccessToken, remote.ID, trackURIs)
+		}
+		if err == nil {
+			s.setPlaylistCover(ctx, p, linked.AccessToken, remote.ID, imageURLs)
+			return remote, false, nil
+		} else if !errors.Is(err, provider.ErrNotFound) {
+			return provider.Playlist{}, false, fmt.Errorf("updating exported playlist: %w", err)
+		}
+		s.Env.Logger.DebugContext(ctx, "exported playlist was deleted, creating a new one",
+			slog.String("remote-id", remote.ID))
+	}
+
+	remote, err = p.CreatePlaylist(ctx, linked.AccessToken, linked.AccountID, playlist.Name, playlist.Description)
+	if err != nil {
+		return provider.Playlist{}, false, fmt.Errorf("creating playlist: %w", err)
+	}
+	// Store the export before adding tracks so a failure doesn't leave a
+	// playlist behind that the next export would duplicate
+	err = s.Env.Database.UpsertPlaylistExport(ctx, database.UpsertPlaylistExportParams{
+		PlaylistID: playlist.ID,
+		Provider:   p.Name(),
+		RemoteID:   remote.ID,
+		Url:        remote.URL,
+	})
+	if err != nil {
+		return provider.Playlist{}, false, fmt.Errorf("storing playlist export: %w", err)
+	}
+	if err := p.AddTracks(ctx, linked.AccessToken, remote.ID, trackURIs); err != nil {
+		return provider.Playlist{}, false, fmt.Errorf("adding tracks: %w", err)
+	}
+	s.setPlaylistCover(ctx, p, linked.AccessToken, remote.ID, imageURLs)
+	return remote, true, nil
+}
+
+// ExportPlaylist exports a user's playlist to the account they linked to a
+// provider. ErrNotLinked is returned if the user hasn't linked an account,
+// ErrPlaylistNotFound if they don't have the playlist and ErrNoTracks if none
+// of its tracks are on the provider. created reports whether a new playlist
+// was created on the provider.
+func (s *Service) ExportPlaylist(
+	ctx context.Context, userID, playlistID uuid.UUID, name string,
+) (remote provider.Playlist, created bool, err error) {
+	// Get provider credentials
+	s.Env.Logger.DebugContext(ctx, "getting provider credentials")
+	p, linked, err := s.LinkedProvider(ctx, userID, name)
+	if err != nil {
+		return provider.Playlist{}, false, err
+	}
+
+	// Get playlist from database
+	playlist, err := s.Env.Database.GetUserPlaylist(ctx, database.GetUserPlaylistParams{
+		UserID: userID,
+		ID:     playlistID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return provider.Playlist{}, false, ErrPlaylistNotFound
+	} else if err != nil {
+		return provider.Playlist{}, false, fmt.Errorf("get playlist: %w", err)
+	}
+
+	// Get track URIs
+	trackURIs, imageURLs, err := s.getPlaylistTracks(ctx, p, playlistID)
+	if err != nil {
+		return provider.Playlist{}, false, fmt.Errorf("get playlist tracks: %w", err)
+	}
+	if len(trackURIs) == 0 {
+		return provider.Playlist{}, false, ErrNoTracks
+	}
+
+	// Export playlist
+	s.Env.Logger.DebugContext(ctx, "exporting playlist")
+	return s.exportPlaylist(ctx, p, linked, playlist, trackURIs, imageURLs)
+}
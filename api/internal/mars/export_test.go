@@ -0,0 +1,214 @@
+package mars
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image/jpeg"
+	"slices"
+	"testing"
+
+	"mars/internal/database"
+	"mars/internal/database/databasetest"
+	"mars/internal/provider"
+
+	"github.com/google/uuid"
+)
+
+func (e serviceTest) export(
+	t *testing.T, playlist database.GetUserPlaylistRow, trackURIs, imageURLs []string,
+) (provider.Playlist, bool, error) {
+	t.Helper()
+	p, linked, err := e.svc.LinkedProvider(context.Background(), e.userID, provider.Spotify)
+	if err != nil {
+		t.Fatalf("LinkedProvider() = %v", err)
+	}
+	return e.svc.exportPlaylist(context.Background(), p, linked, playlist, trackURIs, imageURLs)
+}
+
+func trackURIs(n int) []string {
+	uris := make([]string, n)
+	for i := range uris {
+		uris[i] = fmt.Sprintf("spotify:track:%d", i)
+	}
+	return uris
+}
+
+func TestExportPlaylistAddsTracksInChunks(t *testing.T) {
+	e := newServiceTest(t)
+	playlist := databasetest.NewPlaylist("Oct 2026")
+	uris := trackURIs(250)
+
+	remote, created, err := e.export(t, playlist, uris, nil)
+	if err != nil {
+		t.Fatalf("exportPlaylist() = %v", err)
+	}
+	if !created {
+		t.Error("exportPlaylist() created = false on first export, want true")
+	}
+
+	got, ok := e.spotify.Playlist(remote.ID)
+	if !ok {
+		t.Fatalf("playlist %s was not created on spotify", remote.ID)
+	}
+	if !slices.Equal(got.Tracks, uris) {
+		t.Errorf("spotify playlist has %d tracks, want the %d exported in order", len(got.Tracks), len(uris))
+	}
+	if got.Name != playlist.Name || got.Description != playlist.Description {
+		t.Errorf("spotify playlist is %q (%q), want %q (%q)",
+			got.Name, got.Description, playlist.Name, playlist.Description)
+	}
+	if export := e.db.Exports[playlist.ID]; export.RemoteID != remote.ID || export.Url != remote.URL {
+		t.Errorf("stored export = %+v, want %+v", export, remote)
+	}
+}
+
+func TestExportPlaylistUpdatesInPlace(t *testing.T) {
+	e := newServiceTest(t)
+	playlist := databasetest.NewPlaylist("Last 30 days")
+
+	first, _, err := e.export(t, playlist, trackURIs(150), nil)
+	if err != nil {
+		t.Fatalf("first exportPlaylist() = %v", err)
+	}
+
+	playlist.Name = "Last 30 days (updated)"
+	uris := trackURIs(120)[10:]
+	second, created, err := e.export(t, playlist, uris, nil)
+	if err != nil {
+		t.Fatalf("second exportPlaylist() = %v", err)
+	}
+	if created {
+		t.Error("exportPlaylist() created = true when exporting again, want false")
+	}
+	if second != first {
+		t.Errorf("second export = %+v, want the first export %+v", second, first)
+	}
+	if n := e.spotify.Playlists(); n != 1 {
+		t.Errorf("spotify has %d playlists, want 1", n)
+	}
+
+	got, _ := e.spotify.Playlist(first.ID)
+	if !slices.Equal(got.Tracks, uris) {
+		t.Errorf("spotify playlist has %d tracks, want the %d exported in order", len(got.Tracks), len(uris))
+	}
+	if got.Name != playlist.Name {
+		t.Errorf("spotify playlist name = %q, want %q", got.Name, playlist.Name)
+	}
+}
+
+func TestExportPlaylistRecreatesDeletedPlaylist(t *testing.T) {
+	e := newServiceTest(t)
+	playlist := databasetest.NewPlaylist("Week 41")
+
+	first, _, err := e.export(t, playlist, trackURIs(5), nil)
+	if err != nil {
+		t.Fatalf("first exportPlaylist() = %v", err)
+	}
+	e.spotify.DeletePlaylist(first.ID)
+
+	second, created, err := e.export(t, playlist, trackURIs(5), nil)
+	if err != nil {
+		t.Fatalf("second exportPlaylist() = %v", err)
+	}
+	if !created || second.ID == first.ID {
+		t.Errorf("exportPlaylist() = %+v, created = %t, want a new playlist", second, created)
+	}
+	if export := e.db.Exports[playlist.ID]; export.RemoteID != second.ID {
+		t.Errorf("stored export points to %s, want %s", export.RemoteID, second.ID)
+	}
+}
+
+func TestExportPlaylistRetriesRateLimitedRequests(t *testing.T) {
+	e := newServiceTest(t)
+	e.spotify.RateLimit(2, "0")
+	uris := trackURIs(30)
+
+	remote, _, err := e.export(t, databasetest.NewPlaylist("2026"), uris, nil)
+	if err != nil {
+		t.Fatalf("exportPlaylist() = %v", err)
+	}
+	if got, ok := e.spotify.Playlist(remote.ID); !ok || !slices.Equal(got.Tracks, uris) {
+		t.Error("spotify playlist is missing tracks after rate limited requests were retried")
+	}
+}
+
+func TestExportPlaylistGivesUpOnLongRetryAfter(t *testing.T) {
+	e := newServiceTest(t)
+	e.spotify.RateLimit(1, "3600")
+
+	_, _, err := e.export(t, databasetest.NewPlaylist("2026"), trackURIs(1), nil)
+	var rateLimitErr *provider.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Fatalf("exportPlaylist() = %v, want a rate limit error", err)
+	}
+	if rateLimitErr.RetryAfter.Hours() != 1 {
+		t.Errorf("RetryAfter = %s, want 1h", rateLimitErr.RetryAfter)
+	}
+	if n := e.spotify.Playlists(); n != 0 {
+		t.Errorf("spotify has %d playlists, want none", n)
+	}
+}
+
+func TestExportPlaylistUploadsCover(t *testing.T) {
+	e := newServiceTest(t)
+	images := []string{e.spotify.ImageURL("a.jpg"), e.spotify.ImageURL("b.jpg")}
+
+	remote, _, err := e.export(t, databasetest.NewPlaylist("Oct 2026"), trackURIs(3), images)
+	if err != nil {
+		t.Fatalf("exportPlaylist() = %v", err)
+	}
+
+	got, _ := e.spotify.Playlist(remote.ID)
+	if got.Cover == nil {
+		t.Fatal("no cover was uploaded")
+	}
+	img, err := jpeg.Decode(bytes.NewReader(got.Cover))
+	if err != nil {
+		t.Fatalf("cover is not a jpeg: %v", err)
+	}
+	if b := img.Bounds(); b.Dx() != 640 || b.Dy() != 640 {
+		t.Errorf("cover is %dx%d, want 640x640", b.Dx(), b.Dy())
+	}
+	// The album art is plain gray
+	if r, _, _, _ := img.At(320, 320).RGBA(); r>>8 < 0x70 || r>>8 > 0x90 {
+		t.Errorf("cover has red %#x at its center, want about 0x80 like the album art", r>>8)
+	}
+}
+
+func TestExportPlaylistNotLinked(t *testing.T) {
+	e := newServiceTest(t)
+	playlist := databasetest.NewPlaylist("Oct 2026")
+	e.db.Playlists[playlist.ID] = playlist
+
+	_, _, err := e.svc.ExportPlaylist(context.Background(), uuid.New(), playlist.ID, provider.Spotify)
+	if !errors.Is(err, ErrNotLinked) {
+		t.Errorf("ExportPlaylist() of an unlinked user = %v, want %v", err, ErrNotLinked)
+	}
+	_, _, err = e.svc.ExportPlaylist(context.Background(), e.userID, uuid.New(), provider.Spotify)
+	if !errors.Is(err, ErrPlaylistNotFound) {
+		t.Errorf("ExportPlaylist() of a missing playlist = %v, want %v", err, ErrPlaylistNotFound)
+	}
+}
+
+func TestExportPlaylistWithoutTracksOnProvider(t *testing.T) {
+	e := newServiceTest(t)
+	playlist := databasetest.NewPlaylist("Oct 2026")
+	e.db.Playlists[playlist.ID] = playlist
+	e.db.PlaylistTracks[playlist.ID] = []database.GetPlaylistTracksRow{
+		{ID: "local:abc"},
+		{ID: "musicbrainz:0f0cb9e6-2ea2-4a3a-9d3c-3a5b9b1c7e01"},
+	}
+
+	_, _, err := e.svc.ExportPlaylist(context.Background(), e.userID, playlist.ID, provider.Spotify)
+	if !errors.Is(err, ErrNoTracks) {
+		t.Fatalf("ExportPlaylist() = %v, want %v", err, ErrNoTracks)
+	}
+	if n := e.spotify.Playlists(); n != 0 {
+		t.Errorf("created %d spotify playlists, want none", n)
+	}
+	if _, ok := e.db.Exports[playlist.ID]; ok {
+		t.Errorf("stored an export of a playlist that wasn't exported")
+	}
+}
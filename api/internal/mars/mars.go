@@ -1,253 +1,109 @@
-// Package mars contains functions for interacting with the mars api
+// Package mars contains the core operations of mars, such as syncing listens,
+// refreshing provider tokens and creating playlists. The api handlers and the
+// background jobs both call it directly.
 package mars
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"net/http"
 	"sync"
-	"time"
 
-	marshttp "mars/internal/http"
-	"mars/internal/spotify"
+	"mars/internal/database"
+	"mars/internal/env"
+	"mars/internal/ratelimit"
 
-	"github.com/hashicorp/go-retryablehttp"
+	"github.com/google/uuid"
 )
 
-func Login(ctx context.Context, client marshttp.Client, email, password string) (
-	accessToken string, refreshToken string, csrfToken string, err error,
-) {
-	const endpoint = "http://localhost:8080/api/login"
-	body, err := json.Marshal(map[string]string{
-		"email":    email,
-		"password": password,
-	})
-	if err != nil {
-		return "", "", "", fmt.Errorf("marshaling body: %w", err)
-	}
-	req, err := retryablehttp.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
-	if err != nil {
-		return "", "", "", fmt.Errorf("creating request: %w", err)
-	}
-	req.Header.Set("Content-Type", "application/json")
-	res, err := client.Do(req)
-	if err != nil {
-		return "", "", "", fmt.Errorf("sending request: %w", err)
-	}
-	defer func() { _ = res.Body.Close() }()
-
-	if res.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(res.Body)
-		return "", "", "", fmt.Errorf("request failed with non-200 status: status=%d body=%s", res.StatusCode, string(body))
-	}
-
-	for _, cookie := range res.Cookies() {
-		switch cookie.Name {
-		case "access":
-			accessToken = cookie.Value
-		case "refresh":
-			refreshToken = cookie.Value
-		case "csrf":
-			csrfToken = cookie.Value
-		}
-	}
-	return accessToken, refreshToken, csrfToken, nil
-}
-
-func ListUsers(ctx context.Context, client marshttp.Client, accessToken string) ([]string, error) {
-	const endpoint = "http://localhost:8080/api/users"
-	req, err := retryablehttp.NewRequest(http.MethodGet, endpoint, nil)
-	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
-	}
-	req.Header.Set("Cookie", "access="+accessToken)
-	res, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("sending request: %w", err)
-	}
-	defer func() { _ = res.Body.Close() }()
+const (
+	// usersPageSize is the number of users loaded at once when iterating over
+	// every user.
+	usersPageSize = 100
+	// userConcurrency is the number of users a job works on at once.
+	userConcurrency = 4
+)
 
-	if res.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("request failed with non-200 status: status=%d body=%s", res.StatusCode, string(body))
-	}
+var (
+	// ErrNotLinked is returned when a user hasn't linked an account to a
+	// provider.
+	ErrNotLinked = errors.New("no linked account")
+	// ErrPlaylistNotFound is returned when a user doesn't have a playlist.
+	ErrPlaylistNotFound = errors.New("playlist not found")
+	// ErrNoTracks is returned when a playlist would have no tracks.
+	ErrNoTracks = errors.New("no tracks listened to in this range")
+	// ErrPlaylistExists is returned when a playlist already exists for a period.
+	ErrPlaylistExists = errors.New("playlist already exists for this period")
+	// ErrUserNotFound is returned when a user doesn't exist.
+	ErrUserNotFound = errors.New("user not found")
+)
 
-	var body struct {
-		Ids []string `json:"ids"`
-	}
-	err = json.NewDecoder(res.Body).Decode(&body)
-	if err != nil {
-		return nil, fmt.Errorf("decoding response: %w", err)
-	}
+// Service runs the core operations of mars against the environment's
+// database and providers.
+type Service struct {
+	Env *env.Env
 
-	return body.Ids, nil
+	// resetsPerEmail and resetsPerIP throttle password reset emails.
+	resetsPerEmail *ratelimit.Limiter
+	resetsPerIP    *ratelimit.Limiter
+	// background tracks work that outlives the request that started it.
+	background sync.WaitGroup
 }
 
-func RefreshSpotifyTokens(ctx context.Context, client marshttp.Client, email, password string) error {
-	accessToken, _, csrfToken, err := Login(ctx, client, email, password)
-	if err != nil {
-		return fmt.Errorf("logging in: %w", err)
-	}
-
-	userids, err := ListUsers(ctx, client, accessToken)
-	if err != nil {
-		return fmt.Errorf("listing users: %w", err)
-	}
-
-	var wg sync.WaitGroup
-	var mtx sync.Mutex
-	var errs []error
-	for _, id := range userids {
-		// would probably cause hella rate-limiting errors, but i'm the only user so who cares
-		wg.Go(func() {
-			err := spotify.RefreshToken(ctx, client, id, accessToken, csrfToken)
-			if err != nil {
-				mtx.Lock()
-				errs = append(errs, fmt.Errorf("refreshing tokens for user (%s): %w", id, err))
-				mtx.Unlock()
-			}
-		})
-	}
-	wg.Wait()
-
-	if len(errs) == 0 {
-		return nil
+// New creates a service for an environment.
+func New(e *env.Env) *Service {
+	return &Service{
+		Env:            e,
+		resetsPerEmail: ratelimit.New(passwordResetsPerEmail/3600.0, passwordResetsPerEmail),
+		resetsPerIP:    ratelimit.New(passwordResetsPerIP/3600.0, passwordResetsPerIP),
 	}
-
-	return errors.Join(errs...)
 }
 
-func SyncSpotifyTracks(ctx context.Context, client marshttp.Client, email, password string) error {
-	accessToken, _, csrfToken, err := Login(ctx, client, email, password)
-	if err != nil {
-		return fmt.Errorf("logging in: %w", err)
-	}
-
-	userids, err := ListUsers(ctx, client, accessToken)
-	if err != nil {
-		return fmt.Errorf("listing users: %w", err)
-	}
-
-	var wg sync.WaitGroup
-	var mtx sync.Mutex
-	var errs []error
-	for _, id := range userids {
-		wg.Go(func() {
-			err := spotify.SyncTracks(ctx, client, id, accessToken, csrfToken)
-			if err != nil {
-				mtx.Lock()
-				errs = append(errs, fmt.Errorf("syncing tracks for user (%s): %w", id, err))
-				mtx.Unlock()
-			}
-		})
-	}
-	wg.Wait()
-
-	if len(errs) == 0 {
-		return nil
-	}
-
-	return errors.Join(errs...)
+// Wait blocks until work started in the background, such as sending emails,
+// has finished.
+func (s *Service) Wait() {
+	s.background.Wait()
 }
 
-func CreatePlaylist(ctx context.Context, client marshttp.Client,
-	email, password, playlisttype string,
-	year int, month time.Month, day int,
-) error {
-	accessToken, _, csrfToken, err := Login(ctx, client, email, password)
-	if err != nil {
-		return fmt.Errorf("logging in: %w", err)
-	}
-
-	userids, err := ListUsers(ctx, client, accessToken)
-	if err != nil {
-		return fmt.Errorf("listing users: %w", err)
-	}
+// ForEachUser calls fn for every user, loading users a page at a time so
+// every user is reached however many there are. Up to userConcurrency users
+// are worked on at once. The errors of the users fn failed for are joined.
+func (s *Service) ForEachUser(ctx context.Context, fn func(ctx context.Context, userID uuid.UUID) error) error {
+	var (
+		wg   sync.WaitGroup
+		mtx  sync.Mutex
+		errs []error
+	)
+	sem := make(chan struct{}, userConcurrency)
+	after := uuid.Nil
+	for {
+		users, err := s.Env.Database.ListUserIDsAfter(ctx, database.ListUserIDsAfterParams{
+			ID:    after,
+			Limit: usersPageSize,
+		})
+		if err != nil {
+			wg.Wait()
+			return errors.Join(append(errs, fmt.Errorf("listing users: %w", err))...)
+		}
 
-	var wg sync.WaitGroup
-	var mtx sync.Mutex
-	var errs []error
-	for _, id := range userids {
-		wg.Go(func() {
-			// Create request
-			const endpoint = "http://localhost:8080/api/playlists"
-			body, err := json.Marshal(map[string]any{
-				"user_id": id,
-				"start_date": map[string]int{
-					"year":  year,
-					"month": int(month),
-					"day":   day,
-				},
-				"type": playlisttype,
+		for _, id := range users {
+			sem <- struct{}{}
+			wg.Go(func() {
+				defer func() { <-sem }()
+				if err := fn(ctx, id); err != nil {
+					mtx.Lock()
+					errs = append(errs, fmt.Errorf("user (%s): %w", id, err))
+					mtx.Unlock()
+				}
 			})
-			if err != nil {
-				mtx.Lock()
-				errs = append(errs, fmt.Errorf("marshaling body for user (%s): %w", id, err))
-				mtx.Unlock()
-				return
-			}
-			req, err := retryablehttp.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
-			if err != nil {
-				mtx.Lock()
-				errs = append(errs, fmt.Errorf("creating request for user (%s): %w", id, err))
-				mtx.Unlock()
-				return
-			}
-			req.Header.Set("Content-Type", "application/json")
-			req.Header.Set("X-CSRF-Token", csrfToken)
-			req.Header.Set("Cookie", fmt.Sprintf("access=%s; csrf=%s", accessToken, csrfToken))
-
-			// Send request
-			res, err := client.Do(req)
-			if err != nil {
-				mtx.Lock()
-				errs = append(errs, fmt.Errorf("sending request for user (%s): %w", id, err))
-				mtx.Unlock()
-				return
-			}
-			defer func() { _ = res.Body.Close() }()
-			// No tracks listened to, this is fine.
-			if res.StatusCode == http.StatusConflict {
-				return
-			}
-			if res.StatusCode != http.StatusCreated {
-				body, _ := io.ReadAll(res.Body)
-				mtx.Lock()
-				errs = append(errs,
-					fmt.Errorf("request failed with non-201 status: status=%d body=\"%s\"", res.StatusCode, string(body)))
-				mtx.Unlock()
-				return
-			}
-
-			// Parse playlist ID from response
-			var respBody struct {
-				ID string `json:"id"`
-			}
-			if err := json.NewDecoder(res.Body).Decode(&respBody); err != nil {
-				mtx.Lock()
-				errs = append(errs, fmt.Errorf("decoding response for user (%s): %w", id, err))
-				mtx.Unlock()
-				return
-			}
+		}
 
-			// Create playlist on Spotify
-			if err := spotify.CreatePlaylist(ctx, client, id, respBody.ID, accessToken, csrfToken); err != nil {
-				mtx.Lock()
-				errs = append(errs, fmt.Errorf("creating spotify playlist for user (%s): %w", id, err))
-				mtx.Unlock()
-				return
-			}
-		})
+		if len(users) < usersPageSize {
+			break
+		}
+		after = users[len(users)-1]
 	}
 	wg.Wait()
 
-	if len(errs) == 0 {
-		return nil
-	}
-
 	return errors.Join(errs...)
 }
@@ -0,0 +1,111 @@
+package mars
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"mars/internal/database/databasetest"
+	"mars/internal/provider"
+	"mars/internal/provider/spotify/spotifytest"
+
+	"github.com/google/uuid"
+)
+
+const testAccountID = "mars-user"
+
+// serviceTest is a service whose Spotify provider talks to a fake Spotify,
+// with a user who has linked an account.
+type serviceTest struct {
+	svc     *Service
+	spotify *spotifytest.Server
+	db      *databasetest.Querier
+	userID  uuid.UUID
+}
+
+func newServiceTest(t *testing.T) serviceTest {
+	e, fake, db := spotifytest.NewEnv(t)
+	return serviceTest{
+		svc:     New(e),
+		spotify: fake,
+		db:      db,
+		userID:  fake.LinkUser(db, testAccountID),
+	}
+}
+
+func TestForEachUserVisitsEveryPage(t *testing.T) {
+	e := newServiceTest(t)
+	// Enough users for a few pages, with a partial last page
+	for range 2*usersPageSize + 7 {
+		e.db.Users = append(e.db.Users, uuid.New())
+	}
+
+	var mu sync.Mutex
+	visits := make(map[uuid.UUID]int)
+	failed := e.db.Users[3]
+	err := e.svc.ForEachUser(context.Background(), func(_ context.Context, userID uuid.UUID) error {
+		mu.Lock()
+		defer mu.Unlock()
+		visits[userID]++
+		if userID == failed {
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	if err == nil {
+		t.Error("ForEachUser() = nil, want the error of the failed user")
+	}
+	if len(visits) != len(e.db.Users) {
+		t.Errorf("ForEachUser() visited %d users, want all %d", len(visits), len(e.db.Users))
+	}
+	for id, n := range visits {
+		if n != 1 {
+			t.Errorf("ForEachUser() visited user %s %d times, want once", id, n)
+		}
+	}
+}
+
+func TestRefreshAllProviderTokensSkipsUnlinkedUsers(t *testing.T) {
+	e := newServiceTest(t)
+	for range usersPageSize {
+		e.db.Users = append(e.db.Users, uuid.New())
+	}
+	before := e.db.Tokens[e.userID]
+	e.spotify.ExpireTokens()
+
+	if err := e.svc.RefreshAllProviderTokens(context.Background(), provider.Spotify); err != nil {
+		t.Fatalf("RefreshAllProviderTokens() = %v", err)
+	}
+	after := e.db.Tokens[e.userID]
+	if after.AccessToken == before.AccessToken {
+		t.Error("access token of the linked user was not refreshed")
+	}
+	if len(e.db.Tokens) != 1 {
+		t.Errorf("stored tokens for %d users, want only the linked one", len(e.db.Tokens))
+	}
+}
+
+func TestSyncAllSpotifyPlays(t *testing.T) {
+	e := newServiceTest(t)
+	e.db.Users = append(e.db.Users, uuid.New())
+	track := provider.Track{
+		ID:      "track",
+		Name:    "Track",
+		Artists: []provider.Artist{{ID: "artist", Name: "Artist"}},
+		URI:     "spotify:track:track",
+	}
+	e.spotify.Play(testAccountID, track, time.Now())
+
+	if err := e.svc.SyncAllSpotifyPlays(context.Background()); err != nil {
+		t.Fatalf("SyncAllSpotifyPlays() = %v", err)
+	}
+	if len(e.db.Listens) != 1 {
+		t.Errorf("stored %d listens, want 1", len(e.db.Listens))
+	}
+	if state, ok := e.db.SyncStates[e.userID]; !ok || state.LastItemsInserted != 1 {
+		t.Errorf("sync state = %+v, want a sync that inserted 1 item", state)
+	}
+}
@@ -0,0 +1,420 @@
+package mars
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"mars/internal/argon2id"
+	"mars/internal/database"
+	"mars/internal/tokens"
+	"mars/internal/totp"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// totpIssuer names mars in authenticator apps.
+	totpIssuer = "mars"
+	// recoveryCodeCount is the number of recovery codes issued when TOTP is
+	// enabled.
+	recoveryCodeCount = 10
+	// recoveryCodeBytes is the randomness in each recovery code. The 10 bytes
+	// are written as 16 base32 characters, in two groups of 8.
+	recoveryCodeBytes = 10
+	// mfaChallengeAttempts is the number of codes that may be tried against a
+	// challenge before it is discarded.
+	mfaChallengeAttempts = 5
+	// mfaUserAttempts is the number of wrong codes a user may enter in a row,
+	// across all their challenges, before codes are refused for mfaLockout.
+	mfaUserAttempts = 10
+	// mfaLockout is how long codes are refused after too many wrong ones.
+	mfaLockout = 15 * time.Minute
+)
+
+var (
+	// ErrTOTPEnabled is returned when enrolling a user who already has TOTP
+	// enabled.
+	ErrTOTPEnabled = errors.New("totp already enabled")
+	// ErrTOTPNotEnrolled is returned when a user hasn't started enrolling in,
+	// or hasn't enabled, TOTP.
+	ErrTOTPNotEnrolled = errors.New("totp not enrolled")
+	// ErrInvalidMFACode is returned when a TOTP or recovery code is wrong or
+	// has already been used.
+	ErrInvalidMFACode = errors.New("invalid mfa code")
+	// ErrInvalidMFAToken is returned when an MFA challenge token doesn't
+	// exist, doesn't match, has expired or has had too many attempts.
+	ErrInvalidMFAToken = errors.New("invalid mfa token")
+	// ErrMFALocked is returned when a user has entered too many wrong codes,
+	// until the lockout ends.
+	ErrMFALocked = errors.New("too many invalid mfa codes")
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// MFAStatus describes the second factors a user has set up.
+type MFAStatus struct {
+	TOTPEnabled bool
+	// RecoveryCodes is the number of unused recovery codes.
+	RecoveryCodes int64
+}
+
+// GetMFAStatus returns the second factors a user has set up.
+func (s *Service) GetMFAStatus(ctx context.Context, userID uuid.UUID) (MFAStatus, error) {
+	enabled, err := s.TOTPEnabled(ctx, userID)
+	if err != nil {
+		return MFAStatus{}, err
+	}
+	codes, err := s.Env.Database.CountUnusedRecoveryCodes(ctx, userID)
+	if err != nil {
+		return MFAStatus{}, fmt.Errorf("counting recovery codes: %w", err)
+	}
+	return MFAStatus{TOTPEnabled: enabled, RecoveryCodes: codes}, nil
+}
+
+// TOTPEnabled reports whether logging in as a user requires a TOTP code.
+func (s *Service) TOTPEnabled(ctx context.Context, userID uuid.UUID) (bool, error) {
+	row, err := s.Env.Database.GetUserTOTP(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("getting totp: %w", err)
+	}
+	return row.EnabledAt.Valid, nil
+}
+
+// StartTOTPEnrollment creates a new TOTP secret for a user, returning it and
+// the URI authenticator apps are provisioned with. TOTP isn't required until
+// the user confirms a code with EnableTOTP. Starting again replaces a secret
+// that hasn't been confirmed.
+func (s *Service) StartTOTPEnrollment(
+	ctx context.Context, userID uuid.UUID, email string,
+) (secret, uri string, err error) {
+	secret, err = totp.GenerateSecret()
+	if err != nil {
+		return "", "", fmt.Errorf("generating secret: %w", err)
+	}
+	stored, err := s.Env.Database.UpsertPendingUserTOTP(ctx, database.UpsertPendingUserTOTPParams{
+		UserID: userID,
+		Secret: secret,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("storing secret: %w", err)
+	}
+	if stored == 0 {
+		return "", "", ErrTOTPEnabled
+	}
+	return secret, totp.URI(totpIssuer, email, secret), nil
+}
+
+// EnableTOTP requires TOTP codes for a user once they have confirmed a code
+// from their authenticator app. It returns new recovery codes, which replace
+// any the user had. Only hashes of the codes are stored, so they can't be
+// shown again.
+func (s *Service) EnableTOTP(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	row, err := s.Env.Database.GetUserTOTP(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTOTPNotEnrolled
+	} else if err != nil {
+		return nil, fmt.Errorf("getting totp: %w", err)
+	}
+	if row.EnabledAt.Valid {
+		return nil, ErrTOTPEnabled
+	}
+	step, ok, err := totp.Validate(row.Secret, code, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("validating code: %w", err)
+	}
+	if !ok {
+		return nil, ErrInvalidMFACode
+	}
+
+	// Create recovery codes
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		codes[i], err = newRecoveryCode()
+		if err != nil {
+			return nil, fmt.Errorf("creating recovery code: %w", err)
+		}
+		hashes[i], err = argon2id.HashAndEncode(normalizeRecoveryCode(codes[i]), argon2id.DefaultParams)
+		if err != nil {
+			return nil, fmt.Errorf("hashing recovery code: %w", err)
+		}
+	}
+
+	tx, err := s.Env.Pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := database.New(tx)
+
+	enabled, err := qtx.EnableUserTOTP(ctx, database.EnableUserTOTPParams{
+		UserID:       userID,
+		LastUsedStep: step,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("enabling totp: %w", err)
+	}
+	if enabled == 0 {
+		// Enabled by a concurrent request
+		return nil, ErrTOTPEnabled
+	}
+	if err := qtx.DeleteUserRecoveryCodes(ctx, userID); err != nil {
+		return nil, fmt.Errorf("deleting recovery codes: %w", err)
+	}
+	for _, hash := range hashes {
+		err := qtx.CreateRecoveryCode(ctx, database.CreateRecoveryCodeParams{
+			ID:       uuid.New(),
+			UserID:   userID,
+			CodeHash: hash,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("storing recovery code: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	return codes, nil
+}
+
+// DisableTOTP stops requiring TOTP codes for a user, and deletes their
+// recovery codes. A TOTP or recovery code is required.
+func (s *Service) DisableTOTP(ctx context.Context, userID uuid.UUID, code string) error {
+	if err := s.VerifyMFACode(ctx, userID, code); err != nil {
+		return err
+	}
+
+	tx, err := s.Env.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := database.New(tx)
+
+	if _, err := qtx.DeleteUserTOTP(ctx, userID); err != nil {
+		return fmt.Errorf("deleting totp: %w", err)
+	}
+	if err := qtx.DeleteUserRecoveryCodes(ctx, userID); err != nil {
+		return fmt.Errorf("deleting recovery codes: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// VerifyMFACode checks a TOTP code, or a recovery code, of a user with TOTP
+// enabled. Each code can only be used once. After too many wrong codes in a
+// row, ErrMFALocked is returned without checking codes until the lockout ends.
+func (s *Service) VerifyMFACode(ctx context.Context, userID uuid.UUID, code string) error {
+	row, err := s.Env.Database.GetUserTOTP(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrTOTPNotEnrolled
+	} else if err != nil {
+		return fmt.Errorf("getting totp: %w", err)
+	}
+	if !row.EnabledAt.Valid {
+		return ErrTOTPNotEnrolled
+	}
+
+	// Count the attempt before checking the code, so guesses spread across
+	// challenges, or made concurrently, are limited too
+	attempt, err := s.Env.Database.CountUserMFAAttempt(ctx, database.CountUserMFAAttemptParams{
+		UserID:         userID,
+		MaxAttempts:    mfaUserAttempts,
+		LockoutSeconds: int32(mfaLockout / time.Second),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrTOTPNotEnrolled
+	} else if err != nil {
+		return fmt.Errorf("counting attempt: %w", err)
+	}
+	if attempt.LockedUntil.Valid {
+		return ErrMFALocked
+	}
+
+	if err := s.checkMFACode(ctx, userID, row.Secret, code); err != nil {
+		return err
+	}
+	if err := s.Env.Database.ResetUserMFAAttempts(ctx, userID); err != nil {
+		return fmt.Errorf("resetting attempts: %w", err)
+	}
+	return nil
+}
+
+// checkMFACode checks and uses a TOTP code, or a recovery code.
+func (s *Service) checkMFACode(ctx context.Context, userID uuid.UUID, secret, code string) error {
+	code = strings.TrimSpace(code)
+	if len(code) != totp.Digits {
+		return s.useRecoveryCode(ctx, userID, code)
+	}
+	step, ok, err := totp.Validate(secret, code, time.Now())
+	if err != nil {
+		return fmt.Errorf("validating code: %w", err)
+	}
+	if !ok {
+		return ErrInvalidMFACode
+	}
+	// Only steps after the last accepted one are accepted, so an intercepted
+	// code can't be replayed
+	used, err := s.Env.Database.UseUserTOTPStep(ctx, database.UseUserTOTPStepParams{
+		UserID:       userID,
+		LastUsedStep: step,
+	})
+	if err != nil {
+		return fmt.Errorf("using totp step: %w", err)
+	}
+	if used == 0 {
+		return ErrInvalidMFACode
+	}
+	return nil
+}
+
+// useRecoveryCode marks a matching unused recovery code as used.
+func (s *Service) useRecoveryCode(ctx context.Context, userID uuid.UUID, code string) error {
+	code = normalizeRecoveryCode(code)
+	rows, err := s.Env.Database.ListUnusedRecoveryCodes(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("listing recovery codes: %w", err)
+	}
+	for _, row := range rows {
+		argonParams, salt, groundHash, err := argon2id.DecodeHash(row.CodeHash)
+		if err != nil {
+			return fmt.Errorf("decoding recovery code hash: %w", err)
+		}
+		givenHash := argon2id.HashWithSalt(code, *argonParams, salt)
+		if subtle.ConstantTimeCompare(givenHash, groundHash) == 0 {
+			continue
+		}
+		used, err := s.Env.Database.UseRecoveryCode(ctx, row.ID)
+		if err != nil {
+			return fmt.Errorf("using recovery code: %w", err)
+		}
+		if used == 0 {
+			// Used by a concurrent request
+			return ErrInvalidMFACode
+		}
+		return nil
+	}
+	return ErrInvalidMFACode
+}
+
+// CreateMFAChallenge starts the second step of logging in, for a user who
+// has entered the right password. The returned token is exchanged, together
+// with a code, for a session with CompleteMFAChallenge.
+func (s *Service) CreateMFAChallenge(ctx context.Context, userID uuid.UUID) (string, error) {
+	if err := s.Env.Database.DeleteExpiredUserMFAChallenges(ctx, userID); err != nil {
+		return "", fmt.Errorf("deleting expired challenges: %w", err)
+	}
+
+	challengeID := uuid.New()
+	token, err := tokens.CreateMFAToken(challengeID)
+	if err != nil {
+		return "", fmt.Errorf("creating mfa token: %w", err)
+	}
+	tokenHash, err := argon2id.HashAndEncode(token, argon2id.DefaultParams)
+	if err != nil {
+		return "", fmt.Errorf("hashing mfa token: %w", err)
+	}
+	err = s.Env.Database.CreateMFAChallenge(ctx, database.CreateMFAChallengeParams{
+		ID:        challengeID,
+		UserID:    userID,
+		TokenHash: tokenHash,
+		ExpiresAt: pgtype.Timestamptz{
+			Time:  time.Now().Add(tokens.MFAChallengeDuration()),
+			Valid: true,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("storing challenge: %w", err)
+	}
+	return token, nil
+}
+
+// CompleteMFAChallenge checks the code for a challenge, returning the user
+// logging in. A challenge can only be completed once, and is discarded after
+// too many wrong codes. Wrong codes also count towards the user's lockout, so
+// starting new challenges doesn't allow more guesses.
+func (s *Service) CompleteMFAChallenge(ctx context.Context, token, code string) (uuid.UUID, error) {
+	challengeID, err := tokens.ParseMFAToken(token)
+	if err != nil {
+		return uuid.Nil, ErrInvalidMFAToken
+	}
+	challenge, err := s.Env.Database.GetMFAChallenge(ctx, challengeID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return uuid.Nil, ErrInvalidMFAToken
+	} else if err != nil {
+		return uuid.Nil, fmt.Errorf("getting challenge: %w", err)
+	}
+	argonParams, salt, groundHash, err := argon2id.DecodeHash(challenge.TokenHash)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("decoding mfa token hash: %w", err)
+	}
+	givenHash := argon2id.HashWithSalt(token, *argonParams, salt)
+	if subtle.ConstantTimeCompare(givenHash, groundHash) == 0 {
+		return uuid.Nil, ErrInvalidMFAToken
+	}
+	if challenge.ExpiresAt.Time.Before(time.Now()) {
+		_ = s.Env.Database.DeleteMFAChallenge(ctx, challengeID)
+		return uuid.Nil, ErrInvalidMFAToken
+	}
+
+	// Count the attempt before checking the code, so concurrent guesses are
+	// limited too
+	attempts, err := s.Env.Database.IncrementMFAChallengeAttempts(ctx, challengeID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return uuid.Nil, ErrInvalidMFAToken
+	} else if err != nil {
+		return uuid.Nil, fmt.Errorf("counting attempt: %w", err)
+	}
+	if attempts > mfaChallengeAttempts {
+		if err := s.Env.Database.DeleteMFAChallenge(ctx, challengeID); err != nil {
+			return uuid.Nil, fmt.Errorf("deleting challenge: %w", err)
+		}
+		return uuid.Nil, ErrInvalidMFAToken
+	}
+
+	if err := s.VerifyMFACode(ctx, challenge.UserID, code); err != nil {
+		if errors.Is(err, ErrTOTPNotEnrolled) {
+			// TOTP was disabled since the challenge was created, so it
+			// can't be completed
+			return uuid.Nil, ErrInvalidMFAToken
+		}
+		return uuid.Nil, err
+	}
+	if err := s.Env.Database.DeleteMFAChallenge(ctx, challengeID); err != nil {
+		return uuid.Nil, fmt.Errorf("deleting challenge: %w", err)
+	}
+	return challenge.UserID, nil
+}
+
+// newRecoveryCode returns a random code written as two groups of 8
+// characters, e.g. "abcdefgh-ijklmnop".
+func newRecoveryCode() (string, error) {
+	bytes := make([]byte, recoveryCodeBytes)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	code := strings.ToLower(recoveryCodeEncoding.EncodeToString(bytes))
+	half := len(code) / 2
+	return code[:half] + "-" + code[half:], nil
+}
+
+// normalizeRecoveryCode undoes the formatting of a recovery code, so codes
+// entered in any case and with or without separators are accepted.
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
@@ -0,0 +1,148 @@
+package mars
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"mars/internal/database"
+	"mars/internal/totp"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// enableTOTP enables TOTP for the test user, returning the secret.
+func (e serviceTest) enableTOTP(t *testing.T) string {
+	t.Helper()
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret() = %v", err)
+	}
+	e.db.TOTP[e.userID] = database.UserTotp{
+		UserID:    e.userID,
+		Secret:    secret,
+		EnabledAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+	return secret
+}
+
+// complete completes a new challenge for the test user with a code.
+func (e serviceTest) complete(t *testing.T, code string) error {
+	t.Helper()
+	token, err := e.svc.CreateMFAChallenge(context.Background(), e.userID)
+	if err != nil {
+		t.Fatalf("CreateMFAChallenge() = %v", err)
+	}
+	_, err = e.svc.CompleteMFAChallenge(context.Background(), token, code)
+	return err
+}
+
+// currentCode returns the code of a secret for now.
+func currentCode(t *testing.T, secret string) string {
+	t.Helper()
+	code, err := totp.Code(secret, totp.Step(time.Now()))
+	if err != nil {
+		t.Fatalf("Code() = %v", err)
+	}
+	return code
+}
+
+// wrongCode returns a code that isn't valid for a secret around now.
+func wrongCode(t *testing.T, secret string) string {
+	t.Helper()
+	for i := range 1000 {
+		code := fmt.Sprintf("%06d", i)
+		_, ok, err := totp.Validate(secret, code, time.Now())
+		if err != nil {
+			t.Fatalf("Validate() = %v", err)
+		}
+		if !ok {
+			return code
+		}
+	}
+	t.Fatal("no wrong code found")
+	return ""
+}
+
+func TestCompleteMFAChallengeLocksOutAcrossChallenges(t *testing.T) {
+	e := newServiceTest(t)
+	secret := e.enableTOTP(t)
+	wrong := wrongCode(t, secret)
+
+	// A new challenge for every wrong code stays under the challenge limit
+	for i := range mfaUserAttempts {
+		if err := e.complete(t, wrong); !errors.Is(err, ErrInvalidMFACode) {
+			t.Fatalf("CompleteMFAChallenge() with wrong code %d = %v, want %v", i+1, err, ErrInvalidMFACode)
+		}
+	}
+
+	// Even the right code is refused once locked
+	code := currentCode(t, secret)
+	if err := e.complete(t, code); !errors.Is(err, ErrMFALocked) {
+		t.Fatalf("CompleteMFAChallenge() while locked = %v, want %v", err, ErrMFALocked)
+	}
+	if row := e.db.TOTP[e.userID]; row.LastUsedStep != 0 {
+		t.Errorf("last used step = %d while locked, want the code to be unused", row.LastUsedStep)
+	}
+
+	// The right code is accepted once the lockout ends, and the count reset
+	row := e.db.TOTP[e.userID]
+	row.LockedUntil.Time = time.Now().Add(-time.Second)
+	e.db.TOTP[e.userID] = row
+	if err := e.complete(t, code); err != nil {
+		t.Fatalf("CompleteMFAChallenge() after lockout = %v", err)
+	}
+	if row := e.db.TOTP[e.userID]; row.FailedAttempts != 0 || row.LockedUntil.Valid {
+		t.Errorf("attempts = %d, locked until %v after success, want reset", row.FailedAttempts, row.LockedUntil)
+	}
+}
+
+func TestCompleteMFAChallengeResetsAttemptsOnSuccess(t *testing.T) {
+	e := newServiceTest(t)
+	secret := e.enableTOTP(t)
+	wrong := wrongCode(t, secret)
+
+	for range mfaUserAttempts - 1 {
+		if err := e.complete(t, wrong); !errors.Is(err, ErrInvalidMFACode) {
+			t.Fatalf("CompleteMFAChallenge() with wrong code = %v, want %v", err, ErrInvalidMFACode)
+		}
+	}
+	if err := e.complete(t, currentCode(t, secret)); err != nil {
+		t.Fatalf("CompleteMFAChallenge() with right code = %v", err)
+	}
+
+	// The count started over, so as many wrong codes are allowed again
+	for i := range mfaUserAttempts {
+		if err := e.complete(t, wrong); !errors.Is(err, ErrInvalidMFACode) {
+			t.Fatalf("CompleteMFAChallenge() with wrong code %d after success = %v, want %v",
+				i+1, err, ErrInvalidMFACode)
+		}
+	}
+}
+
+func TestNewRecoveryCode(t *testing.T) {
+	shape := regexp.MustCompile(`^[a-z2-7]{8}-[a-z2-7]{8}$`)
+	seen := make(map[string]bool)
+	for range 100 {
+		code, err := newRecoveryCode()
+		if err != nil {
+			t.Fatalf("newRecoveryCode() = %v", err)
+		}
+		if !shape.MatchString(code) {
+			t.Fatalf("newRecoveryCode() = %q, want two groups of 8 base32 characters", code)
+		}
+		if seen[code] {
+			t.Fatalf("newRecoveryCode() returned %q twice", code)
+		}
+		seen[code] = true
+
+		raw, err := recoveryCodeEncoding.DecodeString(strings.ToUpper(normalizeRecoveryCode(code)))
+		if err != nil || len(raw) != recoveryCodeBytes {
+			t.Fatalf("code %q decodes to %d bytes (%v), want %d", code, len(raw), err, recoveryCodeBytes)
+		}
+	}
+}
@@ -0,0 +1,290 @@
+package mars
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"mars/internal/database"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	ceremonyRegistration = "registration"
+	ceremonyLogin        = "login"
+)
+
+// ErrInvalidPasskey is returned when a passkey registration or login fails
+// verification, or its ceremony doesn't exist or has expired.
+var ErrInvalidPasskey = errors.New("invalid passkey")
+
+// passkeyUser is a user and their passkeys, as the webauthn library sees them.
+type passkeyUser struct {
+	id          uuid.UUID
+	email       string
+	credentials []webauthn.Credential
+}
+
+// WebAuthnID returns the user handle stored in the user's passkeys, which is
+// their ID rather than anything that identifies them personally.
+func (u *passkeyUser) WebAuthnID() []byte {
+	return u.id[:]
+}
+
+func (u *passkeyUser) WebAuthnName() string {
+	return u.email
+}
+
+func (u *passkeyUser) WebAuthnDisplayName() string {
+	return u.email
+}
+
+func (u *passkeyUser) WebAuthnCredentials() []webauthn.Credential {
+	return u.credentials
+}
+
+// BeginPasskeyRegistration starts registering a passkey for a user. It
+// returns the options to pass to navigator.credentials.create() and the ID
+// of the ceremony, which FinishPasskeyRegistration needs.
+func (s *Service) BeginPasskeyRegistration(
+	ctx context.Context, userID uuid.UUID,
+) (*protocol.CredentialCreation, uuid.UUID, error) {
+	user, err := s.passkeyUser(ctx, userID)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+
+	// Passkeys are discoverable, so they can be used without entering an
+	// email, and verify the user, so they replace a password
+	creation, session, err := s.Env.WebAuthn.BeginRegistration(user,
+		webauthn.WithAuthenticatorSelection(protocol.AuthenticatorSelection{
+			ResidentKey:        protocol.ResidentKeyRequirementRequired,
+			RequireResidentKey: protocol.ResidentKeyRequired(),
+			UserVerification:   protocol.VerificationRequired,
+		}),
+		webauthn.WithExclusions(webauthn.Credentials(user.credentials).CredentialDescriptors()),
+	)
+	if err != nil {
+		return nil, uuid.Nil, fmt.Errorf("beginning registration: %w", err)
+	}
+	sessionID, err := s.storeWebAuthnSession(ctx, ceremonyRegistration, session)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+	return creation, sessionID, nil
+}
+
+// FinishPasskeyRegistration verifies the response of
+// navigator.credentials.create() and stores the new passkey under name.
+func (s *Service) FinishPasskeyRegistration(
+	ctx context.Context, userID, sessionID uuid.UUID, name string, response []byte,
+) (database.WebauthnCredential, error) {
+	session, err := s.takeWebAuthnSession(ctx, sessionID, ceremonyRegistration)
+	if err != nil {
+		return database.WebauthnCredential{}, err
+	}
+	user, err := s.passkeyUser(ctx, userID)
+	if err != nil {
+		return database.WebauthnCredential{}, err
+	}
+	// The ceremony must have been started by the same user
+	if !bytes.Equal(session.UserID, user.WebAuthnID()) {
+		return database.WebauthnCredential{}, fmt.Errorf("%w: session belongs to another user", ErrInvalidPasskey)
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(response)
+	if err != nil {
+		return database.WebauthnCredential{}, fmt.Errorf("%w: %w", ErrInvalidPasskey, err)
+	}
+	credential, err := s.Env.WebAuthn.CreateCredential(user, *session, parsed)
+	if err != nil {
+		return database.WebauthnCredential{}, fmt.Errorf("%w: %w", ErrInvalidPasskey, err)
+	}
+
+	// Store passkey
+	data, err := json.Marshal(credential)
+	if err != nil {
+		return database.WebauthnCredential{}, fmt.Errorf("encoding credential: %w", err)
+	}
+	passkey := database.WebauthnCredential{
+		ID:           uuid.New(),
+		UserID:       userID,
+		CredentialID: credential.ID,
+		Credential:   data,
+		Name:         name,
+		CreatedAt:    pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+	err = s.Env.Database.CreateWebAuthnCredential(ctx, database.CreateWebAuthnCredentialParams{
+		ID:           passkey.ID,
+		UserID:       passkey.UserID,
+		CredentialID: passkey.CredentialID,
+		Credential:   passkey.Credential,
+		Name:         passkey.Name,
+	})
+	if database.IsUniqueViolation(err, "webauthn_credentials_unique_credential_id") {
+		return database.WebauthnCredential{}, fmt.Errorf("%w: passkey already registered", ErrInvalidPasskey)
+	}
+	if err != nil {
+		return database.WebauthnCredential{}, fmt.Errorf("storing credential: %w", err)
+	}
+	return passkey, nil
+}
+
+// BeginPasskeyLogin starts logging in with a passkey. It returns the options
+// to pass to navigator.credentials.get() and the ID of the ceremony, which
+// FinishPasskeyLogin needs. The user is identified by the passkey they pick.
+func (s *Service) BeginPasskeyLogin(ctx context.Context) (*protocol.CredentialAssertion, uuid.UUID, error) {
+	assertion, session, err := s.Env.WebAuthn.BeginDiscoverableLogin(
+		webauthn.WithUserVerification(protocol.VerificationRequired),
+	)
+	if err != nil {
+		return nil, uuid.Nil, fmt.Errorf("beginning login: %w", err)
+	}
+	sessionID, err := s.storeWebAuthnSession(ctx, ceremonyLogin, session)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+	return assertion, sessionID, nil
+}
+
+// FinishPasskeyLogin verifies the response of navigator.credentials.get(),
+// returning the user logging in.
+func (s *Service) FinishPasskeyLogin(ctx context.Context, sessionID uuid.UUID, response []byte) (uuid.UUID, error) {
+	session, err := s.takeWebAuthnSession(ctx, sessionID, ceremonyLogin)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: %w", ErrInvalidPasskey, err)
+	}
+
+	// Look up the user by the passkey they picked. Errors other than an
+	// unknown passkey are kept, since the library reports any error from
+	// here as a bad request.
+	var (
+		passkeyID uuid.UUID
+		lookupErr error
+	)
+	handler := func(rawID, userHandle []byte) (webauthn.User, error) {
+		row, err := s.Env.Database.GetWebAuthnCredential(ctx, rawID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("unknown passkey")
+		} else if err != nil {
+			lookupErr = fmt.Errorf("getting credential: %w", err)
+			return nil, lookupErr
+		}
+		if !bytes.Equal(userHandle, row.UserID[:]) {
+			return nil, errors.New("user handle does not match passkey")
+		}
+		user, err := s.passkeyUser(ctx, row.UserID)
+		if err != nil {
+			lookupErr = err
+			return nil, err
+		}
+		passkeyID = row.ID
+		return user, nil
+	}
+	user, credential, err := s.Env.WebAuthn.ValidatePasskeyLogin(handler, *session, parsed)
+	if lookupErr != nil {
+		return uuid.Nil, lookupErr
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: %w", ErrInvalidPasskey, err)
+	}
+	if credential.Authenticator.CloneWarning {
+		return uuid.Nil, fmt.Errorf("%w: sign count went backwards, passkey may be cloned", ErrInvalidPasskey)
+	}
+
+	// Store the new sign count and flags
+	data, err := json.Marshal(credential)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("encoding credential: %w", err)
+	}
+	err = s.Env.Database.UpdateWebAuthnCredentialUse(ctx, database.UpdateWebAuthnCredentialUseParams{
+		ID:         passkeyID,
+		Credential: data,
+	})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("updating credential: %w", err)
+	}
+	return user.(*passkeyUser).id, nil
+}
+
+// passkeyUser gets a user and their passkeys.
+func (s *Service) passkeyUser(ctx context.Context, userID uuid.UUID) (*passkeyUser, error) {
+	user, err := s.Env.Database.GetUser(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUserNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("getting user: %w", err)
+	}
+	rows, err := s.Env.Database.ListUserWebAuthnCredentials(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listing credentials: %w", err)
+	}
+	credentials := make([]webauthn.Credential, len(rows))
+	for i, row := range rows {
+		if err := json.Unmarshal(row.Credential, &credentials[i]); err != nil {
+			return nil, fmt.Errorf("decoding credential %s: %w", row.ID, err)
+		}
+	}
+	return &passkeyUser{id: userID, email: user.Email, credentials: credentials}, nil
+}
+
+// storeWebAuthnSession stores the session data of a ceremony until it is
+// finished, returning its ID.
+func (s *Service) storeWebAuthnSession(
+	ctx context.Context, ceremony string, session *webauthn.SessionData,
+) (uuid.UUID, error) {
+	if err := s.Env.Database.DeleteExpiredWebAuthnSessions(ctx); err != nil {
+		return uuid.Nil, fmt.Errorf("deleting expired sessions: %w", err)
+	}
+	data, err := json.Marshal(session)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("encoding session: %w", err)
+	}
+	id := uuid.New()
+	err = s.Env.Database.CreateWebAuthnSession(ctx, database.CreateWebAuthnSessionParams{
+		ID:          id,
+		Ceremony:    ceremony,
+		SessionData: data,
+		ExpiresAt:   pgtype.Timestamptz{Time: session.Expires, Valid: true},
+	})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("storing session: %w", err)
+	}
+	return id, nil
+}
+
+// takeWebAuthnSession removes the session data of a ceremony and returns it,
+// so each ceremony can only be finished once.
+func (s *Service) takeWebAuthnSession(
+	ctx context.Context, id uuid.UUID, ceremony string,
+) (*webauthn.SessionData, error) {
+	row, err := s.Env.Database.TakeWebAuthnSession(ctx, database.TakeWebAuthnSessionParams{
+		ID:       id,
+		Ceremony: ceremony,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%w: unknown session", ErrInvalidPasskey)
+	} else if err != nil {
+		return nil, fmt.Errorf("taking session: %w", err)
+	}
+	if row.ExpiresAt.Time.Before(time.Now()) {
+		return nil, fmt.Errorf("%w: session expired", ErrInvalidPasskey)
+	}
+	var session webauthn.SessionData
+	if err := json.Unmarshal(row.SessionData, &session); err != nil {
+		return nil, fmt.Errorf("decoding session: %w", err)
+	}
+	return &session, nil
+}
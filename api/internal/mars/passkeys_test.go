@@ -0,0 +1,340 @@
+package mars
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"mars/internal/setup"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+)
+
+const (
+	passkeyRPID   = "mars.test"
+	passkeyOrigin = "https://" + passkeyRPID
+)
+
+// testAuthenticator is a software passkey, which answers ceremonies the way
+// a browser and authenticator would.
+type testAuthenticator struct {
+	key       *ecdsa.PrivateKey
+	id        []byte
+	signCount uint32
+}
+
+func newTestAuthenticator(t *testing.T) *testAuthenticator {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() = %v", err)
+	}
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
+	return &testAuthenticator{key: key, id: id}
+}
+
+// authData returns the authenticator data of a ceremony, with the user
+// present and verified.
+func (a *testAuthenticator) authData(flags byte, attested []byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(passkeyRPID))
+	data := append(rpIDHash[:], flags|0x01|0x04)
+	data = binary.BigEndian.AppendUint32(data, a.signCount)
+	return append(data, attested...)
+}
+
+func clientData(t *testing.T, ceremony string, challenge protocol.URLEncodedBase64) []byte {
+	t.Helper()
+	data, err := json.Marshal(map[string]string{
+		"type":      ceremony,
+		"challenge": base64.RawURLEncoding.EncodeToString(challenge),
+		"origin":    passkeyOrigin,
+	})
+	if err != nil {
+		t.Fatalf("encoding client data: %v", err)
+	}
+	return data
+}
+
+func encodeResponse(t *testing.T, id []byte, response map[string][]byte) []byte {
+	t.Helper()
+	fields := make(map[string]string, len(response))
+	for k, v := range response {
+		fields[k] = base64.RawURLEncoding.EncodeToString(v)
+	}
+	data, err := json.Marshal(map[string]any{
+		"id":       base64.RawURLEncoding.EncodeToString(id),
+		"rawId":    base64.RawURLEncoding.EncodeToString(id),
+		"type":     "public-key",
+		"response": fields,
+	})
+	if err != nil {
+		t.Fatalf("encoding response: %v", err)
+	}
+	return data
+}
+
+// register answers navigator.credentials.create() with a new passkey.
+func (a *testAuthenticator) register(t *testing.T, creation *protocol.CredentialCreation) []byte {
+	t.Helper()
+	publicKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  1, // P-256
+		XCoord: a.key.X.FillBytes(make([]byte, 32)),
+		YCoord: a.key.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		t.Fatalf("encoding public key: %v", err)
+	}
+	attested := make([]byte, 16) // AAGUID
+	attested = binary.BigEndian.AppendUint16(attested, uint16(len(a.id)))
+	attested = append(attested, a.id...)
+	attested = append(attested, publicKey...)
+
+	attestation, err := webauthncbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": a.authData(0x40, attested),
+	})
+	if err != nil {
+		t.Fatalf("encoding attestation: %v", err)
+	}
+	return encodeResponse(t, a.id, map[string][]byte{
+		"clientDataJSON":    clientData(t, "webauthn.create", creation.Response.Challenge),
+		"attestationObject": attestation,
+	})
+}
+
+// login answers navigator.credentials.get(), as the user with userHandle.
+func (a *testAuthenticator) login(t *testing.T, assertion *protocol.CredentialAssertion, userHandle []byte) []byte {
+	t.Helper()
+	a.signCount++
+	authData := a.authData(0, nil)
+	client := clientData(t, "webauthn.get", assertion.Response.Challenge)
+	clientHash := sha256.Sum256(client)
+	digest := sha256.Sum256(append(authData, clientHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		t.Fatalf("signing assertion: %v", err)
+	}
+	return encodeResponse(t, a.id, map[string][]byte{
+		"clientDataJSON":    client,
+		"authenticatorData": authData,
+		"signature":         signature,
+		"userHandle":        userHandle,
+	})
+}
+
+func newPasskeyTest(t *testing.T) serviceTest {
+	e := newServiceTest(t)
+	var err error
+	e.svc.Env.WebAuthn, err = setup.WebAuthn(passkeyOrigin)
+	if err != nil {
+		t.Fatalf("WebAuthn() = %v", err)
+	}
+	e.db.Emails[e.userID] = "user@mars.test"
+	return e
+}
+
+// registerPasskey registers a new passkey for the test user.
+func (e serviceTest) registerPasskey(t *testing.T) *testAuthenticator {
+	t.Helper()
+	ctx := context.Background()
+	a := newTestAuthenticator(t)
+	creation, sessionID, err := e.svc.BeginPasskeyRegistration(ctx, e.userID)
+	if err != nil {
+		t.Fatalf("BeginPasskeyRegistration() = %v", err)
+	}
+	_, err = e.svc.FinishPasskeyRegistration(ctx, e.userID, sessionID, "laptop", a.register(t, creation))
+	if err != nil {
+		t.Fatalf("FinishPasskeyRegistration() = %v", err)
+	}
+	return a
+}
+
+// loginResponse starts a passkey login and answers it as the user with
+// userHandle, returning the ceremony and response.
+func (e serviceTest) loginResponse(t *testing.T, a *testAuthenticator, userHandle []byte) (uuid.UUID, []byte) {
+	t.Helper()
+	assertion, sessionID, err := e.svc.BeginPasskeyLogin(context.Background())
+	if err != nil {
+		t.Fatalf("BeginPasskeyLogin() = %v", err)
+	}
+	return sessionID, a.login(t, assertion, userHandle)
+}
+
+func TestFinishPasskeyRegistrationTakesSession(t *testing.T) {
+	e := newPasskeyTest(t)
+	ctx := context.Background()
+	a := newTestAuthenticator(t)
+	creation, sessionID, err := e.svc.BeginPasskeyRegistration(ctx, e.userID)
+	if err != nil {
+		t.Fatalf("BeginPasskeyRegistration() = %v", err)
+	}
+	response := a.register(t, creation)
+
+	passkey, err := e.svc.FinishPasskeyRegistration(ctx, e.userID, sessionID, "laptop", response)
+	if err != nil {
+		t.Fatalf("FinishPasskeyRegistration() = %v", err)
+	}
+	if stored, ok := e.db.Passkeys[passkey.ID]; !ok || stored.UserID != e.userID {
+		t.Errorf("stored passkey = %+v, want one for user %s", stored, e.userID)
+	}
+	if _, ok := e.db.WebAuthnSessions[sessionID]; ok {
+		t.Error("session still stored after finishing, want it taken")
+	}
+
+	// Replaying the same ceremony fails, as its session was taken
+	_, err = e.svc.FinishPasskeyRegistration(ctx, e.userID, sessionID, "laptop", response)
+	if !errors.Is(err, ErrInvalidPasskey) {
+		t.Errorf("FinishPasskeyRegistration() again = %v, want %v", err, ErrInvalidPasskey)
+	}
+	if len(e.db.Passkeys) != 1 {
+		t.Errorf("stored %d passkeys, want 1", len(e.db.Passkeys))
+	}
+}
+
+func TestFinishPasskeyRegistrationRejectsSession(t *testing.T) {
+	tests := []struct {
+		name string
+		// begin starts a ceremony, returning its session and the options
+		// the authenticator answers
+		begin func(t *testing.T, e serviceTest) (uuid.UUID, *protocol.CredentialCreation)
+	}{
+		{
+			name: "login ceremony",
+			begin: func(t *testing.T, e serviceTest) (uuid.UUID, *protocol.CredentialCreation) {
+				creation, _, err := e.svc.BeginPasskeyRegistration(context.Background(), e.userID)
+				if err != nil {
+					t.Fatalf("BeginPasskeyRegistration() = %v", err)
+				}
+				_, sessionID, err := e.svc.BeginPasskeyLogin(context.Background())
+				if err != nil {
+					t.Fatalf("BeginPasskeyLogin() = %v", err)
+				}
+				return sessionID, creation
+			},
+		},
+		{
+			name: "expired",
+			begin: func(t *testing.T, e serviceTest) (uuid.UUID, *protocol.CredentialCreation) {
+				creation, sessionID, err := e.svc.BeginPasskeyRegistration(context.Background(), e.userID)
+				if err != nil {
+					t.Fatalf("BeginPasskeyRegistration() = %v", err)
+				}
+				session := e.db.WebAuthnSessions[sessionID]
+				session.ExpiresAt.Time = time.Now().Add(-time.Second)
+				e.db.WebAuthnSessions[sessionID] = session
+				return sessionID, creation
+			},
+		},
+		{
+			name: "another user",
+			begin: func(t *testing.T, e serviceTest) (uuid.UUID, *protocol.CredentialCreation) {
+				other := uuid.New()
+				e.db.Emails[other] = "other@mars.test"
+				creation, sessionID, err := e.svc.BeginPasskeyRegistration(context.Background(), other)
+				if err != nil {
+					t.Fatalf("BeginPasskeyRegistration() = %v", err)
+				}
+				return sessionID, creation
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newPasskeyTest(t)
+			sessionID, creation := tt.begin(t, e)
+			response := newTestAuthenticator(t).register(t, creation)
+
+			_, err := e.svc.FinishPasskeyRegistration(context.Background(), e.userID, sessionID, "laptop", response)
+			if !errors.Is(err, ErrInvalidPasskey) {
+				t.Errorf("FinishPasskeyRegistration() = %v, want %v", err, ErrInvalidPasskey)
+			}
+			if len(e.db.Passkeys) != 0 {
+				t.Errorf("stored %d passkeys, want none", len(e.db.Passkeys))
+			}
+		})
+	}
+}
+
+func TestFinishPasskeyLogin(t *testing.T) {
+	e := newPasskeyTest(t)
+	a := e.registerPasskey(t)
+
+	sessionID, response := e.loginResponse(t, a, e.userID[:])
+	userID, err := e.svc.FinishPasskeyLogin(context.Background(), sessionID, response)
+	if err != nil {
+		t.Fatalf("FinishPasskeyLogin() = %v", err)
+	}
+	if userID != e.userID {
+		t.Errorf("FinishPasskeyLogin() = %s, want %s", userID, e.userID)
+	}
+
+	// The ceremony can't be finished again
+	_, err = e.svc.FinishPasskeyLogin(context.Background(), sessionID, response)
+	if !errors.Is(err, ErrInvalidPasskey) {
+		t.Errorf("FinishPasskeyLogin() again = %v, want %v", err, ErrInvalidPasskey)
+	}
+}
+
+func TestFinishPasskeyLoginRejectsUserHandle(t *testing.T) {
+	e := newPasskeyTest(t)
+	a := e.registerPasskey(t)
+	other := uuid.New()
+	e.db.Emails[other] = "other@mars.test"
+
+	sessionID, response := e.loginResponse(t, a, other[:])
+	_, err := e.svc.FinishPasskeyLogin(context.Background(), sessionID, response)
+	if !errors.Is(err, ErrInvalidPasskey) {
+		t.Errorf("FinishPasskeyLogin() as another user = %v, want %v", err, ErrInvalidPasskey)
+	}
+}
+
+func TestFinishPasskeyLoginRejectsClone(t *testing.T) {
+	e := newPasskeyTest(t)
+	a := e.registerPasskey(t)
+
+	sessionID, response := e.loginResponse(t, a, e.userID[:])
+	if _, err := e.svc.FinishPasskeyLogin(context.Background(), sessionID, response); err != nil {
+		t.Fatalf("FinishPasskeyLogin() = %v", err)
+	}
+	var stored []byte
+	for _, passkey := range e.db.Passkeys {
+		stored = passkey.Credential
+	}
+
+	// A copy of the passkey that hasn't seen the last login signs with an
+	// older count
+	a.signCount--
+	sessionID, response = e.loginResponse(t, a, e.userID[:])
+	_, err := e.svc.FinishPasskeyLogin(context.Background(), sessionID, response)
+	if !errors.Is(err, ErrInvalidPasskey) {
+		t.Errorf("FinishPasskeyLogin() with an old sign count = %v, want %v", err, ErrInvalidPasskey)
+	}
+	for _, passkey := range e.db.Passkeys {
+		var credential webauthn.Credential
+		if err := json.Unmarshal(passkey.Credential, &credential); err != nil {
+			t.Fatalf("decoding stored credential: %v", err)
+		}
+		if string(passkey.Credential) != string(stored) || credential.Authenticator.SignCount != 1 {
+			t.Errorf("stored credential changed to sign count %d, want it kept at 1",
+				credential.Authenticator.SignCount)
+		}
+	}
+}
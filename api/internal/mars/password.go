@@ -0,0 +1,213 @@
+package mars
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"mars/internal/argon2id"
+	"mars/internal/database"
+	"mars/internal/mail"
+	"mars/internal/tokens"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// passwordResetsPerEmail is the number of password reset emails that may
+	// be requested for an email per hour.
+	passwordResetsPerEmail = 3
+	// passwordResetsPerIP is the number of password reset emails a client may
+	// request per hour.
+	passwordResetsPerIP = 10
+)
+
+// ErrInvalidResetToken is returned when a password reset token doesn't exist,
+// doesn't match, has expired or has already been used.
+var ErrInvalidResetToken = errors.New("invalid password reset token")
+
+// ChangePassword sets a user's password. Every other session of the user is
+// ended and their access tokens are revoked. The session the password was
+// changed from is kept, so it can refresh its tokens.
+func (s *Service) ChangePassword(ctx context.Context, userID, sessionID uuid.UUID, password string) error {
+	passwordHash, err := argon2id.HashAndEncode(password, argon2id.DefaultParams)
+	if err != nil {
+		return fmt.Errorf("hashing password: %w", err)
+	}
+
+	tx, err := s.Env.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := database.New(tx)
+
+	err = qtx.UpdateUserPassword(ctx, database.UpdateUserPasswordParams{
+		ID:           userID,
+		PasswordHash: passwordHash,
+	})
+	if err != nil {
+		return fmt.Errorf("updating password: %w", err)
+	}
+	if _, err := qtx.IncrementUserTokenVersion(ctx, userID); err != nil {
+		return fmt.Errorf("incrementing token version: %w", err)
+	}
+	err = qtx.DeleteOtherUserSessions(ctx, database.DeleteOtherUserSessionsParams{
+		UserID: userID,
+		ID:     sessionID,
+	})
+	if err != nil {
+		return fmt.Errorf("deleting sessions: %w", err)
+	}
+	// Outstanding reset links would let someone undo the change
+	if err := qtx.DeleteUserPasswordResets(ctx, userID); err != nil {
+		return fmt.Errorf("deleting password resets: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// SendPasswordReset emails a single-use link for resetting the password to
+// the user with the given email. The email is sent in the background, and
+// nothing is reported back, so callers can't tell whether an email is in use
+// from the result or how long it took. Requests are throttled per email and
+// per client IP, and requests over the limit are dropped.
+func (s *Service) SendPasswordReset(ctx context.Context, email, clientIP string) {
+	// The client is checked first, so one client can't use up the limit of
+	// someone else's email
+	if !s.resetsPerIP.Allow(clientIP) || !s.resetsPerEmail.Allow(email) {
+		s.Env.Logger.WarnContext(ctx, "too many password resets requested, dropping request")
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	s.background.Go(func() {
+		if err := s.sendPasswordReset(ctx, email); err != nil {
+			s.Env.Logger.ErrorContext(ctx, "failed to send password reset", slog.Any("error", err))
+		}
+	})
+}
+
+// sendPasswordReset creates a reset token for the user with the given email
+// and emails them a link to use it. Nothing is sent if there is no such user.
+func (s *Service) sendPasswordReset(ctx context.Context, email string) error {
+	user, err := s.Env.Database.GetUserByEmail(ctx, email)
+	if errors.Is(err, pgx.ErrNoRows) {
+		s.Env.Logger.InfoContext(ctx, "password reset requested for unknown email")
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("getting user: %w", err)
+	}
+
+	// Create reset token
+	resetID := uuid.New()
+	token, err := tokens.CreatePasswordResetToken(resetID)
+	if err != nil {
+		return fmt.Errorf("creating reset token: %w", err)
+	}
+	tokenHash, err := argon2id.HashAndEncode(token, argon2id.DefaultParams)
+	if err != nil {
+		return fmt.Errorf("hashing reset token: %w", err)
+	}
+	expiresAt := time.Now().Add(tokens.PasswordResetTokenDuration())
+	err = s.Env.Database.CreatePasswordReset(ctx, database.CreatePasswordResetParams{
+		ID:        resetID,
+		UserID:    user.ID,
+		TokenHash: tokenHash,
+		ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("storing reset token: %w", err)
+	}
+
+	// Email link
+	link := strings.TrimSuffix(s.Env.AppURL, "/") + "/reset-password?token=" + token
+	err = s.Env.Mailer.Send(ctx, mail.Message{
+		To:      user.Email,
+		Subject: "Reset your mars password",
+		Body: "Someone asked to reset the password of your mars account.\n\n" +
+			"To choose a new password, open this link within the next hour:\n\n" +
+			link + "\n\n" +
+			"If you didn't ask for this, you can ignore this email and your password won't change.\n",
+	})
+	if err != nil {
+		return fmt.Errorf("sending reset email: %w", err)
+	}
+	return nil
+}
+
+// ResetPassword sets the password of the user a reset token was issued to.
+// The token can only be used once. Every session of the user is ended and
+// their access tokens are revoked.
+func (s *Service) ResetPassword(ctx context.Context, token, password string) error {
+	resetID, err := tokens.ParsePasswordResetToken(token)
+	if err != nil {
+		return ErrInvalidResetToken
+	}
+	reset, err := s.Env.Database.GetPasswordReset(ctx, resetID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrInvalidResetToken
+	} else if err != nil {
+		return fmt.Errorf("getting password reset: %w", err)
+	}
+	argonParams, salt, groundHash, err := argon2id.DecodeHash(reset.TokenHash)
+	if err != nil {
+		return fmt.Errorf("decoding reset token hash: %w", err)
+	}
+	givenHash := argon2id.HashWithSalt(token, *argonParams, salt)
+	if subtle.ConstantTimeCompare(givenHash, groundHash) == 0 {
+		return ErrInvalidResetToken
+	}
+
+	passwordHash, err := argon2id.HashAndEncode(password, argon2id.DefaultParams)
+	if err != nil {
+		return fmt.Errorf("hashing password: %w", err)
+	}
+
+	tx, err := s.Env.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := database.New(tx)
+
+	// Redeeming checks the token is unused and unexpired, and makes sure
+	// concurrent requests can't both use it
+	redeemed, err := qtx.RedeemPasswordReset(ctx, resetID)
+	if err != nil {
+		return fmt.Errorf("redeeming password reset: %w", err)
+	}
+	if redeemed == 0 {
+		return ErrInvalidResetToken
+	}
+	err = qtx.UpdateUserPassword(ctx, database.UpdateUserPasswordParams{
+		ID:           reset.UserID,
+		PasswordHash: passwordHash,
+	})
+	if err != nil {
+		return fmt.Errorf("updating password: %w", err)
+	}
+	if err := qtx.DeleteUserPasswordResets(ctx, reset.UserID); err != nil {
+		return fmt.Errorf("deleting password resets: %w", err)
+	}
+	if _, err := qtx.IncrementUserTokenVersion(ctx, reset.UserID); err != nil {
+		return fmt.Errorf("incrementing token version: %w", err)
+	}
+	if err := qtx.DeleteUserSessions(ctx, reset.UserID); err != nil {
+		return fmt.Errorf("deleting sessions: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package mars
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"mars/internal/mail"
+	"mars/internal/tokens"
+
+	"github.com/google/uuid"
+)
+
+// fakeMailer keeps the emails it is asked to send, or fails to send them.
+type fakeMailer struct {
+	mu       sync.Mutex
+	messages []mail.Message
+	err      error
+}
+
+func (m *fakeMailer) Send(_ context.Context, msg mail.Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.err != nil {
+		return m.err
+	}
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+// passwordResetTest is a service with a user whose email is known, and which
+// sends emails to a fake mailer.
+type passwordResetTest struct {
+	serviceTest
+	mailer *fakeMailer
+	email  string
+}
+
+func newPasswordResetTest(t *testing.T) passwordResetTest {
+	e := newServiceTest(t)
+	mailer := &fakeMailer{}
+	e.svc.Env.Mailer = mailer
+	e.svc.Env.AppURL = "https://mars.test/"
+	email := "user@mars.test"
+	e.db.Emails[e.userID] = email
+	return passwordResetTest{serviceTest: e, mailer: mailer, email: email}
+}
+
+func TestSendPasswordReset(t *testing.T) {
+	e := newPasswordResetTest(t)
+
+	e.svc.SendPasswordReset(context.Background(), e.email, "192.0.2.1")
+	e.svc.Wait()
+
+	if len(e.mailer.messages) != 1 {
+		t.Fatalf("sent %d emails, want 1", len(e.mailer.messages))
+	}
+	msg := e.mailer.messages[0]
+	if msg.To != e.email {
+		t.Errorf("email sent to %q, want %q", msg.To, e.email)
+	}
+	_, token, ok := strings.Cut(msg.Body, "https://mars.test/reset-password?token=")
+	if !ok {
+		t.Fatalf("email body = %q, want a reset link", msg.Body)
+	}
+	token, _, _ = strings.Cut(token, "\n")
+	resetID, err := tokens.ParsePasswordResetToken(token)
+	if err != nil {
+		t.Fatalf("ParsePasswordResetToken(%q) = %v", token, err)
+	}
+	if reset, ok := e.db.PasswordResets[resetID]; !ok || reset.UserID != e.userID {
+		t.Errorf("stored reset = %+v, want one for user %s", reset, e.userID)
+	}
+}
+
+func TestSendPasswordResetSendsNothing(t *testing.T) {
+	tests := []struct {
+		name      string
+		email     string
+		mailerErr error
+	}{
+		{name: "unknown email", email: "nobody@mars.test"},
+		{name: "mailer fails", mailerErr: errors.New("mail server down")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newPasswordResetTest(t)
+			e.mailer.err = tt.mailerErr
+			email := tt.email
+			if email == "" {
+				email = e.email
+			}
+
+			// Nothing is returned either way, so callers can't tell these
+			// apart from a sent email
+			e.svc.SendPasswordReset(context.Background(), email, "192.0.2.1")
+			e.svc.Wait()
+
+			if len(e.mailer.messages) != 0 {
+				t.Errorf("sent %d emails, want none", len(e.mailer.messages))
+			}
+		})
+	}
+}
+
+func TestSendPasswordResetThrottlesPerEmail(t *testing.T) {
+	e := newPasswordResetTest(t)
+
+	// Requests from different clients still count towards the email's limit
+	for i := range passwordResetsPerEmail + 2 {
+		e.svc.SendPasswordReset(context.Background(), e.email, fmt.Sprintf("192.0.2.%d", i))
+	}
+	e.svc.Wait()
+
+	if len(e.mailer.messages) != passwordResetsPerEmail {
+		t.Errorf("sent %d emails, want %d", len(e.mailer.messages), passwordResetsPerEmail)
+	}
+}
+
+func TestSendPasswordResetThrottlesPerClient(t *testing.T) {
+	e := newPasswordResetTest(t)
+	for range passwordResetsPerIP {
+		e.db.Emails[uuid.New()] = fmt.Sprintf("%s@mars.test", uuid.NewString())
+	}
+	emails := make([]string, 0, len(e.db.Emails))
+	for _, email := range e.db.Emails {
+		emails = append(emails, email)
+	}
+
+	for _, email := range emails {
+		e.svc.SendPasswordReset(context.Background(), email, "192.0.2.1")
+	}
+	e.svc.Wait()
+
+	if len(e.mailer.messages) != passwordResetsPerIP {
+		t.Errorf("sent %d emails, want %d", len(e.mailer.messages), passwordResetsPerIP)
+	}
+
+	// The limit of the emails that weren't sent wasn't used up
+	sent := make(map[string]bool)
+	for _, msg := range e.mailer.messages {
+		sent[msg.To] = true
+	}
+	for _, email := range emails {
+		if !sent[email] {
+			e.svc.SendPasswordReset(context.Background(), email, "198.51.100.1")
+		}
+	}
+	e.svc.Wait()
+	if len(e.mailer.messages) != len(emails) {
+		t.Errorf("sent %d emails after switching client, want %d", len(e.mailer.messages), len(emails))
+	}
+}
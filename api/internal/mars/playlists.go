@@ -0,0 +1,307 @@
+package mars
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"mars/internal/calendar"
+	"mars/internal/database"
+	"mars/internal/log"
+	"mars/internal/playlist"
+	"mars/internal/provider"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// RollingPlaylistDays is the length of the window of rolling_30d playlists.
+const RollingPlaylistDays = 30
+
+// PlaylistPeriods maps the playlist types that cover a calendar period to the period.
+var PlaylistPeriods = map[string]calendar.Period{
+	"weekly":  calendar.Week,
+	"monthly": calendar.Month,
+	"yearly":  calendar.Year,
+}
+
+// UserCalendar returns the timezone and first day of the week of a user.
+func (s *Service) UserCalendar(ctx context.Context, userid uuid.UUID) (*time.Location, time.Weekday, error) {
+	prefs, err := s.Env.Database.GetUserPreferences(ctx, userid)
+	if err != nil {
+		return nil, 0, fmt.Errorf("getting user preferences: %w", err)
+	}
+	loc, err := calendar.LoadLocation(prefs.Timezone)
+	if err != nil {
+		return nil, 0, err
+	}
+	return loc, time.Weekday(prefs.WeekStart), nil
+}
+
+// PlaylistRules returns the playlist rules of a user, or the default rules if
+// the user hasn't set any.
+func (s *Service) PlaylistRules(ctx context.Context, userid uuid.UUID) (playlist.Rules, error) {
+	row, err := s.Env.Database.GetPlaylistRules(ctx, userid)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return playlist.DefaultRules, nil
+	} else if err != nil {
+		return playlist.Rules{}, fmt.Errorf("getting playlist rules: %w", err)
+	}
+	return playlist.Rules{
+		MaxTracks:          int(row.MaxTracks),
+		MinPlays:           int(row.MinPlays),
+		Ordering:           playlist.Ordering(row.Ordering),
+		MaxTracksPerArtist: int(row.MaxTracksPerArtist),
+		NameTemplate:       row.NameTemplate,
+	}, nil
+}
+
+// LatestPeriod returns the range covered by a playlist of the given type as of
+// a time: the most recent week, month or year that ended at or before asOf,
+// or the 30 days before asOf for rolling playlists. asOf should be in the
+// user's timezone.
+func LatestPeriod(playlistType string, asOf time.Time, weekStart time.Weekday) (start, end time.Time) {
+	if playlistType == "rolling_30d" {
+		return calendar.Trailing(RollingPlaylistDays, asOf)
+	}
+	return calendar.LastCompleted(PlaylistPeriods[playlistType], asOf, weekStart)
+}
+
+// playlistExists reports whether a user's scheduled playlist of the given type
+// already covers the period starting at start. Rolling playlists exist if the
+// user's rolling playlist was last moved to the same window.
+func (s *Service) playlistExists(
+	ctx context.Context, userid uuid.UUID, playlistType string, start time.Time,
+) (bool, error) {
+	if playlistType == "rolling_30d" {
+		existing, err := s.Env.Database.GetLatestUserPlaylistByType(ctx, database.GetLatestUserPlaylistByTypeParams{
+			UserID:       userid,
+			PlaylistType: database.PlaylistType(playlistType),
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		} else if err != nil {
+			return false, fmt.Errorf("getting rolling playlist: %w", err)
+		}
+		return existing.PeriodStart.Valid && existing.PeriodStart.Time.Equal(start), nil
+	}
+	if _, ok := PlaylistPeriods[playlistType]; !ok {
+		return false, nil
+	}
+
+	_, err := s.Env.Database.GetUserPlaylistByPeriod(ctx, database.GetUserPlaylistByPeriodParams{
+		UserID:       userid,
+		PlaylistType: database.PlaylistType(playlistType),
+		PeriodStart: pgtype.Timestamptz{
+			Time:  start,
+			Valid: true,
+		},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("checking for existing playlist: %w", err)
+	}
+	return true, nil
+}
+
+// replaceRollingPlaylist moves a user's rolling playlist to a new window and
+// clears its tracks, creating the playlist if the user doesn't have one yet.
+func replaceRollingPlaylist(
+	ctx context.Context, db database.Querier, params database.CreatePlaylistParams,
+) (uuid.UUID, error) {
+	existing, err := db.GetLatestUserPlaylistByType(ctx, database.GetLatestUserPlaylistByTypeParams{
+		UserID:       params.UserID,
+		PlaylistType: params.PlaylistType,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.CreatePlaylist(ctx, params)
+	} else if err != nil {
+		return uuid.Nil, fmt.Errorf("getting rolling playlist: %w", err)
+	}
+	err = db.UpdatePlaylistPeriod(ctx, database.UpdatePlaylistPeriodParams{
+		ID:          existing.ID,
+		Name:        params.Name,
+		PeriodStart: params.PeriodStart,
+		Description: params.Description,
+	})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("updating rolling playlist: %w", err)
+	}
+	if err := db.DeletePlaylistTracks(ctx, existing.ID); err != nil {
+		return uuid.Nil, fmt.Errorf("clearing rolling playlist tracks: %w", err)
+	}
+	return existing.ID, nil
+}
+
+// CreatePlaylist creates a user's playlist of the given type from the tracks
+// they listened to between start and end, following their playlist rules.
+// Rolling playlists are moved to the new range instead. ErrNoTracks is
+// returned if no tracks were listened to in the range and ErrPlaylistExists
+// if the playlist for the period was already created.
+func (s *Service) CreatePlaylist(
+	ctx context.Context, userid uuid.UUID, playlistType string, startDate, endDate time.Time,
+) (uuid.UUID, error) {
+	// Check the playlist for the period doesn't exist yet before querying
+	// the listens, as the scheduled jobs try to create it every hour
+	exists, err := s.playlistExists(ctx, userid, playlistType, startDate)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if exists {
+		return uuid.Nil, ErrPlaylistExists
+	}
+
+	// Load the user's playlist rules
+	s.Env.Logger.DebugContext(ctx, "getting playlist rules")
+	rules, err := s.PlaylistRules(ctx, userid)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	// Query the data
+	s.Env.Logger.DebugContext(ctx,
+		"querying tracks within range", slog.Group("range", slog.Time("start", startDate), slog.Time("end", endDate)))
+	rows, err := s.Env.Database.ListPlaylistCandidates(ctx, database.ListPlaylistCandidatesParams{
+		UserID: userid,
+		StartDate: pgtype.Timestamptz{
+			Time:  startDate,
+			Valid: true,
+		},
+		EndDate: pgtype.Timestamptz{
+			Time:  endDate,
+			Valid: true,
+		},
+		MinPlays: int64(rules.MinPlays),
+	})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("querying tracks within range: %w", err)
+	}
+
+	// Apply the playlist rules
+	candidates := make([]playlist.Candidate, len(rows))
+	for i, row := range rows {
+		candidates[i] = playlist.Candidate{
+			TrackID:       row.TrackID,
+			Artists:       row.Artists,
+			Plays:         row.ListenCount,
+			FirstPlayedAt: row.FirstPlayedAt.Time,
+			FirstHeardAt:  row.FirstHeardAt.Time,
+		}
+	}
+	tracks := playlist.Select(candidates, rules)
+	if len(tracks) == 0 {
+		return uuid.Nil, ErrNoTracks
+	}
+
+	// Create playlist and add tracks in a transaction
+	s.Env.Logger.DebugContext(ctx, "create playlist and add tracks")
+	tx, err := s.Env.Pool.Begin(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := database.New(tx)
+
+	playlistName, err := playlist.Name(rules.NameTemplate, playlistType, startDate)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("naming playlist: %w", err)
+	}
+	var plays int64
+	for _, track := range tracks {
+		plays += track.Plays
+	}
+	params := database.CreatePlaylistParams{
+		UserID:       userid,
+		Name:         playlistName,
+		PlaylistType: database.PlaylistType(playlistType),
+		PeriodStart: pgtype.Timestamptz{
+			Time:  startDate,
+			Valid: playlistType != "custom",
+		},
+		Description: playlist.Description(startDate, endDate, len(tracks), plays),
+	}
+	var playlistID uuid.UUID
+	if playlistType == "rolling_30d" {
+		playlistID, err = replaceRollingPlaylist(ctx, qtx, params)
+	} else {
+		playlistID, err = qtx.CreatePlaylist(ctx, params)
+	}
+	if database.IsUniqueViolation(err, "playlists_unique_scheduled_period") {
+		return uuid.Nil, ErrPlaylistExists
+	} else if err != nil {
+		return uuid.Nil, fmt.Errorf("creating playlist: %w", err)
+	}
+
+	// Add tracks to the playlist
+	for i, track := range tracks {
+		err := qtx.AddPlaylistTrack(ctx, database.AddPlaylistTrackParams{
+			PlaylistID: playlistID,
+			TrackID:    track.TrackID,
+			Plays:      int32(track.Plays),
+			Position: pgtype.Int4{
+				Int32: int32(i),
+				Valid: true,
+			},
+		})
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("adding track (%s) to playlist: %w", track.TrackID, err)
+		}
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.Nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	s.Env.Logger.InfoContext(ctx, "created playlist",
+		slog.String("playlist_id", playlistID.String()),
+		slog.String("type", playlistType),
+		slog.String("name", playlistName),
+		slog.Int("track_count", len(tracks)),
+	)
+	return playlistID, nil
+}
+
+// CreateLatestPlaylist creates a user's playlist of the given type covering
+// the most recent period that ended at or before asOf in the user's timezone.
+func (s *Service) CreateLatestPlaylist(
+	ctx context.Context, userid uuid.UUID, playlistType string, asOf time.Time,
+) (uuid.UUID, error) {
+	s.Env.Logger.DebugContext(ctx, "getting user calendar")
+	loc, weekStart, err := s.UserCalendar(ctx, userid)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	start, end := LatestPeriod(playlistType, asOf.In(loc), weekStart)
+	return s.CreatePlaylist(ctx, userid, playlistType, start, end)
+}
+
+// CreateAllPlaylists creates a playlist of the given type for every user,
+// covering the most recent week, month or year in the user's timezone that
+// ended at or before asOf. Rolling playlists are moved to the 30 days before
+// asOf instead. Every playlist created or moved is then exported to spotify.
+// Users without listens in the period, or whose playlist was already created,
+// are skipped.
+func (s *Service) CreateAllPlaylists(ctx context.Context, playlistType string, asOf time.Time) error {
+	return s.ForEachUser(ctx, func(ctx context.Context, userID uuid.UUID) error {
+		ctx = log.AppendCtx(ctx, slog.String("user-id", userID.String()))
+		playlistID, err := s.CreateLatestPlaylist(ctx, userID, playlistType, asOf)
+		if errors.Is(err, ErrNoTracks) || errors.Is(err, ErrPlaylistExists) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("creating playlist: %w", err)
+		}
+
+		_, _, err = s.ExportPlaylist(ctx, userID, playlistID, provider.Spotify)
+		if errors.Is(err, ErrNotLinked) || errors.Is(err, ErrNoTracks) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("creating spotify playlist: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,56 @@
+package mars
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"mars/internal/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// TestCreateAllPlaylistsSkipsExistingPeriods checks that users whose playlist
+// for the period was already created are skipped before their listens are
+// queried. The fake database panics on ListPlaylistCandidates, so the test
+// fails if they are queried.
+func TestCreateAllPlaylistsSkipsExistingPeriods(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation() = %v", err)
+	}
+	// The start of a day in New York, so that every period lasts a few runs
+	asOf := time.Date(2024, time.October, 16, 4, 0, 0, 0, time.UTC)
+
+	for _, playlistType := range []string{"weekly", "monthly", "yearly", "rolling_30d"} {
+		t.Run(playlistType, func(t *testing.T) {
+			e := newServiceTest(t)
+			e.db.Preferences[e.userID] = database.GetUserPreferencesRow{
+				Timezone:  newYork.String(),
+				WeekStart: int16(time.Monday),
+			}
+			start, _ := LatestPeriod(playlistType, asOf.In(newYork), time.Monday)
+			e.db.ScheduledPlaylists[uuid.New()] = database.GetUserPlaylistByPeriodParams{
+				UserID:       e.userID,
+				PlaylistType: database.PlaylistType(playlistType),
+				PeriodStart:  pgtype.Timestamptz{Time: start, Valid: true},
+			}
+
+			// Every hourly run until the period changes finds the playlist
+			for hour := range 24 {
+				scheduledAt := asOf.Add(time.Duration(hour) * time.Hour)
+				if next, _ := LatestPeriod(playlistType, scheduledAt.In(newYork), time.Monday); !next.Equal(start) {
+					break
+				}
+				err := e.svc.CreateAllPlaylists(context.Background(), playlistType, scheduledAt)
+				if err != nil {
+					t.Fatalf("CreateAllPlaylists() = %v", err)
+				}
+			}
+			if n := e.spotify.Playlists(); n != 0 {
+				t.Errorf("exported %d playlists, want none", n)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package mars
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"mars/internal/database"
+	"mars/internal/log"
+	"mars/internal/provider"
+	"mars/internal/ratelimit"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// LinkedProvider returns a provider along with the account and tokens the
+// user has linked to it. ErrNotLinked is returned if the user hasn't linked an
+// account. Requests made through the provider share the user's rate limit, so
+// syncs and exports of the same user don't exceed it together.
+func (s *Service) LinkedProvider(
+	ctx context.Context, userID uuid.UUID, name string,
+) (provider.Provider, database.GetProviderTokensRow, error) {
+	p, err := s.Env.Providers.Get(name)
+	if err != nil {
+		return nil, database.GetProviderTokensRow{}, err
+	}
+	tokens, err := s.Env.Database.GetProviderTokens(ctx, database.GetProviderTokensParams{
+		UserID:   userID,
+		Provider: name,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, database.GetProviderTokensRow{}, ErrNotLinked
+	} else if err != nil {
+		return nil, database.GetProviderTokensRow{}, fmt.Errorf("get provider tokens: %w", err)
+	}
+	return limitedProvider{Provider: p, bucket: s.Env.RateLimiter.Bucket(userID.String())}, tokens, nil
+}
+
+// limitedProvider makes every request to a provider wait for a user's rate
+// limit bucket.
+type limitedProvider struct {
+	provider.Provider
+	bucket *ratelimit.Bucket
+}
+
+func (l limitedProvider) ctx(ctx context.Context) context.Context {
+	return ratelimit.NewContext(ctx, l.bucket)
+}
+
+func (l limitedProvider) RefreshTokens(ctx context.Context, refreshToken string) (provider.Tokens, error) {
+	return l.Provider.RefreshTokens(l.ctx(ctx), refreshToken)
+}
+
+func (l limitedProvider) GetAccount(ctx context.Context, accessToken string) (provider.Account, error) {
+	return l.Provider.GetAccount(l.ctx(ctx), accessToken)
+}
+
+func (l limitedProvider) RecentPlays(
+	ctx context.Context, accessToken string, after time.Time,
+) (provider.PlaysPage, error) {
+	return l.Provider.RecentPlays(l.ctx(ctx), accessToken, after)
+}
+
+func (l limitedProvider) Artists(ctx context.Context, accessToken string, ids []string) ([]provider.Artist, error) {
+	return l.Provider.Artists(l.ctx(ctx), accessToken, ids)
+}
+
+func (l limitedProvider) CreatePlaylist(
+	ctx context.Context, accessToken, accountID, name, description string,
+) (provider.Playlist, error) {
+	return l.Provider.CreatePlaylist(l.ctx(ctx), accessToken, accountID, name, description)
+}
+
+func (l limitedProvider) AddTracks(ctx context.Context, accessToken, playlistID string, trackURIs []string) error {
+	return l.Provider.AddTracks(l.ctx(ctx), accessToken, playlistID, trackURIs)
+}
+
+func (l limitedProvider) UpdatePlaylist(ctx context.Context, accessToken, playlistID, name, description string) error {
+	return l.Provider.UpdatePlaylist(l.ctx(ctx), accessToken, playlistID, name, description)
+}
+
+func (l limitedProvider) ReplaceTracks(ctx context.Context, accessToken, playlistID string, trackURIs []string) error {
+	return l.Provider.ReplaceTracks(l.ctx(ctx), accessToken, playlistID, trackURIs)
+}
+
+func (l limitedProvider) SetPlaylistCover(ctx context.Context, accessToken, playlistID string, jpeg []byte) error {
+	return l.Provider.SetPlaylistCover(l.ctx(ctx), accessToken, playlistID, jpeg)
+}
+
+func (l limitedProvider) DeletePlaylist(ctx context.Context, accessToken, playlistID string) error {
+	return l.Provider.DeletePlaylist(l.ctx(ctx), accessToken, playlistID)
+}
+
+// StoreProviderTokens stores the tokens of a user's linked account.
+func StoreProviderTokens(
+	ctx context.Context, db database.Querier, userID uuid.UUID, name string, tokens provider.Tokens,
+) error {
+	return db.UpsertProviderTokens(ctx, database.UpsertProviderTokensParams{
+		UserID:       userID,
+		Provider:     name,
+		AccessToken:  tokens.AccessToken,
+		TokenType:    tokens.TokenType,
+		Scope:        tokens.Scope,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresAt: pgtype.Timestamptz{
+			Time:  tokens.ExpiresAt,
+			Valid: true,
+		},
+	})
+}
+
+// RefreshProviderTokens refreshes the tokens of a user's linked account.
+// ErrNotLinked is returned if the user hasn't linked an account.
+func (s *Service) RefreshProviderTokens(ctx context.Context, userID uuid.UUID, name string) error {
+	// Get refresh token
+	s.Env.Logger.DebugContext(ctx, "getting provider refresh token")
+	p, linked, err := s.LinkedProvider(ctx, userID, name)
+	if err != nil {
+		return err
+	}
+
+	// Refresh tokens
+	s.Env.Logger.DebugContext(ctx, "refreshing provider tokens")
+	refreshed, err := p.RefreshTokens(ctx, linked.RefreshToken)
+	if err != nil {
+		return fmt.Errorf("refreshing tokens: %w", err)
+	}
+	if refreshed.RefreshToken == "" {
+		refreshed.RefreshToken = linked.RefreshToken
+	}
+
+	// Update tokens
+	s.Env.Logger.DebugContext(ctx, "updating provider tokens in database")
+	if err := StoreProviderTokens(ctx, s.Env.Database, userID, p.Name(), refreshed); err != nil {
+		return fmt.Errorf("storing tokens: %w", err)
+	}
+	return nil
+}
+
+// RefreshAllProviderTokens refreshes the tokens of every user who has linked
+// an account to a provider.
+func (s *Service) RefreshAllProviderTokens(ctx context.Context, name string) error {
+	return s.ForEachUser(ctx, func(ctx context.Context, userID uuid.UUID) error {
+		ctx = log.AppendCtx(ctx, slog.String("user-id", userID.String()))
+		err := s.RefreshProviderTokens(ctx, userID, name)
+		if errors.Is(err, ErrNotLinked) {
+			return nil
+		}
+		return err
+	})
+}
@@ -0,0 +1,39 @@
+package mars
+
+import (
+	"context"
+	"fmt"
+
+	"mars/internal/database"
+
+	"github.com/google/uuid"
+)
+
+// SignOutEverywhere ends every session of a user and revokes the access
+// tokens already issued to them, so they have to log in again on every
+// device.
+func (s *Service) SignOutEverywhere(ctx context.Context, userID uuid.UUID) error {
+	tx, err := s.Env.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := database.New(tx)
+
+	// Bumping the token version rejects every access token issued before now
+	updated, err := qtx.IncrementUserTokenVersion(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("incrementing token version: %w", err)
+	}
+	if updated == 0 {
+		return ErrUserNotFound
+	}
+	if err := qtx.DeleteUserSessions(ctx, userID); err != nil {
+		return fmt.Errorf("deleting sessions: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
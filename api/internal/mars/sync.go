@@ -0,0 +1,271 @@
+package mars
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"time"
+
+	"mars/internal/database"
+	"mars/internal/log"
+	"mars/internal/provider"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	recentPlaysMaxPages = 20
+)
+
+// SyncResult summarizes a recently-played sync run.
+type SyncResult struct {
+	Cursor        time.Time
+	Pages         int
+	ItemsFetched  int
+	ItemsInserted int
+}
+
+// syncRecentPlays pages through a provider's recently played tracks starting at the cursor
+// until caught up, storing every track and listen along the way.
+func (s *Service) syncRecentPlays(
+	ctx context.Context, p provider.Provider, userID uuid.UUID, accessToken string, cursor time.Time,
+) (SyncResult, error) {
+	result := SyncResult{Cursor: cursor}
+	artistIDs := make(map[string]struct{})
+
+	for result.Pages < recentPlaysMaxPages {
+		page, err := p.RecentPlays(ctx, accessToken, result.Cursor)
+		if err != nil {
+			return result, fmt.Errorf("get recently played page %d: %w", result.Pages, err)
+		}
+		result.Pages++
+		result.ItemsFetched += len(page.Plays)
+
+		newest := result.Cursor
+		for _, play := range page.Plays {
+			if err := s.upsertProviderTrack(ctx, play.Track); err != nil {
+				return result, err
+			}
+			for _, artist := range play.Track.Artists {
+				if artist.ID != "" {
+					artistIDs[artist.ID] = struct{}{}
+				}
+			}
+
+			// Create listen
+			inserted, err := s.Env.Database.UpsertTrackListen(ctx, database.UpsertTrackListenParams{
+				UserID:  userID,
+				TrackID: play.Track.ID,
+				PlayedAt: pgtype.Timestamptz{
+					Time:  play.PlayedAt,
+					Valid: true,
+				},
+			})
+			if err != nil {
+				return result, fmt.Errorf("create listen (%s): %w", play.Track.ID, err)
+			}
+			result.ItemsInserted += int(inserted)
+
+			if play.PlayedAt.After(newest) {
+				newest = play.PlayedAt
+			}
+		}
+
+		// Caught up once there are no more pages or the cursor stops moving
+		caughtUp := !page.More || !newest.After(result.Cursor)
+		result.Cursor = newest
+		if caughtUp {
+			break
+		}
+	}
+
+	// Artist images aren't included in plays, so they're looked up separately. A
+	// failure only leaves the images missing until the next sync.
+	if err := s.syncArtistImages(ctx, p, accessToken, slices.Collect(maps.Keys(artistIDs))); err != nil {
+		s.Env.Logger.ErrorContext(ctx, "failed to sync artist images", slog.Any("error", err))
+	}
+
+	return result, nil
+}
+
+// upsertProviderTrack stores a track along with its album and artists.
+func (s *Service) upsertProviderTrack(ctx context.Context, track provider.Track) error {
+	// Upsert album
+	if track.Album.ID != "" {
+		err := s.Env.Database.UpsertAlbum(ctx, database.UpsertAlbumParams{
+			ID:      track.Album.ID,
+			Name:    track.Album.Name,
+			Artists: track.Album.Artists,
+			Href:    track.Album.Href,
+			Uri:     track.Album.URI,
+			ImageUrl: pgtype.Text{
+				String: track.Album.ImageURL,
+				Valid:  track.Album.ImageURL != "",
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("upsert album (%s): %w", track.Album.ID, err)
+		}
+	}
+
+	// Upsert track
+	err := s.Env.Database.UpsertTrack(ctx, database.UpsertTrackParams{
+		ID:      track.ID,
+		Name:    track.Name,
+		Href:    track.Href,
+		Artists: track.ArtistNames(),
+		ImageUrl: pgtype.Text{
+			String: track.ImageURL,
+			Valid:  track.ImageURL != "",
+		},
+		Uri: track.URI,
+		AlbumID: pgtype.Text{
+			String: track.Album.ID,
+			Valid:  track.Album.ID != "",
+		},
+		DurationMs: pgtype.Int4{
+			Int32: int32(track.DurationMS),
+			Valid: track.DurationMS > 0,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("upsert track (%s): %w", track.ID, err)
+	}
+
+	// Upsert artists
+	for i, artist := range track.Artists {
+		if artist.ID == "" {
+			continue
+		}
+		err := s.Env.Database.UpsertArtist(ctx, database.UpsertArtistParams{
+			ID:   artist.ID,
+			Name: artist.Name,
+			Href: artist.Href,
+			Uri:  artist.URI,
+		})
+		if err != nil {
+			return fmt.Errorf("upsert artist (%s): %w", artist.ID, err)
+		}
+		err = s.Env.Database.UpsertTrackArtist(ctx, database.UpsertTrackArtistParams{
+			TrackID:  track.ID,
+			ArtistID: artist.ID,
+			Position: int16(i),
+		})
+		if err != nil {
+			return fmt.Errorf("upsert track artist (%s, %s): %w", track.ID, artist.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// syncArtistImages fetches images for the artists that don't have one yet.
+func (s *Service) syncArtistImages(ctx context.Context, p provider.Provider, accessToken string, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	missing, err := s.Env.Database.ListArtistsWithoutImages(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("list artists without images: %w", err)
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	artists, err := p.Artists(ctx, accessToken, missing)
+	if err != nil {
+		return fmt.Errorf("get artists: %w", err)
+	}
+	for _, artist := range artists {
+		err := s.Env.Database.UpdateArtistImage(ctx, database.UpdateArtistImageParams{
+			ID: artist.ID,
+			ImageUrl: pgtype.Text{
+				String: artist.ImageURL,
+				Valid:  artist.ImageURL != "",
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("update artist image (%s): %w", artist.ID, err)
+		}
+	}
+	return nil
+}
+
+// SyncSpotifyPlays stores the tracks a user recently played on Spotify,
+// picking up from where the last sync stopped. The outcome is recorded in the
+// user's sync state. ErrNotLinked is returned if the user hasn't linked a
+// Spotify account.
+func (s *Service) SyncSpotifyPlays(ctx context.Context, userID uuid.UUID) (SyncResult, error) {
+	// Get user spotify tokens
+	s.Env.Logger.DebugContext(ctx, "getting spotify access token")
+	p, linked, err := s.LinkedProvider(ctx, userID, provider.Spotify)
+	if err != nil {
+		return SyncResult{}, err
+	}
+
+	// Get sync cursor
+	s.Env.Logger.DebugContext(ctx, "getting sync cursor")
+	var cursor time.Time
+	state, err := s.Env.Database.GetSpotifySyncState(ctx, userID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return SyncResult{}, fmt.Errorf("get sync state: %w", err)
+	}
+	if state.PlayedAtCursor.Valid {
+		cursor = state.PlayedAtCursor.Time
+	}
+	ctx = log.AppendCtx(ctx, slog.Time("cursor", cursor))
+
+	// Sync recent tracks
+	s.Env.Logger.DebugContext(ctx, "syncing recently played tracks")
+	result, err := s.syncRecentPlays(ctx, p, userID, linked.AccessToken, cursor)
+	if err != nil {
+		if err := s.Env.Database.RecordSpotifySyncFailure(ctx, database.RecordSpotifySyncFailureParams{
+			UserID: userID,
+			LastError: pgtype.Text{
+				String: err.Error(),
+				Valid:  true,
+			},
+		}); err != nil {
+			s.Env.Logger.ErrorContext(ctx, "failed to record sync failure", slog.Any("error", err))
+		}
+		return result, fmt.Errorf("sync recently played tracks: %w", err)
+	}
+
+	// Persist sync cursor
+	s.Env.Logger.DebugContext(ctx, "recording sync state",
+		slog.Int("pages", result.Pages),
+		slog.Int("items_fetched", result.ItemsFetched),
+		slog.Int("items_inserted", result.ItemsInserted))
+	err = s.Env.Database.RecordSpotifySyncSuccess(ctx, database.RecordSpotifySyncSuccessParams{
+		UserID: userID,
+		PlayedAtCursor: pgtype.Timestamptz{
+			Time:  result.Cursor,
+			Valid: !result.Cursor.IsZero(),
+		},
+		LastPages:         int32(result.Pages),
+		LastItemsFetched:  int32(result.ItemsFetched),
+		LastItemsInserted: int32(result.ItemsInserted),
+	})
+	if err != nil {
+		return result, fmt.Errorf("record sync state: %w", err)
+	}
+	return result, nil
+}
+
+// SyncAllSpotifyPlays syncs the recently played tracks of every user who has
+// linked a Spotify account.
+func (s *Service) SyncAllSpotifyPlays(ctx context.Context) error {
+	return s.ForEachUser(ctx, func(ctx context.Context, userID uuid.UUID) error {
+		ctx = log.AppendCtx(ctx, slog.String("user-id", userID.String()))
+		_, err := s.SyncSpotifyPlays(ctx, userID)
+		if errors.Is(err, ErrNotLinked) {
+			return nil
+		}
+		return err
+	})
+}
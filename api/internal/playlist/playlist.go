@@ -0,0 +1,241 @@
+// Package playlist applies a user's playlist rules to the tracks they listened
+// to, choosing the tracks of a generated playlist and naming it.
+package playlist
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Ordering is the order of tracks within a playlist.
+type Ordering string
+
+const (
+	// ByPlays orders the most played tracks first.
+	ByPlays Ordering = "plays"
+	// ByFirstHeard orders tracks by when the user first ever heard them, so
+	// long-time favorites come first and new discoveries last.
+	ByFirstHeard Ordering = "first_heard"
+	// Chronological orders tracks by their first play within the period.
+	Chronological Ordering = "chronological"
+)
+
+const (
+	// MaxTracksLimit is the most tracks a playlist can be configured to have.
+	MaxTracksLimit = 500
+	// MaxNameTemplateLength is the longest allowed name template.
+	MaxNameTemplateLength = 100
+)
+
+var (
+	ErrInvalidRules       = errors.New("invalid playlist rules")
+	ErrUnknownPlaceholder = errors.New("unknown name template placeholder")
+)
+
+// Rules control how a user's playlists are generated.
+type Rules struct {
+	// MaxTracks is the most tracks a playlist has.
+	MaxTracks int
+	// MinPlays is the fewest plays a track needs within the period to be included.
+	MinPlays int
+	// Ordering is the order of tracks within the playlist. Tracks are always
+	// chosen by play count first.
+	Ordering Ordering
+	// MaxTracksPerArtist is the most tracks by any one artist, or 0 for no limit.
+	MaxTracksPerArtist int
+	// NameTemplate is the name of the playlist with date placeholders like
+	// {year}, filled in from the start of the period. See Name.
+	NameTemplate string
+}
+
+// DefaultRules are the rules of users who haven't set their own.
+var DefaultRules = Rules{
+	MaxTracks:          50,
+	MinPlays:           1,
+	Ordering:           ByPlays,
+	MaxTracksPerArtist: 0,
+	NameTemplate:       "{mon} {day}, {year}",
+}
+
+// Validate reports whether the rules can be used to generate playlists.
+func (r Rules) Validate() error {
+	if r.MaxTracks < 1 || r.MaxTracks > MaxTracksLimit {
+		return fmt.Errorf("%w: max tracks must be between 1 and %d", ErrInvalidRules, MaxTracksLimit)
+	}
+	if r.MinPlays < 1 {
+		return fmt.Errorf("%w: min plays must be at least 1", ErrInvalidRules)
+	}
+	switch r.Ordering {
+	case ByPlays, ByFirstHeard, Chronological:
+	default:
+		return fmt.Errorf("%w: unknown ordering %q", ErrInvalidRules, r.Ordering)
+	}
+	if r.MaxTracksPerArtist < 0 {
+		return fmt.Errorf("%w: max tracks per artist must not be negative", ErrInvalidRules)
+	}
+	if strings.TrimSpace(r.NameTemplate) == "" || len(r.NameTemplate) > MaxNameTemplateLength {
+		return fmt.Errorf("%w: name template must be between 1 and %d characters",
+			ErrInvalidRules, MaxNameTemplateLength)
+	}
+	if err := validateTemplate(r.NameTemplate); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidRules, err)
+	}
+	return nil
+}
+
+// Candidate is a track listened to within a playlist's period.
+type Candidate struct {
+	TrackID string
+	Artists []string
+	// Plays is the number of plays within the period.
+	Plays int64
+	// FirstPlayedAt is the first play within the period.
+	FirstPlayedAt time.Time
+	// FirstHeardAt is the first play ever.
+	FirstHeardAt time.Time
+}
+
+// Select chooses the tracks of a playlist from candidates ordered by plays,
+// most played first. The most played tracks are kept, skipping tracks with
+// too few plays or by artists that already have the most tracks allowed, then
+// ordered by the rules' ordering.
+func Select(candidates []Candidate, rules Rules) []Candidate {
+	selected := make([]Candidate, 0, min(len(candidates), rules.MaxTracks))
+	artistTracks := make(map[string]int)
+
+	for _, c := range candidates {
+		if len(selected) == rules.MaxTracks {
+			break
+		}
+		if c.Plays < int64(rules.MinPlays) {
+			continue
+		}
+		if rules.MaxTracksPerArtist > 0 {
+			full := slices.ContainsFunc(c.Artists, func(artist string) bool {
+				return artistTracks[strings.ToLower(artist)] >= rules.MaxTracksPerArtist
+			})
+			if full {
+				continue
+			}
+			for _, artist := range c.Artists {
+				artistTracks[strings.ToLower(artist)]++
+			}
+		}
+		selected = append(selected, c)
+	}
+
+	switch rules.Ordering {
+	case ByFirstHeard:
+		slices.SortStableFunc(selected, func(a, b Candidate) int {
+			return a.FirstHeardAt.Compare(b.FirstHeardAt)
+		})
+	case Chronological:
+		slices.SortStableFunc(selected, func(a, b Candidate) int {
+			return a.FirstPlayedAt.Compare(b.FirstPlayedAt)
+		})
+	}
+	return selected
+}
+
+var placeholderPattern = regexp.MustCompile(`\{([a-z_]+)\}`)
+
+// placeholders fill in each name template placeholder, see Name.
+var placeholders = map[string]func(playlistType string, start time.Time) string{
+	"year": func(_ string, start time.Time) string {
+		return strconv.Itoa(start.Year())
+	},
+	"yy": func(_ string, start time.Time) string {
+		return fmt.Sprintf("%02d", start.Year()%100)
+	},
+	"month": func(_ string, start time.Time) string {
+		return strings.ToLower(start.Month().String())
+	},
+	"mon": func(_ string, start time.Time) string {
+		return strings.ToLower(start.Month().String())[:3]
+	},
+	"mm": func(_ string, start time.Time) string {
+		return fmt.Sprintf("%02d", int(start.Month()))
+	},
+	"day": func(_ string, start time.Time) string {
+		return strconv.Itoa(start.Day())
+	},
+	"dd": func(_ string, start time.Time) string {
+		return fmt.Sprintf("%02d", start.Day())
+	},
+	"week": func(_ string, start time.Time) string {
+		_, week := start.ISOWeek()
+		return strconv.Itoa(week)
+	},
+	"type": func(playlistType string, _ time.Time) string {
+		return playlistType
+	},
+}
+
+// validateTemplate reports whether every placeholder in a name template is known.
+func validateTemplate(template string) error {
+	for _, match := range placeholderPattern.FindAllStringSubmatch(template, -1) {
+		if _, ok := placeholders[match[1]]; !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownPlaceholder, match[0])
+		}
+	}
+	return nil
+}
+
+// Name fills in a name template's placeholders from the start of a playlist's
+// period:
+//
+//	{year}  2026      {yy}   26
+//	{month} october   {mon}  oct   {mm} 10
+//	{day}   7         {dd}   07
+//	{week}  41 (ISO week number)
+//	{type}  weekly, monthly, yearly, rolling_30d or custom
+func Name(template, playlistType string, start time.Time) (string, error) {
+	if err := validateTemplate(template); err != nil {
+		return "", err
+	}
+	return placeholderPattern.ReplaceAllStringFunc(template, func(match string) string {
+		return placeholders[match[1:len(match)-1]](playlistType, start)
+	}), nil
+}
+
+// Description describes a playlist of tracks played within [start, end),
+// such as "Your top 50 tracks from Oct 1 – Oct 31, 2026, played 1,234 times.".
+func Description(start, end time.Time, tracks int, plays int64) string {
+	top := fmt.Sprintf("top %d tracks", tracks)
+	if tracks == 1 {
+		top = "top track"
+	}
+	times := "times"
+	if plays == 1 {
+		times = "time"
+	}
+	return fmt.Sprintf("Your %s from %s, played %s %s.", top, dateRange(start, end), thousands(plays), times)
+}
+
+// dateRange formats the days of [start, end), leaving out the first year if
+// both days are in the same year.
+func dateRange(start, end time.Time) string {
+	last := end.AddDate(0, 0, -1)
+	switch {
+	case !last.After(start):
+		return start.Format("Jan 2, 2006")
+	case last.Year() == start.Year():
+		return start.Format("Jan 2") + " – " + last.Format("Jan 2, 2006")
+	default:
+		return start.Format("Jan 2, 2006") + " – " + last.Format("Jan 2, 2006")
+	}
+}
+
+// thousands formats n with commas between groups of thousands.
+func thousands(n int64) string {
+	s := strconv.FormatInt(n, 10)
+	for i := len(s) - 3; i > 0 && s[i-1] != '-'; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return s
+}
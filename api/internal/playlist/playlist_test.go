@@ -0,0 +1,256 @@
+package playlist
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func candidate(id string, plays int64, artists ...string) Candidate {
+	return Candidate{TrackID: id, Artists: artists, Plays: plays}
+}
+
+func trackIDs(candidates []Candidate) string {
+	ids := make([]string, len(candidates))
+	for i, c := range candidates {
+		ids[i] = c.TrackID
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestSelect(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2024, time.October, d, 0, 0, 0, 0, time.UTC)
+	}
+	ordered := []Candidate{
+		{TrackID: "a", Plays: 9, FirstPlayedAt: day(3), FirstHeardAt: day(2)},
+		{TrackID: "b", Plays: 7, FirstPlayedAt: day(1), FirstHeardAt: day(3)},
+		{TrackID: "c", Plays: 7, FirstPlayedAt: day(2), FirstHeardAt: day(1)},
+		{TrackID: "d", Plays: 2, FirstPlayedAt: day(1), FirstHeardAt: day(1)},
+	}
+
+	tests := []struct {
+		name       string
+		candidates []Candidate
+		rules      Rules
+		want       string
+	}{
+		{
+			name:       "no candidates",
+			candidates: nil,
+			rules:      Rules{MaxTracks: 10, MinPlays: 1, Ordering: ByPlays},
+			want:       "",
+		},
+		{
+			name: "max tracks keeps the most played",
+			candidates: []Candidate{
+				candidate("a", 5), candidate("b", 4), candidate("c", 3), candidate("d", 2),
+			},
+			rules: Rules{MaxTracks: 2, MinPlays: 1, Ordering: ByPlays},
+			want:  "a,b",
+		},
+		{
+			name: "min plays",
+			candidates: []Candidate{
+				candidate("a", 5), candidate("b", 3), candidate("c", 2), candidate("d", 1),
+			},
+			rules: Rules{MaxTracks: 10, MinPlays: 3, Ordering: ByPlays},
+			want:  "a,b",
+		},
+		{
+			name: "artist cap",
+			candidates: []Candidate{
+				candidate("a1", 9, "A"), candidate("a2", 8, "A"), candidate("b1", 7, "B"),
+				candidate("a3", 6, "A"), candidate("b2", 5, "B"), candidate("c1", 4, "C"),
+			},
+			rules: Rules{MaxTracks: 10, MinPlays: 1, Ordering: ByPlays, MaxTracksPerArtist: 1},
+			want:  "a1,b1,c1",
+		},
+		{
+			name: "artist cap counts every artist of a track",
+			candidates: []Candidate{
+				candidate("ab", 9, "A", "B"), candidate("a", 8, "A"), candidate("b", 7, "B"),
+				candidate("c", 6, "C"), candidate("ca", 5, "C", "A"),
+			},
+			rules: Rules{MaxTracks: 10, MinPlays: 1, Ordering: ByPlays, MaxTracksPerArtist: 2},
+			want:  "ab,a,b,c",
+		},
+		{
+			name: "artist cap ignores case",
+			candidates: []Candidate{
+				candidate("a1", 9, "Artist"), candidate("a2", 8, "ARTIST"), candidate("b1", 7, "Band"),
+			},
+			rules: Rules{MaxTracks: 10, MinPlays: 1, Ordering: ByPlays, MaxTracksPerArtist: 1},
+			want:  "a1,b1",
+		},
+		{
+			name: "skipped tracks don't count towards max tracks",
+			candidates: []Candidate{
+				candidate("a1", 9, "A"), candidate("a2", 8, "A"), candidate("a3", 7, "A"),
+				candidate("b1", 6, "B"), candidate("c1", 5, "C"),
+			},
+			rules: Rules{MaxTracks: 2, MinPlays: 1, Ordering: ByPlays, MaxTracksPerArtist: 1},
+			want:  "a1,b1",
+		},
+		{
+			name: "no artist cap",
+			candidates: []Candidate{
+				candidate("a1", 9, "A"), candidate("a2", 8, "A"), candidate("a3", 7, "A"),
+			},
+			rules: Rules{MaxTracks: 10, MinPlays: 1, Ordering: ByPlays, MaxTracksPerArtist: 0},
+			want:  "a1,a2,a3",
+		},
+		{
+			name:       "ordered by plays",
+			candidates: ordered,
+			rules:      Rules{MaxTracks: 10, MinPlays: 1, Ordering: ByPlays},
+			want:       "a,b,c,d",
+		},
+		{
+			name:       "ordered by first heard",
+			candidates: ordered,
+			rules:      Rules{MaxTracks: 10, MinPlays: 1, Ordering: ByFirstHeard},
+			want:       "c,d,a,b",
+		},
+		{
+			name:       "ordered chronologically",
+			candidates: ordered,
+			rules:      Rules{MaxTracks: 10, MinPlays: 1, Ordering: Chronological},
+			want:       "b,d,c,a",
+		},
+		{
+			name:       "ordered after choosing by plays",
+			candidates: ordered,
+			rules:      Rules{MaxTracks: 3, MinPlays: 1, Ordering: Chronological},
+			want:       "b,c,a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates := slices.Clone(tt.candidates)
+			got := Select(candidates, tt.rules)
+			if trackIDs(got) != tt.want {
+				t.Errorf("Select() = %s, want %s", trackIDs(got), tt.want)
+			}
+			if trackIDs(candidates) != trackIDs(tt.candidates) {
+				t.Errorf("Select() reordered its candidates")
+			}
+		})
+	}
+}
+
+func TestName(t *testing.T) {
+	start := time.Date(2026, time.October, 7, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		template string
+		start    time.Time
+		want     string
+		wantErr  error
+	}{
+		{template: "{mon} {day}, {year}", start: start, want: "oct 7, 2026"},
+		{template: "{month} '{yy}", start: start, want: "october '26"},
+		{template: "{year}-{mm}-{dd}", start: start, want: "2026-10-07"},
+		{template: "Week {week} ({type})", start: start, want: "Week 41 (weekly)"},
+		{template: "No placeholders", start: start, want: "No placeholders"},
+		{template: "{year}{year}", start: start, want: "20262026"},
+		{template: "{Year} {} {2026}", start: start, want: "{Year} {} {2026}"},
+		// ISO weeks belong to the year of their Thursday
+		{template: "{week}", start: time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC), want: "53"},
+		{template: "{week}", start: time.Date(2024, time.December, 30, 0, 0, 0, 0, time.UTC), want: "1"},
+		{template: "{week}", start: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), want: "1"},
+		{template: "{quarter}", start: start, wantErr: ErrUnknownPlaceholder},
+		{template: "{year} {nope}", start: start, wantErr: ErrUnknownPlaceholder},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			got, err := Name(tt.template, "weekly", tt.start)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Name() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Name() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   func(*Rules)
+		wantErr bool
+	}{
+		{name: "defaults", rules: func(*Rules) {}},
+		{name: "every placeholder", rules: func(r *Rules) {
+			r.NameTemplate = "{year}{yy}{month}{mon}{mm}{day}{dd}{week}{type}"
+		}},
+		{name: "no tracks", rules: func(r *Rules) { r.MaxTracks = 0 }, wantErr: true},
+		{name: "too many tracks", rules: func(r *Rules) { r.MaxTracks = MaxTracksLimit + 1 }, wantErr: true},
+		{name: "no plays", rules: func(r *Rules) { r.MinPlays = 0 }, wantErr: true},
+		{name: "unknown ordering", rules: func(r *Rules) { r.Ordering = "random" }, wantErr: true},
+		{name: "negative artist cap", rules: func(r *Rules) { r.MaxTracksPerArtist = -1 }, wantErr: true},
+		{name: "blank template", rules: func(r *Rules) { r.NameTemplate = "  " }, wantErr: true},
+		{name: "long template", rules: func(r *Rules) {
+			r.NameTemplate = strings.Repeat("a", MaxNameTemplateLength+1)
+		}, wantErr: true},
+		{name: "unknown placeholder", rules: func(r *Rules) { r.NameTemplate = "{season}" }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := DefaultRules
+			tt.rules(&rules)
+			err := rules.Validate()
+			if tt.wantErr && !errors.Is(err, ErrInvalidRules) {
+				t.Errorf("Validate() = %v, want %v", err, ErrInvalidRules)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Validate() = %v", err)
+			}
+		})
+	}
+}
+
+func TestDescription(t *testing.T) {
+	tests := []struct {
+		start, end time.Time
+		tracks     int
+		plays      int64
+		want       string
+	}{
+		{
+			start:  time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC),
+			end:    time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC),
+			tracks: 50,
+			plays:  1234,
+			want:   "Your top 50 tracks from Oct 1 – Oct 31, 2026, played 1,234 times.",
+		},
+		{
+			start:  time.Date(2025, time.December, 29, 0, 0, 0, 0, time.UTC),
+			end:    time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC),
+			tracks: 1,
+			plays:  1,
+			want:   "Your top track from Dec 29, 2025 – Jan 4, 2026, played 1 time.",
+		},
+		{
+			start:  time.Date(2026, time.October, 7, 0, 0, 0, 0, time.UTC),
+			end:    time.Date(2026, time.October, 8, 0, 0, 0, 0, time.UTC),
+			tracks: 3,
+			plays:  1000000,
+			want:   "Your top 3 tracks from Oct 7, 2026, played 1,000,000 times.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := Description(tt.start, tt.end, tt.tracks, tt.plays); got != tt.want {
+				t.Errorf("Description() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+// Package provider defines the interface music providers such as Spotify
+// implement so handlers can link accounts, sync listens and export playlists
+// without depending on a specific provider's API.
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Spotify is the name of the Spotify provider.
+const Spotify = "spotify"
+
+var (
+	// ErrInvalidCode is returned when an OAuth authorization code is rejected.
+	ErrInvalidCode = errors.New("invalid authorization code")
+	// ErrNotConfigured is returned when a provider is missing credentials.
+	ErrNotConfigured = errors.New("provider not configured")
+	// ErrUnknownProvider is returned when a provider is not registered.
+	ErrUnknownProvider = errors.New("unknown provider")
+	// ErrNotFound is returned when something, such as an exported playlist,
+	// no longer exists on the provider.
+	ErrNotFound = errors.New("not found on provider")
+)
+
+// RateLimitError is returned when a provider keeps rate limiting requests, or
+// asks to wait longer than is reasonable within a single request.
+type RateLimitError struct {
+	// RetryAfter is how long the provider asked to wait before retrying.
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limited, retry after %s", e.RetryAfter)
+}
+
+// Tokens are the OAuth tokens for a linked account.
+type Tokens struct {
+	AccessToken  string
+	TokenType    string
+	Scope        string
+	RefreshToken string
+	ExpiresAt    time.Time
+}
+
+// Account is a user's account on a provider.
+type Account struct {
+	ID string
+}
+
+// Artist is an artist as known to a provider. ImageURL may be empty when
+// the artist was returned as part of a track.
+type Artist struct {
+	ID       string
+	Name     string
+	Href     string
+	URI      string
+	ImageURL string
+}
+
+// Album is an album as known to a provider.
+type Album struct {
+	ID       string
+	Name     string
+	Artists  []string
+	Href     string
+	URI      string
+	ImageURL string
+}
+
+// Track is a track as known to a provider. Album is the zero value if the
+// provider doesn't know the track's album.
+type Track struct {
+	ID       string
+	Name     string
+	Artists  []Artist
+	Album    Album
+	Href     string
+	URI      string
+	ImageURL string
+	// DurationMS is the length of the track in milliseconds, or 0 if unknown.
+	DurationMS int
+}
+
+// ArtistNames returns the names of the track's artists.
+func (t Track) ArtistNames() []string {
+	names := make([]string, len(t.Artists))
+	for i, artist := range t.Artists {
+		names[i] = artist.Name
+	}
+	return names
+}
+
+// Play is a single listen of a track.
+type Play struct {
+	Track    Track
+	PlayedAt time.Time
+}
+
+// PlaysPage is a page of recent plays.
+type PlaysPage struct {
+	Plays []Play
+	// More reports whether more plays may be available after this page.
+	More bool
+}
+
+// Playlist is a playlist created on a provider.
+type Playlist struct {
+	ID  string
+	URL string
+}
+
+// Provider is a music service users can link their account to.
+type Provider interface {
+	// Name returns the unique name of the provider, such as "spotify".
+	Name() string
+
+	// ExchangeCode exchanges an OAuth authorization code for tokens.
+	ExchangeCode(ctx context.Context, code string) (Tokens, error)
+	// RefreshTokens exchanges a refresh token for new tokens. The returned
+	// refresh token is empty if the provider did not rotate it.
+	RefreshTokens(ctx context.Context, refreshToken string) (Tokens, error)
+	// GetAccount returns the account the access token belongs to.
+	GetAccount(ctx context.Context, accessToken string) (Account, error)
+
+	// RecentPlays returns a page of plays after the given time. A zero time
+	// returns the most recent plays.
+	RecentPlays(ctx context.Context, accessToken string, after time.Time) (PlaysPage, error)
+	// Artists returns the full details, including images, of artists.
+	Artists(ctx context.Context, accessToken string, ids []string) ([]Artist, error)
+
+	// TrackURI returns the URI a stored track is added to playlists by, or
+	// false if the track isn't on the provider, such as tracks only ever
+	// listened to on other players.
+	TrackURI(track Track) (uri string, ok bool)
+	// CreatePlaylist creates an empty playlist owned by the account.
+	CreatePlaylist(ctx context.Context, accessToken, accountID, name, description string) (Playlist, error)
+	// AddTracks adds tracks, identified by their URIs, to a playlist.
+	AddTracks(ctx context.Context, accessToken, playlistID string, trackURIs []string) error
+	// UpdatePlaylist changes the name and description of a playlist.
+	UpdatePlaylist(ctx context.Context, accessToken, playlistID, name, description string) error
+	// ReplaceTracks replaces all tracks of a playlist with the given tracks.
+	ReplaceTracks(ctx context.Context, accessToken, playlistID string, trackURIs []string) error
+	// SetPlaylistCover replaces the cover image of a playlist with a JPEG.
+	SetPlaylistCover(ctx context.Context, accessToken, playlistID string, jpeg []byte) error
+	// DeletePlaylist removes a playlist from the account.
+	DeletePlaylist(ctx context.Context, accessToken, playlistID string) error
+}
+
+// Registry holds the available providers by name.
+type Registry map[string]Provider
+
+// NewRegistry creates a registry of the given providers.
+func NewRegistry(providers ...Provider) Registry {
+	r := make(Registry, len(providers))
+	for _, p := range providers {
+		r[p.Name()] = p
+	}
+	return r
+}
+
+// Get returns the provider with the given name.
+func (r Registry) Get(name string) (Provider, error) {
+	p, ok := r[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProvider, name)
+	}
+	return p, nil
+}
@@ -0,0 +1,520 @@
+// Package spotify implements the Spotify music provider.
+package spotify
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	marshttp "mars/internal/http"
+	"mars/internal/provider"
+	"mars/internal/ratelimit"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	// defaultAccountsURL is the base URL of the accounts service.
+	defaultAccountsURL = "https://accounts.spotify.com"
+	// defaultAPIURL is the base URL of the Web API.
+	defaultAPIURL = "https://api.spotify.com/v1"
+
+	// trackURIPrefix prefixes the URIs of Spotify tracks.
+	trackURIPrefix = "spotify:track:"
+
+	// recentlyPlayedLimit is the maximum page size of the recently-played endpoint.
+	recentlyPlayedLimit = 50
+	// artistsLimit is the maximum number of artists fetched at once.
+	artistsLimit = 50
+	// playlistTracksLimit is the maximum number of tracks added to a playlist at once.
+	playlistTracksLimit = 100
+
+	// maxRateLimitRetries is the most times a rate limited request is retried.
+	maxRateLimitRetries = 3
+	// maxRetryAfter is the longest Retry-After that is waited out. Longer waits
+	// fail the request instead of holding up a sync or export.
+	maxRetryAfter = 2 * time.Minute
+	// defaultRetryAfter is how long to wait when a rate limited response has
+	// no usable Retry-After header.
+	defaultRetryAfter = time.Second
+)
+
+// Config holds the Spotify application credentials.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURI  string
+	// AccountsURL is the base URL of the accounts service, defaultAccountsURL
+	// if empty.
+	AccountsURL string
+	// APIURL is the base URL of the Web API, defaultAPIURL if empty.
+	APIURL string
+}
+
+// Provider talks to the Spotify Web API.
+type Provider struct {
+	config Config
+	client *marshttp.Client
+}
+
+var _ provider.Provider = (*Provider)(nil)
+
+// New creates a Spotify provider. The client should use RetryPolicy, so rate
+// limited requests are retried by the provider.
+func New(config Config, client *marshttp.Client) *Provider {
+	if config.AccountsURL == "" {
+		config.AccountsURL = defaultAccountsURL
+	}
+	if config.APIURL == "" {
+		config.APIURL = defaultAPIURL
+	}
+	return &Provider{
+		config: config,
+		client: client,
+	}
+}
+
+func (p *Provider) Name() string {
+	return provider.Spotify
+}
+
+// TrackURI returns the track's URI if it is a Spotify track URI.
+func (p *Provider) TrackURI(track provider.Track) (string, bool) {
+	return track.URI, strings.HasPrefix(track.URI, trackURIPrefix)
+}
+
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token"`
+	Scope        string `json:"scope"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+func (t tokenResponse) tokens(issuedAt time.Time) provider.Tokens {
+	return provider.Tokens{
+		AccessToken:  t.AccessToken,
+		TokenType:    t.TokenType,
+		Scope:        t.Scope,
+		RefreshToken: t.RefreshToken,
+		ExpiresAt:    issuedAt.Add(time.Duration(t.ExpiresIn) * time.Second),
+	}
+}
+
+// requestTokens sends a request to the token endpoint.
+func (p *Provider) requestTokens(ctx context.Context, form url.Values) (*http.Response, error) {
+	if p.config.ClientID == "" || p.config.ClientSecret == "" {
+		return nil, fmt.Errorf("%w: SPOTIFY_CLIENT_ID and SPOTIFY_CLIENT_SECRET are required",
+			provider.ErrNotConfigured)
+	}
+
+	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodPost, p.config.AccountsURL+"/api/token",
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	authorization := "Basic " + base64.StdEncoding.EncodeToString([]byte(p.config.ClientID+":"+p.config.ClientSecret))
+	req.Header.Set("content-type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", authorization)
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+	return res, nil
+}
+
+func (p *Provider) ExchangeCode(ctx context.Context, code string) (provider.Tokens, error) {
+	form := url.Values{}
+	form.Add("grant_type", "authorization_code")
+	form.Add("code", code)
+	form.Add("redirect_uri", p.config.RedirectURI)
+
+	issuedAt := time.Now()
+	res, err := p.requestTokens(ctx, form)
+	if err != nil {
+		return provider.Tokens{}, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return provider.Tokens{}, fmt.Errorf("%w: spotify returned status %d: %s",
+			provider.ErrInvalidCode, res.StatusCode, string(body))
+	}
+
+	var tokens tokenResponse
+	if err := json.NewDecoder(res.Body).Decode(&tokens); err != nil {
+		return provider.Tokens{}, fmt.Errorf("decode response: %w", err)
+	}
+	return tokens.tokens(issuedAt), nil
+}
+
+func (p *Provider) RefreshTokens(ctx context.Context, refreshToken string) (provider.Tokens, error) {
+	form := url.Values{}
+	form.Add("grant_type", "refresh_token")
+	form.Add("refresh_token", refreshToken)
+
+	issuedAt := time.Now()
+	res, err := p.requestTokens(ctx, form)
+	if err != nil {
+		return provider.Tokens{}, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return provider.Tokens{}, fmt.Errorf("spotify returned status %d: %s", res.StatusCode, string(body))
+	}
+
+	var tokens tokenResponse
+	if err := json.NewDecoder(res.Body).Decode(&tokens); err != nil {
+		return provider.Tokens{}, fmt.Errorf("decode response: %w", err)
+	}
+	return tokens.tokens(issuedAt), nil
+}
+
+// RetryPolicy retries requests like retryablehttp's default policy, except
+// that rate limited requests are left to the provider, which waits out
+// Retry-After for every request of the same user.
+func RetryPolicy(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		return false, nil
+	}
+	return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+}
+
+// do sends a request to the Web API once the rate limit bucket of the
+// context allows it. Rate limited requests are retried after waiting for
+// Retry-After, pausing the bucket so other requests of the same user wait too.
+func (p *Provider) do(ctx context.Context, req *retryablehttp.Request) (*http.Response, error) {
+	bucket := ratelimit.FromContext(ctx)
+	for attempt := 0; ; attempt++ {
+		if err := bucket.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("wait for rate limit: %w", err)
+		}
+		res, err := p.client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("send request: %w", err)
+		}
+		if res.StatusCode != http.StatusTooManyRequests {
+			return res, nil
+		}
+		_ = res.Body.Close()
+
+		wait := retryAfter(res.Header.Get("Retry-After"), time.Now())
+		if attempt == maxRateLimitRetries || wait > maxRetryAfter {
+			return nil, &provider.RateLimitError{RetryAfter: wait}
+		}
+		bucket.Pause(wait)
+		if err := ratelimit.Sleep(ctx, wait); err != nil {
+			return nil, fmt.Errorf("wait for rate limit: %w", err)
+		}
+	}
+}
+
+// retryAfter parses a Retry-After header, which is either a number of seconds
+// or an HTTP date.
+func retryAfter(header string, now time.Time) time.Duration {
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(header); err == nil {
+		return max(t.Sub(now), 0)
+	}
+	return defaultRetryAfter
+}
+
+// checkStatus returns an error if a response doesn't have the wanted status.
+func checkStatus(res *http.Response, wantStatus int) error {
+	if res.StatusCode == http.StatusNotFound {
+		respBody, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("%w: %s", provider.ErrNotFound, string(respBody))
+	}
+	if res.StatusCode != wantStatus {
+		respBody, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("spotify returned status %d: %s", res.StatusCode, string(respBody))
+	}
+	return nil
+}
+
+// doJSON sends an authorized request to the Web API and decodes the response into out, if given.
+func (p *Provider) doJSON(
+	ctx context.Context, method, endpoint, accessToken string, body any, wantStatus int, out any,
+) error {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshal request body: %w", err)
+		}
+		reader = bytes.NewReader(b)
+	}
+
+	req, err := retryablehttp.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	res, err := p.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if err := checkStatus(res, wantStatus); err != nil {
+		return err
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+			return fmt.Errorf("decode response: %w", err)
+		}
+	}
+	return nil
+}
+
+func (p *Provider) GetAccount(ctx context.Context, accessToken string) (provider.Account, error) {
+	var profile struct {
+		ID string `json:"id"`
+	}
+	err := p.doJSON(ctx, http.MethodGet, p.config.APIURL+"/me", accessToken, nil, http.StatusOK, &profile)
+	if err != nil {
+		return provider.Account{}, err
+	}
+	return provider.Account{ID: profile.ID}, nil
+}
+
+// image is an image of an artist or album. Images are ordered widest first.
+type image struct {
+	URL string `json:"url"`
+}
+
+func firstImage(images []image) string {
+	if len(images) == 0 {
+		return ""
+	}
+	return images[0].URL
+}
+
+type externalURLs struct {
+	Spotify string `json:"spotify"`
+}
+
+// artistObject is an artist returned by the Web API. Images are only set on
+// full artist objects, not on the simplified artists of a track or album.
+type artistObject struct {
+	ID           string       `json:"id"`
+	Name         string       `json:"name"`
+	ExternalUrls externalURLs `json:"external_urls"`
+	URI          string       `json:"uri"`
+	Images       []image      `json:"images"`
+}
+
+func (a artistObject) artist() provider.Artist {
+	return provider.Artist{
+		ID:       a.ID,
+		Name:     a.Name,
+		Href:     a.ExternalUrls.Spotify,
+		URI:      a.URI,
+		ImageURL: firstImage(a.Images),
+	}
+}
+
+// recentlyPlayedItem is a single play returned by the recently-played endpoint.
+type recentlyPlayedItem struct {
+	Track struct {
+		Album struct {
+			ID           string         `json:"id"`
+			Name         string         `json:"name"`
+			Artists      []artistObject `json:"artists"`
+			ExternalUrls externalURLs   `json:"external_urls"`
+			URI          string         `json:"uri"`
+			Images       []image        `json:"images"`
+		} `json:"album"`
+		Artists      []artistObject `json:"artists"`
+		ID           string         `json:"id"`
+		Name         string         `json:"name"`
+		ExternalUrls externalURLs   `json:"external_urls"`
+		URI          string         `json:"uri"`
+		DurationMS   int            `json:"duration_ms"`
+	} `json:"track"`
+	PlayedAt time.Time `json:"played_at"`
+}
+
+func (p *Provider) RecentPlays(ctx context.Context, accessToken string, after time.Time) (provider.PlaysPage, error) {
+	endpoint := fmt.Sprintf("%s/me/player/recently-played?limit=%d", p.config.APIURL, recentlyPlayedLimit)
+	if !after.IsZero() {
+		endpoint += fmt.Sprintf("&after=%d", after.UnixMilli())
+	}
+
+	var body struct {
+		Items []recentlyPlayedItem `json:"items"`
+	}
+	if err := p.doJSON(ctx, http.MethodGet, endpoint, accessToken, nil, http.StatusOK, &body); err != nil {
+		return provider.PlaysPage{}, err
+	}
+
+	page := provider.PlaysPage{
+		Plays: make([]provider.Play, len(body.Items)),
+		More:  len(body.Items) == recentlyPlayedLimit,
+	}
+	for i, item := range body.Items {
+		artists := make([]provider.Artist, len(item.Track.Artists))
+		for j, artist := range item.Track.Artists {
+			artists[j] = artist.artist()
+		}
+		albumArtists := make([]string, len(item.Track.Album.Artists))
+		for j, artist := range item.Track.Album.Artists {
+			albumArtists[j] = artist.Name
+		}
+		imageURL := firstImage(item.Track.Album.Images)
+		page.Plays[i] = provider.Play{
+			Track: provider.Track{
+				ID:      item.Track.ID,
+				Name:    item.Track.Name,
+				Artists: artists,
+				Album: provider.Album{
+					ID:       item.Track.Album.ID,
+					Name:     item.Track.Album.Name,
+					Artists:  albumArtists,
+					Href:     item.Track.Album.ExternalUrls.Spotify,
+					URI:      item.Track.Album.URI,
+					ImageURL: imageURL,
+				},
+				Href:       item.Track.ExternalUrls.Spotify,
+				URI:        item.Track.URI,
+				ImageURL:   imageURL,
+				DurationMS: item.Track.DurationMS,
+			},
+			PlayedAt: item.PlayedAt,
+		}
+	}
+	return page, nil
+}
+
+func (p *Provider) Artists(ctx context.Context, accessToken string, ids []string) ([]provider.Artist, error) {
+	artists := make([]provider.Artist, 0, len(ids))
+	for chunk := range slices.Chunk(ids, artistsLimit) {
+		endpoint := fmt.Sprintf("%s/artists?ids=%s", p.config.APIURL, url.QueryEscape(strings.Join(chunk, ",")))
+		var body struct {
+			Artists []*artistObject `json:"artists"`
+		}
+		if err := p.doJSON(ctx, http.MethodGet, endpoint, accessToken, nil, http.StatusOK, &body); err != nil {
+			return nil, err
+		}
+		for _, artist := range body.Artists {
+			// Unknown IDs are returned as null
+			if artist != nil {
+				artists = append(artists, artist.artist())
+			}
+		}
+	}
+	return artists, nil
+}
+
+func (p *Provider) CreatePlaylist(
+	ctx context.Context, accessToken, accountID, name, description string,
+) (provider.Playlist, error) {
+	endpoint := fmt.Sprintf("%s/users/%s/playlists", p.config.APIURL, url.PathEscape(accountID))
+	body := map[string]any{
+		"name":          name,
+		"public":        true,
+		"collaborative": false,
+		"description":   description,
+	}
+
+	var playlist struct {
+		ID           string `json:"id"`
+		ExternalUrls struct {
+			Spotify string `json:"spotify"`
+		} `json:"external_urls"`
+	}
+	err := p.doJSON(ctx, http.MethodPost, endpoint, accessToken, body, http.StatusCreated, &playlist)
+	if err != nil {
+		return provider.Playlist{}, err
+	}
+
+	return provider.Playlist{
+		ID:  playlist.ID,
+		URL: playlist.ExternalUrls.Spotify,
+	}, nil
+}
+
+// AddTracks appends tracks to a playlist, in chunks as large as Spotify allows.
+func (p *Provider) AddTracks(ctx context.Context, accessToken, playlistID string, trackURIs []string) error {
+	endpoint := fmt.Sprintf("%s/playlists/%s/tracks", p.config.APIURL, url.PathEscape(playlistID))
+	for chunk := range slices.Chunk(trackURIs, playlistTracksLimit) {
+		body := map[string]any{
+			"uris": chunk,
+		}
+		if err := p.doJSON(ctx, http.MethodPost, endpoint, accessToken, body, http.StatusCreated, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Provider) UpdatePlaylist(ctx context.Context, accessToken, playlistID, name, description string) error {
+	endpoint := fmt.Sprintf("%s/playlists/%s", p.config.APIURL, url.PathEscape(playlistID))
+	body := map[string]any{
+		"name":        name,
+		"description": description,
+	}
+	return p.doJSON(ctx, http.MethodPut, endpoint, accessToken, body, http.StatusOK, nil)
+}
+
+// ReplaceTracks replaces a playlist's tracks with the first chunk of tracks,
+// then appends the rest.
+func (p *Provider) ReplaceTracks(ctx context.Context, accessToken, playlistID string, trackURIs []string) error {
+	first := trackURIs[:min(len(trackURIs), playlistTracksLimit)]
+	endpoint := fmt.Sprintf("%s/playlists/%s/tracks", p.config.APIURL, url.PathEscape(playlistID))
+	body := map[string]any{
+		"uris": first,
+	}
+	if err := p.doJSON(ctx, http.MethodPut, endpoint, accessToken, body, http.StatusOK, nil); err != nil {
+		return err
+	}
+	return p.AddTracks(ctx, accessToken, playlistID, trackURIs[len(first):])
+}
+
+// SetPlaylistCover uploads a playlist's cover image. Spotify expects the JPEG
+// base64 encoded, and requires the ugc-image-upload scope.
+func (p *Provider) SetPlaylistCover(ctx context.Context, accessToken, playlistID string, jpeg []byte) error {
+	endpoint := fmt.Sprintf("%s/playlists/%s/images", p.config.APIURL, url.PathEscape(playlistID))
+	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodPut, endpoint,
+		[]byte(base64.StdEncoding.EncodeToString(jpeg)))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "image/jpeg")
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	res, err := p.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	return checkStatus(res, http.StatusAccepted)
+}
+
+// DeletePlaylist unfollows a playlist. Spotify has no way to delete a playlist,
+// but unfollowing one the account owns removes it from the account's library.
+func (p *Provider) DeletePlaylist(ctx context.Context, accessToken, playlistID string) error {
+	endpoint := fmt.Sprintf("%s/playlists/%s/followers", p.config.APIURL, url.PathEscape(playlistID))
+	return p.doJSON(ctx, http.MethodDelete, endpoint, accessToken, nil, http.StatusOK, nil)
+}
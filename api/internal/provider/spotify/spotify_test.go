@@ -0,0 +1,118 @@
+package spotify_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	marshttp "mars/internal/http"
+	"mars/internal/provider"
+	"mars/internal/provider/spotify"
+	"mars/internal/provider/spotify/spotifytest"
+)
+
+func newProvider(t *testing.T) (*spotify.Provider, *spotifytest.Server) {
+	fake := spotifytest.New(t)
+	client := marshttp.New()
+	client.Logger = nil
+	client.CheckRetry = spotify.RetryPolicy
+	return spotify.New(fake.Config(), client), fake
+}
+
+func TestExchangeCode(t *testing.T) {
+	p, fake := newProvider(t)
+	code := fake.Authorize("mars-user")
+
+	tokens, err := p.ExchangeCode(context.Background(), code)
+	if err != nil {
+		t.Fatalf("ExchangeCode() = %v", err)
+	}
+	if tokens.AccessToken == "" || tokens.RefreshToken == "" {
+		t.Errorf("ExchangeCode() = %+v, want access and refresh tokens", tokens)
+	}
+	if until := time.Until(tokens.ExpiresAt); until <= 0 || until > spotifytest.TokenLifetime {
+		t.Errorf("tokens expire in %s, want within %s", until, spotifytest.TokenLifetime)
+	}
+
+	account, err := p.GetAccount(context.Background(), tokens.AccessToken)
+	if err != nil {
+		t.Fatalf("GetAccount() = %v", err)
+	}
+	if account.ID != "mars-user" {
+		t.Errorf("GetAccount() = %q, want %q", account.ID, "mars-user")
+	}
+
+	// Codes can only be used once
+	if _, err := p.ExchangeCode(context.Background(), code); !errors.Is(err, provider.ErrInvalidCode) {
+		t.Errorf("ExchangeCode() with a used code = %v, want %v", err, provider.ErrInvalidCode)
+	}
+}
+
+func TestExchangeCodeNotConfigured(t *testing.T) {
+	fake := spotifytest.New(t)
+	config := fake.Config()
+	config.ClientSecret = ""
+	p := spotify.New(config, marshttp.New())
+
+	if _, err := p.ExchangeCode(context.Background(), "code"); !errors.Is(err, provider.ErrNotConfigured) {
+		t.Errorf("ExchangeCode() = %v, want %v", err, provider.ErrNotConfigured)
+	}
+}
+
+func TestRefreshTokens(t *testing.T) {
+	p, fake := newProvider(t)
+	linked := fake.Tokens("mars-user")
+	fake.ExpireTokens()
+
+	if _, err := p.GetAccount(context.Background(), linked.AccessToken); err == nil {
+		t.Fatal("GetAccount() with an expired token succeeded")
+	}
+	refreshed, err := p.RefreshTokens(context.Background(), linked.RefreshToken)
+	if err != nil {
+		t.Fatalf("RefreshTokens() = %v", err)
+	}
+	if refreshed.RefreshToken != "" {
+		t.Errorf("RefreshTokens() rotated the refresh token to %q, want it kept", refreshed.RefreshToken)
+	}
+	if _, err := p.GetAccount(context.Background(), refreshed.AccessToken); err != nil {
+		t.Errorf("GetAccount() with a refreshed token = %v", err)
+	}
+}
+
+func TestRecentPlays(t *testing.T) {
+	p, fake := newProvider(t)
+	tokens := fake.Tokens("mars-user")
+	start := time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)
+	for i := range 60 {
+		fake.Play("mars-user", provider.Track{
+			ID:      "track",
+			Name:    "Track",
+			Artists: []provider.Artist{{ID: "artist", Name: "Artist"}},
+		}, start.Add(time.Duration(i)*time.Minute))
+	}
+
+	page, err := p.RecentPlays(context.Background(), tokens.AccessToken, start.Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("RecentPlays() = %v", err)
+	}
+	if len(page.Plays) != 50 || !page.More {
+		t.Fatalf("first page has %d plays, more = %t, want a full page of 50 and more", len(page.Plays), page.More)
+	}
+
+	page, err = p.RecentPlays(context.Background(), tokens.AccessToken, start.Add(49*time.Minute))
+	if err != nil {
+		t.Fatalf("RecentPlays() = %v", err)
+	}
+	if len(page.Plays) != 10 || page.More {
+		t.Errorf("second page has %d plays, more = %t, want the last 10 and no more", len(page.Plays), page.More)
+	}
+
+	artists, err := p.Artists(context.Background(), tokens.AccessToken, []string{"artist", "unknown"})
+	if err != nil {
+		t.Fatalf("Artists() = %v", err)
+	}
+	if len(artists) != 1 || artists[0].ImageURL == "" {
+		t.Errorf("Artists() = %+v, want the known artist with an image", artists)
+	}
+}
@@ -0,0 +1,59 @@
+package spotifytest
+
+import (
+	"log/slog"
+	"testing"
+
+	"mars/internal/database"
+	"mars/internal/database/databasetest"
+	"mars/internal/env"
+	marshttp "mars/internal/http"
+	"mars/internal/provider"
+	"mars/internal/provider/spotify"
+	"mars/internal/ratelimit"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// NewEnv returns an environment whose Spotify provider talks to a new fake
+// Spotify and whose database is an in-memory fake. Logs are discarded and
+// rate limits are high enough to never be hit.
+func NewEnv(t testing.TB) (*env.Env, *Server, *databasetest.Querier) {
+	t.Helper()
+	fake := New(t)
+	db := databasetest.NewQuerier()
+
+	e := env.New()
+	e.Logger = slog.New(slog.DiscardHandler)
+	e.Database = db
+	e.HTTP = marshttp.New()
+	e.HTTP.Logger = nil
+	e.RateLimiter = ratelimit.New(1000, 1000)
+	e.SpotifyAccountsURL = fake.AccountsURL
+	e.SpotifyAPIURL = fake.APIURL
+
+	spotifyHTTP := marshttp.New()
+	spotifyHTTP.Logger = nil
+	spotifyHTTP.CheckRetry = spotify.RetryPolicy
+	e.Providers = provider.NewRegistry(spotify.New(fake.Config(), spotifyHTTP))
+
+	return e, fake, db
+}
+
+// LinkUser adds a user to db who has linked the account, as if they had
+// authorized the app before, and returns their ID.
+func (s *Server) LinkUser(db *databasetest.Querier, account string) uuid.UUID {
+	userID := uuid.New()
+	tokens := s.Tokens(account)
+	db.Users = append(db.Users, userID)
+	db.Tokens[userID] = database.GetProviderTokensRow{
+		AccountID:    account,
+		AccessToken:  tokens.AccessToken,
+		TokenType:    tokens.TokenType,
+		Scope:        tokens.Scope,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresAt:    pgtype.Timestamptz{Time: tokens.ExpiresAt, Valid: true},
+	}
+	return userID
+}
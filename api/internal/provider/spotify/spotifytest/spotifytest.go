@@ -0,0 +1,588 @@
+// Package spotifytest provides an in-process fake of the Spotify accounts
+// service and Web API, so code that talks to Spotify can be tested end-to-end
+// without network access.
+package spotifytest
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"mars/internal/provider"
+	"mars/internal/provider/spotify"
+)
+
+const (
+	// ClientID and ClientSecret are the credentials the fake accepts.
+	ClientID     = "client-id"
+	ClientSecret = "client-secret"
+	// RedirectURI is the redirect URI the fake expects in code exchanges.
+	RedirectURI = "http://localhost:8080/api/oauth/spotify/callback"
+
+	// TokenLifetime is how long access tokens issued by the fake are valid.
+	TokenLifetime = time.Hour
+
+	// The limits the real Web API enforces.
+	recentlyPlayedLimit = 50
+	artistsLimit        = 50
+	playlistTracksLimit = 100
+)
+
+// Playlist is a playlist created on the fake.
+type Playlist struct {
+	ID          string
+	Owner       string
+	Name        string
+	Description string
+	Tracks      []string
+	// Cover is the last uploaded cover JPEG, or nil if none was uploaded.
+	Cover []byte
+}
+
+// Server is a fake Spotify. It is safe for concurrent use.
+type Server struct {
+	// AccountsURL and APIURL are the base URLs of the fake accounts service
+	// and Web API.
+	AccountsURL string
+	APIURL      string
+
+	mu            sync.Mutex
+	srv           *httptest.Server
+	codes         map[string]string // authorization code -> account
+	accessTokens  map[string]accessToken
+	refreshTokens map[string]string // refresh token -> account
+	plays         map[string][]provider.Play
+	artists       map[string]provider.Artist
+	playlists     map[string]*Playlist
+	issued        int
+	rateLimited   int
+	retryAfter    string
+}
+
+type accessToken struct {
+	account   string
+	expiresAt time.Time
+}
+
+// New starts a fake Spotify that is closed when the test ends.
+func New(t testing.TB) *Server {
+	t.Helper()
+	s := &Server{
+		codes:         make(map[string]string),
+		accessTokens:  make(map[string]accessToken),
+		refreshTokens: make(map[string]string),
+		plays:         make(map[string][]provider.Play),
+		artists:       make(map[string]provider.Artist),
+		playlists:     make(map[string]*Playlist),
+	}
+
+	api := http.NewServeMux()
+	api.HandleFunc("GET /v1/me", s.me)
+	api.HandleFunc("GET /v1/me/player/recently-played", s.recentlyPlayed)
+	api.HandleFunc("GET /v1/artists", s.getArtists)
+	api.HandleFunc("POST /v1/users/{user}/playlists", s.createPlaylist)
+	api.HandleFunc("PUT /v1/playlists/{id}", s.updatePlaylist)
+	api.HandleFunc("POST /v1/playlists/{id}/tracks", s.addTracks)
+	api.HandleFunc("PUT /v1/playlists/{id}/tracks", s.replaceTracks)
+	api.HandleFunc("PUT /v1/playlists/{id}/images", s.setCover)
+	api.HandleFunc("DELETE /v1/playlists/{id}/followers", s.unfollowPlaylist)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/token", s.token)
+	mux.HandleFunc("GET /images/{name}", s.image)
+	mux.Handle("/v1/", s.authorize(api))
+
+	s.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if s.rateLimited > 0 {
+			s.rateLimited--
+			w.Header().Set("Retry-After", s.retryAfter)
+			writeError(w, http.StatusTooManyRequests, "API rate limit exceeded")
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(s.srv.Close)
+
+	s.AccountsURL = s.srv.URL
+	s.APIURL = s.srv.URL + "/v1"
+	return s
+}
+
+// Config returns a provider configuration that talks to the fake.
+func (s *Server) Config() spotify.Config {
+	return spotify.Config{
+		ClientID:     ClientID,
+		ClientSecret: ClientSecret,
+		RedirectURI:  RedirectURI,
+		AccountsURL:  s.AccountsURL,
+		APIURL:       s.APIURL,
+	}
+}
+
+// Authorize returns an authorization code for an account, as if the user had
+// approved the app. Codes can be exchanged once.
+func (s *Server) Authorize(account string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	code := s.nextID("code")
+	s.codes[code] = account
+	return code
+}
+
+// Tokens issues tokens for an account, as if it had been linked before.
+func (s *Server) Tokens(account string) provider.Tokens {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.issueTokens(account, true)
+}
+
+// ExpireTokens makes every access token issued so far expire.
+func (s *Server) ExpireTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for token, t := range s.accessTokens {
+		t.expiresAt = time.Time{}
+		s.accessTokens[token] = t
+	}
+}
+
+// Play records that an account played a track. The track's artists become
+// known to the artists endpoint, with an image served by the fake.
+func (s *Server) Play(account string, track provider.Track, playedAt time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.plays[account] = append(s.plays[account], provider.Play{Track: track, PlayedAt: playedAt})
+	for _, artist := range track.Artists {
+		artist.ImageURL = s.ImageURL(artist.ID + ".jpg")
+		s.artists[artist.ID] = artist
+	}
+}
+
+// Playlist returns a copy of a playlist on the fake.
+func (s *Server) Playlist(id string) (Playlist, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.playlists[id]
+	if !ok {
+		return Playlist{}, false
+	}
+	c := *p
+	c.Tracks = slices.Clone(p.Tracks)
+	return c, true
+}
+
+// Playlists returns the number of playlists on the fake.
+func (s *Server) Playlists() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.playlists)
+}
+
+// DeletePlaylist removes a playlist, as if its owner had deleted it on Spotify.
+func (s *Server) DeletePlaylist(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.playlists, id)
+}
+
+// RateLimit answers the next n requests with 429 Too Many Requests and the
+// given Retry-After header.
+func (s *Server) RateLimit(n int, retryAfter string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rateLimited = n
+	s.retryAfter = retryAfter
+}
+
+// ImageURL returns the URL of an image served by the fake. Every image is a
+// plain gray JPEG.
+func (s *Server) ImageURL(name string) string {
+	return s.srv.URL + "/images/" + url.PathEscape(name)
+}
+
+// nextID returns a unique ID with a prefix. The caller must hold s.mu.
+func (s *Server) nextID(prefix string) string {
+	s.issued++
+	return fmt.Sprintf("%s%d", prefix, s.issued)
+}
+
+// issueTokens issues an access token, and a refresh token if asked to. The
+// caller must hold s.mu.
+func (s *Server) issueTokens(account string, refresh bool) provider.Tokens {
+	tokens := provider.Tokens{
+		AccessToken: s.nextID("access"),
+		TokenType:   "Bearer",
+		Scope:       "user-read-recently-played playlist-modify-public ugc-image-upload",
+		ExpiresAt:   time.Now().Add(TokenLifetime),
+	}
+	s.accessTokens[tokens.AccessToken] = accessToken{account: account, expiresAt: tokens.ExpiresAt}
+	if refresh {
+		tokens.RefreshToken = s.nextID("refresh")
+		s.refreshTokens[tokens.RefreshToken] = account
+	}
+	return tokens
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"error": map[string]any{"status": status, "message": message},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// token implements the token endpoint for the authorization code and refresh
+// token grants. Like Spotify, refreshing doesn't rotate the refresh token.
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	id, secret, ok := r.BasicAuth()
+	if !ok || id != ClientID || secret != ClientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	var tokens provider.Tokens
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		account, ok := s.codes[r.PostForm.Get("code")]
+		if !ok || r.PostForm.Get("redirect_uri") != RedirectURI {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+			return
+		}
+		delete(s.codes, r.PostForm.Get("code"))
+		tokens = s.issueTokens(account, true)
+	case "refresh_token":
+		account, ok := s.refreshTokens[r.PostForm.Get("refresh_token")]
+		if !ok {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+			return
+		}
+		tokens = s.issueTokens(account, false)
+	default:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+
+	body := map[string]any{
+		"access_token": tokens.AccessToken,
+		"token_type":   tokens.TokenType,
+		"scope":        tokens.Scope,
+		"expires_in":   int(TokenLifetime.Seconds()),
+	}
+	if tokens.RefreshToken != "" {
+		body["refresh_token"] = tokens.RefreshToken
+	}
+	writeJSON(w, http.StatusOK, body)
+}
+
+type accountKeyType struct{}
+
+var accountKey accountKeyType
+
+// authorize rejects Web API requests without a valid access token. The
+// caller must hold s.mu.
+func (s *Server) authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		t, known := s.accessTokens[token]
+		if !ok || !known {
+			writeError(w, http.StatusUnauthorized, "Invalid access token")
+			return
+		}
+		if time.Now().After(t.expiresAt) {
+			writeError(w, http.StatusUnauthorized, "The access token expired")
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), accountKey, t.account)))
+	})
+}
+
+// account returns the account whose access token authorized a request.
+func account(r *http.Request) string {
+	a, _ := r.Context().Value(accountKey).(string)
+	return a
+}
+
+func (s *Server) me(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"id": account(r)})
+}
+
+func artistJSON(a provider.Artist) map[string]any {
+	body := map[string]any{
+		"id":            a.ID,
+		"name":          a.Name,
+		"uri":           a.URI,
+		"external_urls": map[string]string{"spotify": a.Href},
+	}
+	if a.ImageURL != "" {
+		body["images"] = []map[string]string{{"url": a.ImageURL}}
+	}
+	return body
+}
+
+func playJSON(p provider.Play) map[string]any {
+	artists := make([]map[string]any, len(p.Track.Artists))
+	for i, a := range p.Track.Artists {
+		a.ImageURL = ""
+		artists[i] = artistJSON(a)
+	}
+	albumArtists := make([]map[string]any, len(p.Track.Album.Artists))
+	for i, name := range p.Track.Album.Artists {
+		albumArtists[i] = map[string]any{"name": name}
+	}
+	album := map[string]any{
+		"id":            p.Track.Album.ID,
+		"name":          p.Track.Album.Name,
+		"artists":       albumArtists,
+		"uri":           p.Track.Album.URI,
+		"external_urls": map[string]string{"spotify": p.Track.Album.Href},
+	}
+	if p.Track.Album.ImageURL != "" {
+		album["images"] = []map[string]string{{"url": p.Track.Album.ImageURL}}
+	}
+	return map[string]any{
+		"played_at": p.PlayedAt.UTC().Format(time.RFC3339Nano),
+		"track": map[string]any{
+			"id":            p.Track.ID,
+			"name":          p.Track.Name,
+			"artists":       artists,
+			"album":         album,
+			"uri":           p.Track.URI,
+			"duration_ms":   p.Track.DurationMS,
+			"external_urls": map[string]string{"spotify": p.Track.Href},
+		},
+	}
+}
+
+// recentlyPlayed returns up to limit of the plays after the after cursor,
+// oldest first, but in the newest first order Spotify returns them in.
+func (s *Server) recentlyPlayed(w http.ResponseWriter, r *http.Request) {
+	limit := recentlyPlayedLimit
+	if l := r.URL.Query().Get("limit"); l != "" {
+		n, err := strconv.Atoi(l)
+		if err != nil || n < 1 || n > recentlyPlayedLimit {
+			writeError(w, http.StatusBadRequest, "Invalid limit")
+			return
+		}
+		limit = n
+	}
+	var after time.Time
+	if a := r.URL.Query().Get("after"); a != "" {
+		ms, err := strconv.ParseInt(a, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid after")
+			return
+		}
+		after = time.UnixMilli(ms)
+	}
+
+	plays := slices.DeleteFunc(slices.Clone(s.plays[account(r)]), func(p provider.Play) bool {
+		return !p.PlayedAt.After(after)
+	})
+	slices.SortFunc(plays, func(a, b provider.Play) int {
+		return a.PlayedAt.Compare(b.PlayedAt)
+	})
+	plays = plays[:min(len(plays), limit)]
+	slices.Reverse(plays)
+
+	items := make([]map[string]any, len(plays))
+	for i, p := range plays {
+		items[i] = playJSON(p)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"items": items})
+}
+
+func (s *Server) getArtists(w http.ResponseWriter, r *http.Request) {
+	ids := strings.Split(r.URL.Query().Get("ids"), ",")
+	if len(ids) > artistsLimit {
+		writeError(w, http.StatusBadRequest, "Too many ids requested")
+		return
+	}
+	artists := make([]map[string]any, len(ids))
+	for i, id := range ids {
+		// Unknown artists are returned as null
+		if a, ok := s.artists[id]; ok {
+			artists[i] = artistJSON(a)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"artists": artists})
+}
+
+// ownedPlaylist returns the playlist of the request, writing an error if it
+// doesn't exist or isn't owned by the requesting account.
+func (s *Server) ownedPlaylist(w http.ResponseWriter, r *http.Request) (*Playlist, bool) {
+	p, ok := s.playlists[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Not found.")
+		return nil, false
+	}
+	if p.Owner != account(r) {
+		writeError(w, http.StatusForbidden, "You cannot modify this playlist.")
+		return nil, false
+	}
+	return p, true
+}
+
+func (s *Server) createPlaylist(w http.ResponseWriter, r *http.Request) {
+	if r.PathValue("user") != account(r) {
+		writeError(w, http.StatusForbidden, "You cannot create a playlist for another user.")
+		return
+	}
+	var body struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Name == "" {
+		writeError(w, http.StatusBadRequest, "Missing name")
+		return
+	}
+
+	id := s.nextID("playlist")
+	s.playlists[id] = &Playlist{
+		ID:          id,
+		Owner:       account(r),
+		Name:        body.Name,
+		Description: body.Description,
+	}
+	writeJSON(w, http.StatusCreated, map[string]any{
+		"id":            id,
+		"external_urls": map[string]string{"spotify": "https://open.spotify.com/playlist/" + id},
+	})
+}
+
+func (s *Server) updatePlaylist(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.ownedPlaylist(w, r)
+	if !ok {
+		return
+	}
+	var body struct {
+		Name        *string `json:"name"`
+		Description *string `json:"description"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid body")
+		return
+	}
+	if body.Name != nil {
+		p.Name = *body.Name
+	}
+	if body.Description != nil {
+		p.Description = *body.Description
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// decodeURIs decodes the track URIs of a request, enforcing Spotify's limit
+// of 100 tracks per request.
+func decodeURIs(w http.ResponseWriter, r *http.Request) ([]string, bool) {
+	var body struct {
+		URIs []string `json:"uris"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid body")
+		return nil, false
+	}
+	if len(body.URIs) > playlistTracksLimit {
+		writeError(w, http.StatusBadRequest, "You can add a maximum of 100 tracks per request.")
+		return nil, false
+	}
+	return body.URIs, true
+}
+
+func (s *Server) addTracks(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.ownedPlaylist(w, r)
+	if !ok {
+		return
+	}
+	uris, ok := decodeURIs(w, r)
+	if !ok {
+		return
+	}
+	p.Tracks = append(p.Tracks, uris...)
+	writeJSON(w, http.StatusCreated, map[string]string{"snapshot_id": s.nextID("snapshot")})
+}
+
+func (s *Server) replaceTracks(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.ownedPlaylist(w, r)
+	if !ok {
+		return
+	}
+	uris, ok := decodeURIs(w, r)
+	if !ok {
+		return
+	}
+	p.Tracks = uris
+	writeJSON(w, http.StatusOK, map[string]string{"snapshot_id": s.nextID("snapshot")})
+}
+
+func (s *Server) setCover(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.ownedPlaylist(w, r)
+	if !ok {
+		return
+	}
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(r.Body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid body")
+		return
+	}
+	cover, err := base64.StdEncoding.DecodeString(buf.String())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Cover is not base64 encoded")
+		return
+	}
+	p.Cover = cover
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// unfollowPlaylist removes a playlist. The real API only removes it from the
+// owner's library, but to its owner that is the same as deleting it.
+func (s *Server) unfollowPlaylist(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.ownedPlaylist(w, r)
+	if !ok {
+		return
+	}
+	delete(s.playlists, p.ID)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) image(w http.ResponseWriter, _ *http.Request) {
+	img := image.NewRGBA(image.Rect(0, 0, 64, 64))
+	for i := range img.Pix {
+		img.Pix[i] = 0x80
+	}
+	w.Header().Set("Content-Type", "image/jpeg")
+	_ = jpeg.Encode(w, img, nil)
+}
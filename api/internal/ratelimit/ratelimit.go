@@ -0,0 +1,182 @@
+// Package ratelimit limits how fast requests are made to external services
+// with a token bucket per key, such as per user.
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Bucket is a token bucket. Each request takes a token, and tokens are added
+// back at a steady rate up to the bucket's burst size. A nil Bucket never
+// limits requests.
+type Bucket struct {
+	mu          sync.Mutex
+	rate        float64 // tokens per second
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+// NewBucket creates a full bucket that refills at rate tokens per second.
+func NewBucket(rate float64, burst int) *Bucket {
+	return &Bucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a token is taken or ctx is done.
+func (b *Bucket) Wait(ctx context.Context) error {
+	if b == nil {
+		return nil
+	}
+	for {
+		wait := b.reserve(time.Now())
+		if wait == 0 {
+			return nil
+		}
+		if err := Sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token if one is available, or returns how long until one
+// might be.
+func (b *Bucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if now.Before(b.pausedUntil) {
+		return b.pausedUntil.Sub(now)
+	}
+	b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+// full reports whether the bucket will have refilled by now and isn't paused,
+// so it is no different from a new bucket.
+func (b *Bucket) full(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return !now.Before(b.pausedUntil) && b.tokens+now.Sub(b.last).Seconds()*b.rate >= b.burst
+}
+
+// Pause stops handing out tokens for d, such as when a service responds with
+// Retry-After. Pauses never shorten an earlier, longer pause.
+func (b *Bucket) Pause(d time.Duration) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if until := time.Now().Add(d); until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+}
+
+// Limiter holds a bucket per key. Every bucket has the same rate and burst.
+type Limiter struct {
+	mu      sync.Mutex
+	rate    float64
+	burst   int
+	buckets map[string]*Bucket
+	pruned  time.Time
+}
+
+// New creates a limiter whose buckets refill at rate tokens per second.
+func New(rate float64, burst int) *Limiter {
+	return &Limiter{
+		rate:    rate,
+		burst:   burst,
+		buckets: make(map[string]*Bucket),
+		pruned:  time.Now(),
+	}
+}
+
+// Bucket returns the bucket of a key, creating it if needed. A nil Limiter
+// returns a nil bucket, which never limits requests.
+func (l *Limiter) Bucket(key string) *Bucket {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = NewBucket(l.rate, l.burst)
+		l.buckets[key] = b
+	}
+	return b
+}
+
+// Allow takes a token from the bucket of a key if one is available, without
+// waiting, and reports whether it did. It is meant for requests that are
+// refused rather than delayed when over the limit. Now and then, buckets that
+// have refilled are dropped, so keys that stop making requests, such as past
+// clients, don't use memory forever. A nil Limiter allows every request.
+func (l *Limiter) Allow(key string) bool {
+	if l == nil {
+		return true
+	}
+	now := time.Now()
+	l.mu.Lock()
+	if refill := time.Duration(float64(l.burst) / l.rate * float64(time.Second)); now.Sub(l.pruned) >= refill {
+		for k, b := range l.buckets {
+			if b.full(now) {
+				delete(l.buckets, k)
+			}
+		}
+		l.pruned = now
+	}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = NewBucket(l.rate, l.burst)
+		l.buckets[key] = b
+	}
+	l.mu.Unlock()
+
+	return b.reserve(time.Now()) == 0
+}
+
+type bucketKeyType struct{}
+
+var bucketKey bucketKeyType
+
+// NewContext returns a context carrying a bucket that requests made with it
+// wait for.
+func NewContext(ctx context.Context, b *Bucket) context.Context {
+	return context.WithValue(ctx, bucketKey, b)
+}
+
+// FromContext returns the bucket of a context, or nil if it has none.
+func FromContext(ctx context.Context) *Bucket {
+	b, _ := ctx.Value(bucketKey).(*Bucket)
+	return b
+}
+
+// Sleep pauses for d or until ctx is done.
+func Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
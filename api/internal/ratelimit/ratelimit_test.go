@@ -0,0 +1,123 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBucketBurst(t *testing.T) {
+	b := NewBucket(1, 3)
+	now := b.last
+
+	for i := range 3 {
+		if wait := b.reserve(now); wait != 0 {
+			t.Fatalf("request %d waited %s, want no wait within the burst", i, wait)
+		}
+	}
+	if wait := b.reserve(now); wait != time.Second {
+		t.Fatalf("request after burst waited %s, want 1s", wait)
+	}
+}
+
+func TestBucketRefill(t *testing.T) {
+	b := NewBucket(2, 1)
+	now := b.last
+
+	if wait := b.reserve(now); wait != 0 {
+		t.Fatalf("first request waited %s, want no wait", wait)
+	}
+	if wait := b.reserve(now.Add(250 * time.Millisecond)); wait != 250*time.Millisecond {
+		t.Fatalf("request half way through refill waited %s, want 250ms", wait)
+	}
+	if wait := b.reserve(now.Add(500 * time.Millisecond)); wait != 0 {
+		t.Fatalf("request after refill waited %s, want no wait", wait)
+	}
+}
+
+func TestBucketPause(t *testing.T) {
+	b := NewBucket(100, 10)
+	b.Pause(time.Hour)
+	b.Pause(time.Minute)
+
+	wait := b.reserve(time.Now())
+	if wait < 59*time.Minute {
+		t.Fatalf("request while paused waited %s, want the longer pause of about 1h", wait)
+	}
+}
+
+func TestBucketWaitCanceled(t *testing.T) {
+	b := NewBucket(1, 1)
+	b.Pause(time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := b.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Wait() = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestNilBucket(t *testing.T) {
+	var l *Limiter
+	b := l.Bucket("user")
+	b.Pause(time.Hour)
+	if err := b.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait() on nil bucket = %v, want nil", err)
+	}
+	if got := FromContext(context.Background()); got != nil {
+		t.Fatalf("FromContext() without a bucket = %v, want nil", got)
+	}
+}
+
+func TestLimiterBucketPerKey(t *testing.T) {
+	l := New(1, 1)
+	if l.Bucket("a") != l.Bucket("a") {
+		t.Fatal("Bucket() returned different buckets for the same key")
+	}
+	if l.Bucket("a") == l.Bucket("b") {
+		t.Fatal("Bucket() returned the same bucket for different keys")
+	}
+}
+
+func TestLimiterAllow(t *testing.T) {
+	l := New(1.0/3600, 2)
+	for i := range 2 {
+		if !l.Allow("a") {
+			t.Fatalf("Allow() request %d = false, want true within the burst", i)
+		}
+	}
+	if l.Allow("a") {
+		t.Error("Allow() after burst = true, want false")
+	}
+	if !l.Allow("b") {
+		t.Error("Allow() for another key = false, want true")
+	}
+
+	var nilLimiter *Limiter
+	if !nilLimiter.Allow("a") {
+		t.Error("Allow() on nil limiter = false, want true")
+	}
+}
+
+func TestLimiterAllowDropsRefilledBuckets(t *testing.T) {
+	l := New(1, 1)
+	l.Allow("idle")
+	l.Allow("busy")
+
+	// Only the idle bucket has refilled by the next prune
+	past := time.Now().Add(-time.Minute)
+	l.pruned = past
+	l.buckets["idle"].last = past
+	l.Allow("new")
+
+	if _, ok := l.buckets["idle"]; ok {
+		t.Error("refilled bucket was kept, want it dropped")
+	}
+	if _, ok := l.buckets["busy"]; !ok {
+		t.Error("empty bucket was dropped, want it kept")
+	}
+	if l.Allow("busy") {
+		t.Error("Allow() for a kept empty bucket = true, want false")
+	}
+}
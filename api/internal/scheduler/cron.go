@@ -0,0 +1,190 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxSearchYears bounds how far ahead Next looks for a matching time so
+// impossible schedules (e.g. February 30th) terminate.
+const maxSearchYears = 5
+
+var ErrInvalidSchedule = errors.New("invalid cron schedule")
+
+// Schedule is a parsed five-field cron expression
+// (minute hour day-of-month month day-of-week) evaluated in a time zone.
+type Schedule struct {
+	minute   [60]bool
+	hour     [24]bool
+	dom      [32]bool
+	month    [13]bool
+	dow      [7]bool
+	hourStar bool
+	domStar  bool
+	dowStar  bool
+	location *time.Location
+}
+
+type field struct {
+	min, max int
+}
+
+var (
+	minuteField = field{0, 59}
+	hourField   = field{0, 23}
+	domField    = field{1, 31}
+	monthField  = field{1, 12}
+	dowField    = field{0, 7}
+)
+
+// Parse parses a standard five-field cron expression. Each field accepts
+// "*", single values, ranges ("1-5"), steps ("*/15", "0-30/10") and
+// comma-separated lists of those. Day-of-week accepts 0-7 where both 0 and 7
+// are Sunday. As in cron, when both day-of-month and day-of-week are
+// restricted a time matches if either does.
+func Parse(spec string, location *time.Location) (*Schedule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("%w: expected 5 fields, got %d", ErrInvalidSchedule, len(fields))
+	}
+	if location == nil {
+		location = time.UTC
+	}
+
+	s := &Schedule{
+		location: location,
+		hourStar: fields[1] == "*",
+		domStar:  fields[2] == "*",
+		dowStar:  fields[4] == "*",
+	}
+	if err := parseField(fields[0], minuteField, s.minute[:]); err != nil {
+		return nil, fmt.Errorf("minute: %w", err)
+	}
+	if err := parseField(fields[1], hourField, s.hour[:]); err != nil {
+		return nil, fmt.Errorf("hour: %w", err)
+	}
+	if err := parseField(fields[2], domField, s.dom[:]); err != nil {
+		return nil, fmt.Errorf("day of month: %w", err)
+	}
+	if err := parseField(fields[3], monthField, s.month[:]); err != nil {
+		return nil, fmt.Errorf("month: %w", err)
+	}
+	var dow [8]bool
+	if err := parseField(fields[4], dowField, dow[:]); err != nil {
+		return nil, fmt.Errorf("day of week: %w", err)
+	}
+	copy(s.dow[:], dow[:7])
+	s.dow[0] = s.dow[0] || dow[7]
+
+	return s, nil
+}
+
+// parseField marks every value matched by expr in set.
+func parseField(expr string, f field, set []bool) error {
+	for part := range strings.SplitSeq(expr, ",") {
+		rng, stepStr, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepStr)
+			if err != nil || step <= 0 {
+				return fmt.Errorf("%w: invalid step %q", ErrInvalidSchedule, stepStr)
+			}
+		}
+
+		lo, hi := f.min, f.max
+		if rng != "*" {
+			loStr, hiStr, isRange := strings.Cut(rng, "-")
+			var err error
+			lo, err = strconv.Atoi(loStr)
+			if err != nil {
+				return fmt.Errorf("%w: invalid value %q", ErrInvalidSchedule, loStr)
+			}
+			hi = lo
+			if isRange {
+				hi, err = strconv.Atoi(hiStr)
+				if err != nil {
+					return fmt.Errorf("%w: invalid value %q", ErrInvalidSchedule, hiStr)
+				}
+			} else if hasStep {
+				hi = f.max
+			}
+		}
+		if lo < f.min || hi > f.max || lo > hi {
+			return fmt.Errorf("%w: %q out of range %d-%d", ErrInvalidSchedule, part, f.min, f.max)
+		}
+
+		for v := lo; v <= hi; v += step {
+			set[v] = true
+		}
+	}
+	return nil
+}
+
+// Next returns the first time strictly after t that matches the schedule.
+// The zero time is returned if no time matches within the next few years.
+//
+// Times skipped when clocks go forward for daylight saving time never match.
+// When clocks go back, schedules that run every hour match the repeated hour
+// again, while schedules for specific hours only match its first occurrence.
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := s.location
+	// Truncate the elapsed time, as time.Date would resolve a wall clock
+	// time in the repeated hour to its first occurrence, before t.
+	t = t.In(loc).Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(maxSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if !s.month[t.Month()] {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.hour[t.Hour()] {
+			// Advance by elapsed time rather than with time.Date, which can
+			// resolve back into the same hour across a DST gap.
+			t = t.Add(time.Duration(60-t.Minute()) * time.Minute)
+			continue
+		}
+		if !s.minute[t.Minute()] || (!s.hourStar && repeated(t)) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+// repeated reports whether the wall clock time of t already occurred earlier
+// because clocks were set back, as at the end of daylight saving time.
+func repeated(t time.Time) bool {
+	_, offset := t.Zone()
+	_, before := t.Add(-time.Hour).Zone()
+	if before <= offset {
+		return false
+	}
+	earlier := t.Add(-time.Duration(before-offset) * time.Second)
+	return earlier.Hour() == t.Hour() && earlier.Minute() == t.Minute()
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	dom := s.dom[t.Day()]
+	dow := s.dow[t.Weekday()]
+	switch {
+	case s.domStar && s.dowStar:
+		return true
+	case s.domStar:
+		return dow
+	case s.dowStar:
+		return dom
+	default:
+		return dom || dow
+	}
+}
@@ -0,0 +1,225 @@
+package scheduler
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		spec    string
+		wantErr bool
+	}{
+		{spec: "* * * * *"},
+		{spec: "*/15 0-6,22-23 1 1-12/3 1-5"},
+		{spec: "0 9 * * 7"},
+		{spec: "0 9 * * 0,7"},
+		{spec: "0-30/10 * * * *"},
+		{spec: "", wantErr: true},
+		{spec: "* * * *", wantErr: true},
+		{spec: "* * * * * *", wantErr: true},
+		{spec: "60 * * * *", wantErr: true},
+		{spec: "* 24 * * *", wantErr: true},
+		{spec: "* * 0 * *", wantErr: true},
+		{spec: "* * 32 * *", wantErr: true},
+		{spec: "* * * 13 *", wantErr: true},
+		{spec: "* * * * 8", wantErr: true},
+		{spec: "5-1 * * * *", wantErr: true},
+		{spec: "*/0 * * * *", wantErr: true},
+		{spec: "*/x * * * *", wantErr: true},
+		{spec: "a * * * *", wantErr: true},
+		{spec: "1-b * * * *", wantErr: true},
+		{spec: "1,,2 * * * *", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			_, err := Parse(tt.spec, time.UTC)
+			if tt.wantErr && !errors.Is(err, ErrInvalidSchedule) {
+				t.Errorf("Parse(%q) error = %v, want %v", tt.spec, err, ErrInvalidSchedule)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Parse(%q) = %v", tt.spec, err)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation() = %v", err)
+	}
+	utc := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		spec     string
+		location *time.Location
+		from     time.Time
+		want     time.Time
+	}{
+		{
+			name: "next step",
+			spec: "*/15 * * * *",
+			from: utc(2024, time.January, 1, 10, 7),
+			want: utc(2024, time.January, 1, 10, 15),
+		},
+		{
+			name: "strictly after a matching time",
+			spec: "*/15 * * * *",
+			from: utc(2024, time.January, 1, 10, 15),
+			want: utc(2024, time.January, 1, 10, 30),
+		},
+		{
+			name: "seconds are ignored",
+			spec: "*/15 * * * *",
+			from: utc(2024, time.January, 1, 10, 14).Add(59 * time.Second),
+			want: utc(2024, time.January, 1, 10, 15),
+		},
+		{
+			name: "first of next month",
+			spec: "0 0 1 * *",
+			from: utc(2024, time.January, 15, 12, 0),
+			want: utc(2024, time.February, 1, 0, 0),
+		},
+		{
+			name: "across the end of a year",
+			spec: "0 0 1 1 *",
+			from: utc(2024, time.March, 1, 0, 0),
+			want: utc(2025, time.January, 1, 0, 0),
+		},
+		{
+			name: "leap day",
+			spec: "0 0 29 2 *",
+			from: utc(2024, time.March, 1, 0, 0),
+			want: utc(2028, time.February, 29, 0, 0),
+		},
+		{
+			name: "day of month only",
+			spec: "0 0 13 * *",
+			from: utc(2024, time.October, 12, 0, 0),
+			want: utc(2024, time.October, 13, 0, 0),
+		},
+		{
+			name: "day of week only",
+			spec: "0 0 * * 5",
+			from: utc(2024, time.October, 12, 0, 0),
+			want: utc(2024, time.October, 18, 0, 0),
+		},
+		{
+			name: "day of month or day of week matches day of week",
+			spec: "0 0 13 * 5",
+			from: utc(2024, time.September, 1, 0, 0),
+			want: utc(2024, time.September, 6, 0, 0),
+		},
+		{
+			name: "day of month or day of week matches day of month",
+			spec: "0 0 13 * 5",
+			from: utc(2024, time.October, 12, 0, 0),
+			want: utc(2024, time.October, 13, 0, 0),
+		},
+		{
+			name: "day of week 0 is Sunday",
+			spec: "0 9 * * 0",
+			from: utc(2024, time.October, 14, 0, 0),
+			want: utc(2024, time.October, 20, 9, 0),
+		},
+		{
+			name: "day of week 7 is Sunday",
+			spec: "0 9 * * 7",
+			from: utc(2024, time.October, 14, 0, 0),
+			want: utc(2024, time.October, 20, 9, 0),
+		},
+		{
+			name: "month and day of week",
+			spec: "30 12 * 2 1",
+			from: utc(2024, time.March, 1, 0, 0),
+			want: utc(2025, time.February, 3, 12, 30),
+		},
+		{
+			name:     "evaluated in the location",
+			spec:     "0 9 * * *",
+			location: newYork,
+			from:     utc(2024, time.January, 1, 15, 0),
+			want:     utc(2024, time.January, 2, 14, 0),
+		},
+		{
+			name:     "spring forward skips missing time",
+			spec:     "30 2 * * *",
+			location: newYork,
+			from:     utc(2024, time.March, 9, 8, 0),
+			want:     time.Date(2024, time.March, 11, 2, 30, 0, 0, newYork),
+		},
+		{
+			name:     "spring forward after the gap",
+			spec:     "0 3 * * *",
+			location: newYork,
+			from:     utc(2024, time.March, 10, 5, 0),
+			want:     utc(2024, time.March, 10, 7, 0),
+		},
+		{
+			name:     "spring forward hourly",
+			spec:     "0 * * * *",
+			location: newYork,
+			from:     utc(2024, time.March, 10, 6, 30),
+			want:     utc(2024, time.March, 10, 7, 0),
+		},
+		{
+			name:     "fall back first occurrence",
+			spec:     "30 1 * * *",
+			location: newYork,
+			from:     utc(2024, time.November, 3, 4, 0),
+			want:     utc(2024, time.November, 3, 5, 30),
+		},
+		{
+			name:     "fall back does not repeat specific hours",
+			spec:     "30 1 * * *",
+			location: newYork,
+			from:     utc(2024, time.November, 3, 5, 30),
+			want:     utc(2024, time.November, 4, 6, 30),
+		},
+		{
+			name:     "fall back repeats every hour",
+			spec:     "30 * * * *",
+			location: newYork,
+			from:     utc(2024, time.November, 3, 5, 30),
+			want:     utc(2024, time.November, 3, 6, 30),
+		},
+		{
+			name:     "fall back from the repeated hour",
+			spec:     "*/30 * * * *",
+			location: newYork,
+			from:     utc(2024, time.November, 3, 6, 10),
+			want:     utc(2024, time.November, 3, 6, 30),
+		},
+		{
+			name: "february 30th never matches",
+			spec: "0 0 30 2 *",
+			from: utc(2024, time.January, 1, 0, 0),
+			want: time.Time{},
+		},
+		{
+			name: "april 31st never matches",
+			spec: "0 0 31 4 *",
+			from: utc(2024, time.January, 1, 0, 0),
+			want: time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse(tt.spec, tt.location)
+			if err != nil {
+				t.Fatalf("Parse(%q) = %v", tt.spec, err)
+			}
+			got := s.Next(tt.from)
+			if !got.Equal(tt.want) {
+				t.Errorf("Next(%v) = %v, want %v", tt.from, got, tt.want)
+			}
+		})
+	}
+}
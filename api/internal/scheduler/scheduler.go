@@ -0,0 +1,274 @@
+// Package scheduler runs recurring jobs on cron schedules persisted in the database.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"mars/internal/database"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DefaultPollInterval is how often the scheduler checks the database for due
+// or manually triggered jobs.
+const DefaultPollInterval = 15 * time.Second
+
+// maxCatchUpRuns bounds how many missed runs a job replays in one go.
+const maxCatchUpRuns = 100
+
+// RunFunc runs a job. scheduledAt is the time the run was scheduled for,
+// which lags behind the current time when missed runs are being caught up,
+// or the current time when the job was triggered manually.
+type RunFunc func(ctx context.Context, scheduledAt time.Time) error
+
+// Job is a recurring unit of work.
+type Job struct {
+	// Name uniquely identifies the job.
+	Name string
+	// Schedule is a five-field cron expression, see Parse.
+	Schedule string
+	// Timezone is the IANA time zone the schedule is evaluated in.
+	// Defaults to UTC.
+	Timezone string
+	// CatchUp replays every run missed while the scheduler was down.
+	// Otherwise, missed runs are collapsed into a single run.
+	CatchUp bool
+	// Run does the work.
+	Run RunFunc
+}
+
+type registeredJob struct {
+	Job
+	schedule *Schedule
+}
+
+// Scheduler runs registered jobs when they are due. Job state lives in the
+// database so that runs missed while the server was down are caught up on
+// startup, and so that jobs can be paused or triggered through the API.
+type Scheduler struct {
+	db           database.Querier
+	logger       *slog.Logger
+	pollInterval time.Duration
+
+	jobs map[string]registeredJob
+
+	mtx     sync.Mutex
+	running map[string]struct{}
+	wg      sync.WaitGroup
+}
+
+func New(db database.Querier, logger *slog.Logger) *Scheduler {
+	return &Scheduler{
+		db:           db,
+		logger:       logger,
+		pollInterval: DefaultPollInterval,
+		jobs:         make(map[string]registeredJob),
+		running:      make(map[string]struct{}),
+	}
+}
+
+// Register adds a job to the scheduler. It must be called before Start.
+func (s *Scheduler) Register(job Job) error {
+	if job.Timezone == "" {
+		job.Timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(job.Timezone)
+	if err != nil {
+		return fmt.Errorf("loading timezone (%s): %w", job.Timezone, err)
+	}
+	schedule, err := Parse(job.Schedule, loc)
+	if err != nil {
+		return fmt.Errorf("parsing schedule for job (%s): %w", job.Name, err)
+	}
+	if schedule.Next(time.Now()).IsZero() {
+		return fmt.Errorf("schedule for job (%s) never fires", job.Name)
+	}
+	if _, ok := s.jobs[job.Name]; ok {
+		return fmt.Errorf("job (%s) already registered", job.Name)
+	}
+	s.jobs[job.Name] = registeredJob{Job: job, schedule: schedule}
+	return nil
+}
+
+// Start stores the registered jobs in the database and runs them until ctx
+// is cancelled. Jobs whose next run is already in the past are run
+// immediately. Start returns once the jobs are stored.
+func (s *Scheduler) Start(ctx context.Context) error {
+	now := time.Now()
+	for _, job := range s.jobs {
+		err := s.db.UpsertJob(ctx, database.UpsertJobParams{
+			Name:     job.Name,
+			Schedule: job.Schedule,
+			Timezone: job.Timezone,
+			NextRunAt: pgtype.Timestamptz{
+				Time:  job.schedule.Next(now),
+				Valid: true,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("storing job (%s): %w", job.Name, err)
+		}
+	}
+
+	go s.loop(ctx)
+	return nil
+}
+
+func (s *Scheduler) loop(ctx context.Context) {
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			s.logger.Info("stopping job scheduler")
+			s.wg.Wait()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll starts every job that is due or has been triggered and is not
+// already running.
+func (s *Scheduler) poll(ctx context.Context) {
+	rows, err := s.db.ListRunnableJobs(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list runnable jobs", slog.Any("error", err))
+		return
+	}
+
+	for _, row := range rows {
+		job, ok := s.jobs[row.Name]
+		if !ok {
+			// Job was removed from the code but is still in the database
+			continue
+		}
+		if !s.claim(job.Name) {
+			continue
+		}
+
+		s.wg.Go(func() {
+			defer s.release(job.Name)
+			s.run(ctx, job, row)
+		})
+	}
+}
+
+func (s *Scheduler) claim(name string) bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if _, ok := s.running[name]; ok {
+		return false
+	}
+	s.running[name] = struct{}{}
+	return true
+}
+
+func (s *Scheduler) release(name string) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	delete(s.running, name)
+}
+
+// run executes a manual trigger and any due runs of a job.
+func (s *Scheduler) run(ctx context.Context, job registeredJob, row database.Job) {
+	logger := s.logger.With(slog.String("job", job.Name))
+	nextRunAt := row.NextRunAt.Time
+
+	if row.TriggeredAt.Valid {
+		cleared, err := s.db.ClearJobTrigger(ctx, job.Name)
+		if err != nil {
+			logger.ErrorContext(ctx, "failed to clear job trigger", slog.Any("error", err))
+			return
+		}
+		if cleared > 0 {
+			logger.InfoContext(ctx, "running triggered job")
+			s.execute(ctx, logger, job, time.Now(), nextRunAt)
+		}
+	}
+
+	if row.Paused {
+		return
+	}
+
+	for range maxCatchUpRuns {
+		now := time.Now()
+		if nextRunAt.After(now) {
+			return
+		}
+
+		scheduledAt := nextRunAt
+		if job.CatchUp {
+			nextRunAt = job.schedule.Next(scheduledAt)
+		} else {
+			nextRunAt = job.schedule.Next(now)
+		}
+
+		logger.InfoContext(ctx, "running job", slog.Time("scheduled_at", scheduledAt))
+		s.execute(ctx, logger, job, scheduledAt, nextRunAt)
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// execute runs a job once and records the outcome.
+func (s *Scheduler) execute(
+	ctx context.Context, logger *slog.Logger, job registeredJob, scheduledAt, nextRunAt time.Time,
+) {
+	startedAt := time.Now()
+	err := job.Run(ctx, scheduledAt)
+	lastRunAt := pgtype.Timestamptz{
+		Time:  startedAt,
+		Valid: true,
+	}
+	next := pgtype.Timestamptz{
+		Time:  nextRunAt,
+		Valid: true,
+	}
+
+	if err != nil {
+		logger.ErrorContext(ctx, "job failed",
+			slog.Any("error", err),
+			slog.Duration("duration", time.Since(startedAt)))
+		err = s.db.RecordJobFailure(ctx, database.RecordJobFailureParams{
+			Name:      job.Name,
+			LastRunAt: lastRunAt,
+			LastError: pgtype.Text{
+				String: err.Error(),
+				Valid:  true,
+			},
+			NextRunAt: next,
+		})
+	} else {
+		logger.InfoContext(ctx, "job succeeded", slog.Duration("duration", time.Since(startedAt)))
+		err = s.db.RecordJobSuccess(ctx, database.RecordJobSuccessParams{
+			Name:      job.Name,
+			LastRunAt: lastRunAt,
+			NextRunAt: next,
+		})
+	}
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to record job run", slog.Any("error", err))
+	}
+}
+
+// NextRun returns the next time the schedule stored for a job fires after t.
+func NextRun(job database.Job, t time.Time) (time.Time, error) {
+	loc, err := time.LoadLocation(job.Timezone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("loading timezone (%s): %w", job.Timezone, err)
+	}
+	schedule, err := Parse(job.Schedule, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return schedule.Next(t), nil
+}
@@ -0,0 +1,270 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"mars/internal/database"
+	"mars/internal/database/databasetest"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// recorder is a RunFunc that records the times it was scheduled for.
+type recorder struct {
+	mtx  sync.Mutex
+	runs []time.Time
+	err  error
+}
+
+func (r *recorder) run(_ context.Context, scheduledAt time.Time) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.runs = append(r.runs, scheduledAt)
+	return r.err
+}
+
+func newSchedulerTest(t *testing.T, job Job) (*Scheduler, *databasetest.Querier) {
+	t.Helper()
+
+	db := databasetest.NewQuerier()
+	s := New(db, slog.New(slog.DiscardHandler))
+	if err := s.Register(job); err != nil {
+		t.Fatalf("Register() = %v", err)
+	}
+	return s, db
+}
+
+// storeJob stores the registered job as if the scheduler had been running
+// before, with its next run at nextRunAt.
+func storeJob(db *databasetest.Querier, job Job, nextRunAt time.Time) {
+	db.Jobs[job.Name] = database.Job{
+		Name:      job.Name,
+		Schedule:  job.Schedule,
+		Timezone:  job.Timezone,
+		NextRunAt: pgtype.Timestamptz{Time: nextRunAt, Valid: true},
+	}
+}
+
+func TestRegister(t *testing.T) {
+	tests := []struct {
+		name string
+		job  Job
+	}{
+		{
+			name: "invalid schedule",
+			job:  Job{Name: "job", Schedule: "* * *"},
+		},
+		{
+			name: "unknown timezone",
+			job:  Job{Name: "job", Schedule: "* * * * *", Timezone: "Mars/Olympus_Mons"},
+		},
+		{
+			name: "never fires",
+			job:  Job{Name: "job", Schedule: "0 0 30 2 *"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(databasetest.NewQuerier(), slog.New(slog.DiscardHandler))
+			if err := s.Register(tt.job); err == nil {
+				t.Errorf("Register() = nil, want an error")
+			}
+		})
+	}
+
+	t.Run("duplicate name", func(t *testing.T) {
+		s, _ := newSchedulerTest(t, Job{Name: "job", Schedule: "* * * * *"})
+		if err := s.Register(Job{Name: "job", Schedule: "0 * * * *"}); err == nil {
+			t.Errorf("Register() = nil, want an error")
+		}
+	})
+}
+
+func TestCatchUp(t *testing.T) {
+	now := time.Now()
+	start := now.Truncate(time.Hour).Add(-3 * time.Hour)
+
+	tests := []struct {
+		name     string
+		catchUp  bool
+		wantRuns []time.Time
+	}{
+		{
+			name:     "every missed run",
+			catchUp:  true,
+			wantRuns: []time.Time{start, start.Add(time.Hour), start.Add(2 * time.Hour), start.Add(3 * time.Hour)},
+		},
+		{
+			name:     "collapsed",
+			catchUp:  false,
+			wantRuns: []time.Time{start},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &recorder{}
+			job := Job{Name: "hourly", Schedule: "0 * * * *", CatchUp: tt.catchUp, Run: rec.run}
+			s, db := newSchedulerTest(t, job)
+			storeJob(db, job, start)
+
+			s.poll(context.Background())
+			s.wg.Wait()
+
+			if len(rec.runs) != len(tt.wantRuns) {
+				t.Fatalf("runs = %v, want %v", rec.runs, tt.wantRuns)
+			}
+			for i, want := range tt.wantRuns {
+				if !rec.runs[i].Equal(want) {
+					t.Errorf("run %d scheduled at %v, want %v", i, rec.runs[i], want)
+				}
+			}
+			stored := db.Jobs[job.Name]
+			if want := start.Add(4 * time.Hour); !stored.NextRunAt.Time.Equal(want) {
+				t.Errorf("next run = %v, want %v", stored.NextRunAt.Time, want)
+			}
+			if !stored.LastSuccessAt.Valid {
+				t.Errorf("last success was not recorded")
+			}
+		})
+	}
+}
+
+func TestCatchUpLimit(t *testing.T) {
+	rec := &recorder{}
+	job := Job{Name: "minutely", Schedule: "* * * * *", CatchUp: true, Run: rec.run}
+	s, db := newSchedulerTest(t, job)
+	start := time.Now().Truncate(time.Minute).Add(-7 * 24 * time.Hour)
+	storeJob(db, job, start)
+
+	s.poll(context.Background())
+	s.wg.Wait()
+
+	if len(rec.runs) != maxCatchUpRuns {
+		t.Fatalf("runs = %d, want %d", len(rec.runs), maxCatchUpRuns)
+	}
+	// The remaining runs are picked up by the next poll
+	if want := start.Add(maxCatchUpRuns * time.Minute); !db.Jobs[job.Name].NextRunAt.Time.Equal(want) {
+		t.Errorf("next run = %v, want %v", db.Jobs[job.Name].NextRunAt.Time, want)
+	}
+}
+
+func TestFailure(t *testing.T) {
+	rec := &recorder{err: errors.New("boom")}
+	job := Job{Name: "hourly", Schedule: "0 * * * *", Run: rec.run}
+	s, db := newSchedulerTest(t, job)
+	storeJob(db, job, time.Now().Add(-time.Minute))
+
+	s.poll(context.Background())
+	s.wg.Wait()
+
+	stored := db.Jobs[job.Name]
+	if stored.LastError.String != "boom" {
+		t.Errorf("last error = %q, want %q", stored.LastError.String, "boom")
+	}
+	if !stored.NextRunAt.Time.After(time.Now()) {
+		t.Errorf("next run = %v, want a time in the future", stored.NextRunAt.Time)
+	}
+}
+
+func TestPaused(t *testing.T) {
+	rec := &recorder{}
+	job := Job{Name: "hourly", Schedule: "0 * * * *", CatchUp: true, Run: rec.run}
+	s, db := newSchedulerTest(t, job)
+	missed := time.Now().Add(-3 * time.Hour)
+	storeJob(db, job, missed)
+	stored := db.Jobs[job.Name]
+	stored.Paused = true
+	db.Jobs[job.Name] = stored
+
+	s.poll(context.Background())
+	s.wg.Wait()
+
+	if len(rec.runs) != 0 {
+		t.Errorf("runs = %v, want none", rec.runs)
+	}
+	if got := db.Jobs[job.Name].NextRunAt.Time; !got.Equal(missed) {
+		t.Errorf("next run = %v, want %v", got, missed)
+	}
+}
+
+func TestTrigger(t *testing.T) {
+	tests := []struct {
+		name   string
+		paused bool
+	}{
+		{name: "active"},
+		{name: "paused", paused: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &recorder{}
+			job := Job{Name: "daily", Schedule: "0 0 * * *", Run: rec.run}
+			s, db := newSchedulerTest(t, job)
+			next := time.Now().Add(24 * time.Hour)
+			storeJob(db, job, next)
+			stored := db.Jobs[job.Name]
+			stored.Paused = tt.paused
+			db.Jobs[job.Name] = stored
+			if _, err := db.TriggerJob(context.Background(), job.Name); err != nil {
+				t.Fatalf("TriggerJob() = %v", err)
+			}
+
+			before := time.Now()
+			s.poll(context.Background())
+			s.wg.Wait()
+
+			if len(rec.runs) != 1 {
+				t.Fatalf("runs = %v, want 1", rec.runs)
+			}
+			if rec.runs[0].Before(before) {
+				t.Errorf("run scheduled at %v, want the time it was triggered", rec.runs[0])
+			}
+			stored = db.Jobs[job.Name]
+			if stored.TriggeredAt.Valid {
+				t.Errorf("trigger was not cleared")
+			}
+			// A manual run does not move the schedule
+			if !stored.NextRunAt.Time.Equal(next) {
+				t.Errorf("next run = %v, want %v", stored.NextRunAt.Time, next)
+			}
+
+			// The trigger only runs the job once
+			s.poll(context.Background())
+			s.wg.Wait()
+			if len(rec.runs) != 1 {
+				t.Errorf("runs = %v, want 1", rec.runs)
+			}
+		})
+	}
+}
+
+func TestPollSkipsRunningJobs(t *testing.T) {
+	rec := &recorder{}
+	job := Job{Name: "hourly", Schedule: "0 * * * *", Run: rec.run}
+	s, db := newSchedulerTest(t, job)
+	storeJob(db, job, time.Now().Add(-time.Minute))
+
+	if !s.claim(job.Name) {
+		t.Fatalf("claim() = false, want true")
+	}
+	s.poll(context.Background())
+	s.wg.Wait()
+	if len(rec.runs) != 0 {
+		t.Errorf("runs = %v, want none while the job is running", rec.runs)
+	}
+
+	s.release(job.Name)
+	s.poll(context.Background())
+	s.wg.Wait()
+	if len(rec.runs) != 1 {
+		t.Errorf("runs = %v, want 1", rec.runs)
+	}
+}
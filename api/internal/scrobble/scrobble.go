@@ -0,0 +1,185 @@
+// Package scrobble stores listens submitted by players through the
+// ListenBrainz-compatible API.
+package scrobble
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"mars/internal/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	spotifyTrackURIPrefix = "spotify:track:"
+	spotifyTrackPath      = "/track/"
+
+	// musicBrainzIDPrefix prefixes the IDs of tracks identified by a
+	// MusicBrainz recording.
+	musicBrainzIDPrefix = "musicbrainz:"
+	// localIDPrefix prefixes the IDs of tracks only identified by their
+	// title and artist.
+	localIDPrefix = "local:"
+)
+
+// spotifyIDPattern matches Spotify IDs, which are 22 base62 characters.
+var spotifyIDPattern = regexp.MustCompile(`^[0-9A-Za-z]{22}$`)
+
+// Listen is a single submitted listen.
+type Listen struct {
+	ListenedAt time.Time
+	ArtistName string
+	TrackName  string
+	// SpotifyID is a Spotify track URL, URI or ID, if the player knows it.
+	SpotifyID string
+	// RecordingMBID is the MusicBrainz recording ID, if the player knows it.
+	RecordingMBID string
+	// DurationMS is the length of the track in milliseconds, or 0 if unknown.
+	DurationMS int
+}
+
+// Result summarizes a submission.
+type Result struct {
+	// Imported is the number of new listens stored.
+	Imported int
+	// Duplicates is the number of listens that were already stored.
+	Duplicates int
+}
+
+// Submit stores each listen for the user. Tracks are resolved to a canonical
+// ID so that listens from other players count towards the same tracks as
+// listens synced from Spotify:
+//
+//  1. An existing track with the Spotify track ID, if the player sent one.
+//  2. An existing track with the same title and artist, preferring Spotify tracks.
+//  3. The MusicBrainz recording ID, if the player sent one.
+//  4. An ID derived from the title and artist.
+//
+// Spotify tracks are only created by syncs, as the metadata sent by players
+// can't be trusted to match the Spotify track. Tracks created for listens
+// can't be exported to Spotify.
+func Submit(ctx context.Context, db database.Querier, userID uuid.UUID, listens []Listen) (Result, error) {
+	var result Result
+	resolved := make(map[string]string)
+
+	for _, listen := range listens {
+		key := listen.SpotifyID + "\x00" + listen.RecordingMBID + "\x00" +
+			strings.ToLower(listen.ArtistName) + "\x00" + strings.ToLower(listen.TrackName)
+		trackID, ok := resolved[key]
+		if !ok {
+			var err error
+			trackID, err = resolveTrack(ctx, db, listen)
+			if err != nil {
+				return result, fmt.Errorf("resolving track (%s - %s): %w", listen.ArtistName, listen.TrackName, err)
+			}
+			resolved[key] = trackID
+		}
+
+		inserted, err := db.UpsertTrackListen(ctx, database.UpsertTrackListenParams{
+			UserID:  userID,
+			TrackID: trackID,
+			PlayedAt: pgtype.Timestamptz{
+				Time:  listen.ListenedAt,
+				Valid: true,
+			},
+		})
+		if err != nil {
+			return result, fmt.Errorf("creating listen (%s): %w", trackID, err)
+		}
+		if inserted == 0 {
+			result.Duplicates++
+		} else {
+			result.Imported++
+		}
+	}
+
+	return result, nil
+}
+
+// resolveTrack returns the ID of the track the listen is for, creating the
+// track if it doesn't exist.
+func resolveTrack(ctx context.Context, db database.Querier, listen Listen) (string, error) {
+	params := database.CreateTrackIfNotExistsParams{
+		Name:    listen.TrackName,
+		Artists: []string{listen.ArtistName},
+		DurationMs: pgtype.Int4{
+			Int32: int32(listen.DurationMS),
+			Valid: listen.DurationMS > 0,
+		},
+	}
+
+	if id, ok := SpotifyTrackID(listen.SpotifyID); ok {
+		exists, err := db.TrackExists(ctx, id)
+		if err != nil {
+			return "", fmt.Errorf("finding track (%s): %w", id, err)
+		}
+		if exists {
+			return id, nil
+		}
+	}
+
+	id, err := db.FindTrackByNameAndArtist(ctx, database.FindTrackByNameAndArtistParams{
+		Name:   listen.TrackName,
+		Artist: listen.ArtistName,
+	})
+	if err == nil {
+		return id, nil
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return "", fmt.Errorf("finding track: %w", err)
+	}
+
+	if mbid, err := uuid.Parse(listen.RecordingMBID); err == nil {
+		params.ID = musicBrainzIDPrefix + mbid.String()
+		params.Href = "https://musicbrainz.org/recording/" + mbid.String()
+	} else {
+		params.ID = localTrackID(listen.ArtistName, listen.TrackName)
+	}
+	if err := db.CreateTrackIfNotExists(ctx, params); err != nil {
+		return "", fmt.Errorf("creating track (%s): %w", params.ID, err)
+	}
+	return params.ID, nil
+}
+
+// localTrackID derives the ID of a track only identified by its title and
+// artist, ignoring case.
+func localTrackID(artistName, trackName string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(artistName) + "\x00" + strings.ToLower(trackName)))
+	return localIDPrefix + hex.EncodeToString(sum[:16])
+}
+
+// SpotifyTrackID extracts the track ID from a Spotify track URL
+// (https://open.spotify.com/track/<id>), URI (spotify:track:<id>) or bare ID.
+// Anything that isn't a well-formed Spotify ID is rejected.
+func SpotifyTrackID(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	if id, found := strings.CutPrefix(s, spotifyTrackURIPrefix); found {
+		if !spotifyIDPattern.MatchString(id) {
+			return "", false
+		}
+		return id, true
+	}
+	if spotifyIDPattern.MatchString(s) {
+		return s, true
+	}
+
+	u, err := url.Parse(s)
+	if err != nil || u.Host != "open.spotify.com" {
+		return "", false
+	}
+	// Localized links look like /intl-de/track/<id>
+	_, id, found := strings.Cut(u.Path, spotifyTrackPath)
+	if !found || !spotifyIDPattern.MatchString(id) {
+		return "", false
+	}
+	return id, true
+}
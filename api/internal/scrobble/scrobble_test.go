@@ -0,0 +1,184 @@
+package scrobble
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"mars/internal/database"
+	"mars/internal/database/databasetest"
+
+	"github.com/google/uuid"
+)
+
+const testSpotifyID = "4uLU6hMCjMI75M1A2tKUQC"
+
+func TestSpotifyTrackID(t *testing.T) {
+	tests := []struct {
+		input  string
+		want   string
+		wantOK bool
+	}{
+		{input: testSpotifyID, want: testSpotifyID, wantOK: true},
+		{input: "  " + testSpotifyID + "\n", want: testSpotifyID, wantOK: true},
+		{input: "spotify:track:" + testSpotifyID, want: testSpotifyID, wantOK: true},
+		{input: "https://open.spotify.com/track/" + testSpotifyID, want: testSpotifyID, wantOK: true},
+		{input: "https://open.spotify.com/track/" + testSpotifyID + "?si=abc", want: testSpotifyID, wantOK: true},
+		{input: "https://open.spotify.com/intl-de/track/" + testSpotifyID, want: testSpotifyID, wantOK: true},
+		{input: ""},
+		{input: "spotify"},
+		{input: "localhost"},
+		{input: "spotify:track:"},
+		{input: "spotify:track:abc"},
+		{input: "spotify:album:" + testSpotifyID},
+		{input: testSpotifyID + "x"},
+		{input: testSpotifyID[:21] + "-"},
+		{input: "https://open.spotify.com/album/" + testSpotifyID},
+		{input: "https://open.spotify.com/track/"},
+		{input: "https://open.spotify.com/track/" + testSpotifyID + "/extra"},
+		{input: "https://example.com/track/" + testSpotifyID},
+		{input: "https://www.youtube.com/watch?v=dQw4w9WgXcQ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, ok := SpotifyTrackID(tt.input)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("SpotifyTrackID(%q) = %q, %v, want %q, %v", tt.input, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestResolveTrack(t *testing.T) {
+	const mbid = "0f0cb9e6-2ea2-4a3a-9d3c-3a5b9b1c7e01"
+	spotifyTrack := database.UpsertTrackParams{
+		ID:      testSpotifyID,
+		Name:    "Paranoid Android",
+		Artists: []string{"Radiohead"},
+		Uri:     "spotify:track:" + testSpotifyID,
+	}
+	localTrack := database.UpsertTrackParams{
+		ID:      localIDPrefix + "existing",
+		Name:    "Karma Police",
+		Artists: []string{"Radiohead"},
+	}
+
+	tests := []struct {
+		name   string
+		tracks []database.UpsertTrackParams
+		listen Listen
+		want   string
+		// created is whether a new track is stored for the listen
+		created bool
+	}{
+		{
+			name:   "existing spotify track",
+			tracks: []database.UpsertTrackParams{spotifyTrack},
+			listen: Listen{ArtistName: "Someone", TrackName: "Something", SpotifyID: testSpotifyID},
+			want:   testSpotifyID,
+		},
+		{
+			name:   "unknown spotify track falls back to title and artist",
+			tracks: []database.UpsertTrackParams{localTrack},
+			listen: Listen{ArtistName: "radiohead", TrackName: "karma police", SpotifyID: testSpotifyID},
+			want:   localTrack.ID,
+		},
+		{
+			name: "unknown spotify track falls back to the recording",
+			listen: Listen{
+				ArtistName:    "Radiohead",
+				TrackName:     "Airbag",
+				SpotifyID:     testSpotifyID,
+				RecordingMBID: mbid,
+			},
+			want:    musicBrainzIDPrefix + mbid,
+			created: true,
+		},
+		{
+			name:    "unknown spotify track falls back to a local track",
+			listen:  Listen{ArtistName: "Radiohead", TrackName: "Airbag", SpotifyID: testSpotifyID},
+			want:    localTrackID("Radiohead", "Airbag"),
+			created: true,
+		},
+		{
+			name: "title and artist prefer spotify tracks",
+			tracks: []database.UpsertTrackParams{
+				{ID: "a", Name: "Paranoid Android", Artists: []string{"Radiohead"}},
+				spotifyTrack,
+			},
+			listen: Listen{ArtistName: "Radiohead", TrackName: "Paranoid Android"},
+			want:   testSpotifyID,
+		},
+		{
+			name:   "invalid spotify id",
+			tracks: []database.UpsertTrackParams{spotifyTrack},
+			listen: Listen{ArtistName: "Radiohead", TrackName: "Paranoid Android", SpotifyID: "spotify"},
+			want:   testSpotifyID,
+		},
+		{
+			name:    "recording",
+			listen:  Listen{ArtistName: "Radiohead", TrackName: "Airbag", RecordingMBID: mbid},
+			want:    musicBrainzIDPrefix + mbid,
+			created: true,
+		},
+		{
+			name:    "invalid recording",
+			listen:  Listen{ArtistName: "Radiohead", TrackName: "Airbag", RecordingMBID: "not-a-uuid"},
+			want:    localTrackID("Radiohead", "Airbag"),
+			created: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := databasetest.NewQuerier()
+			for _, track := range tt.tracks {
+				db.Tracks[track.ID] = track
+			}
+
+			got, err := resolveTrack(context.Background(), db, tt.listen)
+			if err != nil {
+				t.Fatalf("resolveTrack() = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("resolveTrack() = %q, want %q", got, tt.want)
+			}
+
+			if created := len(db.Tracks) > len(tt.tracks); created != tt.created {
+				t.Errorf("created track = %v, want %v", created, tt.created)
+			}
+			for id, track := range db.Tracks {
+				if strings.HasPrefix(track.Uri, "spotify:track:") && id != spotifyTrack.ID {
+					t.Errorf("created spotify track (%s) from a listen", id)
+				}
+			}
+			if track, ok := db.Tracks[testSpotifyID]; ok && track.Name != spotifyTrack.Name {
+				t.Errorf("spotify track name = %q, want %q", track.Name, spotifyTrack.Name)
+			}
+		})
+	}
+}
+
+func TestSubmitResolvesTracksOnce(t *testing.T) {
+	db := databasetest.NewQuerier()
+	userID := uuid.New()
+	listenedAt := time.Date(2024, time.January, 1, 10, 0, 0, 0, time.UTC)
+	listens := []Listen{
+		{ListenedAt: listenedAt, ArtistName: "Radiohead", TrackName: "Airbag"},
+		{ListenedAt: listenedAt.Add(5 * time.Minute), ArtistName: "RADIOHEAD", TrackName: "airbag"},
+		{ListenedAt: listenedAt.Add(5 * time.Minute), ArtistName: "Radiohead", TrackName: "Airbag"},
+	}
+
+	got, err := Submit(context.Background(), db, userID, listens)
+	if err != nil {
+		t.Fatalf("Submit() = %v", err)
+	}
+	if want := (Result{Imported: 2, Duplicates: 1}); got != want {
+		t.Errorf("Submit() = %+v, want %+v", got, want)
+	}
+	if len(db.Tracks) != 1 {
+		t.Errorf("stored %d tracks, want 1", len(db.Tracks))
+	}
+}
@@ -8,14 +8,18 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net/url"
 	"os"
 	"strconv"
+	"time"
 
 	"mars/internal/admin"
 	"mars/internal/database"
 	"mars/internal/env"
+	"mars/internal/mail"
 	"mars/internal/service"
 
+	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -143,3 +147,64 @@ func ServiceAccount(ctx context.Context, db database.Querier, logger *slog.Logge
 
 	return email, password, nil
 }
+
+// Mailer returns a mailer that sends emails through the SMTP server in
+// SMTP_HOST. Without one, emails are written to the logs instead.
+func Mailer(logger *slog.Logger) (mail.Mailer, error) {
+	host := os.Getenv("SMTP_HOST")
+	if host == "" {
+		logger.Warn("SMTP_HOST not set, emails will be logged instead of sent")
+		return mail.LogMailer{Logger: logger}, nil
+	}
+
+	port := mail.DefaultSMTPPort
+	if portStr := os.Getenv("SMTP_PORT"); portStr != "" {
+		p, err := strconv.ParseUint(portStr, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SMTP_PORT value: %w", err)
+		}
+		port = int(p)
+	}
+
+	mailer, err := mail.NewSMTP(mail.SMTPConfig{
+		Host:     host,
+		Port:     port,
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     os.Getenv("SMTP_FROM"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("configuring smtp: %w", err)
+	}
+	return mailer, nil
+}
+
+// webauthnTimeout is how long users have to finish registering or logging in
+// with a passkey.
+const webauthnTimeout = 5 * time.Minute
+
+// WebAuthn returns the relying party for passkeys. Passkeys are bound to the
+// host of appURL, so they stop working if mars moves to another domain.
+func WebAuthn(appURL string) (*webauthn.WebAuthn, error) {
+	u, err := url.Parse(appURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid app url: %w", err)
+	}
+	if u.Scheme == "" || u.Hostname() == "" {
+		return nil, fmt.Errorf("invalid app url %q: missing scheme or host", appURL)
+	}
+	timeout := webauthn.TimeoutConfig{
+		Enforce:    true,
+		Timeout:    webauthnTimeout,
+		TimeoutUVD: webauthnTimeout,
+	}
+	return webauthn.New(&webauthn.Config{
+		RPID:          u.Hostname(),
+		RPDisplayName: "mars",
+		RPOrigins:     []string{u.Scheme + "://" + u.Host},
+		Timeouts: webauthn.TimeoutsConfig{
+			Login:        timeout,
+			Registration: timeout,
+		},
+	})
+}
@@ -22,6 +22,10 @@ import (
 const (
 	RefreshTokenBytes = 64
 	CSRFTokenBytes    = 64
+	InviteTokenBytes  = 32
+	ListenTokenBytes  = 32
+	ResetTokenBytes   = 32
+	MFATokenBytes     = 32
 )
 
 const (
@@ -34,11 +38,13 @@ const (
 
 type (
 	useridCtxKeyType      struct{}
+	sessionidCtxKeyType   struct{}
 	accessTokenCtxKeyType struct{}
 )
 
 var (
 	useridCtxKey      useridCtxKeyType
+	sessionidCtxKey   sessionidCtxKeyType
 	accessTokenCtxKey accessTokenCtxKeyType
 )
 
@@ -50,22 +56,130 @@ func AccessTokenDuration() time.Duration {
 	return time.Minute * 30 // 30 minutes
 }
 
-func CreateRefreshToken(userid uuid.UUID) (token string, err error) {
+func InviteTokenDuration() time.Duration {
+	return time.Hour * 24 * 7 // 7 days
+}
+
+func PasswordResetTokenDuration() time.Duration {
+	return time.Hour // 1 hour
+}
+
+func CreateRefreshToken(sessionid uuid.UUID) (token string, err error) {
 	bytes := make([]byte, RefreshTokenBytes)
 	_, err = rand.Read(bytes)
 	if err != nil {
 		return "", err
 	}
 	return fmt.Sprintf(
-		"%s$%s", userid, base64.URLEncoding.EncodeToString(bytes)), nil
+		"%s$%s", sessionid, base64.URLEncoding.EncodeToString(bytes)), nil
 }
 
 func ParseRefreshToken(refreshtoken string) (
-	userid uuid.UUID, err error,
+	sessionid uuid.UUID, err error,
 ) {
 	id, _, found := strings.Cut(refreshtoken, "$")
 	if !found {
-		return userid, errors.New("invalid refresh token, expected format \"<user-id>$<random>\"")
+		return sessionid, errors.New("invalid refresh token, expected format \"<session-id>$<random>\"")
+	}
+	sessionid, err = uuid.Parse(id)
+	if err != nil {
+		return sessionid, fmt.Errorf("invalid session id: %w", err)
+	}
+	return sessionid, nil
+}
+
+func MFAChallengeDuration() time.Duration {
+	return time.Minute * 5 // 5 minutes
+}
+
+func CreatePasswordResetToken(resetid uuid.UUID) (token string, err error) {
+	bytes := make([]byte, ResetTokenBytes)
+	_, err = rand.Read(bytes)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(
+		"%s$%s", resetid, base64.URLEncoding.EncodeToString(bytes)), nil
+}
+
+func ParsePasswordResetToken(resettoken string) (
+	resetid uuid.UUID, err error,
+) {
+	id, _, found := strings.Cut(resettoken, "$")
+	if !found {
+		return resetid, errors.New("invalid password reset token, expected format \"<reset-id>$<random>\"")
+	}
+	resetid, err = uuid.Parse(id)
+	if err != nil {
+		return resetid, fmt.Errorf("invalid reset id: %w", err)
+	}
+	return resetid, nil
+}
+
+func CreateMFAToken(challengeid uuid.UUID) (token string, err error) {
+	bytes := make([]byte, MFATokenBytes)
+	_, err = rand.Read(bytes)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(
+		"%s$%s", challengeid, base64.URLEncoding.EncodeToString(bytes)), nil
+}
+
+func ParseMFAToken(mfatoken string) (
+	challengeid uuid.UUID, err error,
+) {
+	id, _, found := strings.Cut(mfatoken, "$")
+	if !found {
+		return challengeid, errors.New("invalid mfa token, expected format \"<challenge-id>$<random>\"")
+	}
+	challengeid, err = uuid.Parse(id)
+	if err != nil {
+		return challengeid, fmt.Errorf("invalid challenge id: %w", err)
+	}
+	return challengeid, nil
+}
+
+func CreateInviteToken(inviteid uuid.UUID) (token string, err error) {
+	bytes := make([]byte, InviteTokenBytes)
+	_, err = rand.Read(bytes)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(
+		"%s$%s", inviteid, base64.URLEncoding.EncodeToString(bytes)), nil
+}
+
+func ParseInviteToken(invitetoken string) (
+	inviteid uuid.UUID, err error,
+) {
+	id, _, found := strings.Cut(invitetoken, "$")
+	if !found {
+		return inviteid, errors.New("invalid invite token, expected format \"<invite-id>$<random>\"")
+	}
+	inviteid, err = uuid.Parse(id)
+	if err != nil {
+		return inviteid, fmt.Errorf("invalid invite id: %w", err)
+	}
+	return inviteid, nil
+}
+
+func CreateListenToken(userid uuid.UUID) (token string, err error) {
+	bytes := make([]byte, ListenTokenBytes)
+	_, err = rand.Read(bytes)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(
+		"%s$%s", userid, base64.URLEncoding.EncodeToString(bytes)), nil
+}
+
+func ParseListenToken(listentoken string) (
+	userid uuid.UUID, err error,
+) {
+	id, _, found := strings.Cut(listentoken, "$")
+	if !found {
+		return userid, errors.New("invalid listen token, expected format \"<user-id>$<random>\"")
 	}
 	userid, err = uuid.Parse(id)
 	if err != nil {
@@ -85,15 +199,19 @@ func CreateCSRFToken() (token string, err error) {
 	return base64.URLEncoding.EncodeToString(bytes), nil
 }
 
-func CreateAccessToken(env *env.Env, userid uuid.UUID, role role.Role) (token string, err error) {
+func CreateAccessToken(
+	env *env.Env, userid, sessionid uuid.UUID, role role.Role, tokenVersion int32,
+) (token string, err error) {
 	secret := env.Get("APP_SECRET")
 	if secret == "" {
 		return "", errors.New("APP_SECRET not set")
 	}
 
 	jwt, err := marsjwt.GenerateJWT(marsjwt.JWTParams{
-		Role:   role,
-		UserID: userid.String(),
+		Role:         role,
+		UserID:       userid.String(),
+		SessionID:    sessionid.String(),
+		TokenVersion: tokenVersion,
 	}, []byte(secret), "1", AccessTokenDuration())
 	if err != nil {
 		return "", fmt.Errorf("creating jwt: %w", err)
@@ -142,6 +260,19 @@ func NewCSRFTokenCookie(token string, secure bool) *http.Cookie {
 	}
 }
 
+// NewExpiredCookie returns a cookie that makes the browser delete the cookie
+// with the given name.
+func NewExpiredCookie(name string, secure bool) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		Secure:   secure,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
 func ParseBearerToken(bearertoken string) (string, error) {
 	token, found := strings.CutPrefix(bearertoken, "Bearer ")
 	if !found {
@@ -150,6 +281,14 @@ func ParseBearerToken(bearertoken string) (string, error) {
 	return token, nil
 }
 
+func ParseListenTokenHeader(header string) (string, error) {
+	token, found := strings.CutPrefix(header, "Token ")
+	if !found {
+		return "", errors.New("listen token should be in format \"Token <token>\"")
+	}
+	return token, nil
+}
+
 func UserIDWithContext(ctx context.Context, userid uuid.UUID) context.Context {
 	return context.WithValue(ctx, useridCtxKey, userid)
 }
@@ -162,6 +301,17 @@ func UserIDFromContext(ctx context.Context) (uuid.UUID, error) {
 	return userid, nil
 }
 
+func SessionIDWithContext(ctx context.Context, sessionid uuid.UUID) context.Context {
+	return context.WithValue(ctx, sessionidCtxKey, sessionid)
+}
+
+// SessionIDFromContext returns the session the request's access token was
+// issued for. Access tokens issued before sessions existed have none.
+func SessionIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	sessionid, ok := ctx.Value(sessionidCtxKey).(uuid.UUID)
+	return sessionid, ok
+}
+
 func AccessTokenWithContext(ctx context.Context, token *jwt.Token) context.Context {
 	return context.WithValue(ctx, accessTokenCtxKey, token)
 }
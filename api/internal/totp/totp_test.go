@@ -0,0 +1,98 @@
+package totp
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA-1 secret of the RFC 6238 test vectors.
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).
+	EncodeToString([]byte("12345678901234567890"))
+
+func TestCode(t *testing.T) {
+	// RFC 6238 appendix B, truncated to 6 digits
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		got, err := Code(rfcSecret, Step(time.Unix(tt.unix, 0)))
+		if err != nil {
+			t.Fatalf("Code() = %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("Code() at %d = %q, want %q", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+	current := Step(now)
+
+	tests := []struct {
+		name   string
+		step   int64
+		wantOK bool
+	}{
+		{"current period", current, true},
+		{"previous period", current - 1, true},
+		{"next period", current + 1, true},
+		{"too old", current - 2, false},
+		{"too new", current + 2, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := Code(rfcSecret, tt.step)
+			if err != nil {
+				t.Fatalf("Code() = %v", err)
+			}
+			step, ok, err := Validate(rfcSecret, code, now)
+			if err != nil {
+				t.Fatalf("Validate() = %v", err)
+			}
+			if ok != tt.wantOK {
+				t.Errorf("Validate() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && step != tt.step {
+				t.Errorf("Validate() step = %d, want %d", step, tt.step)
+			}
+		})
+	}
+
+	if _, ok, _ := Validate(rfcSecret, "12345", now); ok {
+		t.Error("Validate() accepted a code with too few digits")
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret() = %v", err)
+	}
+	if _, err := Code(secret, 1); err != nil {
+		t.Errorf("generated secret %q can't be used: %v", secret, err)
+	}
+}
+
+func TestURI(t *testing.T) {
+	u, err := url.Parse(URI("mars", "user@example.com", rfcSecret))
+	if err != nil {
+		t.Fatalf("parsing URI: %v", err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" || u.Path != "/mars:user@example.com" {
+		t.Errorf("URI = %s, want an otpauth://totp/mars:user@example.com URI", u)
+	}
+	if got := u.Query().Get("secret"); got != rfcSecret {
+		t.Errorf("URI secret = %q, want %q", got, rfcSecret)
+	}
+}
@@ -15,7 +15,7 @@ import {
 } from '@/auth';
 
 // Routes that don't require authentication
-const PUBLIC_ROUTES = ['/login', '/'];
+const PUBLIC_ROUTES = ['/login', '/reset-password', '/'];
 
 function isPublicRoute(pathname: string): boolean {
 	return PUBLIC_ROUTES.some((route) => pathname === route);
@@ -32,3 +32,18 @@ export async function refreshSession(fetch: FetchFn) {
 		throw e;
 	}
 }
+
+export async function logout(fetch: FetchFn, refreshToken: string) {
+	try {
+		return await fetch.post('api/logout', { json: { refresh_token: refreshToken } });
+	} catch (e) {
+		if (isHTTPError(e)) {
+			const err = ApiErrorSchema.safeParse(await e.response.clone().json());
+			if (err.success) {
+				throw new HTTPError(err.data.status, err.data.message, err.data.code, err.data.error_id);
+			}
+			throw new HTTPError(e.response.status, await e.response.text());
+		}
+		throw e;
+	}
+}
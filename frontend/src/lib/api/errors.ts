@@ -11,6 +11,7 @@ export const ErrorCode = {
 	InvalidCredentials: 'invalid_credentials',
 	InvalidAccessToken: 'invalid_access_token',
 	ExpiredAccessToken: 'expired_access_token',
+	RevokedAccessToken: 'revoked_access_token',
 	InvalidRefreshToken: 'invalid_refresh_token',
 	ExpiredRefreshToken: 'expired_refresh_token',
 	InsufficientPermissions: 'insufficient_permissions'
@@ -33,7 +34,8 @@ export const UNRECOVERABLE_AUTH_CODES: ErrorCode[] = [
  */
 export const RECOVERABLE_AUTH_CODES: ErrorCode[] = [
 	ErrorCode.InvalidAccessToken,
-	ErrorCode.ExpiredAccessToken
+	ErrorCode.ExpiredAccessToken,
+	ErrorCode.RevokedAccessToken
 ];
 
 export const ApiErrorSchema = z.object({
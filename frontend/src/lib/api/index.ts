@@ -2,3 +2,4 @@ export * from './types';
 export * from './playlists';
 export * from './spotify';
 export * from './auth';
+export * from './passkeys';
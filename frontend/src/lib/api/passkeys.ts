@@ -0,0 +1,86 @@
+import { getCsrfToken } from '@/http';
+import { PasskeyCeremonySchema, PasskeysSchema, PasskeySchema, type Passkey } from './types';
+import { CSRF_HEADER } from '@/auth';
+import fetchFn, { type FetchFn } from '@/http';
+import { isHTTPError } from 'ky';
+import { ApiErrorSchema, HTTPError } from './errors';
+
+async function toHTTPError(e: unknown): Promise<unknown> {
+	if (isHTTPError(e)) {
+		const err = ApiErrorSchema.safeParse(await e.response.clone().json());
+		if (err.success) {
+			return new HTTPError(err.data.status, err.data.message, err.data.code, err.data.error_id);
+		}
+		return new HTTPError(e.response.status, await e.response.text());
+	}
+	return e;
+}
+
+export async function getPasskeys(fetch: FetchFn = fetchFn): Promise<Passkey[]> {
+	try {
+		const res = await fetch.get('api/me/passkeys').json();
+		return PasskeysSchema.parse(res).passkeys;
+	} catch (e) {
+		throw await toHTTPError(e);
+	}
+}
+
+// Registers a passkey on this device. Must be called from the browser.
+export async function registerPasskey(name: string, fetch: FetchFn = fetchFn): Promise<Passkey> {
+	const headers = { [CSRF_HEADER]: getCsrfToken() ?? '' };
+	try {
+		const ceremony = PasskeyCeremonySchema.parse(
+			await fetch.post('/api/me/passkeys/register/begin', { headers }).json()
+		);
+		const credential = (await navigator.credentials.create({
+			publicKey: PublicKeyCredential.parseCreationOptionsFromJSON(
+				ceremony.options as unknown as PublicKeyCredentialCreationOptionsJSON
+			)
+		})) as PublicKeyCredential | null;
+		if (!credential) {
+			throw new Error('Passkey registration was cancelled');
+		}
+		const res = await fetch
+			.post('/api/me/passkeys/register/finish', {
+				headers,
+				json: { session_id: ceremony.session_id, name, credential: credential.toJSON() }
+			})
+			.json();
+		return PasskeySchema.parse(res);
+	} catch (e) {
+		throw await toHTTPError(e);
+	}
+}
+
+export async function deletePasskey(id: string, fetch: FetchFn = fetchFn): Promise<void> {
+	try {
+		await fetch.delete(`/api/me/passkeys/${id}`, {
+			headers: { [CSRF_HEADER]: getCsrfToken() ?? '' }
+		});
+	} catch (e) {
+		throw await toHTTPError(e);
+	}
+}
+
+// Logs in with a passkey picked by the user, setting the session cookies.
+// Must be called from the browser.
+export async function loginWithPasskey(fetch: FetchFn = fetchFn): Promise<void> {
+	try {
+		const ceremony = PasskeyCeremonySchema.parse(
+			await fetch.post('/api/login/passkey/begin').json()
+		);
+		const credential = (await navigator.credentials.get({
+			publicKey: PublicKeyCredential.parseRequestOptionsFromJSON(
+				ceremony.options as unknown as PublicKeyCredentialRequestOptionsJSON
+			)
+		})) as PublicKeyCredential | null;
+		if (!credential) {
+			throw new Error('Passkey login was cancelled');
+		}
+		await fetch.post('/api/login/passkey/finish', {
+			json: { session_id: ceremony.session_id, credential: credential.toJSON() }
+		});
+	} catch (e) {
+		throw await toHTTPError(e);
+	}
+}
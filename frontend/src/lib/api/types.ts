@@ -10,13 +10,21 @@ export type User = z.infer<typeof UserSchema>;
 
 export const PlaylistSchema = z.object({
 	id: z.string(),
-	type: z.enum(['weekly', 'monthly', 'custom']),
+	type: z.enum(['weekly', 'monthly', 'yearly', 'rolling_30d', 'custom']),
 	name: z.string(),
 	created_at: z.iso.datetime()
 });
 
 export type Playlist = z.infer<typeof PlaylistSchema>;
 
+export const playlistTypeLabels: Record<Playlist['type'], string> = {
+	weekly: 'Weekly',
+	monthly: 'Monthly',
+	yearly: 'Yearly',
+	rolling_30d: 'Last 30 Days',
+	custom: 'Custom'
+};
+
 export const PlaylistsSchema = z.object({
 	playlists: z.array(PlaylistSchema)
 });
@@ -34,8 +42,17 @@ export const TrackSchema = z.object({
 
 export type Track = z.infer<typeof TrackSchema>;
 
+export const SpotifyPlaylistSchema = z.object({
+	id: z.string(),
+	url: z.string()
+});
+
+export type SpotifyPlaylist = z.infer<typeof SpotifyPlaylistSchema>;
+
 export const PlaylistWithTracksSchema = PlaylistSchema.extend({
-	tracks: z.array(TrackSchema)
+	description: z.string(),
+	tracks: z.array(TrackSchema),
+	spotify_playlist: SpotifyPlaylistSchema.optional()
 });
 
 export type PlaylistWithTracks = z.infer<typeof PlaylistWithTracksSchema>;
@@ -49,15 +66,30 @@ export type SpotifyStatus = z.infer<typeof SpotifyStatusSchema>;
 // Re-export error types from errors.ts for backwards compatibility
 export { ApiErrorSchema, type ApiError } from './errors';
 
-export const SpotifyPlaylistSchema = z.object({
+export const TopTracksSchema = z.object({
+	tracks: z.array(TrackSchema)
+});
+
+export type TopTracks = z.infer<typeof TopTracksSchema>;
+
+export const PasskeySchema = z.object({
 	id: z.string(),
-	url: z.string()
+	name: z.string(),
+	created_at: z.iso.datetime(),
+	last_used_at: z.iso.datetime().optional()
 });
 
-export type SpotifyPlaylist = z.infer<typeof SpotifyPlaylistSchema>;
+export type Passkey = z.infer<typeof PasskeySchema>;
 
-export const TopTracksSchema = z.object({
-	tracks: z.array(TrackSchema)
+export const PasskeysSchema = z.object({
+	passkeys: z.array(PasskeySchema)
 });
 
-export type TopTracks = z.infer<typeof TopTracksSchema>;
+export type Passkeys = z.infer<typeof PasskeysSchema>;
+
+export const PasskeyCeremonySchema = z.object({
+	session_id: z.string(),
+	options: z.record(z.string(), z.unknown())
+});
+
+export type PasskeyCeremony = z.infer<typeof PasskeyCeremonySchema>;
@@ -0,0 +1,31 @@
+import type { PageServerLoad } from './$types';
+import { extractAuthCookies, wrapWithCredentials } from '@/http';
+import { getPasskeys } from '$lib/api/passkeys';
+import { redirect } from '@sveltejs/kit';
+import { HTTPError } from '@/api/errors';
+
+export const load: PageServerLoad = async ({ fetch, cookies }) => {
+	try {
+		const { accessToken, refreshToken, csrfToken } = extractAuthCookies(cookies);
+		if (!accessToken || !refreshToken || !csrfToken) {
+			console.debug(
+				'[passkeys] access token, refresh token, and csrf tokens are required - missing one of them, skipping auth'
+			);
+			return redirect(303, '/login');
+		}
+		const fetchFn = wrapWithCredentials(fetch, accessToken, refreshToken, csrfToken);
+
+		console.debug('[passkeys] getting passkeys');
+		const passkeys = await getPasskeys(fetchFn);
+		return { passkeys };
+	} catch (e) {
+		console.error('[passkeys] failed to get passkeys', e);
+		if (e instanceof HTTPError) {
+			if (e.statusCode === 401) {
+				console.debug('[passkeys] redirecting user to login');
+				redirect(302, '/login');
+			}
+		}
+		throw e;
+	}
+};
@@ -5,8 +5,20 @@ import {
 } from '@/auth';
 import type { PageServerLoad } from './$types';
 import { redirect } from '@sveltejs/kit';
+import { extractAuthCookies, wrapServer } from '@/http';
+import { logout } from '@/api';
+
+export const load: PageServerLoad = async ({ fetch, cookies }) => {
+	const { refreshToken } = extractAuthCookies(cookies);
+	if (refreshToken) {
+		try {
+			// End the session on the server, so the refresh token can't be used again
+			await logout(wrapServer(fetch), refreshToken);
+		} catch (e) {
+			console.error('[logout] failed to end session', e);
+		}
+	}
 
-export const load: PageServerLoad = async ({ cookies }) => {
 	cookies.delete(ACCESS_TOKEN_COOKIE_NAME, { path: '/' });
 	cookies.delete(REFRESH_TOKEN_COOKIE_NAME, { path: '/' });
 	cookies.delete(CSRF_TOKEN_COOKIE_NAME, { path: '/' });